@@ -41,6 +41,8 @@ func Run() {
 	cmd.AddCommand(factory.Build(commands.Push))
 	cmd.AddCommand(factory.Build(commands.Pull))
 	cmd.AddCommand(factory.Build(commands.App))
+	cmd.AddCommand(factory.Build(commands.Deployments))
+	cmd.AddCommand(factory.Build(commands.Drafts))
 	cmd.AddCommand(factory.Build(commands.User))
 	cmd.AddCommand(factory.Build(commands.Secrets))
 	cmd.AddCommand(factory.Build(commands.Logs))
@@ -0,0 +1,110 @@
+package e2e
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/fake"
+)
+
+// TestCLIWithFakeRealmServer is responsible for ensuring commands work end-to-end
+// against a Realm server, using the in-memory fake so that no network access is required
+func TestCLIWithFakeRealmServer(t *testing.T) {
+	server := fake.NewRealmServer()
+	defer server.Close()
+
+	groupID := server.GroupIDs()[0]
+
+	tmpDir, err := ioutil.TempDir("", "realm-cli-e2e")
+	assert.Nil(t, err)
+	defer os.RemoveAll(tmpDir)
+
+	bin := filepath.Join(tmpDir, "realm-cli")
+	build := exec.Command("go", "build", "-o", bin, "../main.go")
+	if out, err := build.CombinedOutput(); err != nil {
+		t.Fatalf("failed to build the cli: %s\n%s", err, out)
+	}
+
+	homeDir := filepath.Join(tmpDir, "home")
+	workDir := filepath.Join(tmpDir, "work")
+	assert.Nil(t, os.MkdirAll(homeDir, 0755))
+	assert.Nil(t, os.MkdirAll(workDir, 0755))
+
+	run := func(t *testing.T, dir string, args ...string) string {
+		t.Helper()
+
+		cmd := exec.Command(bin, append(args, "--realm-url", server.URL, "--telemetry", "off", "-y")...)
+		cmd.Dir = dir
+		cmd.Env = append(os.Environ(), "HOME="+homeDir)
+
+		out, err := cmd.CombinedOutput()
+		if err != nil {
+			t.Fatalf("failed to run %s: %s\n%s", strings.Join(args, " "), err, out)
+		}
+		return string(out)
+	}
+
+	t.Run("should log in", func(t *testing.T) {
+		out := run(t, workDir, "login", "--api-key", "publicKey", "--private-api-key", "privateKey")
+		assert.True(t, strings.Contains(out, "Successfully logged in"), "unexpected output:\n%s", out)
+	})
+
+	appDir := filepath.Join(workDir, "test-app")
+
+	t.Run("should create an app", func(t *testing.T) {
+		out := run(t, workDir, "app", "create", "--name", "test-app", "--project", groupID)
+		assert.True(t, strings.Contains(out, "Successfully created app"), "unexpected output:\n%s", out)
+	})
+
+	t.Run("should push a change to the app", func(t *testing.T) {
+		assert.Nil(t, ioutil.WriteFile(filepath.Join(appDir, "values", "value.json"), []byte(`{"name":"value","value":"data"}`), 0666))
+
+		out := run(t, appDir, "push")
+		assert.True(t, strings.Contains(out, "Successfully pushed app up"), "unexpected output:\n%s", out)
+		assert.True(t, strings.Contains(string(server.AppData(appID(t, server))), `"name":"value"`), "expected the value to be deployed")
+
+		out = run(t, appDir, "push")
+		assert.True(t, strings.Contains(out, "Deployed app is identical to proposed version, nothing to do"), "unexpected output:\n%s", out)
+	})
+
+	t.Run("should pull the app", func(t *testing.T) {
+		out := run(t, appDir, "pull", "--local", filepath.Join(workDir, "pulled"), "--project", groupID)
+		assert.True(t, strings.Contains(out, "Successfully pulled app down"), "unexpected output:\n%s", out)
+
+		data, err := ioutil.ReadFile(filepath.Join(workDir, "pulled", "values", "value.json"))
+		assert.Nil(t, err)
+		assert.True(t, strings.Contains(string(data), `"value": "data"`), "unexpected value file:\n%s", data)
+	})
+
+	t.Run("should create a secret", func(t *testing.T) {
+		out := run(t, appDir, "secrets", "create", "--name", "secret", "--value", "shh")
+		assert.True(t, strings.Contains(out, "Successfully created secret"), "unexpected output:\n%s", out)
+
+		value, _ := server.SecretValue(appID(t, server), "secret")
+		assert.Equal(t, "shh", value)
+	})
+
+	t.Run("should delete the app", func(t *testing.T) {
+		run(t, workDir, "app", "delete", "--app", "test-app", "--project", groupID)
+
+		apps := run(t, workDir, "app", "list")
+		assert.True(t, strings.Contains(apps, "No available apps to show"), "unexpected output:\n%s", apps)
+	})
+}
+
+func appID(t *testing.T, server *fake.RealmServer) string {
+	t.Helper()
+
+	for _, app := range server.Apps() {
+		if app.Name == "test-app" {
+			return app.ID
+		}
+	}
+	t.Fatal("failed to find the test app")
+	return ""
+}
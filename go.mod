@@ -11,7 +11,7 @@ require (
 	github.com/edaniels/digest v0.0.0-20170923160545-b81e9c4ee11c
 	github.com/edaniels/golinters v0.0.3
 	github.com/fatih/color v1.10.0
-	github.com/fsnotify/fsnotify v1.4.9 // indirect
+	github.com/fsnotify/fsnotify v1.4.9
 	github.com/golangci/golangci-lint v1.32.2
 	github.com/google/go-cmp v0.5.2
 	github.com/hinshun/vt10x v0.0.0-20180616224451-1954e6464174
@@ -28,8 +28,9 @@ require (
 	github.com/xdg/stringprep v0.0.0-20180714160509-73f8eece6fdc // indirect
 	github.com/xtgo/uuid v0.0.0-20140804021211-a0b114877d4c // indirect
 	go.mongodb.org/mongo-driver v1.5.1
+	golang.org/x/crypto v0.0.0-20200622213623-75b288015ac9
 	gopkg.in/segmentio/analytics-go.v3 v3.1.0
-	gopkg.in/yaml.v2 v2.4.0 // indirect
+	gopkg.in/yaml.v2 v2.4.0
 )
 
 replace github.com/edaniels/golinters => github.com/mongodb-forks/golinters v0.0.4
@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -24,7 +25,7 @@ import (
 // At any point should an error occur, command execution will terminate
 // and the ensuing steps will not be run
 type Command interface {
-	Handler(profile *user.Profile, ui terminal.UI, clients Clients) error
+	Handler(ctx context.Context, profile *user.Profile, ui terminal.UI, clients Clients) error
 }
 
 // Clients are the CLI clients
@@ -39,6 +40,12 @@ type CommandFlags interface {
 	Flags() []flags.Flag
 }
 
+// CommandFlagsChanged provides access for commands to know which of their local flags
+// were explicitly provided, as opposed to defaulted or set from the project config
+type CommandFlagsChanged interface {
+	SetFlagsChanged(changed map[string]bool)
+}
+
 // CommandInputs returns the command inputs
 type CommandInputs interface {
 	Inputs() InputResolver
@@ -1,12 +1,15 @@
 package cli
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
 	"net/http"
 	"os"
+	"os/signal"
 	"strings"
+	"syscall"
 	"time"
 
 	"github.com/10gen/realm-cli/internal/cli/feedback"
@@ -15,6 +18,7 @@ import (
 	"github.com/10gen/realm-cli/internal/cloud/realm"
 	"github.com/10gen/realm-cli/internal/telemetry"
 	"github.com/10gen/realm-cli/internal/terminal"
+	"github.com/10gen/realm-cli/internal/utils/api"
 	"github.com/10gen/realm-cli/internal/utils/flags"
 
 	"github.com/spf13/cobra"
@@ -30,6 +34,9 @@ type CommandFactory struct {
 	outWriter        *os.File
 	errWriter        *os.File
 	telemetryService telemetry.Service
+	verbose          bool
+	verboseTarget    string
+	traceWriter      *os.File
 }
 
 // NewCommandFactory creates a new command factory
@@ -92,18 +99,52 @@ func (factory *CommandFactory) Build(command CommandDefinition) *cobra.Command {
 				os.Exit(1)
 			}
 
+			if err := applyProjectConfig(c, factory.profile.WorkingDirectory); err != nil {
+				factory.ui.Print(terminal.NewErrorLog(err))
+				os.Exit(1)
+			}
+
+			baseTransport, err := api.NewBaseTransport(factory.profile.NetworkOptions())
+			if err != nil {
+				factory.ui.Print(terminal.NewErrorLog(err))
+				os.Exit(1)
+			}
+			api.DefaultTransport.Base = baseTransport
+
+			if factory.profile.NetworkOptions().InsecureSkipVerify {
+				factory.ui.Print(terminal.NewWarningLog("TLS certificate verification is disabled, so the servers this command connects to are not authenticated"))
+			}
+
+			if factory.verbose {
+				api.DefaultTransport.Base = api.NewTraceTransport(baseTransport, factory.traceWriter)
+			}
+
+			credentials, err := factory.profile.Credentials()
+			if err != nil {
+				factory.ui.Print(terminal.NewErrorLog(err))
+				os.Exit(1)
+			}
+
 			factory.telemetryService = telemetry.NewService(
 				factory.profile.Flags.TelemetryMode,
-				factory.profile.Credentials().PublicAPIKey,
+				credentials.PublicAPIKey,
 				display,
 				Version,
 			)
 
-			factory.checkForNewVersion(http.DefaultClient)
+			// the version check goes through the same proxy and CA bundle as every other request
+			factory.checkForNewVersion(&http.Client{Transport: api.DefaultTransport})
 		}
 
 		if command, ok := command.Command.(CommandInputs); ok {
 			cmd.PreRunE = func(c *cobra.Command, a []string) error {
+				if command, ok := command.(CommandFlagsChanged); ok {
+					changed := map[string]bool{}
+					c.LocalNonPersistentFlags().Visit(func(flag *pflag.Flag) {
+						changed[flag.Name] = true
+					})
+					command.SetFlagsChanged(changed)
+				}
 				if err := command.Inputs().Resolve(factory.profile, factory.ui); err != nil {
 					return feedback.WrapErr(display+" setup failed: %w", err)
 				}
@@ -122,12 +163,34 @@ func (factory *CommandFactory) Build(command CommandDefinition) *cobra.Command {
 				additionalFields...,
 			)
 
-			err := command.Command.Handler(factory.profile, factory.ui, Clients{
+			api.DefaultTransport.SetRetryOptions(factory.profile.RetryOptions())
+			api.DefaultTransport.SetTimeout(factory.profile.RequestTimeout())
+
+			credentials, err := factory.profile.Credentials()
+			if err != nil {
+				return feedback.WrapErr(display+" failed: %w", err, feedback.ErrNoUsage{})
+			}
+
+			ctx, cancel := interruptContext()
+			defer cancel()
+
+			err = command.Command.Handler(ctx, factory.profile, factory.ui, Clients{
 				Realm:        realm.NewAuthClient(factory.profile.RealmBaseURL(), factory.profile),
-				Atlas:        atlas.NewAuthClient(factory.profile.AtlasBaseURL(), factory.profile.Credentials()),
-				HostingAsset: http.DefaultClient,
+				Atlas:        atlas.NewAuthClient(factory.profile.AtlasBaseURL(), credentials),
+				HostingAsset: &http.Client{Transport: api.DefaultTransport},
 			})
 			if err != nil {
+				var exitCode feedback.ErrExitCode
+				if errors.As(err, &exitCode) {
+					factory.telemetryService.TrackEvent(
+						telemetry.EventTypeCommandComplete,
+						additionalFields...,
+					)
+					return exitCode
+				}
+				if ctx.Err() != nil && errors.Is(err, context.Canceled) {
+					err = errInterrupted
+				}
 				factory.telemetryService.TrackEvent(
 					telemetry.EventTypeCommandError,
 					append(additionalFields, telemetry.EventDataError(err)...)...,
@@ -155,6 +218,11 @@ func (factory *CommandFactory) Run(cmd *cobra.Command) int {
 		return 0
 	}
 
+	var exitCode feedback.ErrExitCode
+	if errors.As(err, &exitCode) {
+		return exitCode.Code
+	}
+
 	handleUsage(cmd, err)
 
 	if factory.ui == nil {
@@ -183,8 +251,20 @@ func (factory *CommandFactory) SetGlobalFlags(fs *pflag.FlagSet) {
 	fs.SortFlags = false // ensures global flags are added unsorted
 
 	// profile flags
-	fs.StringVar(&factory.profile.Name, user.FlagProfile, user.DefaultProfile, user.FlagProfileUsage)
+	fs.StringVar(&factory.profile.Name, user.FlagProfile, "", user.FlagProfileUsage)
 	fs.Var(&factory.profile.Flags.TelemetryMode, telemetry.FlagMode, telemetry.FlagModeUsage)
+	fs.Var(&factory.profile.Flags.CredentialStore, user.FlagCredentialStore, user.FlagCredentialStoreUsage)
+	fs.Var(&factory.profile.Flags.MaxRetries, user.FlagMaxRetries, user.FlagMaxRetriesUsage)
+	fs.Var(&factory.profile.Flags.RetryMaxWait, user.FlagRetryMaxWait, user.FlagRetryMaxWaitUsage)
+	fs.Var(&factory.profile.Flags.Timeout, user.FlagTimeout, user.FlagTimeoutUsage)
+
+	// network flags
+	fs.Var(&factory.profile.Flags.ProxyURL, user.FlagProxy, user.FlagProxyUsage)
+	fs.Var(&factory.profile.Flags.CABundle, user.FlagCABundle, user.FlagCABundleUsage)
+	fs.Var(&factory.profile.Flags.ClientCert, user.FlagClientCert, user.FlagClientCertUsage)
+	fs.Var(&factory.profile.Flags.ClientKey, user.FlagClientKey, user.FlagClientKeyUsage)
+	fs.Var(&factory.profile.Flags.InsecureSkipVerify, user.FlagInsecureSkipVerify, user.FlagInsecureSkipVerifyUsage)
+	fs.Lookup(user.FlagInsecureSkipVerify).NoOptDefVal = "true"
 
 	// ui flags
 	fs.StringVarP(&factory.uiConfig.OutputTarget, terminal.FlagOutputTarget, terminal.FlagOutputTargetShort, "", terminal.FlagOutputTargetUsage)
@@ -192,6 +272,10 @@ func (factory *CommandFactory) SetGlobalFlags(fs *pflag.FlagSet) {
 	fs.BoolVar(&factory.uiConfig.DisableColors, terminal.FlagDisableColors, false, terminal.FlagDisableColorsUsage)
 	fs.BoolVarP(&factory.uiConfig.AutoConfirm, terminal.FlagAutoConfirm, terminal.FlagAutoConfirmShort, false, terminal.FlagAutoConfirmUsage)
 
+	// trace flags
+	fs.BoolVar(&factory.verbose, api.FlagVerbose, false, api.FlagVerboseUsage)
+	fs.StringVar(&factory.verboseTarget, api.FlagVerboseTarget, "", api.FlagVerboseTargetUsage)
+
 	// hidden flags
 	fs.StringVar(&factory.profile.Flags.AtlasBaseURL, user.FlagAtlasBaseURL, "", user.FlagAtlasBaseURLUsage)
 	flags.MarkHidden(fs, user.FlagAtlasBaseURL)
@@ -202,6 +286,14 @@ func (factory *CommandFactory) SetGlobalFlags(fs *pflag.FlagSet) {
 
 // Setup initializes the command factory
 func (factory *CommandFactory) Setup() {
+	if factory.profile.Name == "" {
+		name, err := user.CurrentProfileName()
+		if err != nil {
+			log.Fatal(err)
+		}
+		factory.profile.Name = name
+	}
+
 	if err := factory.profile.Load(); err != nil {
 		log.Fatal(err)
 	}
@@ -213,6 +305,14 @@ func (factory *CommandFactory) Setup() {
 		}
 		factory.outWriter = f
 	}
+
+	if filepath := factory.verboseTarget; filepath != "" {
+		f, err := os.OpenFile(filepath, os.O_CREATE|os.O_RDWR|os.O_APPEND, 0600)
+		if err != nil {
+			log.Fatal(fmt.Errorf("failed to open verbose target file: %w", err))
+		}
+		factory.traceWriter = f
+	}
 }
 
 func (factory *CommandFactory) close() {
@@ -223,6 +323,10 @@ func (factory *CommandFactory) close() {
 	if factory.uiConfig.OutputTarget != "" {
 		factory.outWriter.Close()
 	}
+
+	if factory.verboseTarget != "" {
+		factory.traceWriter.Close()
+	}
 }
 
 func (factory *CommandFactory) checkForNewVersion(client VersionManifestClient) {
@@ -279,11 +383,38 @@ func (factory *CommandFactory) ensureUI() {
 		}
 	}
 
+	if factory.traceWriter == nil {
+		factory.traceWriter = os.Stderr
+	}
+
 	if factory.ui == nil {
 		factory.ui = terminal.NewUI(factory.uiConfig, factory.inReader, factory.outWriter, factory.errWriter)
 	}
 }
 
+var errInterrupted = errors.New("interrupted")
+
+// interruptContext returns a context which is cancelled once the process
+// receives an interrupt or termination signal, after which any further
+// signal is handled by the default behavior and terminates the process
+func interruptContext() (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, os.Interrupt, syscall.SIGTERM)
+
+	go func() {
+		defer signal.Stop(sigs)
+		select {
+		case <-sigs:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	return ctx, cancel
+}
+
 func handleUsage(cmd *cobra.Command, err error) {
 	var usageHider feedback.ErrUsageHider
 	if errors.As(err, &usageHider) {
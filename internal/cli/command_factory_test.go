@@ -8,10 +8,13 @@ import (
 	"testing"
 	"time"
 
+	"github.com/10gen/realm-cli/internal/cli/feedback"
 	"github.com/10gen/realm-cli/internal/telemetry"
 	"github.com/10gen/realm-cli/internal/utils/api"
 	"github.com/10gen/realm-cli/internal/utils/test/assert"
 	"github.com/10gen/realm-cli/internal/utils/test/mock"
+
+	"github.com/spf13/cobra"
 )
 
 type capturedEvent struct {
@@ -169,3 +172,24 @@ func (client mockVersionClient) Get(_ string) (*http.Response, error) {
 }`, version, OSArch, url))),
 	}, nil
 }
+
+func TestCommandFactoryRun(t *testing.T) {
+	t.Run("should exit with the exit code of the command without printing an error", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		factory := &CommandFactory{ui: ui}
+
+		cmd := &cobra.Command{
+			Use:           "test",
+			SilenceErrors: true,
+			SilenceUsage:  true,
+			RunE: func(c *cobra.Command, a []string) error {
+				return feedback.ErrExitCode{Code: 2}
+			},
+		}
+		cmd.SetArgs([]string{})
+
+		assert.Equal(t, 2, factory.Run(cmd))
+		assert.Equal(t, "", out.String())
+	})
+}
@@ -0,0 +1,34 @@
+package cli
+
+import (
+	"context"
+	"time"
+
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/terminal"
+)
+
+// WaitForDeployment will use the provided Realm client to poll the app deployment
+// until it is no longer created or pending, and returns the finished deployment
+func WaitForDeployment(ctx context.Context, ui terminal.UI, client realm.Client, groupID, appID string, deployment realm.AppDeployment, message string) (realm.AppDeployment, error) {
+	s := ui.Spinner(message, terminal.SpinnerOptions{})
+
+	s.Start()
+	defer s.Stop()
+
+	for deployment.Status == realm.DeploymentStatusCreated || deployment.Status == realm.DeploymentStatusPending {
+		select {
+		case <-ctx.Done():
+			return realm.AppDeployment{}, ctx.Err()
+		case <-time.After(time.Second):
+		}
+
+		var err error
+		deployment, err = client.Deployment(ctx, groupID, appID, deployment.ID)
+		if err != nil {
+			return realm.AppDeployment{}, err
+		}
+	}
+
+	return deployment, nil
+}
@@ -0,0 +1,67 @@
+package cli_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestWaitForDeployment(t *testing.T) {
+	t.Run("should return a finished deployment without polling", func(t *testing.T) {
+		_, ui := mock.NewUI()
+
+		deployment := realm.AppDeployment{ID: "id", Status: realm.DeploymentStatusFailed}
+
+		result, err := cli.WaitForDeployment(context.Background(), ui, mock.RealmClient{}, "groupID", "appID", deployment, "Deploying...")
+		assert.Nil(t, err)
+		assert.Equal(t, deployment, result)
+	})
+
+	t.Run("should poll the deployment until it finishes", func(t *testing.T) {
+		_, ui := mock.NewUI()
+
+		var capturedGroupID, capturedAppID, capturedDeploymentID string
+		realmClient := mock.RealmClient{}
+		realmClient.DeploymentFn = func(groupID, appID, deploymentID string) (realm.AppDeployment, error) {
+			capturedGroupID = groupID
+			capturedAppID = appID
+			capturedDeploymentID = deploymentID
+			return realm.AppDeployment{ID: deploymentID, Status: realm.DeploymentStatusSuccessful}, nil
+		}
+
+		result, err := cli.WaitForDeployment(context.Background(), ui, realmClient, "groupID", "appID", realm.AppDeployment{ID: "id", Status: realm.DeploymentStatusPending}, "Deploying...")
+		assert.Nil(t, err)
+		assert.Equal(t, realm.AppDeployment{ID: "id", Status: realm.DeploymentStatusSuccessful}, result)
+
+		assert.Equal(t, "groupID", capturedGroupID)
+		assert.Equal(t, "appID", capturedAppID)
+		assert.Equal(t, "id", capturedDeploymentID)
+	})
+
+	t.Run("should return an error when getting the deployment fails", func(t *testing.T) {
+		_, ui := mock.NewUI()
+
+		realmClient := mock.RealmClient{}
+		realmClient.DeploymentFn = func(groupID, appID, deploymentID string) (realm.AppDeployment, error) {
+			return realm.AppDeployment{}, errors.New("something bad happened")
+		}
+
+		_, err := cli.WaitForDeployment(context.Background(), ui, realmClient, "groupID", "appID", realm.AppDeployment{ID: "id", Status: realm.DeploymentStatusCreated}, "Deploying...")
+		assert.Equal(t, errors.New("something bad happened"), err)
+	})
+
+	t.Run("should stop polling when the context is cancelled", func(t *testing.T) {
+		_, ui := mock.NewUI()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := cli.WaitForDeployment(ctx, ui, mock.RealmClient{}, "groupID", "appID", realm.AppDeployment{ID: "id", Status: realm.DeploymentStatusPending}, "Deploying...")
+		assert.Equal(t, context.Canceled, err)
+	})
+}
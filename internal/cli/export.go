@@ -0,0 +1,38 @@
+package cli
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/local"
+)
+
+// ExportApp exports the Realm app into a temporary directory and loads it as a local app,
+// returning a cleanup function which removes the directory once the app is no longer needed
+func ExportApp(ctx context.Context, client realm.Client, groupID, appID string, configVersion realm.AppConfigVersion) (local.App, func(), error) {
+	_, zipPkg, err := client.Export(ctx, groupID, appID, realm.ExportRequest{ConfigVersion: configVersion})
+	if err != nil {
+		return local.App{}, nil, err
+	}
+
+	tmpDir, err := ioutil.TempDir("", "realm-cli-export")
+	if err != nil {
+		return local.App{}, nil, err
+	}
+	cleanup := func() { os.RemoveAll(tmpDir) }
+
+	if err := local.WriteZip(tmpDir, zipPkg); err != nil {
+		cleanup()
+		return local.App{}, nil, err
+	}
+
+	app, err := local.LoadApp(tmpDir)
+	if err != nil {
+		cleanup()
+		return local.App{}, nil, err
+	}
+
+	return app, cleanup, nil
+}
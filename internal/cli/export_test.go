@@ -0,0 +1,58 @@
+package cli
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/local"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestExportApp(t *testing.T) {
+	t.Run("should export the app into a temporary directory and load it", func(t *testing.T) {
+		buf := new(bytes.Buffer)
+		w := zip.NewWriter(buf)
+		f, err := w.Create(local.FileRealmConfig.String())
+		assert.Nil(t, err)
+		_, err = f.Write([]byte(`{"config_version": 20210101, "name": "eggcorn"}`))
+		assert.Nil(t, err)
+		assert.Nil(t, w.Close())
+
+		zipPkg, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+		assert.Nil(t, err)
+
+		var exportReq realm.ExportRequest
+		var realmClient mock.RealmClient
+		realmClient.ExportFn = func(groupID, appID string, req realm.ExportRequest) (string, *zip.Reader, error) {
+			exportReq = req
+			return "eggcorn_20210101", zipPkg, nil
+		}
+
+		app, cleanup, err := ExportApp(context.Background(), realmClient, "groupID", "appID", realm.AppConfigVersion20210101)
+		assert.Nil(t, err)
+
+		assert.Equal(t, realm.ExportRequest{ConfigVersion: realm.AppConfigVersion20210101}, exportReq)
+		assert.Equal(t, "eggcorn", app.Name())
+
+		cleanup()
+
+		_, err = os.Stat(app.RootDir)
+		assert.True(t, os.IsNotExist(err), "expected the exported app directory to be removed")
+	})
+
+	t.Run("should return an error when the export fails", func(t *testing.T) {
+		var realmClient mock.RealmClient
+		realmClient.ExportFn = func(groupID, appID string, req realm.ExportRequest) (string, *zip.Reader, error) {
+			return "", nil, errors.New("something bad happened")
+		}
+
+		_, _, err := ExportApp(context.Background(), realmClient, "groupID", "appID", realm.AppConfigVersion20210101)
+		assert.Equal(t, errors.New("something bad happened"), err)
+	})
+}
@@ -19,6 +19,16 @@ type ErrLinkReferrer interface {
 	ReferenceLinks() []interface{}
 }
 
+// ErrExitCode exits the CLI with the provided exit code, without reporting an error
+// It is returned by commands whose exit code signals their result, such as a diff finding changes
+type ErrExitCode struct {
+	Code int
+}
+
+func (err ErrExitCode) Error() string {
+	return fmt.Sprintf("exit code %d", err.Code)
+}
+
 // NewErr returns a new CLI error
 func NewErr(cause error, details ...ErrDetail) error {
 	var d ErrDetails
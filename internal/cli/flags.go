@@ -10,8 +10,14 @@ import (
 const (
 	appFlagUsage = "Specify the name or ID of a Realm app"
 
+	// AppFlagName is the '--app' flag name
+	AppFlagName = "app"
+
 	// ProjectFlagName is the '--project' flag name
 	ProjectFlagName = "project"
+
+	// ProductFlagName is the '--product' flag name
+	ProductFlagName = "product"
 )
 
 // AppFlag is the '--app' flag
@@ -29,7 +35,7 @@ func AppFlagWithDescription(value *string, description string) flags.Flag {
 	return flags.StringFlag{
 		Value: value,
 		Meta: flags.Meta{
-			Name:      "app",
+			Name:      AppFlagName,
 			Shorthand: "a",
 			Usage: flags.Usage{
 				Description: description,
@@ -71,7 +77,7 @@ func ProductFlag(value *[]string) flags.Flag {
 	return flags.StringSliceFlag{
 		Value: value,
 		Meta: flags.Meta{
-			Name: "product",
+			Name: ProductFlagName,
 			Usage: flags.Usage{
 				Description:   `Specify the Realm app product(s)`,
 				AllowedValues: []string{`"standard"`, `"atlas"`},
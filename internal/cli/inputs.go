@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
@@ -77,16 +78,16 @@ type AppOptions struct {
 }
 
 // ResolveApp will use the provided Realm client to resolve the app specified by the options
-func ResolveApp(ui terminal.UI, client realm.Client, opts AppOptions) (realm.App, error) {
+func ResolveApp(ctx context.Context, ui terminal.UI, client realm.Client, opts AppOptions) (realm.App, error) {
 	// if no flags were set and the optional app meta file is present
 	if opts.Filter.GroupID == "" && opts.Filter.App == "" && opts.AppMeta.ConfigVersion != 0 {
 		if opts.FetchDetails {
-			return client.FindApp(opts.AppMeta.GroupID, opts.AppMeta.AppID)
+			return client.FindApp(ctx, opts.AppMeta.GroupID, opts.AppMeta.AppID)
 		}
 		return realm.App{ID: opts.AppMeta.AppID, GroupID: opts.AppMeta.GroupID}, nil
 	}
 
-	apps, err := client.FindApps(opts.Filter)
+	apps, err := client.FindApps(ctx, opts.Filter)
 	if err != nil {
 		return realm.App{}, err
 	}
@@ -116,8 +117,8 @@ func ResolveApp(ui terminal.UI, client realm.Client, opts AppOptions) (realm.App
 }
 
 // ResolveGroupID will use the provided MongoDB Cloud Atlas client to resolve the user's group id
-func ResolveGroupID(ui terminal.UI, client atlas.Client) (string, error) {
-	groups, groupsErr := atlas.AllGroups(client)
+func ResolveGroupID(ctx context.Context, ui terminal.UI, client atlas.Client) (string, error) {
+	groups, groupsErr := atlas.AllGroups(ctx, client)
 	if groupsErr != nil {
 		return "", groupsErr
 	}
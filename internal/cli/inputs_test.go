@@ -1,6 +1,7 @@
 package cli_test
 
 import (
+	"context"
 	"errors"
 	"os"
 	"path/filepath"
@@ -204,7 +205,7 @@ func TestResolveApp(t *testing.T) {
 
 			inputs := cli.ProjectInputs{Project: tc.groupID, App: tc.appID, AppMeta: tc.appMeta}
 
-			app, err := cli.ResolveApp(ui, realmClient, cli.AppOptions{
+			app, err := cli.ResolveApp(context.Background(), ui, realmClient, cli.AppOptions{
 				Filter:       inputs.Filter(),
 				AppMeta:      tc.appMeta,
 				FetchDetails: tc.fetchDetails,
@@ -226,7 +227,7 @@ func TestResolveApp(t *testing.T) {
 			return nil, errors.New("something bad happened")
 		}
 
-		_, err := cli.ResolveApp(nil, realmClient, cli.AppOptions{})
+		_, err := cli.ResolveApp(context.Background(), nil, realmClient, cli.AppOptions{})
 		assert.Equal(t, errors.New("something bad happened"), err)
 	})
 }
@@ -280,7 +281,7 @@ func TestResolveGroupID(t *testing.T) {
 				defer close(doneCh)
 				tc.procedure(console)
 			}()
-			groupID, err := cli.ResolveGroupID(ui, atlasClient)
+			groupID, err := cli.ResolveGroupID(context.Background(), ui, atlasClient)
 
 			console.Tty().Close() // flush the writers
 			<-doneCh              // wait for procedure to complete
@@ -296,7 +297,7 @@ func TestResolveGroupID(t *testing.T) {
 			return atlas.Groups{}, errors.New("something bad happened")
 		}
 
-		_, err := cli.ResolveGroupID(nil, atlasClient)
+		_, err := cli.ResolveGroupID(context.Background(), nil, atlasClient)
 		assert.Equal(t, errors.New("something bad happened"), err)
 	})
 }
@@ -0,0 +1,72 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/local"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	// the name of the flag which selects the environment of the project config to apply
+	flagEnv = "env"
+
+	// the name of the flag which selects the local app directory
+	flagLocal = "local"
+)
+
+// applyProjectConfig sets any command flags not explicitly provided to the defaults
+// found in the project config of the app containing the local app directory,
+// or the working directory when there is none
+func applyProjectConfig(cmd *cobra.Command, wd string) error {
+	path := wd
+	if flag := cmd.LocalNonPersistentFlags().Lookup(flagLocal); flag != nil && flag.Changed && flag.Value.String() != "" {
+		path = flag.Value.String()
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(wd, path)
+		}
+	}
+
+	app, ok, err := local.FindApp(path)
+	if err != nil {
+		var errProjectConfig local.ErrProjectConfig
+		if errors.As(err, &errProjectConfig) {
+			return err
+		}
+		return nil // commands which require the app will surface any other errors
+	}
+	if !ok || app.ProjectConfig == nil {
+		return nil
+	}
+
+	var environment string
+	if app.AppData != nil {
+		environment = string(app.Environment())
+	}
+	if flag := cmd.LocalNonPersistentFlags().Lookup(flagEnv); flag != nil && flag.Changed {
+		if env, ok := flag.Value.(*realm.Environment); ok {
+			environment = string(*env) // the selected environment takes precedence over the app's
+		}
+	}
+
+	command := strings.TrimPrefix(cmd.CommandPath(), cmd.Root().Name()+" ")
+
+	fs := cmd.LocalNonPersistentFlags()
+	for name, values := range app.ProjectConfig.FlagDefaults(command, environment) {
+		flag := fs.Lookup(name)
+		if flag == nil || flag.Changed {
+			continue
+		}
+		for _, value := range values {
+			if err := flag.Value.Set(value); err != nil {
+				return fmt.Errorf("failed to apply project config at %s: invalid value for flag '%s': %w", app.RootDir, name, err)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,142 @@
+package cli
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/local"
+	u "github.com/10gen/realm-cli/internal/utils/test"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+
+	"github.com/spf13/cobra"
+)
+
+func TestApplyProjectConfig(t *testing.T) {
+	tmpDir, teardown, err := u.NewTempDir("project")
+	assert.Nil(t, err)
+	defer teardown()
+
+	assert.Nil(t, ioutil.WriteFile(
+		filepath.Join(tmpDir, local.FileRealmConfig.String()),
+		[]byte(`{"config_version": 20210101, "name": "test", "environment": "production"}`),
+		0666,
+	))
+	assert.Nil(t, ioutil.WriteFile(
+		filepath.Join(tmpDir, local.FileProjectConfig.String()),
+		[]byte(`flags:
+  project: abc
+commands:
+  push:
+    remote: my-app-abcde
+    product: [atlas, cloud]
+environments:
+  production:
+    commands:
+      push:
+        include-hosting: true
+  development:
+    flags:
+      project: dev
+`),
+		0666,
+	))
+
+	setup := func(args ...string) (*cobra.Command, *testFlags) {
+		var tf testFlags
+
+		root := &cobra.Command{Use: Name}
+		cmd := &cobra.Command{Use: "push", Run: func(*cobra.Command, []string) {}}
+		cmd.Flags().StringVar(&tf.project, "project", "", "")
+		cmd.Flags().StringVar(&tf.remote, "remote", "", "")
+		cmd.Flags().StringSliceVar(&tf.products, "product", nil, "")
+		cmd.Flags().BoolVar(&tf.includeHosting, "include-hosting", false, "")
+		cmd.Flags().Var(&tf.environment, "env", "")
+		cmd.Flags().StringVar(&tf.local, "local", "", "")
+		root.AddCommand(cmd)
+
+		assert.Nil(t, cmd.ParseFlags(args))
+		return cmd, &tf
+	}
+
+	t.Run("should apply the project defaults for the command and environment", func(t *testing.T) {
+		cmd, tf := setup()
+
+		assert.Nil(t, applyProjectConfig(cmd, tmpDir))
+
+		assert.Equal(t, "abc", tf.project)
+		assert.Equal(t, "my-app-abcde", tf.remote)
+		assert.Equal(t, []string{"atlas", "cloud"}, tf.products)
+		assert.True(t, tf.includeHosting, "include hosting should be set")
+	})
+
+	t.Run("should not override explicitly provided flags", func(t *testing.T) {
+		cmd, tf := setup("--remote", "other-app", "--include-hosting=false")
+
+		assert.Nil(t, applyProjectConfig(cmd, tmpDir))
+
+		assert.Equal(t, "abc", tf.project)
+		assert.Equal(t, "other-app", tf.remote)
+		assert.False(t, tf.includeHosting, "include hosting should not be set")
+	})
+
+	t.Run("should apply the project defaults for the selected environment", func(t *testing.T) {
+		cmd, tf := setup("--env", "development")
+
+		assert.Nil(t, applyProjectConfig(cmd, tmpDir))
+
+		assert.Equal(t, "dev", tf.project)
+		assert.Equal(t, "my-app-abcde", tf.remote)
+		assert.False(t, tf.includeHosting, "include hosting should not be set")
+	})
+
+	t.Run("should do nothing outside of an app", func(t *testing.T) {
+		cmd, tf := setup()
+
+		assert.Nil(t, applyProjectConfig(cmd, filepath.Dir(tmpDir)))
+
+		assert.Equal(t, "", tf.project)
+	})
+
+	t.Run("should apply the project defaults of the local app directory", func(t *testing.T) {
+		cmd, tf := setup("--local", filepath.Base(tmpDir))
+
+		assert.Nil(t, applyProjectConfig(cmd, filepath.Dir(tmpDir)))
+
+		assert.Equal(t, "abc", tf.project)
+		assert.Equal(t, "my-app-abcde", tf.remote)
+	})
+
+	t.Run("should return an error with a malformed project config", func(t *testing.T) {
+		badDir, teardown, err := u.NewTempDir("project")
+		assert.Nil(t, err)
+		defer teardown()
+
+		assert.Nil(t, ioutil.WriteFile(
+			filepath.Join(badDir, local.FileRealmConfig.String()),
+			[]byte(`{"config_version": 20210101, "name": "test"}`),
+			0666,
+		))
+		assert.Nil(t, ioutil.WriteFile(filepath.Join(badDir, local.FileProjectConfig.String()), []byte("flags: [project"), 0666))
+
+		cmd, _ := setup()
+
+		err = applyProjectConfig(cmd, badDir)
+		assert.NotNil(t, err)
+		assert.True(t, strings.HasPrefix(
+			err.Error(),
+			"failed to load project config at "+filepath.Join(badDir, local.FileProjectConfig.String())+": ",
+		), "unexpected error: "+err.Error())
+	})
+}
+
+type testFlags struct {
+	project        string
+	remote         string
+	products       []string
+	includeHosting bool
+	environment    realm.Environment
+	local          string
+}
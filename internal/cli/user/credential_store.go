@@ -0,0 +1,120 @@
+package user
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/10gen/realm-cli/internal/utils/flags"
+)
+
+// set of supported credential store flags
+const (
+	FlagCredentialStore      = "credential-store"
+	FlagCredentialStoreUsage = `Specify where the secrets for your current profile are stored (Default value: "vault"; Allowed values: "vault", "keyring")`
+)
+
+// CredentialStoreType is the credential store type
+type CredentialStoreType string
+
+// String returns the string representation
+func (cst CredentialStoreType) String() string { return string(cst) }
+
+// Type returns the CredentialStoreType type
+func (cst CredentialStoreType) Type() string { return flags.TypeString }
+
+// Set validates and sets the credential store type value
+func (cst *CredentialStoreType) Set(val string) error {
+	storeType := CredentialStoreType(val)
+
+	if !isValidCredentialStoreType(storeType) {
+		allTypes := []string{string(CredentialStoreTypeVault), string(CredentialStoreTypeKeyring)}
+		return fmt.Errorf("unsupported value, use one of [%s] instead", strings.Join(allTypes, ", "))
+	}
+
+	*cst = storeType
+	return nil
+}
+
+// set of supported credential store types
+const (
+	CredentialStoreTypeEmpty   CredentialStoreType = "" // zero-valued to be flag's default
+	CredentialStoreTypeVault   CredentialStoreType = "vault"
+	CredentialStoreTypeKeyring CredentialStoreType = "keyring"
+)
+
+// resolved returns the credential store type with the default applied
+func (cst CredentialStoreType) resolved() CredentialStoreType {
+	if cst == CredentialStoreTypeEmpty {
+		return CredentialStoreTypeVault
+	}
+	return cst
+}
+
+func isValidCredentialStoreType(storeType CredentialStoreType) bool {
+	switch storeType {
+	case
+		CredentialStoreTypeEmpty,
+		CredentialStoreTypeVault,
+		CredentialStoreTypeKeyring:
+		return true
+	}
+	return false
+}
+
+// CredentialStore stores the sensitive values of CLI profiles
+// outside of the plaintext profile files
+type CredentialStore interface {
+	// Get returns the stored value for the profile and key,
+	// or an empty string if there is none
+	Get(profile, key string) (string, error)
+
+	// Set stages the value for the profile and key to be stored on the next Save,
+	// where an empty value removes the entry from the store
+	Set(profile, key, value string)
+
+	// Save persists all staged values
+	Save() error
+}
+
+// NewCredentialStore creates a new credential store of the specified type
+// which keeps any of its files in the provided directory, apart from any key files
+// which are kept in the CLI data directory instead
+func NewCredentialStore(storeType CredentialStoreType, dir string) (CredentialStore, error) {
+	switch storeType {
+	case CredentialStoreTypeEmpty, CredentialStoreTypeVault:
+		keyDir, err := dataDir()
+		if err != nil {
+			return nil, err
+		}
+		return newVaultStore(dir, keyDir), nil
+	case CredentialStoreTypeKeyring:
+		return newKeyringStore()
+	}
+	return nil, fmt.Errorf("unsupported credential store: %s", storeType)
+}
+
+// StoredCredentials returns the secret portion of the named profile's credentials
+// held by the specified credential store
+func StoredCredentials(storeType CredentialStoreType, profileName string) (Credentials, error) {
+	dir, err := HomeDir()
+	if err != nil {
+		return Credentials{}, err
+	}
+
+	store, err := NewCredentialStore(storeType, dir)
+	if err != nil {
+		return Credentials{}, err
+	}
+
+	privateAPIKey, err := store.Get(profileName, keyPrivateAPIKey)
+	if err != nil {
+		return Credentials{}, err
+	}
+
+	password, err := store.Get(profileName, keyPassword)
+	if err != nil {
+		return Credentials{}, err
+	}
+
+	return Credentials{PrivateAPIKey: privateAPIKey, Password: password}, nil
+}
@@ -7,7 +7,8 @@ import (
 )
 
 const (
-	servicePath = ".config/realm-cli"
+	servicePath     = ".config/realm-cli"
+	dataServicePath = ".local/share/realm-cli"
 )
 
 // HomeDir returns the CLI home directory
@@ -18,3 +19,13 @@ func HomeDir() (string, error) {
 	}
 	return fmt.Sprintf("%s/%s", home, servicePath), nil
 }
+
+// dataDir returns the CLI data directory, which is kept apart from the CLI home directory
+// so that the files kept in it are not copied or shared along with the CLI profiles
+func dataDir() (string, error) {
+	home, err := homedir.Dir()
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s/%s", home, dataServicePath), nil
+}
@@ -0,0 +1,95 @@
+package user
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+const (
+	keyringService = "realm-cli"
+	keyringTool    = "secret-tool"
+)
+
+var (
+	errKeyringUnavailable = errors.New("the OS keyring is unavailable, ensure " + keyringTool + " is installed or use the vault credential store instead")
+)
+
+// keyringStore is a credential store backed by the OS keyring
+// through the freedesktop.org Secret Service
+type keyringStore struct {
+	tool   string
+	staged map[keyringEntry]string
+}
+
+type keyringEntry struct {
+	profile string
+	key     string
+}
+
+func newKeyringStore() (*keyringStore, error) {
+	tool, err := exec.LookPath(keyringTool)
+	if err != nil {
+		return nil, errKeyringUnavailable
+	}
+	return &keyringStore{tool: tool, staged: map[keyringEntry]string{}}, nil
+}
+
+func (k *keyringStore) Get(profile, key string) (string, error) {
+	if value, ok := k.staged[keyringEntry{profile, key}]; ok {
+		return value, nil
+	}
+
+	var stdout, stderr bytes.Buffer
+
+	cmd := exec.Command(k.tool, append([]string{"lookup"}, keyringAttributes(profile, key)...)...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		if _, ok := err.(*exec.ExitError); ok && stderr.Len() == 0 {
+			return "", nil // secret-tool exits non-zero without output when no secret is found
+		}
+		return "", fmt.Errorf("failed to read from the OS keyring: %s", keyringErrMessage(err, stderr))
+	}
+	return stdout.String(), nil
+}
+
+func (k *keyringStore) Set(profile, key, value string) {
+	k.staged[keyringEntry{profile, key}] = value
+}
+
+func (k *keyringStore) Save() error {
+	for entry, value := range k.staged {
+		var cmd *exec.Cmd
+		if value == "" {
+			cmd = exec.Command(k.tool, append([]string{"clear"}, keyringAttributes(entry.profile, entry.key)...)...)
+		} else {
+			label := fmt.Sprintf("--label=%s %s (%s)", keyringService, entry.key, entry.profile)
+			cmd = exec.Command(k.tool, append([]string{"store", label}, keyringAttributes(entry.profile, entry.key)...)...)
+			cmd.Stdin = strings.NewReader(value)
+		}
+
+		var stderr bytes.Buffer
+		cmd.Stderr = &stderr
+
+		if err := cmd.Run(); err != nil {
+			return fmt.Errorf("failed to write to the OS keyring: %s", keyringErrMessage(err, stderr))
+		}
+		delete(k.staged, entry)
+	}
+	return nil
+}
+
+func keyringAttributes(profile, key string) []string {
+	return []string{"service", keyringService, "profile", profile, "key", key}
+}
+
+func keyringErrMessage(err error, stderr bytes.Buffer) string {
+	if msg := strings.TrimSpace(stderr.String()); msg != "" {
+		return msg
+	}
+	return err.Error()
+}
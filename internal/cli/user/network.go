@@ -0,0 +1,165 @@
+package user
+
+import (
+	"errors"
+	"net/url"
+	"path/filepath"
+	"strconv"
+
+	"github.com/10gen/realm-cli/internal/utils/api"
+	"github.com/10gen/realm-cli/internal/utils/flags"
+)
+
+// set of supported network flags
+const (
+	FlagProxy      = "proxy"
+	FlagProxyUsage = `Specify the URL of the HTTP proxy to send requests through for this command (Default value: the profile's proxy, otherwise the "HTTPS_PROXY" environment variable)`
+
+	FlagCABundle      = "ca-bundle"
+	FlagCABundleUsage = `Specify the path to a PEM file of additional root CA certificates to trust for this command (Default value: the profile's CA bundle)`
+
+	FlagClientCert      = "client-cert"
+	FlagClientCertUsage = `Specify the path to a PEM client certificate to present to servers for this command (Default value: the profile's client certificate)`
+
+	FlagClientKey      = "client-key"
+	FlagClientKeyUsage = `Specify the path to the PEM private key of the client certificate for this command (Default value: the profile's client key)`
+
+	FlagInsecureSkipVerify      = "insecure-skip-verify"
+	FlagInsecureSkipVerifyUsage = `Disable TLS certificate verification for this command, only use this with local test servers (Default value: false)`
+)
+
+// NetworkSettings are the CLI profile network settings, named after their flags
+var NetworkSettings = []string{
+	FlagProxy,
+	FlagCABundle,
+	FlagClientCert,
+	FlagClientKey,
+	FlagInsecureSkipVerify,
+	FlagMaxRetries,
+	FlagRetryMaxWait,
+	FlagTimeout,
+}
+
+var networkSettingKeys = map[string]string{
+	FlagProxy:              keyProxyURL,
+	FlagCABundle:           keyCABundle,
+	FlagClientCert:         keyClientCert,
+	FlagClientKey:          keyClientKey,
+	FlagInsecureSkipVerify: keyInsecureSkipVerify,
+	FlagMaxRetries:         keyMaxRetries,
+	FlagRetryMaxWait:       keyRetryMaxWait,
+	FlagTimeout:            keyTimeout,
+}
+
+// ProxyURL is the URL of the HTTP proxy to send requests through
+type ProxyURL string
+
+// String returns the string representation
+func (pu ProxyURL) String() string { return string(pu) }
+
+// Type returns the ProxyURL type
+func (pu ProxyURL) Type() string { return flags.TypeString }
+
+// Set validates and sets the proxy url value
+func (pu *ProxyURL) Set(val string) error {
+	if u, err := url.Parse(val); err != nil || u.Scheme == "" || u.Host == "" {
+		return errors.New(`unsupported value, use a URL (e.g. "http://proxy.example.com:8080") instead`)
+	}
+
+	*pu = ProxyURL(val)
+	return nil
+}
+
+// FilePath is the path to a file, which is saved as an absolute path
+// so it resolves the same regardless of the working directory
+type FilePath string
+
+// String returns the string representation
+func (fp FilePath) String() string { return string(fp) }
+
+// Type returns the FilePath type
+func (fp FilePath) Type() string { return flags.TypeString }
+
+// Set validates and sets the file path value
+func (fp *FilePath) Set(val string) error {
+	path, err := filepath.Abs(val)
+	if err != nil || val == "" {
+		return errors.New("unsupported value, use a file path instead")
+	}
+
+	*fp = FilePath(path)
+	return nil
+}
+
+// InsecureSkipVerify is whether or not TLS certificate verification is disabled
+type InsecureSkipVerify string
+
+// String returns the string representation
+func (isv InsecureSkipVerify) String() string { return string(isv) }
+
+// Type returns the InsecureSkipVerify type, which pflag displays as a bool flag without a value
+func (isv InsecureSkipVerify) Type() string { return "bool" }
+
+// Set validates and sets the insecure skip verify value
+func (isv *InsecureSkipVerify) Set(val string) error {
+	b, err := strconv.ParseBool(val)
+	if err != nil {
+		return errors.New("unsupported value, use one of [true, false] instead")
+	}
+
+	*isv = InsecureSkipVerify(strconv.FormatBool(b))
+	return nil
+}
+
+// NetworkSetting gets the specified CLI profile network setting
+func (p Profile) NetworkSetting(setting string) string {
+	return p.GetString(networkSettingKeys[setting])
+}
+
+// SetNetworkSetting sets the specified CLI profile network setting,
+// where an empty value unsets it
+func (p Profile) SetNetworkSetting(setting, value string) {
+	p.SetString(networkSettingKeys[setting], value)
+}
+
+// resolveNetworkFlags defaults any network flags not set for this command
+// to the CLI profile network settings, without saving the flags to the profile
+func (p *Profile) resolveNetworkFlags() {
+	if p.Flags.ProxyURL == "" {
+		p.Flags.ProxyURL = ProxyURL(p.NetworkSetting(FlagProxy))
+	}
+	if p.Flags.CABundle == "" {
+		p.Flags.CABundle = FilePath(p.NetworkSetting(FlagCABundle))
+	}
+	if p.Flags.ClientCert == "" {
+		p.Flags.ClientCert = FilePath(p.NetworkSetting(FlagClientCert))
+	}
+	if p.Flags.ClientKey == "" {
+		p.Flags.ClientKey = FilePath(p.NetworkSetting(FlagClientKey))
+	}
+	if p.Flags.InsecureSkipVerify == "" {
+		p.Flags.InsecureSkipVerify = InsecureSkipVerify(p.NetworkSetting(FlagInsecureSkipVerify))
+	}
+	if p.Flags.MaxRetries == "" {
+		p.Flags.MaxRetries = MaxRetries(p.NetworkSetting(FlagMaxRetries))
+	}
+	if p.Flags.RetryMaxWait == "" {
+		p.Flags.RetryMaxWait = RetryMaxWait(p.NetworkSetting(FlagRetryMaxWait))
+	}
+	if p.Flags.Timeout == "" {
+		p.Flags.Timeout = Timeout(p.NetworkSetting(FlagTimeout))
+	}
+}
+
+// NetworkOptions returns the CLI profile http network options
+func (p Profile) NetworkOptions() api.NetworkOptions {
+	insecureSkipVerify, _ := strconv.ParseBool(string(p.Flags.InsecureSkipVerify))
+
+	return api.NetworkOptions{
+		ProxyURL:           string(p.Flags.ProxyURL),
+		CABundle:           string(p.Flags.CABundle),
+		ClientCert:         string(p.Flags.ClientCert),
+		ClientKey:          string(p.Flags.ClientKey),
+		InsecureSkipVerify: insecureSkipVerify,
+	}
+}
@@ -31,7 +31,7 @@ const (
 const (
 	FlagProfile = "profile"
 	// TODO(REALMC-9249): add "[Learn more: http://docs.link]"
-	FlagProfileUsage = `Specify your profile (Default value: "default")`
+	FlagProfileUsage = `Specify your profile (Default value: the profile selected with "profiles use", otherwise "default")`
 
 	FlagAtlasBaseURL      = "atlas-url"
 	FlagAtlasBaseURLUsage = "specify the base Atlas server URL"
@@ -49,20 +49,37 @@ type Profile struct {
 	Name             string
 	WorkingDirectory string
 
-	dir string
-	fs  afero.Fs
+	dir    string
+	fs     afero.Fs
+	config *viper.Viper
+	store  CredentialStore
 }
 
 // Flags are the CLI profile flags
 type Flags struct {
-	AtlasBaseURL  string
-	RealmBaseURL  string
-	TelemetryMode telemetry.Mode
+	AtlasBaseURL    string
+	RealmBaseURL    string
+	TelemetryMode   telemetry.Mode
+	CredentialStore CredentialStoreType
+	MaxRetries      MaxRetries
+	RetryMaxWait    RetryMaxWait
+	Timeout         Timeout
+
+	ProxyURL           ProxyURL
+	CABundle           FilePath
+	ClientCert         FilePath
+	ClientKey          FilePath
+	InsecureSkipVerify InsecureSkipVerify
 }
 
-// NewDefaultProfile creates a new default CLI profile
+// NewDefaultProfile creates a new CLI profile for the current profile,
+// which is the default profile unless another has been selected for use
 func NewDefaultProfile() (*Profile, error) {
-	return NewProfile(DefaultProfile)
+	name, err := CurrentProfileName()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create CLI profile: %w", err)
+	}
+	return NewProfile(name)
 }
 
 // NewProfile creates a new CLI profile
@@ -72,6 +89,11 @@ func NewProfile(name string) (*Profile, error) {
 		return nil, fmt.Errorf("failed to create CLI profile: %w", dirErr)
 	}
 
+	keyDir, keyDirErr := dataDir()
+	if keyDirErr != nil {
+		return nil, fmt.Errorf("failed to create CLI profile: %w", keyDirErr)
+	}
+
 	wd, wdErr := os.Getwd()
 	if wdErr != nil {
 		return nil, fmt.Errorf("failed to get current working directory: %w", wdErr)
@@ -81,6 +103,8 @@ func NewProfile(name string) (*Profile, error) {
 		Name:             name,
 		dir:              dir,
 		fs:               afero.NewOsFs(),
+		config:           viper.New(),
+		store:            newVaultStore(dir, keyDir),
 		WorkingDirectory: wd,
 	}, nil
 }
@@ -92,12 +116,35 @@ func (p Profile) Clear(name string) {
 
 // SetString sets the specified CLI profile property
 func (p Profile) SetString(name, value string) {
-	viper.Set(p.propertyKey(name), value)
+	p.config.Set(p.propertyKey(name), value)
 }
 
 // GetString gets the specified CLI profile property
 func (p Profile) GetString(name string) string {
-	return viper.GetString(p.propertyKey(name))
+	return p.config.GetString(p.propertyKey(name))
+}
+
+// getSecret gets the specified CLI profile secret from the credential store,
+// falling back to any plaintext value left in the profile by older CLI versions
+func (p Profile) getSecret(name string) (string, error) {
+	if value := p.GetString(name); value != "" {
+		return value, nil
+	}
+	if p.store == nil {
+		return "", nil
+	}
+	return p.store.Get(p.Name, name)
+}
+
+// setSecret sets the specified CLI profile secret in the credential store
+// and removes any plaintext value from the profile
+func (p Profile) setSecret(name, value string) {
+	if p.store != nil {
+		p.store.Set(p.Name, name, value)
+		p.Clear(name)
+		return
+	}
+	p.SetString(name, value)
 }
 
 func (p Profile) propertyKey(name string) string {
@@ -105,21 +152,29 @@ func (p Profile) propertyKey(name string) string {
 }
 
 // Load loads the CLI profile
-func (p Profile) Load() error {
-	viper.SetConfigName(p.Name)
-	viper.AddConfigPath(p.dir)
-	viper.SetConfigPermissions(0600)
-	viper.SetConfigType(ProfileType)
+func (p *Profile) Load() error {
+	p.config.SetConfigName(p.Name)
+	p.config.AddConfigPath(p.dir)
+	p.config.SetConfigPermissions(0600)
+	p.config.SetConfigType(ProfileType)
 
-	viper.SetEnvPrefix(envPrefix)
-	viper.AutomaticEnv()
+	p.config.SetEnvPrefix(envPrefix)
+	p.config.AutomaticEnv()
 
-	if err := viper.ReadInConfig(); err != nil {
+	if err := p.config.ReadInConfig(); err != nil {
 		if _, ok := err.(viper.ConfigFileNotFoundError); ok {
 			return nil // proceed if profile doesn't exist
 		}
 		return fmt.Errorf("failed to load CLI profile: %s", err)
 	}
+
+	if storeType := p.CredentialStoreType(); storeType.resolved() != CredentialStoreTypeVault {
+		store, err := NewCredentialStore(storeType, p.dir)
+		if err != nil {
+			return fmt.Errorf("failed to load CLI profile: %s", err)
+		}
+		p.store = store
+	}
 	return nil
 }
 
@@ -136,7 +191,13 @@ func (p *Profile) Save() error {
 		}
 	}
 
-	if err := viper.WriteConfigAs(p.Path()); err != nil {
+	if p.store != nil {
+		if err := p.store.Save(); err != nil {
+			return fmt.Errorf("failed to save CLI profile: %s", err)
+		}
+	}
+
+	if err := p.config.WriteConfigAs(p.Path()); err != nil {
 		return fmt.Errorf("failed to save CLI profile: %s", err)
 	}
 	return nil
@@ -167,6 +228,19 @@ func (p *Profile) ResolveFlags() error {
 	}
 	p.SetAtlasBaseURL(p.Flags.AtlasBaseURL)
 
+	p.resolveNetworkFlags()
+
+	if p.Flags.CredentialStore == CredentialStoreTypeEmpty {
+		credentialStore := p.CredentialStoreType()
+		if credentialStore == CredentialStoreTypeEmpty {
+			credentialStore = CredentialStoreTypeVault
+		}
+		p.Flags.CredentialStore = credentialStore
+	}
+	if err := p.SetCredentialStoreType(p.Flags.CredentialStore); err != nil {
+		return err
+	}
+
 	return p.Save()
 }
 
@@ -193,57 +267,138 @@ const (
 	keyAtlasBaseURL     = "atlas_base_url"
 	keyTelemetryMode    = "telemetry_mode"
 	keyLastVersionCheck = "last_version_check"
+	keyCredentialStore  = "credential_store"
+	keyMaxRetries       = "max_retries"
+	keyRetryMaxWait     = "retry_max_wait"
+	keyTimeout          = "timeout"
+
+	keyProxyURL           = "proxy_url"
+	keyCABundle           = "ca_bundle"
+	keyClientCert         = "client_cert"
+	keyClientKey          = "client_key"
+	keyInsecureSkipVerify = "insecure_skip_verify"
 )
 
+// set of CLI profile auth keys held by the credential store
+var secretKeys = []string{keyPrivateAPIKey, keyPassword, keyAccessToken, keyRefreshToken}
+
 // TelemetryMode gets the CLI profile telemetry mode
 func (p Profile) TelemetryMode() telemetry.Mode {
 	return telemetry.Mode(p.GetString(keyTelemetryMode))
 }
 
+// CredentialStoreType gets the CLI profile credential store type
+func (p Profile) CredentialStoreType() CredentialStoreType {
+	return CredentialStoreType(p.GetString(keyCredentialStore))
+}
+
+// SetCredentialStoreType sets the CLI profile credential store type,
+// moving any secrets held by the previous credential store into the new one
+func (p *Profile) SetCredentialStoreType(storeType CredentialStoreType) error {
+	if p.store != nil && storeType.resolved() == p.CredentialStoreType().resolved() {
+		// verify the existing store is accessible so failures surface early
+		for _, key := range secretKeys {
+			if _, err := p.store.Get(p.Name, key); err != nil {
+				return err
+			}
+		}
+		p.SetString(keyCredentialStore, string(storeType))
+		return nil
+	}
+
+	store, err := NewCredentialStore(storeType, p.dir)
+	if err != nil {
+		return err
+	}
+
+	secrets := make(map[string]string, len(secretKeys))
+	for _, key := range secretKeys {
+		secret, err := p.getSecret(key)
+		if err != nil {
+			return err
+		}
+		secrets[key] = secret
+	}
+
+	if p.store != nil {
+		for _, key := range secretKeys {
+			p.store.Set(p.Name, key, "")
+		}
+		if err := p.store.Save(); err != nil {
+			return err
+		}
+	}
+
+	p.store = store
+	for key, value := range secrets {
+		p.setSecret(key, value)
+	}
+
+	p.SetString(keyCredentialStore, string(storeType))
+	return nil
+}
+
 // Credentials gets the CLI profile credentials
-func (p Profile) Credentials() Credentials {
+func (p Profile) Credentials() (Credentials, error) {
+	privateAPIKey, err := p.getSecret(keyPrivateAPIKey)
+	if err != nil {
+		return Credentials{}, err
+	}
+
+	password, err := p.getSecret(keyPassword)
+	if err != nil {
+		return Credentials{}, err
+	}
+
 	return Credentials{
 		PublicAPIKey:  p.GetString(keyPublicAPIKey),
-		PrivateAPIKey: p.GetString(keyPrivateAPIKey),
+		PrivateAPIKey: privateAPIKey,
 		Username:      p.GetString(keyUsername),
-		Password:      p.GetString(keyPassword),
-	}
+		Password:      password,
+	}, nil
 }
 
 // SetCredentials sets the CLI profile credentials
 func (p Profile) SetCredentials(creds Credentials) {
 	p.SetString(keyPublicAPIKey, creds.PublicAPIKey)
-	p.SetString(keyPrivateAPIKey, creds.PrivateAPIKey)
+	p.setSecret(keyPrivateAPIKey, creds.PrivateAPIKey)
 	p.SetString(keyUsername, creds.Username)
-	p.SetString(keyPassword, creds.Password)
+	p.setSecret(keyPassword, creds.Password)
 }
 
 // ClearCredentials clears the CLI profile credentials
 func (p Profile) ClearCredentials() {
 	p.Clear(keyPublicAPIKey)
-	p.Clear(keyPrivateAPIKey)
+	p.setSecret(keyPrivateAPIKey, "")
 	p.Clear(keyUsername)
-	p.Clear(keyPassword)
+	p.setSecret(keyPassword, "")
 }
 
 // Session gets the CLI profile session
-func (p Profile) Session() Session {
-	return Session{
-		p.GetString(keyAccessToken),
-		p.GetString(keyRefreshToken),
+func (p Profile) Session() (Session, error) {
+	accessToken, err := p.getSecret(keyAccessToken)
+	if err != nil {
+		return Session{}, err
 	}
+
+	refreshToken, err := p.getSecret(keyRefreshToken)
+	if err != nil {
+		return Session{}, err
+	}
+
+	return Session{accessToken, refreshToken}, nil
 }
 
 // SetSession sets the CLI profile session
 func (p Profile) SetSession(session Session) {
-	p.SetString(keyAccessToken, session.AccessToken)
-	p.SetString(keyRefreshToken, session.RefreshToken)
+	p.setSecret(keyAccessToken, session.AccessToken)
+	p.setSecret(keyRefreshToken, session.RefreshToken)
 }
 
 // ClearSession clears the CLI profile session
 func (p Profile) ClearSession() {
-	p.Clear(keyAccessToken)
-	p.Clear(keyRefreshToken)
+	p.setSecret(keyAccessToken, "")
+	p.setSecret(keyRefreshToken, "")
 }
 
 // RealmBaseURL gets the CLI profile Realm base url
@@ -1,8 +1,10 @@
 package user_test
 
 import (
+	"errors"
 	"fmt"
 	"io/ioutil"
+	"os"
 	"strings"
 	"testing"
 
@@ -55,3 +57,158 @@ func TestProfile(t *testing.T) {
 		assert.Equal(t, cachePath, profile.HostingAssetCachePath())
 	})
 }
+
+func TestProfileCredentialStore(t *testing.T) {
+	tmpDir, teardownTmpDir, tmpDirErr := u.NewTempDir("home")
+	assert.Nil(t, tmpDirErr)
+	defer teardownTmpDir()
+
+	_, teardownHomeDir := u.SetupHomeDir(tmpDir)
+	defer teardownHomeDir()
+
+	t.Run("Should keep secrets out of the profile and migrate existing plaintext secrets", func(t *testing.T) {
+		profile, err := user.NewProfile("credentials")
+		assert.Nil(t, err)
+
+		// simulate a profile written by an older CLI version
+		profile.SetString("public_api_key", "public")
+		profile.SetString("private_api_key", "private")
+		credentials, err := profile.Credentials()
+		assert.Nil(t, err)
+		assert.Equal(t, user.Credentials{PublicAPIKey: "public", PrivateAPIKey: "private"}, credentials)
+
+		profile.SetCredentials(credentials)
+		assert.Nil(t, profile.Save())
+
+		assert.Equal(t, "", profile.GetString("private_api_key"))
+		credentials, err = profile.Credentials()
+		assert.Nil(t, err)
+		assert.Equal(t, user.Credentials{PublicAPIKey: "public", PrivateAPIKey: "private"}, credentials)
+
+		out, err := ioutil.ReadFile(profile.Path())
+		assert.Nil(t, err)
+		assert.False(t, strings.Contains(string(out), "private_api_key: private"), "profile must not contain the private api key")
+
+		stored, err := user.StoredCredentials(user.CredentialStoreTypeVault, profile.Name)
+		assert.Nil(t, err)
+		assert.Equal(t, user.Credentials{PrivateAPIKey: "private"}, stored)
+	})
+
+	t.Run("Should fail to get the secrets of a profile when the vault cannot be unlocked", func(t *testing.T) {
+		lockedDir, teardownLockedDir, err := u.NewTempDir("home")
+		assert.Nil(t, err)
+		defer teardownLockedDir()
+
+		_, teardownLockedHomeDir := u.SetupHomeDir(lockedDir)
+		defer teardownLockedHomeDir()
+
+		os.Setenv(user.EnvVaultPassphrase, "passphrase")
+		defer os.Unsetenv(user.EnvVaultPassphrase)
+
+		profile, err := user.NewProfile("locked")
+		assert.Nil(t, err)
+
+		profile.SetCredentials(user.Credentials{PublicAPIKey: "public", PrivateAPIKey: "private"})
+		profile.SetSession(user.Session{"access", "refresh"})
+		assert.Nil(t, profile.Save())
+
+		os.Setenv(user.EnvVaultPassphrase, "wrong")
+
+		locked, err := user.NewProfile("locked")
+		assert.Nil(t, err)
+		assert.Nil(t, locked.Load())
+
+		_, err = locked.Credentials()
+		assert.Equal(t, errors.New("failed to unlock the credential vault, check the value of REALM_CLI_VAULT_PASSPHRASE"), err)
+
+		_, err = locked.Session()
+		assert.Equal(t, errors.New("failed to unlock the credential vault, check the value of REALM_CLI_VAULT_PASSPHRASE"), err)
+	})
+
+	t.Run("Should fail to resolve flags with an unavailable keyring", func(t *testing.T) {
+		origPath := os.Getenv("PATH")
+		os.Setenv("PATH", "")
+		defer os.Setenv("PATH", origPath)
+
+		profile, err := user.NewProfile("keyring")
+		assert.Nil(t, err)
+
+		profile.Flags.CredentialStore = user.CredentialStoreTypeKeyring
+
+		assert.NotNil(t, profile.ResolveFlags())
+	})
+
+	t.Run("Should load the keyring credential store for a profile configured to use it", func(t *testing.T) {
+		profile, err := user.NewProfile("keyring-load")
+		assert.Nil(t, err)
+
+		assert.Nil(t, os.MkdirAll(profile.Dir(), 0700))
+		assert.Nil(t, ioutil.WriteFile(profile.Path(), []byte("keyring-load:\n  credential_store: keyring\n"), 0600))
+
+		origPath := os.Getenv("PATH")
+		os.Setenv("PATH", "")
+		defer os.Setenv("PATH", origPath)
+
+		assert.NotNil(t, profile.Load())
+	})
+
+	t.Run("Should keep the profile and its secrets under the old name when a rename fails to save", func(t *testing.T) {
+		profile, err := user.NewProfile("rename")
+		assert.Nil(t, err)
+
+		profile.SetRealmBaseURL("https://realm-staging.mongodb.com")
+		profile.SetCredentials(user.Credentials{PublicAPIKey: "public", PrivateAPIKey: "private"})
+		assert.Nil(t, profile.Save())
+
+		renamed, err := user.NewProfile("renamed")
+		assert.Nil(t, err)
+
+		// a directory in place of the new profile file fails the save after the credential store is saved
+		assert.Nil(t, os.MkdirAll(renamed.Path(), 0700))
+
+		assert.NotNil(t, profile.Rename("renamed"))
+
+		assert.Equal(t, "rename", profile.Name)
+		assert.Equal(t, "https://realm-staging.mongodb.com", profile.RealmBaseURL())
+		credentials, credentialsErr := profile.Credentials()
+		assert.Nil(t, credentialsErr)
+		assert.Equal(t, user.Credentials{PublicAPIKey: "public", PrivateAPIKey: "private"}, credentials)
+
+		stored, err := user.StoredCredentials(user.CredentialStoreTypeVault, "rename")
+		assert.Nil(t, err)
+		assert.Equal(t, user.Credentials{PrivateAPIKey: "private"}, stored)
+
+		stored, err = user.StoredCredentials(user.CredentialStoreTypeVault, "renamed")
+		assert.Nil(t, err)
+		assert.Equal(t, user.Credentials{}, stored)
+	})
+}
+
+func TestCurrentProfileName(t *testing.T) {
+	tmpDir, teardownTmpDir, tmpDirErr := u.NewTempDir("home")
+	assert.Nil(t, tmpDirErr)
+	defer teardownTmpDir()
+
+	_, teardownHomeDir := u.SetupHomeDir(tmpDir)
+	defer teardownHomeDir()
+
+	t.Run("Should default to the default profile", func(t *testing.T) {
+		name, err := user.CurrentProfileName()
+		assert.Nil(t, err)
+		assert.Equal(t, user.DefaultProfile, name)
+	})
+
+	t.Run("Should persist the current profile and be respected by the default profile", func(t *testing.T) {
+		assert.Nil(t, user.SetCurrentProfileName("staging"))
+
+		profile, err := user.NewDefaultProfile()
+		assert.Nil(t, err)
+		assert.Equal(t, "staging", profile.Name)
+
+		assert.Nil(t, user.SetCurrentProfileName(user.DefaultProfile))
+
+		name, err := user.CurrentProfileName()
+		assert.Nil(t, err)
+		assert.Equal(t, user.DefaultProfile, name)
+	})
+}
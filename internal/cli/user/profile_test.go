@@ -1,9 +1,13 @@
 package user
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/10gen/realm-cli/internal/telemetry"
+	"github.com/10gen/realm-cli/internal/utils/api"
 	"github.com/10gen/realm-cli/internal/utils/test/assert"
 
 	"go.mongodb.org/mongo-driver/bson/primitive"
@@ -56,3 +60,154 @@ func TestProfileResolveFlags(t *testing.T) {
 		assert.Equal(t, "https://cloud-dev.mongodb.com", profile.AtlasBaseURL())
 	})
 }
+
+func TestProfileRetryOptions(t *testing.T) {
+	t.Run("should use the default retry options if flags are empty", func(t *testing.T) {
+		profile, err := NewProfile(primitive.NewObjectID().Hex())
+		assert.Nil(t, err)
+
+		assert.Nil(t, profile.ResolveFlags())
+
+		assert.Equal(t, api.DefaultRetryOptions, profile.RetryOptions())
+	})
+
+	t.Run("should use flags to set the retry options without saving them in the profile", func(t *testing.T) {
+		profile, err := NewProfile(primitive.NewObjectID().Hex())
+		assert.Nil(t, err)
+
+		assert.Nil(t, profile.Flags.MaxRetries.Set("5"))
+		assert.Nil(t, profile.Flags.RetryMaxWait.Set("250ms"))
+
+		assert.Nil(t, profile.ResolveFlags())
+
+		assert.Equal(t, api.RetryOptions{
+			MaxRetries: 5,
+			MinBackoff: 250 * time.Millisecond,
+			MaxBackoff: 250 * time.Millisecond,
+		}, profile.RetryOptions())
+		assert.Equal(t, "", profile.GetString(keyMaxRetries))
+		assert.Equal(t, "", profile.GetString(keyRetryMaxWait))
+	})
+
+	t.Run("should use the profile settings for any retry flags not set", func(t *testing.T) {
+		profile, err := NewProfile(primitive.NewObjectID().Hex())
+		assert.Nil(t, err)
+
+		profile.SetNetworkSetting(FlagMaxRetries, "5")
+		profile.SetNetworkSetting(FlagRetryMaxWait, "250ms")
+
+		assert.Nil(t, profile.Flags.MaxRetries.Set("1"))
+
+		assert.Nil(t, profile.ResolveFlags())
+
+		assert.Equal(t, api.RetryOptions{
+			MaxRetries: 1,
+			MinBackoff: 250 * time.Millisecond,
+			MaxBackoff: 250 * time.Millisecond,
+		}, profile.RetryOptions())
+		assert.Equal(t, "5", profile.NetworkSetting(FlagMaxRetries))
+	})
+
+	t.Run("should fail to set invalid retry flags", func(t *testing.T) {
+		var maxRetries MaxRetries
+		assert.NotNil(t, maxRetries.Set("-1"))
+
+		var retryMaxWait RetryMaxWait
+		assert.NotNil(t, retryMaxWait.Set("forever"))
+	})
+}
+
+func TestProfileRequestTimeout(t *testing.T) {
+	t.Run("should use the default timeout if the flag is empty", func(t *testing.T) {
+		profile, err := NewProfile(primitive.NewObjectID().Hex())
+		assert.Nil(t, err)
+
+		assert.Nil(t, profile.ResolveFlags())
+
+		assert.Equal(t, api.DefaultTimeout, profile.RequestTimeout())
+	})
+
+	t.Run("should use the flag to set the timeout without saving it in the profile", func(t *testing.T) {
+		profile, err := NewProfile(primitive.NewObjectID().Hex())
+		assert.Nil(t, err)
+
+		assert.Nil(t, profile.Flags.Timeout.Set("0s"))
+
+		assert.Nil(t, profile.ResolveFlags())
+
+		assert.Equal(t, time.Duration(0), profile.RequestTimeout())
+		assert.Equal(t, "", profile.GetString(keyTimeout))
+	})
+
+	t.Run("should use the profile timeout if the flag is not set", func(t *testing.T) {
+		profile, err := NewProfile(primitive.NewObjectID().Hex())
+		assert.Nil(t, err)
+
+		profile.SetNetworkSetting(FlagTimeout, "1m")
+
+		assert.Nil(t, profile.ResolveFlags())
+
+		assert.Equal(t, time.Minute, profile.RequestTimeout())
+	})
+
+	t.Run("should fail to set an invalid timeout", func(t *testing.T) {
+		var timeout Timeout
+		assert.NotNil(t, timeout.Set("-1m"))
+	})
+}
+
+func TestProfileNetworkOptions(t *testing.T) {
+	t.Run("should use flags to set the network options without saving them in the profile", func(t *testing.T) {
+		profile, err := NewProfile(primitive.NewObjectID().Hex())
+		assert.Nil(t, err)
+
+		wd, err := os.Getwd()
+		assert.Nil(t, err)
+
+		assert.Nil(t, profile.Flags.ProxyURL.Set("http://proxy.example.com:8080"))
+		assert.Nil(t, profile.Flags.CABundle.Set("ca.pem"))
+		assert.Nil(t, profile.Flags.InsecureSkipVerify.Set("true"))
+
+		assert.Nil(t, profile.ResolveFlags())
+
+		assert.Equal(t, api.NetworkOptions{
+			ProxyURL:           "http://proxy.example.com:8080",
+			CABundle:           filepath.Join(wd, "ca.pem"),
+			InsecureSkipVerify: true,
+		}, profile.NetworkOptions())
+		assert.Equal(t, "", profile.GetString(keyProxyURL))
+		assert.Equal(t, "", profile.GetString(keyCABundle))
+		assert.Equal(t, "", profile.GetString(keyInsecureSkipVerify))
+	})
+
+	t.Run("should use the profile network settings for any network flags not set", func(t *testing.T) {
+		profile, err := NewProfile(primitive.NewObjectID().Hex())
+		assert.Nil(t, err)
+
+		profile.SetNetworkSetting(FlagProxy, "http://proxy.example.com:8080")
+		profile.SetNetworkSetting(FlagClientCert, "/certs/client.pem")
+		profile.SetNetworkSetting(FlagClientKey, "/certs/client.key")
+
+		assert.Nil(t, profile.Flags.ProxyURL.Set("http://other-proxy.example.com:8080"))
+
+		assert.Nil(t, profile.ResolveFlags())
+
+		assert.Equal(t, api.NetworkOptions{
+			ProxyURL:   "http://other-proxy.example.com:8080",
+			ClientCert: "/certs/client.pem",
+			ClientKey:  "/certs/client.key",
+		}, profile.NetworkOptions())
+		assert.Equal(t, "http://proxy.example.com:8080", profile.NetworkSetting(FlagProxy))
+
+		profile.SetNetworkSetting(FlagProxy, "")
+		assert.Equal(t, "", profile.GetString(keyProxyURL))
+	})
+
+	t.Run("should fail to set invalid network flags", func(t *testing.T) {
+		var proxyURL ProxyURL
+		assert.NotNil(t, proxyURL.Set("proxy.example.com"))
+
+		var insecureSkipVerify InsecureSkipVerify
+		assert.NotNil(t, insecureSkipVerify.Set("sometimes"))
+	})
+}
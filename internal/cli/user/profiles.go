@@ -0,0 +1,224 @@
+package user
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+const (
+	currentProfileFile = "current_profile"
+)
+
+// CurrentProfileName returns the name of the profile selected for use,
+// or the default profile name if none has been selected
+func CurrentProfileName() (string, error) {
+	dir, err := HomeDir()
+	if err != nil {
+		return "", err
+	}
+
+	data, err := ioutil.ReadFile(filepath.Join(dir, currentProfileFile))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return DefaultProfile, nil
+		}
+		return "", fmt.Errorf("failed to read current profile: %w", err)
+	}
+
+	name := strings.TrimSpace(string(data))
+	if name == "" {
+		return DefaultProfile, nil
+	}
+	return name, nil
+}
+
+// SetCurrentProfileName persists the name of the profile selected for use
+func SetCurrentProfileName(name string) error {
+	dir, err := HomeDir()
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return fmt.Errorf("failed to set current profile: %w", err)
+	}
+
+	if name == DefaultProfile {
+		if err := os.Remove(filepath.Join(dir, currentProfileFile)); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("failed to set current profile: %w", err)
+		}
+		return nil
+	}
+
+	if err := ioutil.WriteFile(filepath.Join(dir, currentProfileFile), []byte(name+"\n"), 0600); err != nil {
+		return fmt.Errorf("failed to set current profile: %w", err)
+	}
+	return nil
+}
+
+// ProfileNames returns the names of all profiles saved in the CLI home directory
+func ProfileNames() ([]string, error) {
+	dir, err := HomeDir()
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		filename := entry.Name()
+		if ext := filepath.Ext(filename); ext == "."+ProfileType {
+			names = append(names, filename[0:len(filename)-len(ext)])
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// ValidateProfileName validates the profile name can be used as a filename
+func ValidateProfileName(name string) error {
+	if name == "" {
+		return errors.New("profile name cannot be empty")
+	}
+	if strings.ContainsAny(name, `/\.`) || strings.TrimSpace(name) != name {
+		return fmt.Errorf("invalid profile name: %s, profile names cannot contain whitespace, '.', '/' or '\\'", name)
+	}
+	return nil
+}
+
+// Exists returns true if the CLI profile has been saved
+func (p Profile) Exists() (bool, error) {
+	if _, err := p.fs.Stat(p.Path()); err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// Rename renames the CLI profile, moving its saved settings, secrets and hosting asset cache
+func (p *Profile) Rename(name string) error {
+	if err := ValidateProfileName(name); err != nil {
+		return err
+	}
+
+	oldName, oldConfig, oldPath, oldCachePath := p.Name, p.config, p.Path(), p.HostingAssetCachePath()
+
+	// viper keys are case-insensitive and always returned lowercased
+	prefix := strings.ToLower(oldName) + "."
+
+	config := viper.New()
+	for _, key := range p.config.AllKeys() {
+		if strings.HasPrefix(key, prefix) {
+			config.Set(name+"."+strings.TrimPrefix(key, prefix), p.config.Get(key))
+		}
+	}
+
+	secrets := make(map[string]string, len(secretKeys))
+	for _, key := range secretKeys {
+		secret, err := p.getSecret(key)
+		if err != nil {
+			return fmt.Errorf("failed to rename CLI profile: %w", err)
+		}
+		secrets[key] = secret
+	}
+	for key := range secrets {
+		p.setSecret(key, "")
+	}
+
+	p.Name = name
+	p.config = config
+
+	for key, value := range secrets {
+		p.setSecret(key, value)
+	}
+
+	if err := p.Save(); err != nil {
+		// the credential store may have been saved before the profile failed to be,
+		// so the secrets are moved back under the old name and saved again
+		for key := range secrets {
+			p.setSecret(key, "")
+		}
+
+		p.Name = oldName
+		p.config = oldConfig
+
+		for key, value := range secrets {
+			p.setSecret(key, value)
+		}
+
+		if p.store != nil {
+			if storeErr := p.store.Save(); storeErr != nil {
+				return fmt.Errorf("failed to rename CLI profile: %w, and failed to restore its secrets: %s", err, storeErr)
+			}
+		}
+		return fmt.Errorf("failed to rename CLI profile: %w", err)
+	}
+
+	if err := p.fs.Remove(oldPath); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to rename CLI profile: %w", err)
+	}
+
+	if err := p.fs.Rename(oldCachePath, p.HostingAssetCachePath()); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to rename CLI profile: %w", err)
+	}
+
+	current, err := CurrentProfileName()
+	if err != nil {
+		return err
+	}
+	if current == oldName {
+		return SetCurrentProfileName(name)
+	}
+	return nil
+}
+
+// Delete deletes the CLI profile along with its secrets and hosting asset cache
+func (p *Profile) Delete() error {
+	for _, key := range secretKeys {
+		p.setSecret(key, "")
+	}
+
+	if p.store != nil {
+		if err := p.store.Save(); err != nil {
+			return fmt.Errorf("failed to delete CLI profile: %w", err)
+		}
+	}
+
+	if err := p.fs.Remove(p.Path()); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to delete CLI profile: %w", err)
+	}
+
+	if err := p.fs.Remove(p.HostingAssetCachePath()); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to delete CLI profile: %w", err)
+	}
+
+	p.config = viper.New()
+
+	current, err := CurrentProfileName()
+	if err != nil {
+		return err
+	}
+	if current == p.Name {
+		return SetCurrentProfileName(DefaultProfile)
+	}
+	return nil
+}
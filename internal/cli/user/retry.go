@@ -0,0 +1,127 @@
+package user
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/10gen/realm-cli/internal/utils/api"
+	"github.com/10gen/realm-cli/internal/utils/flags"
+)
+
+// set of supported request flags
+const (
+	FlagMaxRetries      = "max-retries"
+	FlagMaxRetriesUsage = `Specify the maximum number of times a failed request is retried for this command (Default value: the profile's max retries, otherwise 3)`
+
+	FlagRetryMaxWait      = "retry-max-wait"
+	FlagRetryMaxWaitUsage = `Specify the maximum time to wait before retrying a failed request for this command (Default value: the profile's retry max wait, otherwise "30s")`
+
+	FlagTimeout      = "timeout"
+	FlagTimeoutUsage = `Specify the maximum time to wait for a request to complete for this command, where "0s" disables the timeout (Default value: the profile's timeout, otherwise "5m")`
+)
+
+// MaxRetries is the maximum number of times a failed request is retried
+type MaxRetries string
+
+// String returns the string representation
+func (mr MaxRetries) String() string { return string(mr) }
+
+// Type returns the MaxRetries type
+func (mr MaxRetries) Type() string { return flags.TypeInt }
+
+// Set validates and sets the max retries value
+func (mr *MaxRetries) Set(val string) error {
+	if _, err := parseMaxRetries(val); err != nil {
+		return err
+	}
+
+	*mr = MaxRetries(val)
+	return nil
+}
+
+func parseMaxRetries(val string) (int, error) {
+	n, err := strconv.Atoi(val)
+	if err != nil || n < 0 {
+		return 0, errors.New("unsupported value, use a non-negative integer instead")
+	}
+	return n, nil
+}
+
+// RetryMaxWait is the maximum time to wait before retrying a failed request
+type RetryMaxWait string
+
+// String returns the string representation
+func (rmw RetryMaxWait) String() string { return string(rmw) }
+
+// Type returns the RetryMaxWait type
+func (rmw RetryMaxWait) Type() string { return flags.TypeString }
+
+// Set validates and sets the retry max wait value
+func (rmw *RetryMaxWait) Set(val string) error {
+	if _, err := parseDuration(val); err != nil {
+		return err
+	}
+
+	*rmw = RetryMaxWait(val)
+	return nil
+}
+
+// Timeout is the maximum time to wait for a request to complete
+type Timeout string
+
+// String returns the string representation
+func (t Timeout) String() string { return string(t) }
+
+// Type returns the Timeout type
+func (t Timeout) Type() string { return flags.TypeString }
+
+// Set validates and sets the timeout value
+func (t *Timeout) Set(val string) error {
+	if _, err := parseDuration(val); err != nil {
+		return err
+	}
+
+	*t = Timeout(val)
+	return nil
+}
+
+func parseDuration(val string) (time.Duration, error) {
+	d, err := time.ParseDuration(val)
+	if err != nil || d < 0 {
+		return 0, errors.New(`unsupported value, use a non-negative duration (e.g. "30s") instead`)
+	}
+	return d, nil
+}
+
+// RetryOptions returns the CLI profile http retry options
+func (p Profile) RetryOptions() api.RetryOptions {
+	options := api.DefaultRetryOptions
+
+	if p.Flags.MaxRetries != "" {
+		if n, err := parseMaxRetries(string(p.Flags.MaxRetries)); err == nil {
+			options.MaxRetries = n
+		}
+	}
+
+	if p.Flags.RetryMaxWait != "" {
+		if d, err := parseDuration(string(p.Flags.RetryMaxWait)); err == nil {
+			options.MaxBackoff = d
+			if options.MinBackoff > d {
+				options.MinBackoff = d
+			}
+		}
+	}
+
+	return options
+}
+
+// RequestTimeout returns the CLI profile http request timeout
+func (p Profile) RequestTimeout() time.Duration {
+	if p.Flags.Timeout != "" {
+		if d, err := parseDuration(string(p.Flags.Timeout)); err == nil {
+			return d
+		}
+	}
+	return api.DefaultTimeout
+}
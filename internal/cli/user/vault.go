@@ -0,0 +1,296 @@
+package user
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	// EnvVaultPassphrase is the environment variable used to derive the vault key
+	// When unset, a randomly generated key file kept in the CLI data directory is used instead,
+	// which protects the vault only from those who cannot read the files of the current user
+	EnvVaultPassphrase = "REALM_CLI_VAULT_PASSPHRASE"
+
+	vaultFile    = "credentials.vault"
+	vaultKeyFile = ".vault-key"
+	vaultVersion = 1
+	vaultKeySize = 32
+
+	scryptN = 1 << 15
+	scryptR = 8
+	scryptP = 1
+)
+
+var (
+	errVaultLocked = errors.New("failed to unlock the credential vault, check the value of " + EnvVaultPassphrase)
+)
+
+// vaultStore is a credential store backed by an AES-GCM encrypted file
+type vaultStore struct {
+	dir     string
+	keyDir  string
+	loaded  bool
+	salt    []byte
+	entries map[string]map[string]string
+	staged  map[string]map[string]string
+}
+
+type vaultPayload struct {
+	Version int    `json:"version"`
+	Salt    []byte `json:"salt"`
+	Nonce   []byte `json:"nonce"`
+	Data    []byte `json:"data"`
+}
+
+func newVaultStore(dir, keyDir string) *vaultStore {
+	return &vaultStore{
+		dir:     dir,
+		keyDir:  keyDir,
+		entries: map[string]map[string]string{},
+		staged:  map[string]map[string]string{},
+	}
+}
+
+func (v *vaultStore) path() string {
+	return filepath.Join(v.dir, vaultFile)
+}
+
+func (v *vaultStore) Get(profile, key string) (string, error) {
+	if value, ok := v.staged[profile][key]; ok {
+		return value, nil
+	}
+	if err := v.load(); err != nil {
+		return "", err
+	}
+	return v.entries[profile][key], nil
+}
+
+func (v *vaultStore) Set(profile, key, value string) {
+	if _, ok := v.staged[profile]; !ok {
+		v.staged[profile] = map[string]string{}
+	}
+	v.staged[profile][key] = value
+}
+
+func (v *vaultStore) Save() error {
+	if len(v.staged) == 0 {
+		return nil
+	}
+
+	if err := v.load(); err != nil {
+		return err
+	}
+
+	var changed bool
+	for profile, values := range v.staged {
+		for key, value := range values {
+			if v.entries[profile][key] == value {
+				continue
+			}
+			changed = true
+
+			if value == "" {
+				delete(v.entries[profile], key)
+				if len(v.entries[profile]) == 0 {
+					delete(v.entries, profile)
+				}
+				continue
+			}
+
+			if _, ok := v.entries[profile]; !ok {
+				v.entries[profile] = map[string]string{}
+			}
+			v.entries[profile][key] = value
+		}
+	}
+	v.staged = map[string]map[string]string{}
+
+	if !changed {
+		return nil
+	}
+
+	if len(v.salt) == 0 {
+		v.salt = make([]byte, 16)
+		if _, err := io.ReadFull(rand.Reader, v.salt); err != nil {
+			return err
+		}
+	}
+
+	key, err := v.key(true)
+	if err != nil {
+		return err
+	}
+
+	gcm, err := newGCM(key)
+	if err != nil {
+		return err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return err
+	}
+
+	plaintext, err := json.Marshal(v.entries)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(vaultPayload{
+		Version: vaultVersion,
+		Salt:    v.salt,
+		Nonce:   nonce,
+		Data:    gcm.Seal(nil, nonce, plaintext, nil),
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(v.dir, 0700); err != nil {
+		return fmt.Errorf("failed to save credential vault: %w", err)
+	}
+	if err := ioutil.WriteFile(v.path(), data, 0600); err != nil {
+		return fmt.Errorf("failed to save credential vault: %w", err)
+	}
+	return nil
+}
+
+func (v *vaultStore) load() error {
+	if v.loaded {
+		return nil
+	}
+
+	data, err := ioutil.ReadFile(v.path())
+	if err != nil {
+		if os.IsNotExist(err) {
+			v.loaded = true
+			return nil
+		}
+		return fmt.Errorf("failed to read credential vault: %w", err)
+	}
+
+	var payload vaultPayload
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return fmt.Errorf("failed to read credential vault: %w", err)
+	}
+	if payload.Version != vaultVersion {
+		return fmt.Errorf("failed to read credential vault: unsupported version %d", payload.Version)
+	}
+	v.salt = payload.Salt
+
+	key, err := v.key(false)
+	if err != nil {
+		return err
+	}
+
+	gcm, err := newGCM(key)
+	if err != nil {
+		return err
+	}
+
+	plaintext, err := gcm.Open(nil, payload.Nonce, payload.Data, nil)
+	if err != nil {
+		return errVaultLocked
+	}
+
+	entries := map[string]map[string]string{}
+	if err := json.Unmarshal(plaintext, &entries); err != nil {
+		return fmt.Errorf("failed to read credential vault: %w", err)
+	}
+
+	v.entries = entries
+	v.loaded = true
+	return nil
+}
+
+// key derives the vault key from the passphrase set in the environment
+// or otherwise reads it from the key file, optionally generating the key file
+// if it does not exist yet
+func (v *vaultStore) key(create bool) ([]byte, error) {
+	if passphrase := os.Getenv(EnvVaultPassphrase); passphrase != "" {
+		return scrypt.Key([]byte(passphrase), v.salt, scryptN, scryptR, scryptP, vaultKeySize)
+	}
+
+	path := filepath.Join(v.keyDir, vaultKeyFile)
+
+	key, err := readVaultKey(path)
+	if err != nil {
+		return nil, err
+	}
+	if key != nil {
+		return key, nil
+	}
+
+	// older CLI versions kept the key file alongside the vault, from where it is moved
+	legacyPath := filepath.Join(v.dir, vaultKeyFile)
+
+	key, err = readVaultKey(legacyPath)
+	if err != nil {
+		return nil, err
+	}
+	if key != nil {
+		if err := writeVaultKey(path, key); err != nil {
+			return nil, err
+		}
+		if err := os.Remove(legacyPath); err != nil {
+			return nil, fmt.Errorf("failed to move credential vault key: %w", err)
+		}
+		return key, nil
+	}
+
+	if !create {
+		return nil, errVaultLocked
+	}
+
+	key = make([]byte, vaultKeySize)
+	if _, err := io.ReadFull(rand.Reader, key); err != nil {
+		return nil, err
+	}
+	if err := writeVaultKey(path, key); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// readVaultKey reads the key file at the path, returning no key if it does not exist
+func readVaultKey(path string) ([]byte, error) {
+	key, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read credential vault key: %w", err)
+	}
+	if len(key) != vaultKeySize {
+		return nil, errVaultLocked
+	}
+	return key, nil
+}
+
+func writeVaultKey(path string, key []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return fmt.Errorf("failed to save credential vault key: %w", err)
+	}
+	if err := ioutil.WriteFile(path, key, 0600); err != nil {
+		return fmt.Errorf("failed to save credential vault key: %w", err)
+	}
+	return nil
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
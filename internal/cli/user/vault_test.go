@@ -0,0 +1,133 @@
+package user
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+)
+
+func TestVaultStore(t *testing.T) {
+	t.Run("should return empty values when the vault does not exist", func(t *testing.T) {
+		tmpDir, err := ioutil.TempDir("", "vault")
+		assert.Nil(t, err)
+		defer os.RemoveAll(tmpDir)
+
+		keyDir := filepath.Join(tmpDir, "data")
+
+		store := newVaultStore(tmpDir, keyDir)
+
+		value, err := store.Get("default", keyPrivateAPIKey)
+		assert.Nil(t, err)
+		assert.Equal(t, "", value)
+
+		assert.Nil(t, store.Save())
+
+		_, err = os.Stat(filepath.Join(tmpDir, vaultFile))
+		assert.True(t, os.IsNotExist(err), "vault must not be written without changes")
+	})
+
+	t.Run("should persist encrypted values across stores", func(t *testing.T) {
+		tmpDir, err := ioutil.TempDir("", "vault")
+		assert.Nil(t, err)
+		defer os.RemoveAll(tmpDir)
+
+		keyDir := filepath.Join(tmpDir, "data")
+
+		store := newVaultStore(tmpDir, keyDir)
+		store.Set("default", keyPrivateAPIKey, "my-private-key")
+		store.Set("other", keyPassword, "my-password")
+		assert.Nil(t, store.Save())
+
+		data, err := ioutil.ReadFile(filepath.Join(tmpDir, vaultFile))
+		assert.Nil(t, err)
+		assert.False(t, strings.Contains(string(data), "my-private-key"), "vault must not contain plaintext secrets")
+
+		info, err := os.Stat(filepath.Join(keyDir, vaultKeyFile))
+		assert.Nil(t, err)
+		assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+		_, err = os.Stat(filepath.Join(tmpDir, vaultKeyFile))
+		assert.True(t, os.IsNotExist(err), "key file must not be written alongside the vault")
+
+		reloaded := newVaultStore(tmpDir, keyDir)
+
+		value, err := reloaded.Get("default", keyPrivateAPIKey)
+		assert.Nil(t, err)
+		assert.Equal(t, "my-private-key", value)
+
+		value, err = reloaded.Get("other", keyPassword)
+		assert.Nil(t, err)
+		assert.Equal(t, "my-password", value)
+
+		t.Run("and remove values set to empty", func(t *testing.T) {
+			reloaded.Set("default", keyPrivateAPIKey, "")
+			assert.Nil(t, reloaded.Save())
+
+			value, err := newVaultStore(tmpDir, keyDir).Get("default", keyPrivateAPIKey)
+			assert.Nil(t, err)
+			assert.Equal(t, "", value)
+
+			value, err = newVaultStore(tmpDir, keyDir).Get("other", keyPassword)
+			assert.Nil(t, err)
+			assert.Equal(t, "my-password", value)
+		})
+	})
+
+	t.Run("should move a key file kept alongside the vault by older versions", func(t *testing.T) {
+		tmpDir, err := ioutil.TempDir("", "vault")
+		assert.Nil(t, err)
+		defer os.RemoveAll(tmpDir)
+
+		keyDir := filepath.Join(tmpDir, "data")
+
+		legacy := newVaultStore(tmpDir, tmpDir)
+		legacy.Set("default", keyPrivateAPIKey, "my-private-key")
+		assert.Nil(t, legacy.Save())
+
+		value, err := newVaultStore(tmpDir, keyDir).Get("default", keyPrivateAPIKey)
+		assert.Nil(t, err)
+		assert.Equal(t, "my-private-key", value)
+
+		_, err = os.Stat(filepath.Join(tmpDir, vaultKeyFile))
+		assert.True(t, os.IsNotExist(err), "key file must be moved from alongside the vault")
+
+		value, err = newVaultStore(tmpDir, keyDir).Get("default", keyPrivateAPIKey)
+		assert.Nil(t, err)
+		assert.Equal(t, "my-private-key", value)
+	})
+
+	t.Run("with a passphrase set", func(t *testing.T) {
+		tmpDir, err := ioutil.TempDir("", "vault")
+		assert.Nil(t, err)
+		defer os.RemoveAll(tmpDir)
+
+		keyDir := filepath.Join(tmpDir, "data")
+
+		os.Setenv(EnvVaultPassphrase, "passphrase")
+		defer os.Unsetenv(EnvVaultPassphrase)
+
+		store := newVaultStore(tmpDir, keyDir)
+		store.Set("default", keyAccessToken, "my-access-token")
+		assert.Nil(t, store.Save())
+
+		_, err = os.Stat(filepath.Join(keyDir, vaultKeyFile))
+		assert.True(t, os.IsNotExist(err), "key file must not be written when using a passphrase")
+
+		t.Run("should read values with the same passphrase", func(t *testing.T) {
+			value, err := newVaultStore(tmpDir, keyDir).Get("default", keyAccessToken)
+			assert.Nil(t, err)
+			assert.Equal(t, "my-access-token", value)
+		})
+
+		t.Run("should fail to read values with a different passphrase", func(t *testing.T) {
+			os.Setenv(EnvVaultPassphrase, "wrong")
+
+			_, err := newVaultStore(tmpDir, keyDir).Get("default", keyAccessToken)
+			assert.Equal(t, errVaultLocked, err)
+		})
+	})
+}
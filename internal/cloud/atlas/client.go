@@ -1,6 +1,7 @@
 package atlas
 
 import (
+	"context"
 	"encoding/json"
 	"net"
 	"net/http"
@@ -22,13 +23,13 @@ const (
 
 // Client is a MongoDB Cloud Atlas client
 type Client interface {
-	Groups(url string, useBaseURL bool) (Groups, error)
+	Groups(ctx context.Context, url string, useBaseURL bool) (Groups, error)
 
-	Clusters(groupID string) ([]Cluster, error)
-	ServerlessInstances(groupID string) ([]ServerlessInstance, error)
-	Datalakes(groupID string) ([]Datalake, error)
+	Clusters(ctx context.Context, groupID string) ([]Cluster, error)
+	ServerlessInstances(ctx context.Context, groupID string) ([]ServerlessInstance, error)
+	Datalakes(ctx context.Context, groupID string) ([]Datalake, error)
 
-	Status() error
+	Status(ctx context.Context) error
 }
 
 // NewClient returns a new MongoDB Cloud Atlas client
@@ -38,9 +39,12 @@ func NewClient(baseURL string) Client {
 
 // NewAuthClient returns a new authenticated MongoDB Cloud Atlas client
 func NewAuthClient(baseURL string, creds user.Credentials) Client {
+	transport := digest.NewTransport(creds.PublicAPIKey, creds.PrivateAPIKey)
+	transport.Transport = api.DefaultTransport
+
 	return &client{
 		baseURL:   baseURL,
-		transport: digest.NewTransport(creds.PublicAPIKey, creds.PrivateAPIKey),
+		transport: transport,
 	}
 }
 
@@ -49,25 +53,19 @@ type client struct {
 	transport *digest.Transport
 }
 
-func (c *client) doWithBaseURL(method, path string, options api.RequestOptions) (*http.Response, error) {
-	return c.doWithURL(method, c.baseURL+path, options)
+func (c *client) doWithBaseURL(ctx context.Context, method, path string, options api.RequestOptions) (*http.Response, error) {
+	return c.doWithURL(ctx, method, c.baseURL+path, options)
 }
 
-func (c *client) doWithURL(method, url string, options api.RequestOptions) (*http.Response, error) {
-	req, reqErr := http.NewRequest(method, url, options.Body)
+func (c *client) doWithURL(ctx context.Context, method, url string, options api.RequestOptions) (*http.Response, error) {
+	req, reqErr := api.NewRequest(ctx, method, url, options)
 	if reqErr != nil {
 		return nil, reqErr
 	}
 
-	api.IncludeQuery(req, options.Query)
-
 	req.Header.Set(userAgentHeader, cliHeaderValue)
 
-	if options.ContentType != "" {
-		req.Header.Set(api.HeaderContentType, options.ContentType)
-	}
-
-	client := &http.Client{}
+	client := &http.Client{Transport: api.DefaultTransport}
 	client.Timeout = time.Second * 20
 
 	if c.transport == nil {
@@ -80,6 +78,9 @@ func (c *client) doWithURL(method, url string, options api.RequestOptions) (*htt
 
 	res, resErr := client.Do(req)
 	if resErr != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, ctxErr
+		}
 		if netErr, ok := resErr.(net.Error); ok && netErr.Timeout() {
 			return nil, errServerError{"request timed out after " + client.Timeout.String()}
 		}
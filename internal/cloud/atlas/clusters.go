@@ -1,6 +1,7 @@
 package atlas
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -23,8 +24,9 @@ const (
 	clustersPattern = atlasAPI + "/groups/%s/clusters"
 )
 
-func (c *client) Clusters(groupID string) ([]Cluster, error) {
+func (c *client) Clusters(ctx context.Context, groupID string) ([]Cluster, error) {
 	res, err := c.doWithBaseURL(
+		ctx,
 		http.MethodGet,
 		fmt.Sprintf(clustersPattern, groupID),
 		api.RequestOptions{},
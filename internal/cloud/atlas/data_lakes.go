@@ -1,6 +1,7 @@
 package atlas
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -18,8 +19,9 @@ const (
 	datalakesPattern = atlasAPI + "/groups/%s/dataLakes"
 )
 
-func (c *client) Datalakes(groupID string) ([]Datalake, error) {
+func (c *client) Datalakes(ctx context.Context, groupID string) ([]Datalake, error) {
 	res, err := c.doWithBaseURL(
+		ctx,
 		http.MethodGet,
 		fmt.Sprintf(datalakesPattern, groupID),
 		api.RequestOptions{},
@@ -1,6 +1,7 @@
 package atlas_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/10gen/realm-cli/internal/cli/user"
@@ -29,7 +30,7 @@ func TestDatalakes(t *testing.T) {
 		},
 	} {
 		t.Run(tc.description, func(t *testing.T) {
-			_, err := tc.client.Datalakes(u.CloudGroupID())
+			_, err := tc.client.Datalakes(context.Background(), u.CloudGroupID())
 			assert.Equal(t, tc.expectedErr, err)
 		})
 	}
@@ -37,7 +38,7 @@ func TestDatalakes(t *testing.T) {
 	t.Run("with an authenticated client should return the list of atlas data lakes", func(t *testing.T) {
 		client := newAuthClient(t)
 
-		datalakes, err := client.Datalakes(u.CloudGroupID())
+		datalakes, err := client.Datalakes(context.Background(), u.CloudGroupID())
 		assert.Nil(t, err)
 		assert.Equal(t, u.CloudAtlasDatalakeCount(), len(datalakes))
 	})
@@ -1,6 +1,7 @@
 package atlas
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 
@@ -31,11 +32,12 @@ type Groups struct {
 	Links   []Link  `json:"links"`
 }
 
-func (c *client) Groups(url string, useBaseURL bool) (Groups, error) {
+func (c *client) Groups(ctx context.Context, url string, useBaseURL bool) (Groups, error) {
 	if useBaseURL {
 		url = c.baseURL + url
 	}
 	res, err := c.doWithURL(
+		ctx,
 		http.MethodGet,
 		url,
 		api.RequestOptions{},
@@ -56,25 +58,25 @@ func (c *client) Groups(url string, useBaseURL bool) (Groups, error) {
 }
 
 // AllGroups fetches all atlas groups
-func AllGroups(c Client) ([]Group, error) {
-	groups, err := c.Groups(groupsPath, true)
+func AllGroups(ctx context.Context, c Client) ([]Group, error) {
+	groups, err := c.Groups(ctx, groupsPath, true)
 	if err != nil {
 		return nil, err
 	}
-	return fetchNextGroups(c, groups)
+	return fetchNextGroups(ctx, c, groups)
 }
 
-func fetchNextGroups(c Client, groups Groups) ([]Group, error) {
+func fetchNextGroups(ctx context.Context, c Client, groups Groups) ([]Group, error) {
 	allGroups := groups.Results
 	for _, link := range groups.Links {
 		if link.Rel != linkRelNext {
 			continue
 		}
-		res, err := c.Groups(link.Href, false)
+		res, err := c.Groups(ctx, link.Href, false)
 		if err != nil {
 			return nil, err
 		}
-		nextGroups, err := fetchNextGroups(c, res)
+		nextGroups, err := fetchNextGroups(ctx, c, res)
 		if err != nil {
 			return nil, err
 		}
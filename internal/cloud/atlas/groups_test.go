@@ -1,6 +1,7 @@
 package atlas_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/10gen/realm-cli/internal/cli/user"
@@ -30,7 +31,7 @@ func TestAtlasGroups(t *testing.T) {
 		},
 	} {
 		t.Run(tc.description, func(t *testing.T) {
-			_, err := atlas.AllGroups(tc.client)
+			_, err := atlas.AllGroups(context.Background(), tc.client)
 			assert.Equal(t, tc.expectedErr, err)
 		})
 	}
@@ -38,7 +39,7 @@ func TestAtlasGroups(t *testing.T) {
 	t.Run("With an authenticated client should return the list of groups", func(t *testing.T) {
 		client := newAuthClient(t)
 
-		groups, err := atlas.AllGroups(client)
+		groups, err := atlas.AllGroups(context.Background(), client)
 		assert.Nil(t, err)
 		assert.Equal(t, u.CloudGroupCount(), len(groups))
 
@@ -83,7 +84,7 @@ func TestFetchGroups(t *testing.T) {
 	}
 
 	t.Run("should fetch all groups", func(t *testing.T) {
-		groups, err := atlas.AllGroups(client)
+		groups, err := atlas.AllGroups(context.Background(), client)
 		assert.Nil(t, err)
 		assert.Equal(t, []atlas.Group{
 			{Name: "one"},
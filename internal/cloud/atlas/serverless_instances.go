@@ -1,6 +1,7 @@
 package atlas
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -23,8 +24,9 @@ const (
 	serverlessInstancesPattern = atlasAPI + "/groups/%s/serverless"
 )
 
-func (c *client) ServerlessInstances(groupID string) ([]ServerlessInstance, error) {
+func (c *client) ServerlessInstances(ctx context.Context, groupID string) ([]ServerlessInstance, error) {
 	res, err := c.doWithBaseURL(
+		ctx,
 		http.MethodGet,
 		fmt.Sprintf(serverlessInstancesPattern, groupID),
 		api.RequestOptions{},
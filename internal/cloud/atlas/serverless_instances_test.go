@@ -1,6 +1,7 @@
 package atlas_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/10gen/realm-cli/internal/cli/user"
@@ -30,7 +31,7 @@ func TestServerlessInstances(t *testing.T) {
 			},
 		} {
 			t.Run(tc.description, func(t *testing.T) {
-				_, err := tc.client.ServerlessInstances(u.CloudGroupID())
+				_, err := tc.client.ServerlessInstances(context.Background(), u.CloudGroupID())
 				assert.Equal(t, tc.expectedErr, err)
 			})
 		}
@@ -39,7 +40,7 @@ func TestServerlessInstances(t *testing.T) {
 	t.Run("with an authenticated client should return the list of atlas serverless instances", func(t *testing.T) {
 		client := newAuthClient(t)
 
-		serverlessInstances, err := client.ServerlessInstances(u.CloudGroupID())
+		serverlessInstances, err := client.ServerlessInstances(context.Background(), u.CloudGroupID())
 		assert.Nil(t, err)
 		assert.Equal(t, u.CloudAtlasServerlessInstanceCount(), len(serverlessInstances))
 	})
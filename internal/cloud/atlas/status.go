@@ -1,6 +1,7 @@
 package atlas
 
 import (
+	"context"
 	"errors"
 	"net/http"
 
@@ -12,8 +13,8 @@ var (
 	ErrServerUnavailable = errors.New("Atlas server is not available")
 )
 
-func (c *client) Status() error {
-	res, err := c.doWithBaseURL(http.MethodGet, publicAPI, api.RequestOptions{})
+func (c *client) Status(ctx context.Context) error {
+	res, err := c.doWithBaseURL(ctx, http.MethodGet, publicAPI, api.RequestOptions{})
 	if err != nil {
 		return ErrServerUnavailable
 	}
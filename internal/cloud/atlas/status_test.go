@@ -1,6 +1,7 @@
 package atlas_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/10gen/realm-cli/internal/cloud/atlas"
@@ -14,7 +15,7 @@ func TestAtlasStatus(t *testing.T) {
 	client := newAuthClient(t)
 
 	t.Run("Should return no error if the server is running", func(t *testing.T) {
-		err := client.Status()
+		err := client.Status(context.Background())
 		assert.Nil(t, err)
 	})
 }
@@ -24,7 +25,7 @@ func TestAtlasStatusFailure(t *testing.T) {
 	client := atlas.NewClient(baseURL)
 
 	t.Run("Should return an error if the server is not running", func(t *testing.T) {
-		err := client.Status()
+		err := client.Status(context.Background())
 		assert.Equal(t, atlas.ErrServerUnavailable, err)
 	})
 }
@@ -1,6 +1,7 @@
 package realm
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -32,8 +33,9 @@ type allowedIPRequest struct {
 	UseCurrent bool   `json:"use_current,omitempty"`
 }
 
-func (c *client) AllowedIPs(groupID, appID string) ([]AllowedIP, error) {
+func (c *client) AllowedIPs(ctx context.Context, groupID, appID string) ([]AllowedIP, error) {
 	res, resErr := c.do(
+		ctx,
 		http.MethodGet,
 		fmt.Sprintf(allowedIPsPathPattern, groupID, appID),
 		api.RequestOptions{},
@@ -55,8 +57,9 @@ func (c *client) AllowedIPs(groupID, appID string) ([]AllowedIP, error) {
 	return accessList.AllowedIPs, nil
 }
 
-func (c *client) AllowedIPCreate(groupID, appID, address, comment string, useCurrent bool) (AllowedIP, error) {
+func (c *client) AllowedIPCreate(ctx context.Context, groupID, appID, address, comment string, useCurrent bool) (AllowedIP, error) {
 	res, resErr := c.doJSON(
+		ctx,
 		http.MethodPost,
 		fmt.Sprintf(allowedIPsPathPattern, groupID, appID),
 		allowedIPRequest{
@@ -83,8 +86,9 @@ func (c *client) AllowedIPCreate(groupID, appID, address, comment string, useCur
 	return allowedIP, nil
 }
 
-func (c *client) AllowedIPUpdate(groupID, appID, allowedIPID, newAddress, newComment string) error {
+func (c *client) AllowedIPUpdate(ctx context.Context, groupID, appID, allowedIPID, newAddress, newComment string) error {
 	res, err := c.doJSON(
+		ctx,
 		http.MethodPatch,
 		fmt.Sprintf(allowedIPPathPattern, groupID, appID, allowedIPID),
 		allowedIPRequest{
@@ -104,8 +108,9 @@ func (c *client) AllowedIPUpdate(groupID, appID, allowedIPID, newAddress, newCom
 	return nil
 }
 
-func (c *client) AllowedIPDelete(groupID, appID, allowedIPID string) error {
+func (c *client) AllowedIPDelete(ctx context.Context, groupID, appID, allowedIPID string) error {
 	res, err := c.do(
+		ctx,
 		http.MethodDelete,
 		fmt.Sprintf(allowedIPPathPattern, groupID, appID, allowedIPID),
 		api.RequestOptions{},
@@ -1,6 +1,7 @@
 package realm
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -49,8 +50,9 @@ type createAppRequest struct {
 	AppMeta
 }
 
-func (c *client) CreateApp(groupID, name string, meta AppMeta) (App, error) {
+func (c *client) CreateApp(ctx context.Context, groupID, name string, meta AppMeta) (App, error) {
 	res, resErr := c.doJSON(
+		ctx,
 		http.MethodPost,
 		fmt.Sprintf(appsPathPattern, groupID),
 		createAppRequest{name, meta},
@@ -71,8 +73,9 @@ func (c *client) CreateApp(groupID, name string, meta AppMeta) (App, error) {
 	return app, nil
 }
 
-func (c *client) DeleteApp(groupID, appID string) error {
+func (c *client) DeleteApp(ctx context.Context, groupID, appID string) error {
 	res, resErr := c.do(
+		ctx,
 		http.MethodDelete,
 		fmt.Sprintf(appPathPattern, groupID, appID),
 		api.RequestOptions{},
@@ -87,8 +90,9 @@ func (c *client) DeleteApp(groupID, appID string) error {
 }
 
 // TODO(REALMC-9462): remove this once /apps has "template_id" in the payload
-func (c *client) FindApp(groupID, appID string) (App, error) {
+func (c *client) FindApp(ctx context.Context, groupID, appID string) (App, error) {
 	res, err := c.do(
+		ctx,
 		http.MethodGet,
 		fmt.Sprintf(appPathPattern, groupID, appID),
 		api.RequestOptions{},
@@ -126,16 +130,16 @@ var (
 	defaultProducts = []string{productStandard, productAtlas, productDataAPI, productDeviceSync}
 )
 
-func (c *client) FindApps(filter AppFilter) ([]App, error) {
+func (c *client) FindApps(ctx context.Context, filter AppFilter) ([]App, error) {
 	var apps []App
 	if filter.GroupID == "" {
-		arr, err := c.getAppsForUser(filter.Products)
+		arr, err := c.getAppsForUser(ctx, filter.Products)
 		if err != nil {
 			return nil, err
 		}
 		apps = arr
 	} else {
-		arr, err := c.getApps(filter.GroupID, filter.Products)
+		arr, err := c.getApps(ctx, filter.GroupID, filter.Products)
 		if err != nil {
 			return nil, err
 		}
@@ -155,15 +159,15 @@ func (c *client) FindApps(filter AppFilter) ([]App, error) {
 	return filtered, nil
 }
 
-func (c *client) getAppsForUser(products []string) ([]App, error) {
-	profile, profileErr := c.AuthProfile()
+func (c *client) getAppsForUser(ctx context.Context, products []string) ([]App, error) {
+	profile, profileErr := c.AuthProfile(ctx)
 	if profileErr != nil {
 		return nil, profileErr
 	}
 
 	var apps []App
 	for _, groupID := range profile.AllGroupIDs() {
-		projectApps, err := c.getApps(groupID, products)
+		projectApps, err := c.getApps(ctx, groupID, products)
 		if err != nil {
 			return nil, err
 		}
@@ -172,12 +176,12 @@ func (c *client) getAppsForUser(products []string) ([]App, error) {
 	return apps, nil
 }
 
-func (c *client) getApps(groupID string, products []string) ([]App, error) {
+func (c *client) getApps(ctx context.Context, groupID string, products []string) ([]App, error) {
 	allProducts := resolveProducts(products)
 
 	var apps []App
 	for _, product := range allProducts {
-		productApps, err := c.getAppsForProduct(groupID, product)
+		productApps, err := c.getAppsForProduct(ctx, groupID, product)
 		if err != nil {
 			return nil, err
 		}
@@ -199,7 +203,7 @@ func resolveProducts(products []string) []string {
 	return allProducts
 }
 
-func (c *client) getAppsForProduct(groupID, product string) ([]App, error) {
+func (c *client) getAppsForProduct(ctx context.Context, groupID, product string) ([]App, error) {
 	// TODO(REALMC-8886): add tests to verify correct url is being hit
 	url := fmt.Sprintf(appsPathPattern, groupID)
 	switch product {
@@ -208,7 +212,7 @@ func (c *client) getAppsForProduct(groupID, product string) ([]App, error) {
 		url += "?product=" + product
 	}
 
-	res, err := c.do(http.MethodGet, url, api.RequestOptions{})
+	res, err := c.do(ctx, http.MethodGet, url, api.RequestOptions{})
 	if err != nil {
 		return nil, err
 	}
@@ -1,6 +1,7 @@
 package realm
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -163,8 +164,9 @@ type AppDescription struct {
 	LogForwarders     []LogForwarderSummary      `json:"log_forwarders"`
 }
 
-func (c *client) AppDescription(groupID, appID string) (AppDescription, error) {
+func (c *client) AppDescription(ctx context.Context, groupID, appID string) (AppDescription, error) {
 	res, resErr := c.do(
+		ctx,
 		http.MethodGet,
 		fmt.Sprintf(appDescriptionPathPattern, groupID, appID),
 		api.RequestOptions{},
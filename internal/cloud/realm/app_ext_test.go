@@ -1,6 +1,7 @@
 package realm_test
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"testing"
@@ -17,7 +18,7 @@ func TestRealmApps(t *testing.T) {
 	t.Run("should fail without an auth client", func(t *testing.T) {
 		client := realm.NewClient(u.RealmServerURL())
 
-		_, err := client.FindApps(realm.AppFilter{})
+		_, err := client.FindApps(context.Background(), realm.AppFilter{})
 		assert.Equal(t, realm.ErrInvalidSession(user.DefaultProfile), err)
 	})
 
@@ -28,7 +29,7 @@ func TestRealmApps(t *testing.T) {
 		t.Run("should create an app", func(t *testing.T) {
 			name := "eggcorn"
 
-			app, appErr := client.CreateApp(groupID, name, realm.AppMeta{})
+			app, appErr := client.CreateApp(context.Background(), groupID, name, realm.AppMeta{})
 			assert.Nil(t, appErr)
 
 			assert.NotEqualf(t, "", app.ID, "expected app to have id")
@@ -37,20 +38,20 @@ func TestRealmApps(t *testing.T) {
 			assert.True(t, strings.HasPrefix(app.ClientAppID, name), "expected client app id to be prefixed with name")
 
 			t.Run("and find the app by client app id", func(t *testing.T) {
-				apps, err := client.FindApps(realm.AppFilter{App: app.ClientAppID})
+				apps, err := client.FindApps(context.Background(), realm.AppFilter{App: app.ClientAppID})
 				assert.Nil(t, err)
 				assert.Equal(t, []realm.App{app}, apps)
 			})
 
 			// TODO(REALMC-9462): remove this once /apps has "template_id" in the payload
 			t.Run("and find the app by group and app id", func(t *testing.T) {
-				found, err := client.FindApp(app.GroupID, app.ID)
+				found, err := client.FindApp(context.Background(), app.GroupID, app.ID)
 				assert.Nil(t, err)
 				assert.Equal(t, app.ID, found.ID)
 			})
 
 			t.Run("and get the app description by id", func(t *testing.T) {
-				appDesc, err := client.AppDescription(groupID, app.ID)
+				appDesc, err := client.AppDescription(context.Background(), groupID, app.ID)
 				assert.Nil(t, err)
 				assert.Match(t, realm.AppDescription{
 					ClientAppID:    app.ClientAppID,
@@ -75,9 +76,9 @@ func TestRealmApps(t *testing.T) {
 			})
 
 			t.Run("and delete the app by id", func(t *testing.T) {
-				assert.Nil(t, client.DeleteApp(groupID, app.ID))
+				assert.Nil(t, client.DeleteApp(context.Background(), groupID, app.ID))
 
-				apps, err := client.FindApps(realm.AppFilter{App: app.ClientAppID})
+				apps, err := client.FindApps(context.Background(), realm.AppFilter{App: app.ClientAppID})
 				assert.Nil(t, err)
 				assert.Equal(t, []realm.App{}, apps)
 			})
@@ -87,10 +88,10 @@ func TestRealmApps(t *testing.T) {
 
 func setupTestApp(t *testing.T, client realm.Client, groupID, name string) (realm.App, func()) {
 	t.Helper()
-	app, err := client.CreateApp(groupID, name, realm.AppMeta{})
+	app, err := client.CreateApp(context.Background(), groupID, name, realm.AppMeta{})
 	assert.Nil(t, err)
 	teardown := func() {
-		if deleteErr := client.DeleteApp(groupID, app.ID); deleteErr != nil {
+		if deleteErr := client.DeleteApp(context.Background(), groupID, app.ID); deleteErr != nil {
 			t.Logf("warning: failed to delete test app (id: %s): %s", app.ID, deleteErr)
 		}
 	}
@@ -1,6 +1,7 @@
 package realm
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -37,7 +38,7 @@ type authRequestLocal struct {
 	Password string `json:"password"`
 }
 
-func (c *client) Authenticate(authType string, creds user.Credentials) (Session, error) {
+func (c *client) Authenticate(ctx context.Context, authType string, creds user.Credentials) (Session, error) {
 	var payload interface{}
 	switch authType {
 	case AuthTypeCloud:
@@ -47,6 +48,7 @@ func (c *client) Authenticate(authType string, creds user.Credentials) (Session,
 	}
 
 	res, resErr := c.doJSON(
+		ctx,
 		http.MethodPost,
 		fmt.Sprintf(authenticatePathPattern, authType),
 		payload,
@@ -77,8 +79,8 @@ type Role struct {
 	GroupID string `json:"group_id"`
 }
 
-func (c *client) AuthProfile() (AuthProfile, error) {
-	res, resErr := c.do(http.MethodGet, authProfilePath, api.RequestOptions{})
+func (c *client) AuthProfile(ctx context.Context) (AuthProfile, error) {
+	res, resErr := c.do(ctx, http.MethodGet, authProfilePath, api.RequestOptions{})
 	if resErr != nil {
 		return AuthProfile{}, resErr
 	}
@@ -103,7 +105,11 @@ func (c *client) getAuthToken(options api.RequestOptions) (string, error) {
 			return "", ErrInvalidSession(user.DefaultProfile)
 		}
 
-		session := c.profile.Session()
+		session, err := c.profile.Session()
+		if err != nil {
+			return "", err
+		}
+
 		if requiresRefreshToken {
 			if session.RefreshToken == "" {
 				return "", ErrInvalidSession(c.profile.Name)
@@ -122,8 +128,9 @@ func (c *client) getAuthToken(options api.RequestOptions) (string, error) {
 	return "", nil
 }
 
-func (c *client) refreshAuth() error {
+func (c *client) refreshAuth(ctx context.Context) error {
 	res, resErr := c.do(
+		ctx,
 		http.MethodPost,
 		authSessionPath,
 		api.RequestOptions{RefreshAuth: true, PreventRefresh: true},
@@ -141,7 +148,10 @@ func (c *client) refreshAuth() error {
 		return err
 	}
 
-	session := c.profile.Session()
+	session, err := c.profile.Session()
+	if err != nil {
+		return err
+	}
 	session.AccessToken = s.AccessToken
 	c.profile.SetSession(session)
 
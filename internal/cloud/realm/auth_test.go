@@ -1,6 +1,7 @@
 package realm_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/10gen/realm-cli/internal/cli/user"
@@ -16,7 +17,7 @@ func TestRealmAuthenticateCloud(t *testing.T) {
 	client := realm.NewClient(u.RealmServerURL())
 
 	t.Run("Should fail with invalid cloud credentials", func(t *testing.T) {
-		_, err := client.Authenticate(realm.AuthTypeCloud, user.Credentials{PublicAPIKey: "username", PrivateAPIKey: "apiKey"})
+		_, err := client.Authenticate(context.Background(), realm.AuthTypeCloud, user.Credentials{PublicAPIKey: "username", PrivateAPIKey: "apiKey"})
 		assert.Equal(t,
 			realm.ServerError{Message: "failed to authenticate with MongoDB Cloud API: You are not authorized for this resource."},
 			err,
@@ -24,7 +25,7 @@ func TestRealmAuthenticateCloud(t *testing.T) {
 	})
 
 	t.Run("Should return session details with valid cloud credentials", func(t *testing.T) {
-		session, err := client.Authenticate(realm.AuthTypeCloud, user.Credentials{
+		session, err := client.Authenticate(context.Background(), realm.AuthTypeCloud, user.Credentials{
 			PublicAPIKey:  u.CloudUsername(),
 			PrivateAPIKey: u.CloudAPIKey(),
 		})
@@ -40,7 +41,7 @@ func TestRealmAuthenticateLocal(t *testing.T) {
 	client := realm.NewClient(u.RealmServerURL())
 
 	t.Run("Should return session details with valid local credentials", func(t *testing.T) {
-		session, err := client.Authenticate(realm.AuthTypeLocal, user.Credentials{
+		session, err := client.Authenticate(context.Background(), realm.AuthTypeLocal, user.Credentials{
 			Username: "unique_user@domain.com",
 			Password: "password",
 		})
@@ -56,14 +57,14 @@ func TestRealmAuthProfile(t *testing.T) {
 	t.Run("Should fail without an auth client", func(t *testing.T) {
 		client := realm.NewClient(u.RealmServerURL())
 
-		_, err := client.AuthProfile()
+		_, err := client.AuthProfile(context.Background())
 		assert.Equal(t, realm.ErrInvalidSession(user.DefaultProfile), err)
 	})
 
 	t.Run("With an active session should return session details with valid credentials", func(t *testing.T) {
 		client := newAuthClient(t)
 
-		profile, err := client.AuthProfile()
+		profile, err := client.AuthProfile(context.Background())
 		assert.Nil(t, err)
 		assert.NotEqualf(t, 0, len(profile.Roles), "expected profile to have role(s)")
 
@@ -84,7 +85,7 @@ func TestRealmAuthRefresh(t *testing.T) {
 	t.Run("Does not refresh auth if request does not return invalid session code", func(t *testing.T) {
 		client := realm.NewClient(u.RealmServerURL())
 
-		session, err := client.Authenticate(realm.AuthTypeCloud, user.Credentials{
+		session, err := client.Authenticate(context.Background(), realm.AuthTypeCloud, user.Credentials{
 			PublicAPIKey:  u.CloudUsername(),
 			PrivateAPIKey: u.CloudAPIKey(),
 		})
@@ -96,7 +97,7 @@ func TestRealmAuthRefresh(t *testing.T) {
 		profile := mock.NewProfileWithSession(t, session)
 
 		client = realm.NewAuthClient(profile.RealmBaseURL(), profile)
-		_, err = client.AuthProfile()
+		_, err = client.AuthProfile(context.Background())
 		serverError, ok := err.(realm.ServerError)
 		assert.True(t, ok, "expected %T to be server error", err)
 		assert.Equal(t, realm.ServerError{Message: "invalid session: valid Issuer required"}, serverError)
@@ -105,7 +106,7 @@ func TestRealmAuthRefresh(t *testing.T) {
 	t.Run("Should return the invalid session error when credentials are invalid", func(t *testing.T) {
 		client := realm.NewClient(u.RealmServerURL())
 
-		session, err := client.Authenticate(realm.AuthTypeCloud, user.Credentials{
+		session, err := client.Authenticate(context.Background(), realm.AuthTypeCloud, user.Credentials{
 			PublicAPIKey:  u.CloudUsername(),
 			PrivateAPIKey: u.CloudAPIKey(),
 		})
@@ -118,7 +119,7 @@ func TestRealmAuthRefresh(t *testing.T) {
 		profile := mock.NewProfileWithSession(t, session)
 
 		client = realm.NewAuthClient(profile.RealmBaseURL(), profile)
-		_, err = client.AuthProfile()
+		_, err = client.AuthProfile(context.Background())
 		assert.Equal(t, realm.ErrInvalidSession(profile.Name), err)
 	})
 
@@ -128,7 +129,7 @@ func TestRealmAuthRefresh(t *testing.T) {
 		t.Run("should use the refresh token to generate a new access token", func(t *testing.T) {
 			client := realm.NewClient(u.RealmServerURL())
 
-			session, err := client.Authenticate(realm.AuthTypeCloud, user.Credentials{
+			session, err := client.Authenticate(context.Background(), realm.AuthTypeCloud, user.Credentials{
 				PublicAPIKey:  u.CloudUsername(),
 				PrivateAPIKey: u.CloudAPIKey(),
 			})
@@ -141,10 +142,11 @@ func TestRealmAuthRefresh(t *testing.T) {
 			profile.SetSession(user.Session{u.ExpiredAccessToken(), session.RefreshToken})
 
 			client = realm.NewAuthClient(profile.RealmBaseURL(), profile)
-			_, err = client.AuthProfile()
+			_, err = client.AuthProfile(context.Background())
 			assert.Nil(t, err)
 
-			updatedSession := profile.Session()
+			updatedSession, err := profile.Session()
+			assert.Nil(t, err)
 
 			t.Log("and update the access token")
 			assert.NotEqual(t, u.ExpiredAccessToken(), updatedSession.AccessToken, "access token was not updated")
@@ -159,10 +161,11 @@ func TestRealmAuthRefresh(t *testing.T) {
 			profile.SetSession(user.Session{u.ExpiredAccessToken(), u.ExpiredAccessToken()})
 
 			client := realm.NewAuthClient(profile.RealmBaseURL(), profile)
-			_, err := client.AuthProfile()
+			_, err := client.AuthProfile(context.Background())
 			assert.Equal(t, realm.ErrInvalidSession(profile.Name), err)
 
-			session := profile.Session()
+			session, err := profile.Session()
+			assert.Nil(t, err)
 
 			t.Log("and clear the session tokens")
 			assert.Equalf(t, "", session.AccessToken, "access token was not cleared")
@@ -176,7 +179,7 @@ func newAuthClient(t *testing.T) realm.Client {
 
 	client := realm.NewClient(u.RealmServerURL())
 
-	session, err := client.Authenticate(realm.AuthTypeCloud, user.Credentials{
+	session, err := client.Authenticate(context.Background(), realm.AuthTypeCloud, user.Credentials{
 		PublicAPIKey:  u.CloudUsername(),
 		PrivateAPIKey: u.CloudAPIKey(),
 	})
@@ -3,8 +3,10 @@ package realm
 import (
 	"archive/zip"
 	"bytes"
+	"context"
 	"encoding/json"
 	"io"
+	"io/ioutil"
 	"net/http"
 
 	"github.com/10gen/realm-cli/internal/cli/user"
@@ -21,69 +23,71 @@ const (
 
 // Client is a Realm client
 type Client interface {
-	AuthProfile() (AuthProfile, error)
-	Authenticate(authType string, credentials user.Credentials) (Session, error)
-
-	Export(groupID, appID string, req ExportRequest) (string, *zip.Reader, error)
-	ExportDependencies(groupID, appID string) (string, io.ReadCloser, error)
-	ExportDependenciesArchive(groupID, appID string) (string, io.ReadCloser, error)
-	Import(groupID, appID string, appData interface{}) error
-	ImportDependencies(groupID, appID, uploadPath string) error
-	Diff(groupID, appID string, appData interface{}) ([]string, error)
-	DiffDependencies(groupID, appID, uploadPath string) (DependenciesDiff, error)
-	DependenciesStatus(groupID, appID string) (DependenciesStatus, error)
-
-	CreateApp(groupID, name string, meta AppMeta) (App, error)
-	DeleteApp(groupID, appID string) error
+	AuthProfile(ctx context.Context) (AuthProfile, error)
+	Authenticate(ctx context.Context, authType string, credentials user.Credentials) (Session, error)
+
+	Export(ctx context.Context, groupID, appID string, req ExportRequest) (string, *zip.Reader, error)
+	ExportDependencies(ctx context.Context, groupID, appID string) (string, io.ReadCloser, error)
+	ExportDependenciesArchive(ctx context.Context, groupID, appID string) (string, io.ReadCloser, error)
+	Import(ctx context.Context, groupID, appID string, appData interface{}) error
+	ImportDependencies(ctx context.Context, groupID, appID, uploadPath string) error
+	Diff(ctx context.Context, groupID, appID string, appData interface{}) ([]string, error)
+	DiffDependencies(ctx context.Context, groupID, appID, uploadPath string) (DependenciesDiff, error)
+	DependenciesStatus(ctx context.Context, groupID, appID string) (DependenciesStatus, error)
+
+	CreateApp(ctx context.Context, groupID, name string, meta AppMeta) (App, error)
+	DeleteApp(ctx context.Context, groupID, appID string) error
 	// TODO(REALMC-9462): remove this once /apps has "template_id" in the payload
-	FindApp(groupID, appID string) (App, error)
-	FindApps(filter AppFilter) ([]App, error)
-	AppDescription(groupID, appID string) (AppDescription, error)
-
-	CreateDraft(groupID, appID string) (AppDraft, error)
-	DeployDraft(groupID, appID, draftID string) (AppDeployment, error)
-	DiffDraft(groupID, appID, draftID string) (AppDraftDiff, error)
-	DiscardDraft(groupID, appID, draftID string) error
-	Deployments(groupID, appID string) ([]AppDeployment, error)
-	Deployment(groupID, appID, deploymentID string) (AppDeployment, error)
-	Draft(groupID, appID string) (AppDraft, error)
-
-	Secrets(groupID, appID string) ([]Secret, error)
-	CreateSecret(groupID, appID, name, value string) (Secret, error)
-	DeleteSecret(groupID, appID, secretID string) error
-	UpdateSecret(groupID, appID, secretID, name, value string) error
-
-	CreateAPIKey(groupID, appID, apiKeyName string) (APIKey, error)
-	CreateUser(groupID, appID, email, password string) (User, error)
-	DeleteUser(groupID, appID, userID string) error
-	DisableUser(groupID, appID, userID string) error
-	EnableUser(groupID, appID, userID string) error
-	FindUsers(groupID, appID string, filter UserFilter) ([]User, error)
-	RevokeUserSessions(groupID, appID, userID string) error
-
-	HostingAssets(groupID, appID string) ([]HostingAsset, error)
-	HostingAssetUpload(groupID, appID, rootDir string, asset HostingAsset) error
-	HostingAssetRemove(groupID, appID, path string) error
-	HostingAssetAttributesUpdate(groupID, appID, path string, attrs ...HostingAssetAttribute) error
-	HostingCacheInvalidate(groupID, appID, path string) error
-
-	Functions(groupID, appID string) ([]Function, error)
-	AppDebugExecuteFunction(groupID, appID, userID, name string, args []interface{}) (ExecutionResults, error)
-
-	Logs(groupID, appID string, opts LogsOptions) (Logs, error)
-
-	SchemaModels(groupID, appID, language string) ([]SchemaModel, error)
-
-	AllTemplates() (Templates, error)
-	ClientTemplate(groupID, appID, templateID string) (*zip.Reader, bool, error)
-	CompatibleTemplates(groupID, appID string) (Templates, error)
-
-	AllowedIPs(groupID, appID string) ([]AllowedIP, error)
-	AllowedIPCreate(groupID, appID, address, comment string, useCurrent bool) (AllowedIP, error)
-	AllowedIPUpdate(groupID, appID, allowedIPID, newAddress, newComment string) error
-	AllowedIPDelete(groupID, appID, allowedIPID string) error
-
-	Status() error
+	FindApp(ctx context.Context, groupID, appID string) (App, error)
+	FindApps(ctx context.Context, filter AppFilter) ([]App, error)
+	AppDescription(ctx context.Context, groupID, appID string) (AppDescription, error)
+
+	CreateDraft(ctx context.Context, groupID, appID string) (AppDraft, error)
+	DeployDraft(ctx context.Context, groupID, appID, draftID string) (AppDeployment, error)
+	DiffDraft(ctx context.Context, groupID, appID, draftID string) (AppDraftDiff, error)
+	DiscardDraft(ctx context.Context, groupID, appID, draftID string) error
+	Deployments(ctx context.Context, groupID, appID string) ([]AppDeployment, error)
+	Deployment(ctx context.Context, groupID, appID, deploymentID string) (AppDeployment, error)
+	Redeploy(ctx context.Context, groupID, appID, deploymentID string) error
+	Draft(ctx context.Context, groupID, appID string) (AppDraft, error)
+
+	Secrets(ctx context.Context, groupID, appID string) ([]Secret, error)
+	CreateSecret(ctx context.Context, groupID, appID, name, value string) (Secret, error)
+	DeleteSecret(ctx context.Context, groupID, appID, secretID string) error
+	UpdateSecret(ctx context.Context, groupID, appID, secretID, name, value string) error
+
+	CreateAPIKey(ctx context.Context, groupID, appID, apiKeyName string) (APIKey, error)
+	CreateUser(ctx context.Context, groupID, appID, email, password string) (User, error)
+	DeleteUser(ctx context.Context, groupID, appID, userID string) error
+	DisableUser(ctx context.Context, groupID, appID, userID string) error
+	EnableUser(ctx context.Context, groupID, appID, userID string) error
+	FindUsers(ctx context.Context, groupID, appID string, filter UserFilter) ([]User, error)
+	RevokeUserSessions(ctx context.Context, groupID, appID, userID string) error
+
+	HostingAssets(ctx context.Context, groupID, appID string) ([]HostingAsset, error)
+	HostingAssetUpload(ctx context.Context, groupID, appID, rootDir string, asset HostingAsset) error
+	HostingAssetRemove(ctx context.Context, groupID, appID, path string) error
+	HostingAssetAttributesUpdate(ctx context.Context, groupID, appID, path string, attrs ...HostingAssetAttribute) error
+	HostingCacheInvalidate(ctx context.Context, groupID, appID, path string) error
+
+	Functions(ctx context.Context, groupID, appID string) ([]Function, error)
+	AppDebugExecuteFunction(ctx context.Context, groupID, appID, userID, name string, args []interface{}) (ExecutionResults, error)
+
+	Logs(ctx context.Context, groupID, appID string, opts LogsOptions) (Logs, error)
+	LogsPage(ctx context.Context, groupID, appID string, opts LogsOptions) (LogsPage, error)
+
+	SchemaModels(ctx context.Context, groupID, appID, language string) ([]SchemaModel, error)
+
+	AllTemplates(ctx context.Context) (Templates, error)
+	ClientTemplate(ctx context.Context, groupID, appID, templateID string) (*zip.Reader, bool, error)
+	CompatibleTemplates(ctx context.Context, groupID, appID string) (Templates, error)
+
+	AllowedIPs(ctx context.Context, groupID, appID string) ([]AllowedIP, error)
+	AllowedIPCreate(ctx context.Context, groupID, appID, address, comment string, useCurrent bool) (AllowedIP, error)
+	AllowedIPUpdate(ctx context.Context, groupID, appID, allowedIPID, newAddress, newComment string) error
+	AllowedIPDelete(ctx context.Context, groupID, appID, allowedIPID string) error
+
+	Status(ctx context.Context) error
 }
 
 // NewClient creates a new Realm client
@@ -101,7 +105,7 @@ type client struct {
 	profile *user.Profile
 }
 
-func (c *client) doJSON(method, path string, payload interface{}, options api.RequestOptions) (*http.Response, error) {
+func (c *client) doJSON(ctx context.Context, method, path string, payload interface{}, options api.RequestOptions) (*http.Response, error) {
 	body, err := json.Marshal(payload)
 	if err != nil {
 		return nil, err
@@ -110,36 +114,30 @@ func (c *client) doJSON(method, path string, payload interface{}, options api.Re
 	options.Body = bytes.NewReader(body)
 	options.ContentType = api.MediaTypeJSON
 
-	return c.do(method, path, options)
+	return c.do(ctx, method, path, options)
 }
 
-func (c *client) do(method, path string, options api.RequestOptions) (*http.Response, error) {
-	var bodyCopy bytes.Buffer
-	var tee io.Reader
-	if options.Body != nil {
-		tee = io.TeeReader(options.Body, &bodyCopy)
-	}
-
-	req, err := http.NewRequest(method, c.baseURL+path, tee)
+func (c *client) do(ctx context.Context, method, path string, options api.RequestOptions) (*http.Response, error) {
+	req, err := api.NewRequest(ctx, method, c.baseURL+path, options)
 	if err != nil {
 		return nil, err
 	}
 
-	api.IncludeQuery(req, options.Query)
+	// keep a copy of any body which cannot otherwise be replayed after refreshing the session
+	var bodyCopy bytes.Buffer
+	if req.Body != nil && req.GetBody == nil {
+		req.Body = ioutil.NopCloser(io.TeeReader(req.Body, &bodyCopy))
+	}
 
 	req.Header.Set(requestOriginHeader, cliHeaderValue)
 
-	if options.ContentType != "" {
-		req.Header.Set(api.HeaderContentType, options.ContentType)
-	}
-
 	if token, err := c.getAuthToken(options); err != nil {
 		return nil, err
 	} else if token != "" {
 		req.Header.Set(api.HeaderAuthorization, "Bearer "+token)
 	}
 
-	client := &http.Client{}
+	client := &http.Client{Transport: api.DefaultTransport}
 
 	res, resErr := client.Do(req)
 	if resErr != nil {
@@ -158,7 +156,7 @@ func (c *client) do(method, path string, options api.RequestOptions) (*http.Resp
 		return nil, err
 	}
 
-	if refreshErr := c.refreshAuth(); refreshErr != nil {
+	if refreshErr := c.refreshAuth(ctx); refreshErr != nil {
 		c.profile.ClearSession()
 		if err := c.profile.Save(); err != nil {
 			return nil, ErrInvalidSession(c.profile.Name)
@@ -167,7 +165,12 @@ func (c *client) do(method, path string, options api.RequestOptions) (*http.Resp
 	}
 
 	options.PreventRefresh = true
-	options.Body = &bodyCopy
+	if req.GetBody != nil {
+		options.Body = nil
+		options.GetBody = req.GetBody
+	} else if req.Body != nil {
+		options.Body = &bodyCopy
+	}
 
-	return c.do(method, path, options)
+	return c.do(ctx, method, path, options)
 }
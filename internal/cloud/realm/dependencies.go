@@ -2,6 +2,7 @@ package realm
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -37,8 +38,9 @@ const (
 	DependenciesStateFailed     = "failed"
 )
 
-func (c *client) DependenciesStatus(groupID, appID string) (DependenciesStatus, error) {
+func (c *client) DependenciesStatus(ctx context.Context, groupID, appID string) (DependenciesStatus, error) {
 	res, err := c.do(
+		ctx,
 		http.MethodGet,
 		fmt.Sprintf(dependenciesStatusPathPattern, groupID, appID),
 		api.RequestOptions{},
@@ -57,7 +59,7 @@ func (c *client) DependenciesStatus(groupID, appID string) (DependenciesStatus,
 	return status, nil
 }
 
-func (c *client) ImportDependencies(groupID, appID, uploadPath string) error {
+func (c *client) ImportDependencies(ctx context.Context, groupID, appID, uploadPath string) error {
 	file, fileErr := os.Open(uploadPath)
 	if fileErr != nil {
 		return fileErr
@@ -85,6 +87,7 @@ func (c *client) ImportDependencies(groupID, appID, uploadPath string) error {
 	}
 
 	res, err := c.do(
+		ctx,
 		http.MethodPut,
 		fmt.Sprintf(dependenciesPathPattern, groupID, appID),
 		api.RequestOptions{
@@ -102,8 +105,8 @@ func (c *client) ImportDependencies(groupID, appID, uploadPath string) error {
 	return nil
 }
 
-func (c *client) ExportDependencies(groupID, appID string) (string, io.ReadCloser, error) {
-	res, resErr := c.do(http.MethodGet, fmt.Sprintf(dependenciesExportPathPattern, groupID, appID), api.RequestOptions{})
+func (c *client) ExportDependencies(ctx context.Context, groupID, appID string) (string, io.ReadCloser, error) {
+	res, resErr := c.do(ctx, http.MethodGet, fmt.Sprintf(dependenciesExportPathPattern, groupID, appID), api.RequestOptions{})
 	if resErr != nil {
 		return "", nil, resErr
 	}
@@ -124,8 +127,8 @@ func (c *client) ExportDependencies(groupID, appID string) (string, io.ReadClose
 	return filename, res.Body, nil
 }
 
-func (c *client) ExportDependenciesArchive(groupID, appID string) (string, io.ReadCloser, error) {
-	res, resErr := c.do(http.MethodGet, fmt.Sprintf(dependenciesArchivePathPattern, groupID, appID), api.RequestOptions{})
+func (c *client) ExportDependenciesArchive(ctx context.Context, groupID, appID string) (string, io.ReadCloser, error) {
+	res, resErr := c.do(ctx, http.MethodGet, fmt.Sprintf(dependenciesArchivePathPattern, groupID, appID), api.RequestOptions{})
 	if resErr != nil {
 		return "", nil, resErr
 	}
@@ -146,7 +149,7 @@ func (c *client) ExportDependenciesArchive(groupID, appID string) (string, io.Re
 	return filename, res.Body, nil
 }
 
-func (c *client) DiffDependencies(groupID, appID, uploadPath string) (DependenciesDiff, error) {
+func (c *client) DiffDependencies(ctx context.Context, groupID, appID, uploadPath string) (DependenciesDiff, error) {
 	file, err := os.Open(uploadPath)
 	if err != nil {
 		return DependenciesDiff{}, err
@@ -174,6 +177,7 @@ func (c *client) DiffDependencies(groupID, appID, uploadPath string) (Dependenci
 	}
 
 	res, err := c.do(
+		ctx,
 		http.MethodPost,
 		fmt.Sprintf(dependenciesDiffPathPattern, groupID, appID),
 		api.RequestOptions{
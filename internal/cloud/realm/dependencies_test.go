@@ -2,6 +2,7 @@ package realm_test
 
 import (
 	"archive/zip"
+	"context"
 	"encoding/json"
 	"io/ioutil"
 	"os"
@@ -32,12 +33,12 @@ func TestRealmDependencies(t *testing.T) {
 
 			uploadPath := filepath.Join(wd, "testdata/dependencies_upload.zip")
 
-			_, err = client.DependenciesStatus(groupID, app.ID)
+			_, err = client.DependenciesStatus(context.Background(), groupID, app.ID)
 			assert.Nil(t, err)
 
-			assert.Nil(t, client.ImportDependencies(groupID, app.ID, uploadPath))
+			assert.Nil(t, client.ImportDependencies(context.Background(), groupID, app.ID, uploadPath))
 
-			startStatus, err := client.DependenciesStatus(groupID, app.ID)
+			startStatus, err := client.DependenciesStatus(context.Background(), groupID, app.ID)
 			assert.Nil(t, err)
 			assert.Equal(t, realm.DependenciesStatus{
 				State:   realm.DependenciesStateCreated,
@@ -48,7 +49,7 @@ func TestRealmDependencies(t *testing.T) {
 			var currentStatus realm.DependenciesStatus
 			for i := 0; i < 50; i++ {
 				var err error
-				currentStatus, err = client.DependenciesStatus(groupID, app.ID)
+				currentStatus, err = client.DependenciesStatus(context.Background(), groupID, app.ID)
 				assert.Nil(t, err)
 				if currentStatus.State != realm.DependenciesStateCreated {
 					break
@@ -67,7 +68,7 @@ func TestRealmDependencies(t *testing.T) {
 			assert.Nil(t, tmpDirErr)
 			defer teardown()
 
-			name, zipPkg, err := client.ExportDependenciesArchive(groupID, app.ID)
+			name, zipPkg, err := client.ExportDependenciesArchive(context.Background(), groupID, app.ID)
 			assert.Nil(t, err)
 
 			assert.Equal(t, "node_modules.zip", name)
@@ -93,7 +94,7 @@ func TestRealmDependencies(t *testing.T) {
 			assert.Nil(t, err)
 
 			uploadPath := filepath.Join(wd, "testdata/dependencies_diff.zip")
-			diff, err := client.DiffDependencies(groupID, app.ID, uploadPath)
+			diff, err := client.DiffDependencies(context.Background(), groupID, app.ID, uploadPath)
 			assert.Nil(t, err)
 			assert.Equal(t, realm.DependenciesDiff{
 				Added:    []realm.DependencyData{{"twilio", "3.35.1"}},
@@ -117,12 +118,12 @@ func TestRealmDependencies(t *testing.T) {
 
 			uploadPath := filepath.Join(wd, "testdata/package.json")
 
-			_, err = client.DependenciesStatus(groupID, app.ID)
+			_, err = client.DependenciesStatus(context.Background(), groupID, app.ID)
 			assert.Nil(t, err)
 
-			assert.Nil(t, client.ImportDependencies(groupID, app.ID, uploadPath))
+			assert.Nil(t, client.ImportDependencies(context.Background(), groupID, app.ID, uploadPath))
 
-			startStatus, err := client.DependenciesStatus(groupID, app.ID)
+			startStatus, err := client.DependenciesStatus(context.Background(), groupID, app.ID)
 			assert.Nil(t, err)
 			assert.Equal(t, realm.DependenciesStatus{
 				State:   realm.DependenciesStateCreated,
@@ -133,7 +134,7 @@ func TestRealmDependencies(t *testing.T) {
 			var currentStatus realm.DependenciesStatus
 			for i := 0; i < 150; i++ {
 				var err error
-				currentStatus, err = client.DependenciesStatus(groupID, app.ID)
+				currentStatus, err = client.DependenciesStatus(context.Background(), groupID, app.ID)
 				assert.Nil(t, err)
 				if currentStatus.State != realm.DependenciesStateCreated {
 					break
@@ -152,7 +153,7 @@ func TestRealmDependencies(t *testing.T) {
 			assert.Nil(t, tmpDirErr)
 			defer teardown()
 
-			name, pkgJSON, err := client.ExportDependencies(groupID, app.ID)
+			name, pkgJSON, err := client.ExportDependencies(context.Background(), groupID, app.ID)
 			assert.Nil(t, err)
 
 			assert.Equal(t, "package.json", name)
@@ -180,7 +181,7 @@ func TestRealmDependencies(t *testing.T) {
 			assert.Nil(t, err)
 
 			uploadPath := filepath.Join(wd, "testdata/updated-package.json")
-			diff, err := client.DiffDependencies(groupID, app.ID, uploadPath)
+			diff, err := client.DiffDependencies(context.Background(), groupID, app.ID, uploadPath)
 			assert.Nil(t, err)
 			assert.Equal(t, realm.DependenciesDiff{
 				Added:    []realm.DependencyData{{"twilio", "3.35.1"}},
@@ -192,7 +193,7 @@ func TestRealmDependencies(t *testing.T) {
 }
 
 func waitForDepDeployment(client realm.Client, groupID string, appID string, t *testing.T) {
-	deployments, err := client.Deployments(groupID, appID)
+	deployments, err := client.Deployments(context.Background(), groupID, appID)
 	assert.Nil(t, err)
 
 	if len(deployments) == 0 {
@@ -210,7 +211,7 @@ func waitForDepDeployment(client realm.Client, groupID string, appID string, t *
 		}
 		time.Sleep(time.Second)
 
-		deployment, err = client.Deployment(groupID, appID, deployment.ID)
+		deployment, err = client.Deployment(context.Background(), groupID, appID, deployment.ID)
 		assert.Nil(t, err)
 
 		counter++
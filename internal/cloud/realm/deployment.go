@@ -1,6 +1,7 @@
 package realm
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -11,11 +12,18 @@ import (
 const (
 	deploymentsPathPattern = appPathPattern + "/deployments"
 	deploymentPathPattern  = deploymentsPathPattern + "/%s"
+	redeployPathPattern    = deploymentPathPattern + "/redeploy"
 )
 
 // AppDeployment is a Realm app deployment
 type AppDeployment struct {
 	ID                 string           `json:"_id"`
+	AppID              string           `json:"app_id,omitempty"`
+	DraftID            string           `json:"draft_id,omitempty"`
+	UserID             string           `json:"user_id,omitempty"`
+	DeployedAt         int64            `json:"deployed_at,omitempty"`
+	Origin             string           `json:"origin,omitempty"`
+	Commit             string           `json:"commit,omitempty"`
 	Status             DeploymentStatus `json:"status"`
 	StatusErrorMessage string           `json:"status_error_message"`
 }
@@ -31,8 +39,9 @@ const (
 	DeploymentStatusPending    DeploymentStatus = "pending"
 )
 
-func (c *client) Deployments(groupID, appID string) ([]AppDeployment, error) {
+func (c *client) Deployments(ctx context.Context, groupID, appID string) ([]AppDeployment, error) {
 	res, resErr := c.do(
+		ctx,
 		http.MethodGet,
 		fmt.Sprintf(deploymentsPathPattern, groupID, appID),
 		api.RequestOptions{},
@@ -52,8 +61,9 @@ func (c *client) Deployments(groupID, appID string) ([]AppDeployment, error) {
 	return deployments, nil
 }
 
-func (c *client) Deployment(groupID, appID, deploymentID string) (AppDeployment, error) {
+func (c *client) Deployment(ctx context.Context, groupID, appID, deploymentID string) (AppDeployment, error) {
 	res, resErr := c.do(
+		ctx,
 		http.MethodGet,
 		fmt.Sprintf(deploymentPathPattern, groupID, appID, deploymentID),
 		api.RequestOptions{},
@@ -72,3 +82,19 @@ func (c *client) Deployment(groupID, appID, deploymentID string) (AppDeployment,
 	}
 	return deployment, nil
 }
+
+func (c *client) Redeploy(ctx context.Context, groupID, appID, deploymentID string) error {
+	res, resErr := c.do(
+		ctx,
+		http.MethodPost,
+		fmt.Sprintf(redeployPathPattern, groupID, appID, deploymentID),
+		api.RequestOptions{},
+	)
+	if resErr != nil {
+		return resErr
+	}
+	if res.StatusCode != http.StatusNoContent {
+		return api.ErrUnexpectedStatusCode{"redeploy", res.StatusCode}
+	}
+	return nil
+}
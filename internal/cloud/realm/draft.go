@@ -1,6 +1,7 @@
 package realm
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -20,8 +21,9 @@ type AppDraft struct {
 	ID string `json:"_id"`
 }
 
-func (c *client) CreateDraft(groupID, appID string) (AppDraft, error) {
+func (c *client) CreateDraft(ctx context.Context, groupID, appID string) (AppDraft, error) {
 	res, resErr := c.do(
+		ctx,
 		http.MethodPost,
 		fmt.Sprintf(draftsPathPattern, groupID, appID),
 		api.RequestOptions{},
@@ -41,8 +43,9 @@ func (c *client) CreateDraft(groupID, appID string) (AppDraft, error) {
 	return draft, nil
 }
 
-func (c *client) DeployDraft(groupID, appID, draftID string) (AppDeployment, error) {
+func (c *client) DeployDraft(ctx context.Context, groupID, appID, draftID string) (AppDeployment, error) {
 	res, resErr := c.do(
+		ctx,
 		http.MethodPost,
 		fmt.Sprintf(draftDeployPathPattern, groupID, appID, draftID),
 		api.RequestOptions{},
@@ -62,8 +65,9 @@ func (c *client) DeployDraft(groupID, appID, draftID string) (AppDeployment, err
 	return deployment, nil
 }
 
-func (c *client) DiffDraft(groupID, appID, draftID string) (AppDraftDiff, error) {
+func (c *client) DiffDraft(ctx context.Context, groupID, appID, draftID string) (AppDraftDiff, error) {
 	res, resErr := c.do(
+		ctx,
 		http.MethodGet,
 		fmt.Sprintf(draftDiffPathPattern, groupID, appID, draftID),
 		api.RequestOptions{},
@@ -83,8 +87,9 @@ func (c *client) DiffDraft(groupID, appID, draftID string) (AppDraftDiff, error)
 	return draftDiff, nil
 }
 
-func (c *client) DiscardDraft(groupID, appID, draftID string) error {
+func (c *client) DiscardDraft(ctx context.Context, groupID, appID, draftID string) error {
 	res, resErr := c.do(
+		ctx,
 		http.MethodDelete,
 		fmt.Sprintf(draftPathPattern, groupID, appID, draftID),
 		api.RequestOptions{},
@@ -98,8 +103,9 @@ func (c *client) DiscardDraft(groupID, appID, draftID string) error {
 	return nil
 }
 
-func (c *client) Draft(groupID, appID string) (AppDraft, error) {
+func (c *client) Draft(ctx context.Context, groupID, appID string) (AppDraft, error) {
 	res, resErr := c.do(
+		ctx,
 		http.MethodGet,
 		fmt.Sprintf(draftsPathPattern, groupID, appID),
 		api.RequestOptions{},
@@ -1,6 +1,7 @@
 package realm_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/10gen/realm-cli/internal/cli/user"
@@ -20,7 +21,7 @@ func TestRealmDrafts(t *testing.T) {
 			{
 				"with a call to get draft",
 				func(client realm.Client) error {
-					_, err := client.Draft("groupID", "appID")
+					_, err := client.Draft(context.Background(), "groupID", "appID")
 					return err
 				},
 			},
@@ -37,35 +38,35 @@ func TestRealmDrafts(t *testing.T) {
 		client := newAuthClient(t)
 		groupID := u.CloudGroupID()
 
-		app, appErr := client.CreateApp(groupID, "users-test", realm.AppMeta{})
+		app, appErr := client.CreateApp(context.Background(), groupID, "users-test", realm.AppMeta{})
 		assert.Nil(t, appErr)
 
 		t.Run("getting drafts should fail if there are none", func(t *testing.T) {
-			_, err := client.Draft(groupID, app.ID)
+			_, err := client.Draft(context.Background(), groupID, app.ID)
 			assert.Equal(t, realm.ErrDraftNotFound, err)
 		})
 
 		t.Run("should create a draft", func(t *testing.T) {
-			draft, draftErr := client.CreateDraft(groupID, app.ID)
+			draft, draftErr := client.CreateDraft(context.Background(), groupID, app.ID)
 			assert.Nil(t, draftErr)
 			assert.True(t, draft.ID != "", "created draft id should not be empty")
 
 			t.Run("and be able to retrieve the draft", func(t *testing.T) {
-				found, err := client.Draft(groupID, app.ID)
+				found, err := client.Draft(context.Background(), groupID, app.ID)
 				assert.Nil(t, err)
 				assert.Equal(t, draft, found)
 			})
 
 			t.Run("and be able to get diffs of the draft", func(t *testing.T) {
-				diffs, err := client.DiffDraft(groupID, app.ID, draft.ID)
+				diffs, err := client.DiffDraft(context.Background(), groupID, app.ID, draft.ID)
 				assert.Nil(t, err)
 				assert.Equal(t, 0, diffs.Len())
 			})
 
 			t.Run("and be able to discard the draft", func(t *testing.T) {
-				assert.Nil(t, client.DiscardDraft(groupID, app.ID, draft.ID))
+				assert.Nil(t, client.DiscardDraft(context.Background(), groupID, app.ID, draft.ID))
 
-				_, err := client.Draft(groupID, app.ID)
+				_, err := client.Draft(context.Background(), groupID, app.ID)
 				assert.Equal(t, realm.ErrDraftNotFound, err)
 			})
 		})
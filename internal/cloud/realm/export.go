@@ -3,6 +3,7 @@ package realm
 import (
 	"archive/zip"
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"io/ioutil"
@@ -30,7 +31,7 @@ type ExportRequest struct {
 	IsTemplated   bool
 }
 
-func (c *client) Export(groupID, appID string, req ExportRequest) (string, *zip.Reader, error) {
+func (c *client) Export(ctx context.Context, groupID, appID string, req ExportRequest) (string, *zip.Reader, error) {
 	options := api.RequestOptions{Query: map[string]string{
 		exportQueryVersion: DefaultAppConfigVersion.String(),
 	}}
@@ -44,7 +45,7 @@ func (c *client) Export(groupID, appID string, req ExportRequest) (string, *zip.
 		options.Query[exportQueryForSourceControl] = trueVal
 	}
 
-	res, resErr := c.do(http.MethodGet, fmt.Sprintf(exportPathPattern, groupID, appID), options)
+	res, resErr := c.do(ctx, http.MethodGet, fmt.Sprintf(exportPathPattern, groupID, appID), options)
 	if resErr != nil {
 		return "", nil, resErr
 	}
@@ -1,6 +1,7 @@
 package realm
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -32,7 +33,7 @@ type Function struct {
 	Name string `json:"name"`
 }
 
-func (c *client) AppDebugExecuteFunction(groupID, appID, userID, name string, args []interface{}) (ExecutionResults, error) {
+func (c *client) AppDebugExecuteFunction(ctx context.Context, groupID, appID, userID, name string, args []interface{}) (ExecutionResults, error) {
 	query := map[string]string{}
 	if userID == "" {
 		query["run_as_system"] = "true"
@@ -40,6 +41,7 @@ func (c *client) AppDebugExecuteFunction(groupID, appID, userID, name string, ar
 		query["user_id"] = userID
 	}
 	res, err := c.doJSON(
+		ctx,
 		http.MethodPost,
 		fmt.Sprintf(AppDebugExecuteFunctionPattern, groupID, appID),
 		map[string]interface{}{
@@ -63,8 +65,9 @@ func (c *client) AppDebugExecuteFunction(groupID, appID, userID, name string, ar
 	return response, nil
 }
 
-func (c *client) Functions(groupID, appID string) ([]Function, error) {
+func (c *client) Functions(ctx context.Context, groupID, appID string) ([]Function, error) {
 	res, err := c.do(
+		ctx,
 		http.MethodGet,
 		fmt.Sprintf(FunctionsPattern, groupID, appID),
 		api.RequestOptions{},
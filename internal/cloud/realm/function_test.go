@@ -1,6 +1,7 @@
 package realm_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/10gen/realm-cli/internal/cli/user"
@@ -16,7 +17,7 @@ func TestFunctions(t *testing.T) {
 	t.Run("should fail without an auth client", func(t *testing.T) {
 		client := realm.NewClient(u.RealmServerURL())
 
-		_, err := client.Functions(u.CloudGroupID(), "test-app-1234")
+		_, err := client.Functions(context.Background(), u.CloudGroupID(), "test-app-1234")
 		assert.Equal(t, realm.ErrInvalidSession(user.DefaultProfile), err)
 	})
 
@@ -29,7 +30,7 @@ func TestFunctions(t *testing.T) {
 		defer teardown()
 
 		t.Run("should find 0 functions", func(t *testing.T) {
-			functions, err := client.Functions(u.CloudGroupID(), app.ID)
+			functions, err := client.Functions(context.Background(), u.CloudGroupID(), app.ID)
 			assert.Nil(t, err)
 
 			assert.Equal(t, 0, len(functions))
@@ -52,10 +53,10 @@ func TestFunctions(t *testing.T) {
 				},
 			}}
 
-			err := client.Import(groupID, app.ID, appData)
+			err := client.Import(context.Background(), groupID, app.ID, appData)
 			assert.Nil(t, err)
 
-			functions, err := client.Functions(u.CloudGroupID(), app.ID)
+			functions, err := client.Functions(context.Background(), u.CloudGroupID(), app.ID)
 			assert.Nil(t, err)
 
 			assert.Equal(t, 1, len(functions))
@@ -70,7 +71,7 @@ func TestAppDebugExecuteFunction(t *testing.T) {
 	t.Run("should fail without an auth client", func(t *testing.T) {
 		client := realm.NewClient(u.RealmServerURL())
 
-		_, err := client.AppDebugExecuteFunction(u.CloudGroupID(), "test-app-1234", "", "test-function", nil)
+		_, err := client.AppDebugExecuteFunction(context.Background(), u.CloudGroupID(), "test-app-1234", "", "test-function", nil)
 		assert.Equal(t, realm.ErrInvalidSession(user.DefaultProfile), err)
 	})
 
@@ -100,11 +101,11 @@ func TestAppDebugExecuteFunction(t *testing.T) {
 			},
 		}}
 
-		err := client.Import(groupID, app.ID, appData)
+		err := client.Import(context.Background(), groupID, app.ID, appData)
 		assert.Nil(t, err)
 
 		t.Run("should return string", func(t *testing.T) {
-			response, err := client.AppDebugExecuteFunction(u.CloudGroupID(), app.ID, "", "simple_test", nil)
+			response, err := client.AppDebugExecuteFunction(context.Background(), u.CloudGroupID(), app.ID, "", "simple_test", nil)
 			assert.Nil(t, err)
 
 			assert.Equal(t, "successful test", response.Result)
@@ -119,7 +120,7 @@ func TestAppDebugExecuteFunction(t *testing.T) {
 				[]int{1, 2},
 			}
 
-			response, err := client.AppDebugExecuteFunction(u.CloudGroupID(), app.ID, "", "passed_args_test", args)
+			response, err := client.AppDebugExecuteFunction(context.Background(), u.CloudGroupID(), app.ID, "", "passed_args_test", args)
 			assert.Nil(t, err)
 
 			assert.Equal(t, map[string]interface{}{
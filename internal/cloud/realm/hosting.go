@@ -1,6 +1,7 @@
 package realm
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -46,8 +47,9 @@ type HostingAssetAttribute struct {
 	Value string `json:"value"`
 }
 
-func (c *client) HostingAssets(groupID, appID string) ([]HostingAsset, error) {
+func (c *client) HostingAssets(ctx context.Context, groupID, appID string) ([]HostingAsset, error) {
 	res, err := c.do(
+		ctx,
 		http.MethodGet,
 		fmt.Sprintf(hostingAssetsPathPattern, groupID, appID),
 		api.RequestOptions{Query: map[string]string{hostingQueryRecursive: trueVal}},
@@ -67,13 +69,7 @@ func (c *client) HostingAssets(groupID, appID string) ([]HostingAsset, error) {
 	return assets, nil
 }
 
-func (c *client) HostingAssetUpload(groupID, appID, rootDir string, asset HostingAsset) error {
-	file, err := os.Open(filepath.Join(rootDir, asset.FilePath))
-	if err != nil {
-		return err
-	}
-	defer file.Close()
-
+func (c *client) HostingAssetUpload(ctx context.Context, groupID, appID, rootDir string, asset HostingAsset) error {
 	data, err := json.Marshal(HostingAsset{
 		AppID: appID,
 		HostingAssetData: HostingAssetData{
@@ -87,50 +83,66 @@ func (c *client) HostingAssetUpload(groupID, appID, rootDir string, asset Hostin
 		return err
 	}
 
-	// Construct a pipe stream: the reader side will be consumed and sent as the body of the outgoing request,
-	// and the writer side we can use to asynchronously populate it.
-	pipeReader, pipeWriter := io.Pipe()
+	boundary := multipart.NewWriter(nil).Boundary()
 
-	bodyWriter := multipart.NewWriter(pipeWriter)
-	errChan := make(chan error, 2)
-
-	go func() {
-		defer func() {
-			bodyWriter.Close()
-			pipeWriter.Close()
-		}()
-		// Create the first part and write the metadata into it
-		mw, err := bodyWriter.CreateFormField(paramMetadata)
+	// getBody constructs a pipe stream: the reader side will be consumed and sent as the body of the outgoing request,
+	// and the writer side we can use to asynchronously populate it. A new stream is constructed each time
+	// so the upload can be replayed if the request is retried
+	getBody := func() (io.ReadCloser, error) {
+		file, err := os.Open(filepath.Join(rootDir, asset.FilePath))
 		if err != nil {
-			errChan <- fmt.Errorf("failed to create metadata multipart field: %w", err)
+			return nil, err
 		}
 
-		if _, err := mw.Write(data); err != nil {
-			errChan <- fmt.Errorf("failed to write metadata to body: %w", err)
-		}
+		pipeReader, pipeWriter := io.Pipe()
 
-		fw, err := bodyWriter.CreateFormField(paramFile)
-		if err != nil {
-			errChan <- fmt.Errorf("failed to create file multipart field: %w", err)
+		bodyWriter := multipart.NewWriter(pipeWriter)
+		if err := bodyWriter.SetBoundary(boundary); err != nil {
+			file.Close()
+			return nil, err
 		}
 
-		if _, err := io.Copy(fw, file); err != nil {
-			errChan <- fmt.Errorf("failed to write file to body: %w", err)
-		}
-		errChan <- nil
-	}()
+		go func() {
+			defer file.Close()
+
+			// Create the first part and write the metadata into it
+			mw, err := bodyWriter.CreateFormField(paramMetadata)
+			if err != nil {
+				pipeWriter.CloseWithError(fmt.Errorf("failed to create metadata multipart field: %w", err))
+				return
+			}
+
+			if _, err := mw.Write(data); err != nil {
+				pipeWriter.CloseWithError(fmt.Errorf("failed to write metadata to body: %w", err))
+				return
+			}
+
+			fw, err := bodyWriter.CreateFormField(paramFile)
+			if err != nil {
+				pipeWriter.CloseWithError(fmt.Errorf("failed to create file multipart field: %w", err))
+				return
+			}
+
+			if _, err := io.Copy(fw, file); err != nil {
+				pipeWriter.CloseWithError(fmt.Errorf("failed to write file to body: %w", err))
+				return
+			}
+
+			pipeWriter.CloseWithError(bodyWriter.Close())
+		}()
+
+		return pipeReader, nil
+	}
 
 	res, err := c.do(
+		ctx,
 		http.MethodPut,
 		fmt.Sprintf(hostingAssetPathPattern, groupID, appID),
 		api.RequestOptions{
-			Body:        pipeReader,
-			ContentType: "multipart/mixed; boundary=" + bodyWriter.Boundary(),
+			GetBody:     getBody,
+			ContentType: "multipart/mixed; boundary=" + boundary,
 		},
 	)
-	if err := <-errChan; err != nil {
-		return err
-	}
 	if err != nil {
 		return err
 	}
@@ -140,8 +152,9 @@ func (c *client) HostingAssetUpload(groupID, appID, rootDir string, asset Hostin
 	return nil
 }
 
-func (c *client) HostingAssetRemove(groupID, appID, path string) error {
+func (c *client) HostingAssetRemove(ctx context.Context, groupID, appID, path string) error {
 	res, err := c.do(
+		ctx,
 		http.MethodDelete,
 		fmt.Sprintf(hostingAssetPathPattern, groupID, appID),
 		api.RequestOptions{Query: map[string]string{paramPath: path}},
@@ -159,8 +172,9 @@ type hostingAssetAttributesUpdateRequest struct {
 	Attributes HostingAssetAttributes `json:"attributes"`
 }
 
-func (c *client) HostingAssetAttributesUpdate(groupID, appID, path string, attrs ...HostingAssetAttribute) error {
+func (c *client) HostingAssetAttributesUpdate(ctx context.Context, groupID, appID, path string, attrs ...HostingAssetAttribute) error {
 	res, err := c.doJSON(
+		ctx,
 		http.MethodPatch,
 		fmt.Sprintf(hostingAssetPathPattern, groupID, appID),
 		hostingAssetAttributesUpdateRequest{attrs},
@@ -180,8 +194,9 @@ type hostingCacheInvalidateRequest struct {
 	Path       string `json:"path"`
 }
 
-func (c *client) HostingCacheInvalidate(groupID, appID, path string) error {
+func (c *client) HostingCacheInvalidate(ctx context.Context, groupID, appID, path string) error {
 	res, err := c.doJSON(
+		ctx,
 		http.MethodPut,
 		fmt.Sprintf(hostingCachePathPattern, groupID, appID),
 		hostingCacheInvalidateRequest{true, path},
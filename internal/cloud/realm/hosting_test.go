@@ -1,6 +1,7 @@
 package realm_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/10gen/realm-cli/internal/cloud/realm"
@@ -21,13 +22,13 @@ func TestRealmHosting(t *testing.T) {
 	defer teardown()
 
 	t.Run("should initially get empty hosting assets", func(t *testing.T) {
-		assets, err := client.HostingAssets(groupID, app.ID)
+		assets, err := client.HostingAssets(context.Background(), groupID, app.ID)
 		assert.Nil(t, err)
 		assert.Equal(t, 0, len(assets))
 	})
 
 	t.Run("should upload a file successfully", func(t *testing.T) {
-		assert.Nil(t, client.HostingAssetUpload(groupID, app.ID, "testdata/hosting", realm.HostingAsset{
+		assert.Nil(t, client.HostingAssetUpload(context.Background(), groupID, app.ID, "testdata/hosting", realm.HostingAsset{
 			HostingAssetData: realm.HostingAssetData{
 				FilePath: "/index.html",
 				FileHash: "9163ebc83aa75cae0a7e74b4e16af317",
@@ -38,7 +39,7 @@ func TestRealmHosting(t *testing.T) {
 	})
 
 	t.Run("should then get the hosting asset", func(t *testing.T) {
-		assets, err := client.HostingAssets(groupID, app.ID)
+		assets, err := client.HostingAssets(context.Background(), groupID, app.ID)
 		assert.Nil(t, err)
 		assert.Equal(t, 1, len(assets))
 
@@ -54,9 +55,9 @@ func TestRealmHosting(t *testing.T) {
 		t.Run("should be able to update the hosting asset attribute", func(t *testing.T) {
 			attr := realm.HostingAssetAttribute{api.HeaderContentType, api.MediaTypeJSON}
 
-			assert.Nil(t, client.HostingAssetAttributesUpdate(groupID, app.ID, asset.FilePath, attr))
+			assert.Nil(t, client.HostingAssetAttributesUpdate(context.Background(), groupID, app.ID, asset.FilePath, attr))
 
-			appAssets, err := client.HostingAssets(groupID, app.ID)
+			appAssets, err := client.HostingAssets(context.Background(), groupID, app.ID)
 			assert.Nil(t, err)
 			assert.Equal(t, 1, len(appAssets))
 
@@ -71,24 +72,24 @@ func TestRealmHosting(t *testing.T) {
 		})
 
 		t.Run("should be able to remove the hosting asset", func(t *testing.T) {
-			assert.Nil(t, client.HostingAssetRemove(groupID, app.ID, asset.FilePath))
+			assert.Nil(t, client.HostingAssetRemove(context.Background(), groupID, app.ID, asset.FilePath))
 
-			appAssets, err := client.HostingAssets(groupID, app.ID)
+			appAssets, err := client.HostingAssets(context.Background(), groupID, app.ID)
 			assert.Nil(t, err)
 			assert.Equal(t, 0, len(appAssets))
 		})
 	})
 
 	t.Run("should fail to invalidate the cache with hosting disabled", func(t *testing.T) {
-		err := client.HostingCacheInvalidate(groupID, app.ID, "/*")
+		err := client.HostingCacheInvalidate(context.Background(), groupID, app.ID, "/*")
 		assert.Equal(t, realm.ServerError{Message: "hosting is disabled"}, err)
 	})
 
 	t.Run("should invalidate the cache for all files successfully with hosting enabled", func(t *testing.T) {
-		assert.Nil(t, client.Import(groupID, app.ID, &local.AppRealmConfigJSON{local.AppDataV2{local.AppStructureV2{
+		assert.Nil(t, client.Import(context.Background(), groupID, app.ID, &local.AppRealmConfigJSON{local.AppDataV2{local.AppStructureV2{
 			ConfigVersion: realm.AppConfigVersion20210101,
 			Hosting:       map[string]interface{}{"enabled": true},
 		}}}))
-		assert.Nil(t, client.HostingCacheInvalidate(groupID, app.ID, "/*"))
+		assert.Nil(t, client.HostingCacheInvalidate(context.Background(), groupID, app.ID, "/*"))
 	})
 }
@@ -1,6 +1,7 @@
 package realm
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -17,8 +18,8 @@ const (
 	importStrategyReplaceByName = "replace-by-name"
 )
 
-func (c *client) Diff(groupID, appID string, appData interface{}) ([]string, error) {
-	res, resErr := c.doImport(groupID, appID, appData, true)
+func (c *client) Diff(ctx context.Context, groupID, appID string, appData interface{}) ([]string, error) {
+	res, resErr := c.doImport(ctx, groupID, appID, appData, true)
 	if resErr != nil {
 		return nil, resErr
 	}
@@ -34,8 +35,8 @@ func (c *client) Diff(groupID, appID string, appData interface{}) ([]string, err
 	return diffs, nil
 }
 
-func (c *client) Import(groupID, appID string, appData interface{}) error {
-	res, resErr := c.doImport(groupID, appID, appData, false)
+func (c *client) Import(ctx context.Context, groupID, appID string, appData interface{}) error {
+	res, resErr := c.doImport(ctx, groupID, appID, appData, false)
 	if resErr != nil {
 		return resErr
 	}
@@ -45,13 +46,14 @@ func (c *client) Import(groupID, appID string, appData interface{}) error {
 	return nil
 }
 
-func (c *client) doImport(groupID, appID string, appData interface{}, diff bool) (*http.Response, error) {
+func (c *client) doImport(ctx context.Context, groupID, appID string, appData interface{}, diff bool) (*http.Response, error) {
 	query := map[string]string{importQueryStrategy: importStrategyReplaceByName}
 	if diff {
 		query[importQueryDiff] = trueVal
 	}
 
 	return c.doJSON(
+		ctx,
 		http.MethodPost,
 		fmt.Sprintf(importPathPattern, groupID, appID),
 		appData,
@@ -2,6 +2,7 @@ package realm_test
 
 import (
 	"archive/zip"
+	"context"
 	"fmt"
 	"io/ioutil"
 	"path/filepath"
@@ -54,9 +55,9 @@ func TestRealmImportExportRoundTrip(t *testing.T) {
 
 			appData := tc.importData(app)
 
-			assert.Nil(t, client.Import(groupID, app.ID, appData))
+			assert.Nil(t, client.Import(context.Background(), groupID, app.ID, appData))
 
-			filename, zipPkg, exportErr := client.Export(groupID, app.ID, realm.ExportRequest{ConfigVersion: tc.configVersion})
+			filename, zipPkg, exportErr := client.Export(context.Background(), groupID, app.ID, realm.ExportRequest{ConfigVersion: tc.configVersion})
 			assert.Nil(t, exportErr)
 
 			filenameMatch, matchErr := regexp.MatchString(fmt.Sprintf("%s_.*\\.zip", app.Name), filename)
@@ -87,7 +88,7 @@ func TestRealmImport20210101(t *testing.T) {
 	defer teardown()
 
 	t.Run("Should import a service with a secret successfully", func(t *testing.T) {
-		assert.Nil(t, client.Import(groupID, app.ID, local.AppDataV2{local.AppStructureV2{
+		assert.Nil(t, client.Import(context.Background(), groupID, app.ID, local.AppDataV2{local.AppStructureV2{
 			ConfigVersion:   realm.AppConfigVersion20210101,
 			ID:              app.ClientAppID,
 			Name:            app.Name,
@@ -114,12 +115,12 @@ func TestRealmImport20210101(t *testing.T) {
 			Secrets: local.SecretsStructure{Services: map[string]map[string]string{"twilio_svc": map[string]string{"auth_token": "my-secret-auth-token"}}},
 		}}))
 
-		secrets, secretsErr := client.Secrets(groupID, app.ID)
+		secrets, secretsErr := client.Secrets(context.Background(), groupID, app.ID)
 		assert.Nil(t, secretsErr)
 		assert.Equal(t, 1, len(secrets))
 		assert.Equal(t, "__twilio_svc_auth_token", secrets[0].Name)
 
-		_, zipPkg, exportErr := client.Export(groupID, app.ID, realm.ExportRequest{ConfigVersion: realm.AppConfigVersion20210101})
+		_, zipPkg, exportErr := client.Export(context.Background(), groupID, app.ID, realm.ExportRequest{ConfigVersion: realm.AppConfigVersion20210101})
 		assert.Nil(t, exportErr)
 
 		exported := parseZipPkg(t, zipPkg)
@@ -152,7 +153,7 @@ func TestRealmImportLegacy(t *testing.T) {
 
 	for _, configVersion := range []realm.AppConfigVersion{realm.AppConfigVersion20180301, realm.AppConfigVersion20200603} {
 		t.Run(fmt.Sprintf("Should import a service with a secret successfully for config version %d", configVersion), func(t *testing.T) {
-			assert.Nil(t, client.Import(groupID, app.ID, local.AppDataV1{local.AppStructureV1{
+			assert.Nil(t, client.Import(context.Background(), groupID, app.ID, local.AppDataV1{local.AppStructureV1{
 				ConfigVersion:   configVersion,
 				ID:              app.ClientAppID,
 				Name:            app.Name,
@@ -179,12 +180,12 @@ func TestRealmImportLegacy(t *testing.T) {
 				Secrets: local.SecretsStructure{Services: map[string]map[string]string{"twilio_svc": map[string]string{"auth_token": "my-secret-auth-token"}}},
 			}}))
 
-			secrets, secretsErr := client.Secrets(groupID, app.ID)
+			secrets, secretsErr := client.Secrets(context.Background(), groupID, app.ID)
 			assert.Nil(t, secretsErr)
 			assert.Equal(t, 1, len(secrets))
 			assert.Equal(t, "__twilio_svc_auth_token", secrets[0].Name)
 
-			_, zipPkg, exportErr := client.Export(groupID, app.ID, realm.ExportRequest{ConfigVersion: configVersion})
+			_, zipPkg, exportErr := client.Export(context.Background(), groupID, app.ID, realm.ExportRequest{ConfigVersion: configVersion})
 			assert.Nil(t, exportErr)
 
 			exported := parseZipPkg(t, zipPkg)
@@ -1,9 +1,11 @@
 package realm
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
@@ -15,6 +17,7 @@ const (
 
 	logsQueryEndDate    = "end_date"
 	logsQueryErrorsOnly = "errors_only"
+	logsQuerySkip       = "skip"
 	logsQueryStartDate  = "start_date"
 	logsQueryType       = "type"
 
@@ -54,6 +57,16 @@ type LogsOptions struct {
 	Types      []string
 	Start      time.Time
 	End        time.Time
+	Skip       int
+}
+
+// LogsPage is a page of Realm app logs, sorted from most to least recent,
+// along with the cursor to request the next page with
+// When there are no more logs, the next end date is the zero time
+type LogsPage struct {
+	Logs        Logs
+	NextEndDate time.Time
+	NextSkip    int
 }
 
 // Logs is an array of Realm app logs
@@ -65,6 +78,7 @@ func (l Logs) Swap(i, j int)      { l[i], l[j] = l[j], l[i] }
 
 // Log is a Realm app log
 type Log struct {
+	ID                    string        `json:"_id"`
 	Messages              []interface{} `json:"messages"`
 	Type                  string        `json:"type"`
 	Started               time.Time     `json:"started"`
@@ -89,10 +103,20 @@ type LogAuthEvent struct {
 }
 
 type logsResponse struct {
-	Logs []Log `json:"logs"`
+	Logs        []Log  `json:"logs"`
+	NextEndDate string `json:"nextEndDate"`
+	NextSkip    int    `json:"nextSkip"`
+}
+
+func (c *client) Logs(ctx context.Context, groupID, appID string, opts LogsOptions) (Logs, error) {
+	page, err := c.LogsPage(ctx, groupID, appID, opts)
+	if err != nil {
+		return nil, err
+	}
+	return page.Logs, nil
 }
 
-func (c *client) Logs(groupID, appID string, opts LogsOptions) (Logs, error) {
+func (c *client) LogsPage(ctx context.Context, groupID, appID string, opts LogsOptions) (LogsPage, error) {
 	query := map[string]string{}
 	if len(opts.Types) > 0 {
 		query[logsQueryType] = strings.Join(opts.Types, ",")
@@ -106,23 +130,36 @@ func (c *client) Logs(groupID, appID string, opts LogsOptions) (Logs, error) {
 	if !opts.End.IsZero() {
 		query[logsQueryEndDate] = opts.End.Format(logsDateFormat)
 	}
+	if opts.Skip > 0 {
+		query[logsQuerySkip] = strconv.Itoa(opts.Skip)
+	}
 
 	res, err := c.do(
+		ctx,
 		http.MethodGet,
 		fmt.Sprintf(logsPathPattern, groupID, appID),
 		api.RequestOptions{Query: query},
 	)
 	if err != nil {
-		return nil, err
+		return LogsPage{}, err
 	}
 	if res.StatusCode != http.StatusOK {
-		return nil, api.ErrUnexpectedStatusCode{"get logs", res.StatusCode}
+		return LogsPage{}, api.ErrUnexpectedStatusCode{"get logs", res.StatusCode}
 	}
 	defer res.Body.Close()
 
 	var out logsResponse
 	if err := json.NewDecoder(res.Body).Decode(&out); err != nil {
-		return nil, err
+		return LogsPage{}, err
+	}
+
+	page := LogsPage{Logs: out.Logs, NextSkip: out.NextSkip}
+	if out.NextEndDate != "" {
+		nextEndDate, err := time.Parse(time.RFC3339Nano, out.NextEndDate)
+		if err != nil {
+			return LogsPage{}, err
+		}
+		page.NextEndDate = nextEndDate
 	}
-	return out.Logs, nil
+	return page, nil
 }
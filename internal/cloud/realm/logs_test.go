@@ -1,6 +1,7 @@
 package realm_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/10gen/realm-cli/internal/cloud/realm"
@@ -19,9 +20,16 @@ func TestRealmLogs(t *testing.T) {
 		defer teardown()
 
 		t.Run("getting logs should return an empty list if there are none", func(t *testing.T) {
-			logs, err := client.Logs(groupID, app.ID, realm.LogsOptions{})
+			logs, err := client.Logs(context.Background(), groupID, app.ID, realm.LogsOptions{})
 			assert.Nil(t, err)
 			assert.Equal(t, 0, len(logs))
 		})
+
+		t.Run("getting a logs page should return an empty page without a next page if there are none", func(t *testing.T) {
+			page, err := client.LogsPage(context.Background(), groupID, app.ID, realm.LogsOptions{})
+			assert.Nil(t, err)
+			assert.Equal(t, 0, len(page.Logs))
+			assert.True(t, page.NextEndDate.IsZero(), "expected no next page")
+		})
 	})
 }
@@ -1,6 +1,7 @@
 package realm
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -41,8 +42,9 @@ type SchemaModelAlert struct {
 	Code    string `json:"error_code"`
 }
 
-func (c *client) SchemaModels(groupID, appID, language string) ([]SchemaModel, error) {
+func (c *client) SchemaModels(ctx context.Context, groupID, appID, language string) ([]SchemaModel, error) {
 	res, err := c.do(
+		ctx,
 		http.MethodGet,
 		fmt.Sprintf(syncClientSchemasPathPattern, groupID, appID, language),
 		api.RequestOptions{},
@@ -1,6 +1,7 @@
 package realm_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/10gen/realm-cli/internal/cli/user"
@@ -15,7 +16,7 @@ func TestRealmSchemaModels(t *testing.T) {
 	t.Run("should respond with 401 when not authenticated", func(t *testing.T) {
 		client := realm.NewClient(u.RealmServerURL())
 
-		_, err := client.SchemaModels("", "", realm.DataModelLanguageJava)
+		_, err := client.SchemaModels(context.Background(), "", "", realm.DataModelLanguageJava)
 		assert.Equal(t, realm.ErrInvalidSession(user.DefaultProfile), err)
 	})
 
@@ -27,7 +28,7 @@ func TestRealmSchemaModels(t *testing.T) {
 		defer teardown()
 
 		t.Run("should respond with an empty list of data models with no app schema", func(t *testing.T) {
-			models, err := client.SchemaModels(groupID, app.ID, realm.DataModelLanguageTypescript)
+			models, err := client.SchemaModels(context.Background(), groupID, app.ID, realm.DataModelLanguageTypescript)
 			assert.Nil(t, err)
 			assert.Equal(t, 0, len(models))
 		})
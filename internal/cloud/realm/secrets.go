@@ -1,6 +1,7 @@
 package realm
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -19,8 +20,9 @@ type Secret struct {
 	Name string `json:"name"`
 }
 
-func (c *client) Secrets(groupID, appID string) ([]Secret, error) {
+func (c *client) Secrets(ctx context.Context, groupID, appID string) ([]Secret, error) {
 	res, resErr := c.do(
+		ctx,
 		http.MethodGet,
 		fmt.Sprintf(secretsPathPattern, groupID, appID),
 		api.RequestOptions{},
@@ -47,8 +49,9 @@ type secretsPayload struct {
 	Value string `json:"value"`
 }
 
-func (c *client) CreateSecret(groupID, appID, name, value string) (Secret, error) {
+func (c *client) CreateSecret(ctx context.Context, groupID, appID, name, value string) (Secret, error) {
 	res, resErr := c.doJSON(
+		ctx,
 		http.MethodPost,
 		fmt.Sprintf(secretsPathPattern, groupID, appID),
 		secretsPayload{name, value},
@@ -68,8 +71,9 @@ func (c *client) CreateSecret(groupID, appID, name, value string) (Secret, error
 	return secret, nil
 }
 
-func (c *client) DeleteSecret(groupID, appID, secretID string) error {
+func (c *client) DeleteSecret(ctx context.Context, groupID, appID, secretID string) error {
 	res, err := c.do(
+		ctx,
 		http.MethodDelete,
 		fmt.Sprintf(secretPathPattern, groupID, appID, secretID),
 		api.RequestOptions{},
@@ -86,8 +90,9 @@ func (c *client) DeleteSecret(groupID, appID, secretID string) error {
 	return nil
 }
 
-func (c *client) UpdateSecret(groupID, appID, secretID, name, value string) error {
+func (c *client) UpdateSecret(ctx context.Context, groupID, appID, secretID, name, value string) error {
 	res, err := c.doJSON(
+		ctx,
 		http.MethodPut,
 		fmt.Sprintf(secretPathPattern, groupID, appID, secretID),
 		secretsPayload{name, value},
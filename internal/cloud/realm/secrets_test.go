@@ -1,6 +1,7 @@
 package realm_test
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -18,7 +19,7 @@ func TestRealmSecrets(t *testing.T) {
 	t.Run("should fail without an auth client", func(t *testing.T) {
 		client := realm.NewClient(u.RealmServerURL())
 
-		_, err := client.Secrets(primitive.NewObjectID().Hex(), primitive.NewObjectID().Hex())
+		_, err := client.Secrets(context.Background(), primitive.NewObjectID().Hex(), primitive.NewObjectID().Hex())
 		assert.Equal(t, realm.ErrInvalidSession(user.DefaultProfile), err)
 	})
 
@@ -30,7 +31,7 @@ func TestRealmSecrets(t *testing.T) {
 		defer teardown()
 
 		t.Run("should have no secrets upon app initialization", func(t *testing.T) {
-			secrets, err := client.Secrets(groupID, testApp.ID)
+			secrets, err := client.Secrets(context.Background(), groupID, testApp.ID)
 			assert.Nil(t, err)
 			assert.Equal(t, 0, len(secrets))
 		})
@@ -39,41 +40,41 @@ func TestRealmSecrets(t *testing.T) {
 			secretName := "secretName"
 			secretValue := "secretValue"
 
-			secret, err := client.CreateSecret(groupID, testApp.ID, secretName, secretValue)
+			secret, err := client.CreateSecret(context.Background(), groupID, testApp.ID, secretName, secretValue)
 			assert.Nil(t, err)
 
 			t.Run("and list all app secrets", func(t *testing.T) {
-				secrets, err := client.Secrets(groupID, testApp.ID)
+				secrets, err := client.Secrets(context.Background(), groupID, testApp.ID)
 				assert.Nil(t, err)
 				assert.Equal(t, []realm.Secret{secret}, secrets)
 			})
 
 			t.Run("and should update the app secret name", func(t *testing.T) {
-				assert.Nil(t, client.UpdateSecret(groupID, testApp.ID, secret.ID, "newName", ""))
+				assert.Nil(t, client.UpdateSecret(context.Background(), groupID, testApp.ID, secret.ID, "newName", ""))
 
 				t.Run("and list the new app secret value", func(t *testing.T) {
-					secrets, err := client.Secrets(groupID, testApp.ID)
+					secrets, err := client.Secrets(context.Background(), groupID, testApp.ID)
 					assert.Nil(t, err)
 					assert.Equal(t, "newName", secrets[0].Name)
 				})
 
 				t.Run("and return an error if we can't find the secret", func(t *testing.T) {
-					err := client.UpdateSecret(groupID, testApp.ID, "notFound", "notUsed", "notUsed")
+					err := client.UpdateSecret(context.Background(), groupID, testApp.ID, "notFound", "notUsed", "notUsed")
 					assert.Equal(t, realm.ServerError{Message: `secret not found: "notFound"`}, err)
 				})
 			})
 
 			t.Run("and should delete the app secret", func(t *testing.T) {
-				assert.Nil(t, client.DeleteSecret(groupID, testApp.ID, secret.ID))
+				assert.Nil(t, client.DeleteSecret(context.Background(), groupID, testApp.ID, secret.ID))
 
 				t.Run("and list no more app secrets", func(t *testing.T) {
-					secrets, err := client.Secrets(groupID, testApp.ID)
+					secrets, err := client.Secrets(context.Background(), groupID, testApp.ID)
 					assert.Nil(t, err)
 					assert.Equal(t, []realm.Secret{}, secrets)
 				})
 
 				t.Run("and return an error if we can't find the secret", func(t *testing.T) {
-					err := client.DeleteSecret(groupID, testApp.ID, secret.ID)
+					err := client.DeleteSecret(context.Background(), groupID, testApp.ID, secret.ID)
 					assert.Equal(t, realm.ServerError{Message: fmt.Sprintf("secret not found: %q", secret.ID)}, err)
 				})
 			})
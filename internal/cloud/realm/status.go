@@ -1,6 +1,7 @@
 package realm
 
 import (
+	"context"
 	"errors"
 	"net/http"
 
@@ -16,8 +17,8 @@ var (
 	ErrServerUnavailable = errors.New("Realm server is not available")
 )
 
-func (c *client) Status() error {
-	res, err := c.do(http.MethodGet, statusPath, api.RequestOptions{NoAuth: true})
+func (c *client) Status(ctx context.Context) error {
+	res, err := c.do(ctx, http.MethodGet, statusPath, api.RequestOptions{NoAuth: true})
 	if err != nil {
 		return ErrServerUnavailable
 	}
@@ -1,6 +1,7 @@
 package realm_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/10gen/realm-cli/internal/cloud/realm"
@@ -13,7 +14,7 @@ func TestRealmStatus(t *testing.T) {
 	client := realm.NewClient(u.RealmServerURL())
 
 	t.Run("Should return no error if the server is running", func(t *testing.T) {
-		err := client.Status()
+		err := client.Status(context.Background())
 		assert.Nil(t, err)
 	})
 }
@@ -23,7 +24,7 @@ func TestRealmStatusFailure(t *testing.T) {
 	client := realm.NewClient(baseURL)
 
 	t.Run("Should return an error if the server is not running", func(t *testing.T) {
-		err := client.Status()
+		err := client.Status(context.Background())
 		assert.Equal(t, realm.ErrServerUnavailable, err)
 	})
 }
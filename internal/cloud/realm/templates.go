@@ -3,6 +3,7 @@ package realm
 import (
 	"archive/zip"
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -46,8 +47,9 @@ const (
 	compatibleTemplatesPathPattern = appPathPattern + "/templates"
 )
 
-func (c *client) AllTemplates() (Templates, error) {
+func (c *client) AllTemplates(ctx context.Context) (Templates, error) {
 	res, resErr := c.do(
+		ctx,
 		http.MethodGet,
 		allTemplatesPath,
 		api.RequestOptions{},
@@ -67,8 +69,8 @@ func (c *client) AllTemplates() (Templates, error) {
 	return templates, nil
 }
 
-func (c *client) ClientTemplate(groupID, appID, templateID string) (*zip.Reader, bool, error) {
-	res, resErr := c.do(http.MethodGet, fmt.Sprintf(clientTemplatePathPattern, groupID, appID, templateID), api.RequestOptions{})
+func (c *client) ClientTemplate(ctx context.Context, groupID, appID, templateID string) (*zip.Reader, bool, error) {
+	res, resErr := c.do(ctx, http.MethodGet, fmt.Sprintf(clientTemplatePathPattern, groupID, appID, templateID), api.RequestOptions{})
 	if resErr != nil {
 		return nil, false, resErr
 	}
@@ -94,8 +96,8 @@ func (c *client) ClientTemplate(groupID, appID, templateID string) (*zip.Reader,
 	return zipPkg, true, nil
 }
 
-func (c *client) CompatibleTemplates(groupID, appID string) (Templates, error) {
-	res, resErr := c.do(http.MethodGet, fmt.Sprintf(compatibleTemplatesPathPattern, groupID, appID), api.RequestOptions{})
+func (c *client) CompatibleTemplates(ctx context.Context, groupID, appID string) (Templates, error) {
+	res, resErr := c.do(ctx, http.MethodGet, fmt.Sprintf(compatibleTemplatesPathPattern, groupID, appID), api.RequestOptions{})
 	if resErr != nil {
 		return nil, resErr
 	}
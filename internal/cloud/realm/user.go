@@ -1,6 +1,7 @@
 package realm
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -183,8 +184,9 @@ type createAPIKeyRequest struct {
 	Name string `json:"name"`
 }
 
-func (c *client) CreateAPIKey(groupID, appID, apiKeyName string) (APIKey, error) {
+func (c *client) CreateAPIKey(ctx context.Context, groupID, appID, apiKeyName string) (APIKey, error) {
 	res, resErr := c.doJSON(
+		ctx,
 		http.MethodPost,
 		fmt.Sprintf(apiKeysPathPattern, groupID, appID),
 		createAPIKeyRequest{apiKeyName},
@@ -210,8 +212,9 @@ type createUserRequest struct {
 	Password string `json:"password"`
 }
 
-func (c *client) CreateUser(groupID, appID, email, password string) (User, error) {
+func (c *client) CreateUser(ctx context.Context, groupID, appID, email, password string) (User, error) {
 	res, resErr := c.doJSON(
+		ctx,
 		http.MethodPost,
 		fmt.Sprintf(usersPathPattern, groupID, appID),
 		createUserRequest{email, password},
@@ -232,8 +235,9 @@ func (c *client) CreateUser(groupID, appID, email, password string) (User, error
 	return user, nil
 }
 
-func (c *client) DeleteUser(groupID, appID, userID string) error {
+func (c *client) DeleteUser(ctx context.Context, groupID, appID, userID string) error {
 	res, resErr := c.do(
+		ctx,
 		http.MethodDelete,
 		fmt.Sprintf(userPathPattern, groupID, appID, userID),
 		api.RequestOptions{},
@@ -247,8 +251,9 @@ func (c *client) DeleteUser(groupID, appID, userID string) error {
 	return nil
 }
 
-func (c *client) DisableUser(groupID, appID, userID string) error {
+func (c *client) DisableUser(ctx context.Context, groupID, appID, userID string) error {
 	res, resErr := c.do(
+		ctx,
 		http.MethodPut,
 		fmt.Sprintf(userDisablePathPattern, groupID, appID, userID),
 		api.RequestOptions{},
@@ -262,8 +267,9 @@ func (c *client) DisableUser(groupID, appID, userID string) error {
 	return nil
 }
 
-func (c *client) EnableUser(groupID, appID, userID string) error {
+func (c *client) EnableUser(ctx context.Context, groupID, appID, userID string) error {
 	res, resErr := c.do(
+		ctx,
 		http.MethodPut,
 		fmt.Sprintf(userEnablePathPattern, groupID, appID, userID),
 		api.RequestOptions{},
@@ -285,18 +291,19 @@ type UserFilter struct {
 	State     UserState
 }
 
-func (c *client) FindUsers(groupID, appID string, filter UserFilter) ([]User, error) {
+func (c *client) FindUsers(ctx context.Context, groupID, appID string, filter UserFilter) ([]User, error) {
 	if filter.Pending {
-		return c.getPendingUsers(groupID, appID, filter.IDs)
+		return c.getPendingUsers(ctx, groupID, appID, filter.IDs)
 	}
 	if len(filter.IDs) == 0 {
-		return c.getUsers(groupID, appID, filter.State, filter.Providers)
+		return c.getUsers(ctx, groupID, appID, filter.State, filter.Providers)
 	}
-	return c.getUsersByIDs(groupID, appID, filter.IDs, filter.State, filter.Providers)
+	return c.getUsersByIDs(ctx, groupID, appID, filter.IDs, filter.State, filter.Providers)
 }
 
-func (c *client) RevokeUserSessions(groupID, appID, userID string) error {
+func (c *client) RevokeUserSessions(ctx context.Context, groupID, appID, userID string) error {
 	res, resErr := c.do(
+		ctx,
 		http.MethodPut,
 		fmt.Sprintf(userLogoutPathPattern, groupID, appID, userID),
 		api.RequestOptions{},
@@ -310,8 +317,9 @@ func (c *client) RevokeUserSessions(groupID, appID, userID string) error {
 	return nil
 }
 
-func (c *client) getPendingUsers(groupID, appID string, userIDs []string) ([]User, error) {
+func (c *client) getPendingUsers(ctx context.Context, groupID, appID string, userIDs []string) ([]User, error) {
 	res, resErr := c.do(
+		ctx,
 		http.MethodGet,
 		fmt.Sprintf(pendingUsersPathPattern, groupID, appID),
 		api.RequestOptions{},
@@ -348,8 +356,9 @@ func (c *client) getPendingUsers(groupID, appID string, userIDs []string) ([]Use
 	return filtered, nil
 }
 
-func (c *client) getUser(groupID, appID, userID string) (User, error) {
+func (c *client) getUser(ctx context.Context, groupID, appID, userID string) (User, error) {
 	res, resErr := c.do(
+		ctx,
 		http.MethodGet,
 		fmt.Sprintf(userPathPattern, groupID, appID, userID),
 		api.RequestOptions{},
@@ -369,7 +378,7 @@ func (c *client) getUser(groupID, appID, userID string) (User, error) {
 	return user, nil
 }
 
-func (c *client) getUsers(groupID, appID string, userState UserState, authProviderTypes AuthProviderTypes) ([]User, error) {
+func (c *client) getUsers(ctx context.Context, groupID, appID string, userState UserState, authProviderTypes AuthProviderTypes) ([]User, error) {
 	options := api.RequestOptions{Query: make(map[string]string)}
 	if userState != UserStateNil {
 		options.Query[usersQueryStatus] = string(userState)
@@ -378,7 +387,7 @@ func (c *client) getUsers(groupID, appID string, userState UserState, authProvid
 		options.Query[usersQueryProviderTypes] = authProviderTypes.join(",")
 	}
 
-	res, resErr := c.do(http.MethodGet, fmt.Sprintf(usersPathPattern, groupID, appID), options)
+	res, resErr := c.do(ctx, http.MethodGet, fmt.Sprintf(usersPathPattern, groupID, appID), options)
 	if resErr != nil {
 		return nil, resErr
 	}
@@ -394,10 +403,10 @@ func (c *client) getUsers(groupID, appID string, userState UserState, authProvid
 	return users, nil
 }
 
-func (c *client) getUsersByIDs(groupID, appID string, userIDs []string, userState UserState, authProviderTypes []AuthProviderType) ([]User, error) {
+func (c *client) getUsersByIDs(ctx context.Context, groupID, appID string, userIDs []string, userState UserState, authProviderTypes []AuthProviderType) ([]User, error) {
 	users := make([]User, 0, len(userIDs))
 	for _, userID := range userIDs {
-		user, err := c.getUser(groupID, appID, userID)
+		user, err := c.getUser(ctx, groupID, appID, userID)
 		if err != nil {
 			return nil, err
 		}
@@ -1,6 +1,7 @@
 package realm_test
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -19,7 +20,7 @@ func TestRealmUsers(t *testing.T) {
 	t.Run("Should fail without an auth client", func(t *testing.T) {
 		client := realm.NewClient(u.RealmServerURL())
 
-		_, err := client.FindUsers(primitive.NewObjectID().Hex(), primitive.NewObjectID().Hex(), realm.UserFilter{})
+		_, err := client.FindUsers(context.Background(), primitive.NewObjectID().Hex(), primitive.NewObjectID().Hex(), realm.UserFilter{})
 		assert.Equal(t, realm.ErrInvalidSession(user.DefaultProfile), err)
 	})
 
@@ -30,7 +31,7 @@ func TestRealmUsers(t *testing.T) {
 		app, teardown := setupTestApp(t, client, groupID, "users-test")
 		defer teardown()
 
-		assert.Nil(t, client.Import(groupID, app.ID, local.AppDataV2{local.AppStructureV2{
+		assert.Nil(t, client.Import(context.Background(), groupID, app.ID, local.AppDataV2{local.AppStructureV2{
 			ConfigVersion:   realm.AppConfigVersion20210101,
 			ID:              app.ClientAppID,
 			Name:            app.Name,
@@ -48,16 +49,16 @@ func TestRealmUsers(t *testing.T) {
 		}}))
 
 		t.Run("Should create users", func(t *testing.T) {
-			email1, createErr := client.CreateUser(groupID, app.ID, "one@domain.com", "password1")
+			email1, createErr := client.CreateUser(context.Background(), groupID, app.ID, "one@domain.com", "password1")
 			assert.Nil(t, createErr)
-			email2, createErr := client.CreateUser(groupID, app.ID, "two@domain.com", "password2")
+			email2, createErr := client.CreateUser(context.Background(), groupID, app.ID, "two@domain.com", "password2")
 			assert.Nil(t, createErr)
-			email3, createErr := client.CreateUser(groupID, app.ID, "three@domain.com", "password3")
+			email3, createErr := client.CreateUser(context.Background(), groupID, app.ID, "three@domain.com", "password3")
 			assert.Nil(t, createErr)
 
-			apiKey1, createErr := client.CreateAPIKey(groupID, app.ID, "one")
+			apiKey1, createErr := client.CreateAPIKey(context.Background(), groupID, app.ID, "one")
 			assert.Nil(t, createErr)
-			apiKey2, createErr := client.CreateAPIKey(groupID, app.ID, "two")
+			apiKey2, createErr := client.CreateAPIKey(context.Background(), groupID, app.ID, "two")
 			assert.Nil(t, createErr)
 
 			apiKeyIDs := map[string]string{
@@ -66,7 +67,7 @@ func TestRealmUsers(t *testing.T) {
 			}
 
 			t.Run("And find all types of users", func(t *testing.T) {
-				users, err := client.FindUsers(groupID, app.ID, realm.UserFilter{})
+				users, err := client.FindUsers(context.Background(), groupID, app.ID, realm.UserFilter{})
 				assert.Nil(t, err)
 
 				emailUsers := make([]realm.User, 0, 3)
@@ -95,34 +96,34 @@ func TestRealmUsers(t *testing.T) {
 			})
 
 			t.Run("And find a certain type of user", func(t *testing.T) {
-				users, err := client.FindUsers(groupID, app.ID, realm.UserFilter{Providers: []realm.AuthProviderType{realm.AuthProviderTypeUserPassword}})
+				users, err := client.FindUsers(context.Background(), groupID, app.ID, realm.UserFilter{Providers: []realm.AuthProviderType{realm.AuthProviderTypeUserPassword}})
 				assert.Nil(t, err)
 				assert.Equal(t, []realm.User{email1, email2, email3}, users)
 			})
 
 			t.Run("And find specific user ids", func(t *testing.T) {
-				users, err := client.FindUsers(groupID, app.ID, realm.UserFilter{IDs: []string{email2.ID, email3.ID}})
+				users, err := client.FindUsers(context.Background(), groupID, app.ID, realm.UserFilter{IDs: []string{email2.ID, email3.ID}})
 				assert.Nil(t, err)
 				assert.Equal(t, []realm.User{email2, email3}, users)
 			})
 
 			t.Run("And disable users", func(t *testing.T) {
-				assert.Nil(t, client.DisableUser(groupID, app.ID, email1.ID))
-				users1, users1Err := client.FindUsers(groupID, app.ID, realm.UserFilter{IDs: []string{email1.ID}})
+				assert.Nil(t, client.DisableUser(context.Background(), groupID, app.ID, email1.ID))
+				users1, users1Err := client.FindUsers(context.Background(), groupID, app.ID, realm.UserFilter{IDs: []string{email1.ID}})
 				assert.Nil(t, users1Err)
 				assert.Equal(t, 1, len(users1))
 				email1Disabled := users1[0]
 				assert.True(t, email1Disabled.Disabled, fmt.Sprintf("expected %s to be disabled", email1Disabled.Data["email"]))
 
-				assert.Nil(t, client.DisableUser(groupID, app.ID, email3.ID))
-				users3, users3Err := client.FindUsers(groupID, app.ID, realm.UserFilter{IDs: []string{email3.ID}})
+				assert.Nil(t, client.DisableUser(context.Background(), groupID, app.ID, email3.ID))
+				users3, users3Err := client.FindUsers(context.Background(), groupID, app.ID, realm.UserFilter{IDs: []string{email3.ID}})
 				assert.Nil(t, users3Err)
 				assert.Equal(t, 1, len(users3))
 				email3Disabled := users3[0]
 				assert.True(t, email3Disabled.Disabled, fmt.Sprintf("expected %s to be disabled", email3Disabled.Data["email"]))
 
 				t.Run("And find all disabled users", func(t *testing.T) {
-					users, err := client.FindUsers(groupID, app.ID, realm.UserFilter{State: realm.UserStateDisabled})
+					users, err := client.FindUsers(context.Background(), groupID, app.ID, realm.UserFilter{State: realm.UserStateDisabled})
 					assert.Nil(t, err)
 					assert.Equal(t, []realm.User{email1Disabled, email3Disabled}, users)
 				})
@@ -133,27 +134,27 @@ func TestRealmUsers(t *testing.T) {
 						State:     realm.UserStateDisabled,
 						Providers: []realm.AuthProviderType{realm.AuthProviderTypeUserPassword},
 					}
-					users, err := client.FindUsers(groupID, app.ID, filter)
+					users, err := client.FindUsers(context.Background(), groupID, app.ID, filter)
 					assert.Nil(t, err)
 					assert.Equal(t, []realm.User{email3Disabled}, users)
 				})
 			})
 
 			t.Run("And enable users", func(t *testing.T) {
-				assert.Nil(t, client.EnableUser(groupID, app.ID, email1.ID))
-				users1, users1Err := client.FindUsers(groupID, app.ID, realm.UserFilter{IDs: []string{email1.ID}})
+				assert.Nil(t, client.EnableUser(context.Background(), groupID, app.ID, email1.ID))
+				users1, users1Err := client.FindUsers(context.Background(), groupID, app.ID, realm.UserFilter{IDs: []string{email1.ID}})
 				assert.Nil(t, users1Err)
 				email1Enabled := users1[0]
 				assert.False(t, email1Enabled.Disabled, fmt.Sprintf("expected %s to be enabled", email1Enabled.Data["email"]))
 
-				assert.Nil(t, client.EnableUser(groupID, app.ID, email3.ID))
-				users3, users3Err := client.FindUsers(groupID, app.ID, realm.UserFilter{IDs: []string{email3.ID}})
+				assert.Nil(t, client.EnableUser(context.Background(), groupID, app.ID, email3.ID))
+				users3, users3Err := client.FindUsers(context.Background(), groupID, app.ID, realm.UserFilter{IDs: []string{email3.ID}})
 				assert.Nil(t, users3Err)
 				email3Enabled := users3[0]
 				assert.False(t, email3Enabled.Disabled, fmt.Sprintf("expected %s to be enabled", email3Enabled.Data["email"]))
 
 				t.Run("And find all enabled users", func(t *testing.T) {
-					users, err := client.FindUsers(groupID, app.ID, realm.UserFilter{State: realm.UserStateEnabled})
+					users, err := client.FindUsers(context.Background(), groupID, app.ID, realm.UserFilter{State: realm.UserStateEnabled})
 					assert.Nil(t, err)
 					assert.Equal(t, 5, len(users))
 
@@ -175,7 +176,7 @@ func TestRealmUsers(t *testing.T) {
 
 			t.Run("And find enabled user/password users", func(t *testing.T) {
 				filter := realm.UserFilter{State: realm.UserStateEnabled, Providers: []realm.AuthProviderType{realm.AuthProviderTypeUserPassword}, IDs: []string{email1.ID, email2.ID, email3.ID}}
-				users, err := client.FindUsers(groupID, app.ID, filter)
+				users, err := client.FindUsers(context.Background(), groupID, app.ID, filter)
 				assert.Nil(t, err)
 				for _, user := range users {
 					assert.False(t, user.Disabled, fmt.Sprintf("expected %s to be enabled", user.Data["email"]))
@@ -183,18 +184,18 @@ func TestRealmUsers(t *testing.T) {
 			})
 
 			t.Run("And revoking a user session should succeed", func(t *testing.T) {
-				assert.Nil(t, client.RevokeUserSessions(groupID, app.ID, email1.ID))
+				assert.Nil(t, client.RevokeUserSessions(context.Background(), groupID, app.ID, email1.ID))
 			})
 
 			t.Run("And delete users", func(t *testing.T) {
 				for _, userID := range []string{email1.ID, email2.ID, email3.ID, apiKeyIDs[apiKey1.ID], apiKeyIDs[apiKey2.ID]} {
-					assert.Nilf(t, client.DeleteUser(groupID, app.ID, userID), "failed to successfully delete user: %s", userID)
+					assert.Nilf(t, client.DeleteUser(context.Background(), groupID, app.ID, userID), "failed to successfully delete user: %s", userID)
 				}
 			})
 		})
 
 		t.Run("And finding pending users should return an empty list", func(t *testing.T) {
-			users, err := client.FindUsers(groupID, app.ID, realm.UserFilter{Pending: true})
+			users, err := client.FindUsers(context.Background(), groupID, app.ID, realm.UserFilter{Pending: true})
 			assert.Nil(t, err)
 			assert.Equal(t, []realm.User{}, users)
 		})
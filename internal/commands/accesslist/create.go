@@ -1,6 +1,7 @@
 package accesslist
 
 import (
+	"context"
 	"errors"
 
 	"github.com/10gen/realm-cli/internal/cli"
@@ -90,8 +91,8 @@ func (cmd *CommandCreate) Inputs() cli.InputResolver {
 }
 
 // Handler is the command handler
-func (cmd *CommandCreate) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
-	app, err := cli.ResolveApp(ui, clients.Realm, cli.AppOptions{
+func (cmd *CommandCreate) Handler(ctx context.Context, profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, err := cli.ResolveApp(ctx, ui, clients.Realm, cli.AppOptions{
 		AppMeta: cmd.inputs.AppMeta,
 		Filter:  cmd.inputs.Filter(),
 	})
@@ -99,7 +100,7 @@ func (cmd *CommandCreate) Handler(profile *user.Profile, ui terminal.UI, clients
 		return err
 	}
 
-	allowedIP, err := clients.Realm.AllowedIPCreate(app.GroupID, app.ID, cmd.inputs.Address, cmd.inputs.Comment, cmd.inputs.UseCurrent)
+	allowedIP, err := clients.Realm.AllowedIPCreate(ctx, app.GroupID, app.ID, cmd.inputs.Address, cmd.inputs.Comment, cmd.inputs.UseCurrent)
 	if err != nil {
 		return err
 	}
@@ -1,6 +1,7 @@
 package accesslist
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"testing"
@@ -60,7 +61,7 @@ func TestAllowedIPCreateHandler(t *testing.T) {
 			AllowAll:   allowedIPAllowAll,
 		}}
 
-		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Nil(t, cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient}))
 		assert.Equal(t, fmt.Sprintf("Successfully created allowed IP, id: %s\n", "allowedIPID"), out.String())
 
 		t.Log("and should properly pass through the expected inputs")
@@ -109,7 +110,7 @@ func TestAllowedIPCreateHandler(t *testing.T) {
 
 				cmd := &CommandCreate{}
 
-				err := cmd.Handler(nil, nil, cli.Clients{Realm: realmClient})
+				err := cmd.Handler(context.Background(), nil, nil, cli.Clients{Realm: realmClient})
 				assert.Equal(t, tc.expectedErr, err)
 			})
 		}
@@ -1,6 +1,7 @@
 package accesslist
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strings"
@@ -71,8 +72,8 @@ func (cmd *CommandDelete) Inputs() cli.InputResolver {
 }
 
 // Handler is the command handler
-func (cmd *CommandDelete) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
-	app, err := cli.ResolveApp(ui, clients.Realm, cli.AppOptions{
+func (cmd *CommandDelete) Handler(ctx context.Context, profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, err := cli.ResolveApp(ctx, ui, clients.Realm, cli.AppOptions{
 		AppMeta: cmd.inputs.AppMeta,
 		Filter:  cmd.inputs.Filter(),
 	})
@@ -80,7 +81,7 @@ func (cmd *CommandDelete) Handler(profile *user.Profile, ui terminal.UI, clients
 		return err
 	}
 
-	allowedIPs, err := clients.Realm.AllowedIPs(app.GroupID, app.ID)
+	allowedIPs, err := clients.Realm.AllowedIPs(ctx, app.GroupID, app.ID)
 	if err != nil {
 		return err
 	}
@@ -96,7 +97,7 @@ func (cmd *CommandDelete) Handler(profile *user.Profile, ui terminal.UI, clients
 
 	outputs := make([]deleteAllowedIPOutput, len(selectedAllowedIPs))
 	for i, allowedIP := range selectedAllowedIPs {
-		err := clients.Realm.AllowedIPDelete(app.GroupID, app.ID, allowedIP.ID)
+		err := clients.Realm.AllowedIPDelete(ctx, app.GroupID, app.ID, allowedIP.ID)
 		outputs[i] = deleteAllowedIPOutput{allowedIP, err}
 	}
 
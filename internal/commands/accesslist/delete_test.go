@@ -1,6 +1,7 @@
 package accesslist
 
 import (
+	"context"
 	"errors"
 	"strings"
 	"testing"
@@ -45,7 +46,7 @@ func TestAllowedIPDeleteHandler(t *testing.T) {
 			},
 		}}
 
-		assert.Equal(t, errors.New("no IP addresses or CIDR blocks to delete"), cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, errors.New("no IP addresses or CIDR blocks to delete"), cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient}))
 	})
 
 	for _, tc := range []struct {
@@ -113,7 +114,7 @@ func TestAllowedIPDeleteHandler(t *testing.T) {
 
 			out, ui := mock.NewUI()
 
-			assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+			assert.Nil(t, cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient}))
 			assert.Equal(t, tc.expectedOutput, out.String())
 			assert.Equal(t, "projectID", deleteArgs.groupID)
 			assert.Equal(t, "appID", deleteArgs.appID)
@@ -177,7 +178,7 @@ func TestAllowedIPDeleteHandler(t *testing.T) {
 					cli.ProjectInputs{Project: projectID, App: appID},
 					tc.testInput,
 				}}
-				err := cmd.Handler(nil, nil, cli.Clients{Realm: tc.realmClient()})
+				err := cmd.Handler(context.Background(), nil, nil, cli.Clients{Realm: tc.realmClient()})
 				assert.Equal(t, tc.expectedErr, err)
 			})
 		}
@@ -1,6 +1,7 @@
 package accesslist
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/10gen/realm-cli/internal/cli"
@@ -52,8 +53,8 @@ func (cmd *CommandList) Inputs() cli.InputResolver {
 }
 
 // Handler is the command handler
-func (cmd *CommandList) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
-	app, appErr := cli.ResolveApp(ui, clients.Realm, cli.AppOptions{
+func (cmd *CommandList) Handler(ctx context.Context, profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, appErr := cli.ResolveApp(ctx, ui, clients.Realm, cli.AppOptions{
 		AppMeta: cmd.inputs.AppMeta,
 		Filter:  cmd.inputs.Filter(),
 	})
@@ -61,7 +62,7 @@ func (cmd *CommandList) Handler(profile *user.Profile, ui terminal.UI, clients c
 		return appErr
 	}
 
-	allowedIPs, allowedIPsErr := clients.Realm.AllowedIPs(app.GroupID, app.ID)
+	allowedIPs, allowedIPsErr := clients.Realm.AllowedIPs(ctx, app.GroupID, app.ID)
 	if allowedIPsErr != nil {
 		return allowedIPsErr
 	}
@@ -1,6 +1,7 @@
 package accesslist
 
 import (
+	"context"
 	"errors"
 	"strings"
 	"testing"
@@ -69,7 +70,7 @@ func TestAllowedIPListHandler(t *testing.T) {
 				App:     appID,
 			}}}
 
-			assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+			assert.Nil(t, cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient}))
 			assert.Equal(t, tc.expectedOutput, out.String())
 		})
 	}
@@ -111,7 +112,7 @@ func TestAllowedIPListHandler(t *testing.T) {
 
 				cmd := &CommandList{}
 
-				err := cmd.Handler(nil, nil, cli.Clients{Realm: realmClient})
+				err := cmd.Handler(context.Background(), nil, nil, cli.Clients{Realm: realmClient})
 				assert.Equal(t, tc.expectedErr, err)
 			})
 		}
@@ -1,6 +1,7 @@
 package accesslist
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/10gen/realm-cli/internal/cli"
@@ -81,8 +82,8 @@ func (cmd *CommandUpdate) Inputs() cli.InputResolver {
 }
 
 // Handler is the command handler
-func (cmd *CommandUpdate) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
-	app, err := cli.ResolveApp(ui, clients.Realm, cli.AppOptions{
+func (cmd *CommandUpdate) Handler(ctx context.Context, profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, err := cli.ResolveApp(ctx, ui, clients.Realm, cli.AppOptions{
 		AppMeta: cmd.inputs.AppMeta,
 		Filter:  cmd.inputs.Filter(),
 	})
@@ -90,7 +91,7 @@ func (cmd *CommandUpdate) Handler(profile *user.Profile, ui terminal.UI, clients
 		return err
 	}
 
-	allowedIPs, err := clients.Realm.AllowedIPs(app.GroupID, app.ID)
+	allowedIPs, err := clients.Realm.AllowedIPs(ctx, app.GroupID, app.ID)
 	if err != nil {
 		return err
 	}
@@ -101,6 +102,7 @@ func (cmd *CommandUpdate) Handler(profile *user.Profile, ui terminal.UI, clients
 	}
 
 	if err := clients.Realm.AllowedIPUpdate(
+		ctx,
 		app.GroupID,
 		app.ID,
 		allowedIP.ID,
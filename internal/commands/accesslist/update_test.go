@@ -1,6 +1,7 @@
 package accesslist
 
 import (
+	"context"
 	"errors"
 	"testing"
 
@@ -81,7 +82,7 @@ func TestAllowedIPUpdateHandler(t *testing.T) {
 
 			out, ui := mock.NewUI()
 
-			assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+			assert.Nil(t, cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient}))
 
 			assert.Equal(t, "Successfully updated allowed IP\n", out.String())
 			assert.Equal(t, "projectID", updateArgs.groupID)
@@ -166,7 +167,7 @@ func TestAllowedIPUpdateHandler(t *testing.T) {
 
 				realmClient := tc.clientSetup()
 				cmd := &CommandUpdate{tc.inputs}
-				assert.Equal(t, tc.expectedErr, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+				assert.Equal(t, tc.expectedErr, cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient}))
 			})
 		}
 	})
@@ -1,6 +1,7 @@
 package app
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"os"
@@ -153,8 +154,8 @@ func (cmd *CommandCreate) AdditionalTrackedFields() []telemetry.EventData {
 }
 
 // Handler is the command handler
-func (cmd *CommandCreate) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
-	appRemote, err := cmd.inputs.resolveRemoteApp(ui, clients.Realm)
+func (cmd *CommandCreate) Handler(ctx context.Context, profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	appRemote, err := cmd.inputs.resolveRemoteApp(ctx, ui, clients.Realm)
 	if err != nil {
 		return err
 	}
@@ -164,13 +165,13 @@ func (cmd *CommandCreate) Handler(profile *user.Profile, ui terminal.UI, clients
 		groupID = appRemote.GroupID
 	}
 	if groupID == "" {
-		groupID, err = cli.ResolveGroupID(ui, clients.Atlas)
+		groupID, err = cli.ResolveGroupID(ctx, ui, clients.Atlas)
 		if err != nil {
 			return err
 		}
 	}
 
-	err = cmd.inputs.resolveName(ui, clients.Realm, appRemote.GroupID, appRemote.ClientAppID)
+	err = cmd.inputs.resolveName(ctx, ui, clients.Realm, appRemote.GroupID, appRemote.ClientAppID)
 	if err != nil {
 		return err
 	}
@@ -180,11 +181,11 @@ func (cmd *CommandCreate) Handler(profile *user.Profile, ui terminal.UI, clients
 		return err
 	}
 
-	if err := cmd.inputs.resolveTemplateID(clients.Realm); err != nil {
+	if err := cmd.inputs.resolveTemplateID(ctx, clients.Realm); err != nil {
 		return err
 	}
 
-	dsClusters, dsClustersMissing, err := cmd.inputs.resolveClusters(ui, clients.Atlas, groupID)
+	dsClusters, dsClustersMissing, err := cmd.inputs.resolveClusters(ctx, ui, clients.Atlas, groupID)
 	if err != nil {
 		return err
 	}
@@ -192,7 +193,7 @@ func (cmd *CommandCreate) Handler(profile *user.Profile, ui terminal.UI, clients
 	var dsServerlessInstances []dataSourceCluster
 	var dsServerlessInstancesMissing []string
 	if len(cmd.inputs.ServerlessInstances) > 0 {
-		dsServerlessInstances, dsServerlessInstancesMissing, err = cmd.inputs.resolveServerlessInstances(ui, clients.Atlas, groupID)
+		dsServerlessInstances, dsServerlessInstancesMissing, err = cmd.inputs.resolveServerlessInstances(ctx, ui, clients.Atlas, groupID)
 		if err != nil {
 			return err
 		}
@@ -201,7 +202,7 @@ func (cmd *CommandCreate) Handler(profile *user.Profile, ui terminal.UI, clients
 	var dsDatalakes []dataSourceDatalake
 	var dsDatalakesMissing []string
 	if len(cmd.inputs.Datalakes) > 0 {
-		dsDatalakes, dsDatalakesMissing, err = cmd.inputs.resolveDatalakes(ui, clients.Atlas, groupID)
+		dsDatalakes, dsDatalakesMissing, err = cmd.inputs.resolveDatalakes(ctx, ui, clients.Atlas, groupID)
 		if err != nil {
 			return err
 		}
@@ -230,12 +231,12 @@ func (cmd *CommandCreate) Handler(profile *user.Profile, ui terminal.UI, clients
 	}
 
 	if cmd.inputs.Template == "" {
-		return cmd.handleCreateApp(profile, ui, clients, groupID, rootDir, appRemote, dsClusters, dsServerlessInstances, dsDatalakes)
+		return cmd.handleCreateApp(ctx, profile, ui, clients, groupID, rootDir, appRemote, dsClusters, dsServerlessInstances, dsDatalakes)
 	}
-	return cmd.handleCreateTemplateApp(profile, ui, clients, groupID, rootDir, dsClusters, dsDatalakes)
+	return cmd.handleCreateTemplateApp(ctx, profile, ui, clients, groupID, rootDir, dsClusters, dsDatalakes)
 }
 
-func (cmd CommandCreate) handleCreateApp(
+func (cmd CommandCreate) handleCreateApp(ctx context.Context,
 	profile *user.Profile,
 	ui terminal.UI,
 	clients cli.Clients,
@@ -278,6 +279,7 @@ func (cmd CommandCreate) handleCreateApp(
 	}
 
 	appRealm, err := clients.Realm.CreateApp(
+		ctx,
 		groupID,
 		cmd.inputs.Name,
 		createAppMetadata,
@@ -304,6 +306,7 @@ func (cmd CommandCreate) handleCreateApp(
 		})
 	} else {
 		_, zipPkg, err := clients.Realm.Export(
+			ctx,
 			appRemote.GroupID,
 			appRemote.ID,
 			realm.ExportRequest{},
@@ -369,7 +372,7 @@ func (cmd CommandCreate) handleCreateApp(
 		return err
 	}
 
-	if err := clients.Realm.Import(appRealm.GroupID, appRealm.ID, appLocal.AppData); err != nil {
+	if err := clients.Realm.Import(ctx, appRealm.GroupID, appRealm.ID, appLocal.AppData); err != nil {
 		return err
 	}
 
@@ -398,7 +401,7 @@ func (cmd CommandCreate) handleCreateApp(
 
 // handleCreateTemplateApp creates a template app and writes it to the user's disk.
 // This function does not take in serverless instances because they are not compatible with template apps.
-func (cmd CommandCreate) handleCreateTemplateApp(
+func (cmd CommandCreate) handleCreateTemplateApp(ctx context.Context,
 	profile *user.Profile,
 	ui terminal.UI,
 	clients cli.Clients,
@@ -444,6 +447,7 @@ func (cmd CommandCreate) handleCreateTemplateApp(
 		defer s.Stop()
 
 		appRealm, err := clients.Realm.CreateApp(
+			ctx,
 			groupID,
 			cmd.inputs.Name,
 			createAppMetadata,
@@ -459,7 +463,7 @@ func (cmd CommandCreate) handleCreateTemplateApp(
 		return err
 	}
 
-	_, err = writeTemplateAppToLocal(ui, clients.Realm, appRealm.ID, appRealm.GroupID, cmd.inputs.Template, rootDir)
+	_, err = writeTemplateAppToLocal(ctx, ui, clients.Realm, appRealm.ID, appRealm.GroupID, cmd.inputs.Template, rootDir)
 	if err != nil {
 		return err
 	}
@@ -499,11 +503,12 @@ func (cmd *CommandCreate) display(omitDryRun bool) string {
 	return cli.CommandDisplay(CommandMetaCreate.Display, cmd.inputs.args(omitDryRun))
 }
 
-func writeTemplateAppToLocal(ui terminal.UI, realmClient realm.Client, appID, groupID, templateID, rootDir string) (local.App, error) {
+func writeTemplateAppToLocal(ctx context.Context, ui terminal.UI, realmClient realm.Client, appID, groupID, templateID, rootDir string) (local.App, error) {
 	backendDir := filepath.Join(rootDir, local.BackendPath)
 	frontendDir := filepath.Join(rootDir, local.FrontendPath)
 
 	_, zipPkg, err := realmClient.Export(
+		ctx,
 		groupID,
 		appID,
 		realm.ExportRequest{},
@@ -528,6 +533,7 @@ func writeTemplateAppToLocal(ui terminal.UI, realmClient realm.Client, appID, gr
 		defer s.Stop()
 
 		zipPkg, ok, err := realmClient.ClientTemplate(
+			ctx,
 			groupID,
 			appID,
 			templateID,
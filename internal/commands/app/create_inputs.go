@@ -1,6 +1,7 @@
 package app
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"os"
@@ -88,9 +89,9 @@ func (i *createInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
 	return nil
 }
 
-func (i *createInputs) resolveName(ui terminal.UI, client realm.Client, groupID, appNameOrClientID string) error {
+func (i *createInputs) resolveName(ctx context.Context, ui terminal.UI, client realm.Client, groupID, appNameOrClientID string) error {
 	if i.Name == "" {
-		app, err := cli.ResolveApp(ui, client, cli.AppOptions{Filter: realm.AppFilter{
+		app, err := cli.ResolveApp(ctx, ui, client, cli.AppOptions{Filter: realm.AppFilter{
 			GroupID: groupID,
 			App:     appNameOrClientID,
 		}})
@@ -157,9 +158,9 @@ func (i *createInputs) resolveLocalPath(ui terminal.UI, wd string) (string, erro
 	return fullPath, nil
 }
 
-func (i *createInputs) resolveClusters(ui terminal.UI, client atlas.Client, groupID string) ([]dataSourceCluster, []string, error) {
+func (i *createInputs) resolveClusters(ctx context.Context, ui terminal.UI, client atlas.Client, groupID string) ([]dataSourceCluster, []string, error) {
 	if i.Template != "" {
-		clusters, err := client.Clusters(groupID)
+		clusters, err := client.Clusters(ctx, groupID)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -225,7 +226,7 @@ func (i *createInputs) resolveClusters(ui terminal.UI, client atlas.Client, grou
 	dsClusters := make([]dataSourceCluster, 0, len(i.Clusters))
 	nonExistingClusters := make([]string, 0, len(i.Clusters))
 	if len(i.Clusters) > 0 {
-		clusters, err := client.Clusters(groupID)
+		clusters, err := client.Clusters(ctx, groupID)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -272,12 +273,12 @@ func (i *createInputs) resolveClusters(ui terminal.UI, client atlas.Client, grou
 	return dsClusters, nonExistingClusters, nil
 }
 
-func (i *createInputs) resolveServerlessInstances(ui terminal.UI, client atlas.Client, groupID string) ([]dataSourceCluster, []string, error) {
+func (i *createInputs) resolveServerlessInstances(ctx context.Context, ui terminal.UI, client atlas.Client, groupID string) ([]dataSourceCluster, []string, error) {
 	if i.Template != "" && len(i.ServerlessInstances) > 0 {
 		return nil, nil, errors.New("cannot create a template app with Serverless instances")
 	}
 
-	serverlessInstances, err := client.ServerlessInstances(groupID)
+	serverlessInstances, err := client.ServerlessInstances(ctx, groupID)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -324,12 +325,12 @@ func (i *createInputs) resolveServerlessInstances(ui terminal.UI, client atlas.C
 	return dsServerlessInstances, nonExistingServerlessInstances, nil
 }
 
-func (i *createInputs) resolveDatalakes(ui terminal.UI, client atlas.Client, groupID string) ([]dataSourceDatalake, []string, error) {
+func (i *createInputs) resolveDatalakes(ctx context.Context, ui terminal.UI, client atlas.Client, groupID string) ([]dataSourceDatalake, []string, error) {
 	if i.Template != "" && len(i.Datalakes) > 0 {
 		return nil, nil, errors.New("cannot create a template app with data lakes")
 	}
 
-	datalakes, err := client.Datalakes(groupID)
+	datalakes, err := client.Datalakes(ctx, groupID)
 	if err != nil {
 		return nil, nil, err
 	}
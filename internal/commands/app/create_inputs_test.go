@@ -2,6 +2,7 @@ package app
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"io/ioutil"
@@ -118,7 +119,7 @@ func TestAppCreateInputsResolveName(t *testing.T) {
 				return []realm.App{testApp}, nil
 			}
 
-			err := tc.inputs.resolveName(nil, rc, tc.appRemote.GroupID, tc.appRemote.ClientAppID)
+			err := tc.inputs.resolveName(context.Background(), nil, rc, tc.appRemote.GroupID, tc.appRemote.ClientAppID)
 
 			assert.Nil(t, err)
 			assert.Equal(t, tc.expectedName, tc.inputs.Name)
@@ -134,7 +135,7 @@ func TestAppCreateInputsResolveName(t *testing.T) {
 			return nil, errors.New("realm client error")
 		}
 		inputs := createInputs{}
-		err := inputs.resolveName(nil, rc, testApp.GroupID, testApp.ClientAppID)
+		err := inputs.resolveName(context.Background(), nil, rc, testApp.GroupID, testApp.ClientAppID)
 
 		assert.Equal(t, errors.New("realm client error"), err)
 		assert.Equal(t, "", inputs.Name)
@@ -250,7 +251,7 @@ func TestAppCreateInputsResolveDirectory(t *testing.T) {
 			ConfigVersion: realm.DefaultAppConfigVersion,
 		}}
 		cmd := &CommandCreate{inputs}
-		assert.Nil(t, cmd.Handler(profile, ui, cli.Clients{Realm: rc}))
+		assert.Nil(t, cmd.Handler(context.Background(), profile, ui, cli.Clients{Realm: rc}))
 
 		existingDir := filepath.Join(profile.WorkingDirectory, existingApp.Name)
 		dir, err := inputs.resolveLocalPath(ui, existingDir)
@@ -291,7 +292,7 @@ func TestAppCreateInputsResolveDirectory(t *testing.T) {
 			ConfigVersion: realm.DefaultAppConfigVersion,
 		}}
 		cmd := &CommandCreate{inputs}
-		assert.Nil(t, cmd.Handler(profile, ui, cli.Clients{Realm: rc}))
+		assert.Nil(t, cmd.Handler(context.Background(), profile, ui, cli.Clients{Realm: rc}))
 
 		doneCh := make(chan (struct{}))
 		go func() {
@@ -397,7 +398,7 @@ func TestAppCreateInputsResolveCluster(t *testing.T) {
 			ClusterServiceNames: []string{"mongodb-atlas"},
 		}
 
-		ds, _, err := inputs.resolveClusters(ui, ac, "123")
+		ds, _, err := inputs.resolveClusters(context.Background(), ui, ac, "123")
 		assert.Nil(t, err)
 
 		assert.Equal(t, []dataSourceCluster{
@@ -433,7 +434,7 @@ func TestAppCreateInputsResolveCluster(t *testing.T) {
 			ClusterServiceNames: []string{"mongodb-atlas", "another-data-source"},
 		}
 
-		ds, _, err := inputs.resolveClusters(ui, ac, "123")
+		ds, _, err := inputs.resolveClusters(context.Background(), ui, ac, "123")
 		assert.Nil(t, err)
 
 		assert.Equal(t, []dataSourceCluster{
@@ -507,12 +508,12 @@ func TestAppCreateInputsResolveCluster(t *testing.T) {
 		}()
 
 		cmd := &CommandCreate{inputs}
-		assert.Nil(t, cmd.Handler(profile, ui, cli.Clients{Realm: rc, Atlas: ac}))
+		assert.Nil(t, cmd.Handler(context.Background(), profile, ui, cli.Clients{Realm: rc, Atlas: ac}))
 
 		console.Tty().Close() // flush the writers
 		<-doneCh              // wait for procedure to complete
 
-		ds, _, err := inputs.resolveClusters(ui, ac, "123")
+		ds, _, err := inputs.resolveClusters(context.Background(), ui, ac, "123")
 		assert.Nil(t, err)
 
 		assert.Equal(t, []dataSourceCluster{
@@ -595,7 +596,7 @@ func TestAppCreateInputsResolveCluster(t *testing.T) {
 				}
 
 				cmd := &CommandCreate{inputs}
-				assert.Nil(t, cmd.Handler(profile, ui, cli.Clients{Realm: rc, Atlas: ac}))
+				assert.Nil(t, cmd.Handler(context.Background(), profile, ui, cli.Clients{Realm: rc, Atlas: ac}))
 
 				console.Tty().Close() // flush the writers
 				<-doneCh              // wait for procedure to complete
@@ -682,7 +683,7 @@ func TestAppCreateInputsResolveCluster(t *testing.T) {
 					ClusterServiceNames: tc.clusterServiceNames,
 				}
 
-				ds, _, err := inputs.resolveClusters(ui, ac, "123")
+				ds, _, err := inputs.resolveClusters(context.Background(), ui, ac, "123")
 				assert.Nil(t, err)
 
 				console.Tty().Close() // flush the writers
@@ -725,7 +726,7 @@ func TestAppCreateInputsResolveCluster(t *testing.T) {
 
 		inputs := createInputs{Clusters: []string{"test-cluster"}}
 
-		_, _, err := inputs.resolveClusters(ui, ac, "123")
+		_, _, err := inputs.resolveClusters(context.Background(), ui, ac, "123")
 		assert.Equal(t, errors.New("client error"), err)
 		assert.Equal(t, "123", expectedGroupID)
 	})
@@ -747,7 +748,7 @@ func TestAppCreateInputsResolveCluster(t *testing.T) {
 				},
 				Clusters: clusterNames,
 			}
-			_, _, err := inputs.resolveClusters(ui, ac, "123")
+			_, _, err := inputs.resolveClusters(context.Background(), ui, ac, "123")
 			assert.Equal(t, errors.New("template apps can only be created with one cluster"), err)
 		})
 
@@ -765,7 +766,7 @@ func TestAppCreateInputsResolveCluster(t *testing.T) {
 				},
 				Clusters: clusterNames,
 			}
-			_, _, err := inputs.resolveClusters(ui, ac, "123")
+			_, _, err := inputs.resolveClusters(context.Background(), ui, ac, "123")
 			assert.Equal(t, errors.New("please create an Atlas cluster before creating a template app"), err)
 		})
 
@@ -786,7 +787,7 @@ func TestAppCreateInputsResolveCluster(t *testing.T) {
 				Clusters: clusterNames,
 			}
 
-			_, _, err := inputs.resolveClusters(ui, ac, "123")
+			_, _, err := inputs.resolveClusters(context.Background(), ui, ac, "123")
 			assert.Equal(t, errors.New("could not find Atlas cluster 'nonExistentCluster'"), err)
 		})
 
@@ -808,7 +809,7 @@ func TestAppCreateInputsResolveCluster(t *testing.T) {
 				Clusters: clusterNames,
 			}
 
-			clusters, _, err := inputs.resolveClusters(ui, ac, "123")
+			clusters, _, err := inputs.resolveClusters(context.Background(), ui, ac, "123")
 			assert.Equal(t, nil, err)
 			assert.Equal(t, 1, len(clusters))
 			assert.Equal(t, "Cluster0", clusters[0].Config.ClusterName)
@@ -829,7 +830,7 @@ func TestAppCreateInputsResolveCluster(t *testing.T) {
 				},
 			}
 
-			clusters, _, err := inputs.resolveClusters(ui, ac, "123")
+			clusters, _, err := inputs.resolveClusters(context.Background(), ui, ac, "123")
 			assert.Equal(t, nil, err)
 			assert.Equal(t, 1, len(clusters))
 			assert.Equal(t, "Cluster1", clusters[0].Config.ClusterName)
@@ -862,7 +863,7 @@ func TestAppCreateInputsResolveCluster(t *testing.T) {
 				},
 			}
 
-			clusters, _, err := inputs.resolveClusters(ui, ac, "123")
+			clusters, _, err := inputs.resolveClusters(context.Background(), ui, ac, "123")
 			assert.Equal(t, nil, err)
 			assert.Equal(t, 1, len(clusters))
 			assert.Equal(t, "Cluster1", clusters[0].Config.ClusterName)
@@ -885,7 +886,7 @@ func TestAppCreateInputsResolveCluster(t *testing.T) {
 				Clusters:            clusterNames,
 				ClusterServiceNames: []string{"overridden_name"},
 			}
-			clusters, _, err := inputs.resolveClusters(ui, ac, "123")
+			clusters, _, err := inputs.resolveClusters(context.Background(), ui, ac, "123")
 			assert.Equal(t, nil, err)
 			assert.Equal(t, 1, len(clusters))
 			assert.Equal(t, "mongodb-atlas", clusters[0].Name)
@@ -909,7 +910,7 @@ func TestAppCreateInputsResolveServerlessInstance(t *testing.T) {
 			ServerlessInstanceServiceNames: []string{"mongodb-atlas"},
 		}
 
-		ds, _, err := inputs.resolveServerlessInstances(ui, ac, "123")
+		ds, _, err := inputs.resolveServerlessInstances(context.Background(), ui, ac, "123")
 		assert.Nil(t, err)
 
 		assert.Equal(t, []dataSourceCluster{
@@ -945,7 +946,7 @@ func TestAppCreateInputsResolveServerlessInstance(t *testing.T) {
 			ServerlessInstanceServiceNames: []string{"mongodb-atlas", "another-data-source"},
 		}
 
-		ds, _, err := inputs.resolveServerlessInstances(ui, ac, "123")
+		ds, _, err := inputs.resolveServerlessInstances(context.Background(), ui, ac, "123")
 		assert.Nil(t, err)
 
 		assert.Equal(t, []dataSourceCluster{
@@ -1019,14 +1020,14 @@ func TestAppCreateInputsResolveServerlessInstance(t *testing.T) {
 			}()
 
 			cmd := &CommandCreate{inputs}
-			assert.Nil(t, cmd.Handler(profile, ui, cli.Clients{Realm: rc, Atlas: ac}))
+			assert.Nil(t, cmd.Handler(context.Background(), profile, ui, cli.Clients{Realm: rc, Atlas: ac}))
 
 			console.Tty().Close() // flush the writers
 			<-doneCh              // wait for procedure to complete
 		})
 
 		t.Run("should resolve to found serverless instances", func(t *testing.T) {
-			ds, _, err := inputs.resolveServerlessInstances(ui, ac, "123")
+			ds, _, err := inputs.resolveServerlessInstances(context.Background(), ui, ac, "123")
 			assert.Nil(t, err)
 
 			assert.Equal(t, []dataSourceCluster{
@@ -1113,7 +1114,7 @@ func TestAppCreateInputsResolveServerlessInstance(t *testing.T) {
 				}
 
 				cmd := &CommandCreate{inputs}
-				assert.Nil(t, cmd.Handler(profile, ui, cli.Clients{Realm: rc, Atlas: ac}))
+				assert.Nil(t, cmd.Handler(context.Background(), profile, ui, cli.Clients{Realm: rc, Atlas: ac}))
 
 				console.Tty().Close() // flush the writers
 				<-doneCh              // wait for procedure to complete
@@ -1201,7 +1202,7 @@ func TestAppCreateInputsResolveServerlessInstance(t *testing.T) {
 					ServerlessInstanceServiceNames: tc.serverlessInstanceServiceNames,
 				}
 
-				ds, _, err := inputs.resolveServerlessInstances(ui, ac, "123")
+				ds, _, err := inputs.resolveServerlessInstances(context.Background(), ui, ac, "123")
 				assert.Nil(t, err)
 
 				console.Tty().Close() // flush the writers
@@ -1244,7 +1245,7 @@ func TestAppCreateInputsResolveServerlessInstance(t *testing.T) {
 
 		inputs := createInputs{ServerlessInstances: []string{"test-serverless-instance"}}
 
-		_, _, err := inputs.resolveServerlessInstances(ui, ac, "123")
+		_, _, err := inputs.resolveServerlessInstances(context.Background(), ui, ac, "123")
 		assert.Equal(t, errors.New("client error"), err)
 		assert.Equal(t, "123", expectedGroupID)
 	})
@@ -1259,7 +1260,7 @@ func TestAppCreateInputsResolveServerlessInstance(t *testing.T) {
 			ServerlessInstances: []string{"test-serverless-instance"},
 		}
 
-		_, _, err := inputs.resolveServerlessInstances(ui, ac, "123")
+		_, _, err := inputs.resolveServerlessInstances(context.Background(), ui, ac, "123")
 		assert.Equal(t, errors.New("cannot create a template app with Serverless instances"), err)
 	})
 }
@@ -1280,7 +1281,7 @@ func TestAppCreateInputsResolveDatalake(t *testing.T) {
 			DatalakeServiceNames: []string{"mongodb-datalake"},
 		}
 
-		ds, _, err := inputs.resolveDatalakes(ui, ac, "123")
+		ds, _, err := inputs.resolveDatalakes(context.Background(), ui, ac, "123")
 		assert.Nil(t, err)
 
 		assert.Equal(t, []dataSourceDatalake{
@@ -1313,7 +1314,7 @@ func TestAppCreateInputsResolveDatalake(t *testing.T) {
 			DatalakeServiceNames: []string{"mongodb-datalake", "another-data-source"},
 		}
 
-		ds, _, err := inputs.resolveDatalakes(ui, ac, "123")
+		ds, _, err := inputs.resolveDatalakes(context.Background(), ui, ac, "123")
 		assert.Nil(t, err)
 
 		assert.Equal(t, []dataSourceDatalake{
@@ -1383,12 +1384,12 @@ func TestAppCreateInputsResolveDatalake(t *testing.T) {
 		}()
 
 		cmd := &CommandCreate{inputs}
-		assert.Nil(t, cmd.Handler(profile, ui, cli.Clients{Realm: rc, Atlas: ac}))
+		assert.Nil(t, cmd.Handler(context.Background(), profile, ui, cli.Clients{Realm: rc, Atlas: ac}))
 
 		console.Tty().Close() // flush the writers
 		<-doneCh              // wait for procedure to complete
 
-		ds, _, err := inputs.resolveDatalakes(ui, ac, "123")
+		ds, _, err := inputs.resolveDatalakes(context.Background(), ui, ac, "123")
 		assert.Nil(t, err)
 
 		assert.Equal(t, []dataSourceDatalake{
@@ -1471,7 +1472,7 @@ func TestAppCreateInputsResolveDatalake(t *testing.T) {
 				}
 
 				cmd := &CommandCreate{inputs}
-				assert.Nil(t, cmd.Handler(profile, ui, cli.Clients{Realm: rc, Atlas: ac}))
+				assert.Nil(t, cmd.Handler(context.Background(), profile, ui, cli.Clients{Realm: rc, Atlas: ac}))
 
 				console.Tty().Close() // flush the writers
 				<-doneCh              // wait for procedure to complete
@@ -1559,7 +1560,7 @@ func TestAppCreateInputsResolveDatalake(t *testing.T) {
 					DatalakeServiceNames: tc.datalakeServiceNames,
 				}
 
-				ds, _, err := inputs.resolveDatalakes(ui, ac, "123")
+				ds, _, err := inputs.resolveDatalakes(context.Background(), ui, ac, "123")
 				assert.Nil(t, err)
 
 				console.Tty().Close() // flush the writers
@@ -1596,7 +1597,7 @@ func TestAppCreateInputsResolveDatalake(t *testing.T) {
 
 		inputs := createInputs{Datalakes: []string{"test-datalake"}}
 
-		_, _, err := inputs.resolveDatalakes(ui, ac, "123")
+		_, _, err := inputs.resolveDatalakes(context.Background(), ui, ac, "123")
 		assert.Equal(t, errors.New("client error"), err)
 		assert.Equal(t, "123", expectedGroupID)
 	})
@@ -1611,7 +1612,7 @@ func TestAppCreateInputsResolveDatalake(t *testing.T) {
 			Datalakes: []string{"test-datalake"},
 		}
 
-		_, _, err := inputs.resolveDatalakes(ui, ac, "123")
+		_, _, err := inputs.resolveDatalakes(context.Background(), ui, ac, "123")
 		assert.Equal(t, errors.New("cannot create a template app with data lakes"), err)
 	})
 }
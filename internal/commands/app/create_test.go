@@ -3,6 +3,7 @@ package app
 import (
 	"archive/zip"
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"io/ioutil"
@@ -55,7 +56,7 @@ func TestAppCreateHandler(t *testing.T) {
 			ConfigVersion:   realm.DefaultAppConfigVersion,
 		}}}
 
-		assert.Nil(t, cmd.Handler(profile, ui, cli.Clients{Realm: client}))
+		assert.Nil(t, cmd.Handler(context.Background(), profile, ui, cli.Clients{Realm: client}))
 
 		fullDir := filepath.Join(profile.WorkingDirectory, cmd.inputs.Name)
 
@@ -241,7 +242,7 @@ Check out your app: cd ./test-app && realm-cli app describe
 			ConfigVersion:   realm.DefaultAppConfigVersion,
 		}}}
 
-		assert.Nil(t, cmd.Handler(profile, ui, cli.Clients{Realm: rc, Atlas: ac}))
+		assert.Nil(t, cmd.Handler(context.Background(), profile, ui, cli.Clients{Realm: rc, Atlas: ac}))
 
 		console.Tty().Close() // flush the writers
 		<-doneCh              // wait for procedure to complete
@@ -377,7 +378,7 @@ Check out your app: cd ./test-app && realm-cli app describe
 			Clusters: []string{"test-cluster"},
 		}}
 
-		assert.Nil(t, cmd.Handler(profile, ui, cli.Clients{Realm: realmClient, Atlas: atlasClient}))
+		assert.Nil(t, cmd.Handler(context.Background(), profile, ui, cli.Clients{Realm: realmClient, Atlas: atlasClient}))
 
 		appPath := filepath.Join(profile.WorkingDirectory, cmd.inputs.Name)
 		_, err = local.LoadApp(appPath)
@@ -464,7 +465,7 @@ Check out your app: cd ./test-app && realm-cli app describe
 					DeploymentModel: realm.DeploymentModelGlobal,
 				}}}
 
-				assert.Nil(t, cmd.Handler(profile, ui, cli.Clients{Realm: client}))
+				assert.Nil(t, cmd.Handler(context.Background(), profile, ui, cli.Clients{Realm: client}))
 
 				appLocal, err := local.LoadApp(filepath.Join(profile.WorkingDirectory, tc.localPath))
 				assert.Nil(t, err)
@@ -587,7 +588,7 @@ Check out your app: cd ./remote-app && realm-cli app describe
 			Clusters: []string{"test-cluster"},
 		}}
 
-		assert.Nil(t, cmd.Handler(profile, ui, cli.Clients{Realm: client, Atlas: mock.AtlasClient{
+		assert.Nil(t, cmd.Handler(context.Background(), profile, ui, cli.Clients{Realm: client, Atlas: mock.AtlasClient{
 			ClustersFn: func(groupID string) ([]atlas.Cluster, error) {
 				return []atlas.Cluster{{Name: "test-cluster"}}, nil
 			},
@@ -766,7 +767,7 @@ Check out your app: cd ./remote-app && realm-cli app describe
 				},
 			}
 
-			assert.Nil(t, cmd.Handler(profile, ui, cli.Clients{Realm: rc, Atlas: tc.atlasClient}))
+			assert.Nil(t, cmd.Handler(context.Background(), profile, ui, cli.Clients{Realm: rc, Atlas: tc.atlasClient}))
 
 			appLocal, err := local.LoadApp(filepath.Join(profile.WorkingDirectory, cmd.inputs.Name))
 			assert.Nil(t, err)
@@ -988,7 +989,7 @@ Check out your app: cd ./test-app && realm-cli app describe
 				},
 			}
 
-			assert.Nil(t, cmd.Handler(profile, ui, tc.clients))
+			assert.Nil(t, cmd.Handler(context.Background(), profile, ui, tc.clients))
 
 			expectedDir := filepath.Join(profile.WorkingDirectory, "test-app")
 			assert.Equal(t, tc.displayExpected(expectedDir, cmd), out.String())
@@ -1191,7 +1192,7 @@ Check out your app: cd ./test-app && realm-cli app describe
 			out := new(bytes.Buffer)
 			ui := mock.NewUIWithOptions(tc.uiOptions, out)
 
-			assert.Equal(t, tc.expectedErr, cmd.Handler(profile, ui, tc.clients))
+			assert.Equal(t, tc.expectedErr, cmd.Handler(context.Background(), profile, ui, tc.clients))
 		})
 	}
 
@@ -1281,7 +1282,7 @@ Check out your app: cd ./test-app && realm-cli app describe
 				}
 
 				cmd := &CommandCreate{inputs}
-				assert.Nil(t, cmd.Handler(profile, ui, cli.Clients{Realm: rc, Atlas: ac}))
+				assert.Nil(t, cmd.Handler(context.Background(), profile, ui, cli.Clients{Realm: rc, Atlas: ac}))
 				assert.Equal(t, tc.appCreated, appCreated)
 			})
 		}
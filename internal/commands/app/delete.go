@@ -1,6 +1,7 @@
 package app
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/10gen/realm-cli/internal/cli"
@@ -42,8 +43,8 @@ func (cmd *CommandDelete) Flags() []flags.Flag {
 }
 
 // Handler is the command handler
-func (cmd *CommandDelete) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
-	apps, err := cmd.inputs.resolveApps(ui, clients.Realm)
+func (cmd *CommandDelete) Handler(ctx context.Context, profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	apps, err := cmd.inputs.resolveApps(ctx, ui, clients.Realm)
 	if err != nil {
 		return err
 	}
@@ -56,7 +57,7 @@ func (cmd *CommandDelete) Handler(profile *user.Profile, ui terminal.UI, clients
 	outputs := make([]appOutput, 0, len(apps))
 	deletedCount := 0
 	for _, app := range apps {
-		err := clients.Realm.DeleteApp(app.GroupID, app.ID)
+		err := clients.Realm.DeleteApp(ctx, app.GroupID, app.ID)
 		if err == nil {
 			deletedCount++
 		}
@@ -1,6 +1,7 @@
 package app
 
 import (
+	"context"
 	"strings"
 
 	"github.com/10gen/realm-cli/internal/cli"
@@ -15,8 +16,8 @@ type deleteInputs struct {
 	Project string
 }
 
-func (inputs *deleteInputs) resolveApps(ui terminal.UI, client realm.Client) ([]realm.App, error) {
-	apps, err := client.FindApps(realm.AppFilter{GroupID: inputs.Project})
+func (inputs *deleteInputs) resolveApps(ctx context.Context, ui terminal.UI, client realm.Client) ([]realm.App, error) {
+	apps, err := client.FindApps(ctx, realm.AppFilter{GroupID: inputs.Project})
 	if err != nil {
 		return nil, err
 	}
@@ -1,6 +1,7 @@
 package app
 
 import (
+	"context"
 	"errors"
 	"testing"
 
@@ -39,7 +40,7 @@ func TestResolveApps(t *testing.T) {
 				return nil, errors.New("client error")
 			}
 			inputs := deleteInputs{}
-			_, err := inputs.resolveApps(nil, realmClient)
+			_, err := inputs.resolveApps(context.Background(), nil, realmClient)
 
 			assert.Equal(t, errors.New("client error"), err)
 		})
@@ -84,7 +85,7 @@ func TestResolveApps(t *testing.T) {
 					return tc.apps, nil
 				}
 
-				apps, err := tc.inputs.resolveApps(nil, realmClient)
+				apps, err := tc.inputs.resolveApps(context.Background(), nil, realmClient)
 				assert.Equal(t, tc.expectedErr, err)
 				assert.Equal(t, tc.expectedApps, apps)
 			})
@@ -110,7 +111,7 @@ func TestResolveApps(t *testing.T) {
 				console.ExpectEOF()
 			}()
 			inputs := deleteInputs{}
-			apps, err := inputs.resolveApps(ui, realmClient)
+			apps, err := inputs.resolveApps(context.Background(), ui, realmClient)
 			assert.Nil(t, err)
 
 			console.Tty().Close() // flush the writers
@@ -128,7 +129,7 @@ func TestResolveApps(t *testing.T) {
 			out, ui := mock.NewUI()
 
 			inputs := deleteInputs{Apps: []string{"nonexistent", "app1"}}
-			apps, err := inputs.resolveApps(ui, realmClient)
+			apps, err := inputs.resolveApps(context.Background(), ui, realmClient)
 			assert.Nil(t, err)
 			assert.Equal(t, "Unable to delete certain apps because they were not found: nonexistent\n", out.String())
 			assert.Equal(t, []realm.App{app1}, apps)
@@ -143,7 +144,7 @@ func TestResolveApps(t *testing.T) {
 			out, ui := mock.NewUI()
 
 			inputs := deleteInputs{Apps: []string{"nonexistent", "missing"}}
-			apps, err := inputs.resolveApps(ui, realmClient)
+			apps, err := inputs.resolveApps(context.Background(), ui, realmClient)
 			assert.Nil(t, err)
 			assert.Equal(t, "Unable to delete certain apps because they were not found: nonexistent, missing\n", out.String())
 			assert.Equal(t, []realm.App{}, apps)
@@ -1,6 +1,7 @@
 package app
 
 import (
+	"context"
 	"errors"
 	"strings"
 	"testing"
@@ -118,7 +119,7 @@ func TestAppDeleteHandler(t *testing.T) {
 			}
 
 			cmd := &CommandDelete{inputs: tc.inputs}
-			assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+			assert.Nil(t, cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient}))
 			assert.Equal(t, tc.expectedOutput, out.String())
 
 			assert.Equal(t, tc.inputs.Project, capturedFindGroupID)
@@ -1,6 +1,8 @@
 package app
 
 import (
+	"context"
+
 	"github.com/10gen/realm-cli/internal/cli"
 	"github.com/10gen/realm-cli/internal/cli/user"
 	"github.com/10gen/realm-cli/internal/terminal"
@@ -41,8 +43,8 @@ func (cmd *CommandDescribe) Inputs() cli.InputResolver {
 }
 
 // Handler is the command handler
-func (cmd *CommandDescribe) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
-	app, err := cli.ResolveApp(ui, clients.Realm, cli.AppOptions{
+func (cmd *CommandDescribe) Handler(ctx context.Context, profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, err := cli.ResolveApp(ctx, ui, clients.Realm, cli.AppOptions{
 		Filter:  cmd.inputs.Filter(),
 		AppMeta: cmd.inputs.AppMeta,
 	})
@@ -50,7 +52,7 @@ func (cmd *CommandDescribe) Handler(profile *user.Profile, ui terminal.UI, clien
 		return err
 	}
 
-	appDesc, err := clients.Realm.AppDescription(app.GroupID, app.ID)
+	appDesc, err := clients.Realm.AppDescription(ctx, app.GroupID, app.ID)
 	if err != nil {
 		return err
 	}
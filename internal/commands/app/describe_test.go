@@ -1,6 +1,7 @@
 package app
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io/ioutil"
@@ -50,7 +51,7 @@ func TestAppDescribeHandler(t *testing.T) {
 		}
 
 		cmd := &CommandDescribe{inputs: describeInputs{cli.ProjectInputs{App: "test-app"}}}
-		assert.Equal(t, cli.ErrAppNotFound{App: "test-app"}, cmd.Handler(nil, nil, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, cli.ErrAppNotFound{App: "test-app"}, cmd.Handler(context.Background(), nil, nil, cli.Clients{Realm: realmClient}))
 	})
 
 	for _, tc := range []struct {
@@ -104,7 +105,7 @@ func TestAppDescribeHandler(t *testing.T) {
 			}
 
 			cmd := &CommandDescribe{inputs: tc.inputs}
-			assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+			assert.Nil(t, cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient}))
 			assert.Equal(t, "123", groupIDActual)
 			assert.Equal(t, "456", appIDActual)
 		})
@@ -234,7 +235,7 @@ func TestAppDescribeHandler(t *testing.T) {
 		}
 
 		cmd := &CommandDescribe{inputs: describeInputs{cli.ProjectInputs{App: "todo-abcde"}}}
-		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Nil(t, cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient}))
 
 		assert.Equal(t, `App description
 {
@@ -361,7 +362,7 @@ func TestAppDescribeHandler(t *testing.T) {
 		}
 
 		cmd := &CommandDescribe{inputs: describeInputs{cli.ProjectInputs{Project: "test", App: "test-app"}}}
-		assert.Equal(t, errors.New("realm client error"), cmd.Handler(nil, nil, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, errors.New("realm client error"), cmd.Handler(context.Background(), nil, nil, cli.Clients{Realm: realmClient}))
 	})
 
 	t.Run("should return an error when describing an app fails", func(t *testing.T) {
@@ -374,6 +375,6 @@ func TestAppDescribeHandler(t *testing.T) {
 		}
 
 		cmd := &CommandDescribe{inputs: describeInputs{cli.ProjectInputs{Project: "test", App: "test-app"}}}
-		assert.Equal(t, errors.New("realm client error"), cmd.Handler(nil, nil, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, errors.New("realm client error"), cmd.Handler(context.Background(), nil, nil, cli.Clients{Realm: realmClient}))
 	})
 }
@@ -1,12 +1,14 @@
 package app
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
 
 	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/feedback"
 	"github.com/10gen/realm-cli/internal/cli/user"
 	"github.com/10gen/realm-cli/internal/cloud/realm"
 	"github.com/10gen/realm-cli/internal/local"
@@ -20,6 +22,12 @@ const (
 	flagIncludeNodeModules  = "include-node-modules"
 	flagIncludePackageJSON  = "include-package-json"
 	flagIncludeDependencies = "include-dependencies"
+	flagUnified             = "unified"
+	flagEnv                 = "env"
+)
+
+const (
+	diffExitCode = 2
 )
 
 const (
@@ -34,7 +42,19 @@ var CommandMetaDiff = cli.CommandMeta{
 	Description: "Show differences between your local directory and your Realm app",
 	HelpText: `Displays file-by-file differences between your local directory and the latest
 version of your Realm app. If you have more than one Realm app, you will be
-prompted to select a Realm app to view.`,
+prompted to select a Realm app to view.
+
+With JSON output, the differences are displayed as a list of changes, each with
+the type and name of the changed resource, the operation, and the resource before
+and after the change. With "--unified", the differences are displayed as a unified
+diff of your local files against a fresh export of your Realm app.
+
+The command exits with code 2 when differences are found, so that scripts can
+check whether your Realm app has drifted from your local directory.
+
+To compare the Realm apps of two environments, as configured in the ".realm-cli.yaml"
+project config, set "--env" twice. The differences are the changes which pushing
+the first environment's Realm app to the second environment's would make.`,
 }
 
 // CommandDiff is the `app diff` command
@@ -50,6 +70,8 @@ type diffInputs struct {
 	IncludeNodeModules  bool
 	IncludePackageJSON  bool
 	IncludeHosting      bool
+	Unified             bool
+	Environments        []string
 }
 
 // Flags is the command flags
@@ -115,6 +137,25 @@ func (cmd *CommandDiff) Flags() []flags.Flag {
 				},
 			},
 		},
+		flags.BoolFlag{
+			Value: &cmd.inputs.Unified,
+			Meta: flags.Meta{
+				Name: flagUnified,
+				Usage: flags.Usage{
+					Description: "Show the differences as a unified diff of your local files against an export of your Realm app",
+				},
+			},
+		},
+		flags.StringSliceFlag{
+			Value: &cmd.inputs.Environments,
+			Meta: flags.Meta{
+				Name: flagEnv,
+				Usage: flags.Usage{
+					Description:   "Specify two environments to compare their Realm apps, as configured in the project config",
+					AllowedValues: realm.EnvironmentValues,
+				},
+			},
+		},
 		cli.ProjectFlag(&cmd.inputs.Project),
 	}
 }
@@ -125,13 +166,17 @@ func (cmd *CommandDiff) Inputs() cli.InputResolver {
 }
 
 // Handler is the command handler
-func (cmd *CommandDiff) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+func (cmd *CommandDiff) Handler(ctx context.Context, profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
 	app, err := local.LoadApp(cmd.inputs.LocalPath)
 	if err != nil {
 		return err
 	}
 
-	appToDiff, err := cli.ResolveApp(ui, clients.Realm, cli.AppOptions{
+	if len(cmd.inputs.Environments) > 0 {
+		return cmd.diffEnvironments(ctx, ui, clients.Realm, app)
+	}
+
+	appToDiff, err := cli.ResolveApp(ctx, ui, clients.Realm, cli.AppOptions{
 		Filter:  realm.AppFilter{GroupID: cmd.inputs.Project, App: cmd.inputs.RemoteApp},
 		AppMeta: app.Meta,
 	})
@@ -139,9 +184,30 @@ func (cmd *CommandDiff) Handler(profile *user.Profile, ui terminal.UI, clients c
 		return err
 	}
 
-	diffs, err := clients.Realm.Diff(appToDiff.GroupID, appToDiff.ID, app.AppData)
-	if err != nil {
-		return err
+	structured := ui.OutputFormat() == terminal.OutputFormatJSON
+
+	var diffs []string
+	var changes []local.AppChange
+	if structured || cmd.inputs.Unified {
+		remoteApp, cleanup, err := cli.ExportApp(ctx, clients.Realm, appToDiff.GroupID, appToDiff.ID, app.ConfigVersion())
+		if err != nil {
+			return err
+		}
+		defer cleanup()
+
+		if structured {
+			changes, err = local.DiffAppData(app.AppData, remoteApp.AppData)
+		} else {
+			diffs, err = local.UnifiedDiff(remoteApp.RootDir, app.RootDir)
+		}
+		if err != nil {
+			return err
+		}
+	} else {
+		diffs, err = clients.Realm.Diff(ctx, appToDiff.GroupID, appToDiff.ID, app.AppData)
+		if err != nil {
+			return err
+		}
 	}
 
 	if cmd.inputs.IncludeNodeModules || cmd.inputs.IncludePackageJSON || cmd.inputs.IncludeDependencies {
@@ -156,11 +222,12 @@ func (cmd *CommandDiff) Handler(profile *user.Profile, ui terminal.UI, clients c
 		}
 		defer cleanup()
 
-		dependenciesDiff, err := clients.Realm.DiffDependencies(appToDiff.GroupID, appToDiff.ID, uploadPath)
+		dependenciesDiff, err := clients.Realm.DiffDependencies(ctx, appToDiff.GroupID, appToDiff.ID, uploadPath)
 		if err != nil {
 			return err
 		}
 		diffs = append(diffs, dependenciesDiff.Strings()...)
+		changes = append(changes, local.DependenciesChanges(dependenciesDiff)...)
 	}
 
 	if cmd.inputs.IncludeHosting {
@@ -169,7 +236,7 @@ func (cmd *CommandDiff) Handler(profile *user.Profile, ui terminal.UI, clients c
 			return err
 		}
 
-		appAssets, err := clients.Realm.HostingAssets(appToDiff.GroupID, appToDiff.ID)
+		appAssets, err := clients.Realm.HostingAssets(ctx, appToDiff.GroupID, appToDiff.ID)
 		if err != nil {
 			return err
 		}
@@ -180,6 +247,16 @@ func (cmd *CommandDiff) Handler(profile *user.Profile, ui terminal.UI, clients c
 		}
 
 		diffs = append(diffs, hostingDiffs.Strings()...)
+		changes = append(changes, local.HostingChanges(hostingDiffs)...)
+	}
+
+	if structured {
+		if len(changes) == 0 {
+			ui.Print(terminal.NewJSONLog("Deployed app is identical to proposed version", []local.AppChange{}))
+			return nil
+		}
+		ui.Print(terminal.NewJSONLog("The following reflects the proposed changes to your Realm app", changes))
+		return feedback.ErrExitCode{Code: diffExitCode}
 	}
 
 	if len(diffs) == 0 {
@@ -193,10 +270,103 @@ func (cmd *CommandDiff) Handler(profile *user.Profile, ui terminal.UI, clients c
 		strings.Join(diffs, "\n"),
 	))
 
-	return nil
+	return feedback.ErrExitCode{Code: diffExitCode}
+}
+
+// diffEnvironments compares the deployed Realm apps of two environments,
+// showing the changes which pushing the first to the second would make
+func (cmd *CommandDiff) diffEnvironments(ctx context.Context, ui terminal.UI, realmClient realm.Client, app local.App) error {
+	from, to := realm.Environment(cmd.inputs.Environments[0]), realm.Environment(cmd.inputs.Environments[1])
+
+	fromMeta, err := app.EnvironmentMeta(from)
+	if err != nil {
+		return err
+	}
+
+	toMeta, err := app.EnvironmentMeta(to)
+	if err != nil {
+		return err
+	}
+
+	fromApp, fromCleanup, err := cli.ExportApp(ctx, realmClient, fromMeta.GroupID, fromMeta.AppID, app.ConfigVersion())
+	if err != nil {
+		return err
+	}
+	defer fromCleanup()
+
+	toRemote, err := realmClient.FindApp(ctx, toMeta.GroupID, toMeta.AppID)
+	if err != nil {
+		return err
+	}
+
+	// the first environment's app is pushed as the app of the second environment
+	fromApp.AppData.SetID(toRemote.ClientAppID)
+	fromApp.AppData.SetName(toRemote.Name)
+	fromApp.AppData.SetEnvironment(to)
+
+	structured := ui.OutputFormat() == terminal.OutputFormatJSON
+
+	var diffs []string
+	var changes []local.AppChange
+	if structured || cmd.inputs.Unified {
+		toApp, toCleanup, err := cli.ExportApp(ctx, realmClient, toMeta.GroupID, toMeta.AppID, app.ConfigVersion())
+		if err != nil {
+			return err
+		}
+		defer toCleanup()
+		toApp.AppData.SetEnvironment(to)
+
+		if structured {
+			changes, err = local.DiffAppData(fromApp.AppData, toApp.AppData)
+		} else {
+			// the unified diff compares the exports on disk, so their configs are rewritten first
+			if err := fromApp.WriteConfig(); err != nil {
+				return err
+			}
+			if err := toApp.WriteConfig(); err != nil {
+				return err
+			}
+			diffs, err = local.UnifiedDiff(toApp.RootDir, fromApp.RootDir)
+		}
+		if err != nil {
+			return err
+		}
+	} else {
+		diffs, err = realmClient.Diff(ctx, toMeta.GroupID, toMeta.AppID, fromApp.AppData)
+		if err != nil {
+			return err
+		}
+	}
+
+	if structured {
+		if len(changes) == 0 {
+			ui.Print(terminal.NewJSONLog(fmt.Sprintf("Deployed apps of the '%s' and '%s' environments are identical", from, to), []local.AppChange{}))
+			return nil
+		}
+		ui.Print(terminal.NewJSONLog(fmt.Sprintf("The following reflects the proposed changes to promote the '%s' environment to '%s'", from, to), changes))
+		return feedback.ErrExitCode{Code: diffExitCode}
+	}
+
+	if len(diffs) == 0 {
+		ui.Print(terminal.NewTextLog("Deployed apps of the '%s' and '%s' environments are identical", from, to))
+		return nil
+	}
+
+	ui.Print(terminal.NewTextLog(
+		"The following reflects the proposed changes to promote the '%s' environment to '%s'\n%s",
+		from,
+		to,
+		strings.Join(diffs, "\n"),
+	))
+
+	return feedback.ErrExitCode{Code: diffExitCode}
 }
 
 func (i *diffInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	if err := i.resolveEnvironments(); err != nil {
+		return err
+	}
+
 	if i.IncludePackageJSON {
 		if i.IncludeNodeModules {
 			return fmt.Errorf(errDependencyFlagConflictTemplate, flagIncludeNodeModules, flagIncludePackageJSON)
@@ -206,45 +376,87 @@ func (i *diffInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
 		}
 	}
 
-	searchPath := i.LocalPath
+	app, err := resolveLocalApp(profile, ui, i.LocalPath)
+	if err != nil {
+		return err
+	}
+	i.LocalPath = app.RootDir
+
+	if i.RemoteApp == "" && app.Meta.AppID == "" {
+		i.RemoteApp = app.Option()
+	}
+
+	return nil
+}
+
+// resolveLocalApp finds the local app at the local path, or else the working directory,
+// prompting for the local path when no app is found in the working directory
+func resolveLocalApp(profile *user.Profile, ui terminal.UI, localPath string) (local.App, error) {
+	searchPath := localPath
 	if searchPath == "" {
 		searchPath = profile.WorkingDirectory
 	}
 
 	searchPathAbs, err := filepath.Abs(searchPath)
 	if err != nil {
-		return err
+		return local.App{}, err
 	}
 
 	if _, err = os.Stat(searchPathAbs); os.IsNotExist(err) {
-		return errProjectInvalid(searchPath, false)
+		return local.App{}, errProjectInvalid(searchPath, false)
 	}
 
 	app, _, err := local.FindApp(searchPath)
 	if err != nil {
-		return err
+		return local.App{}, err
 	}
 
-	if i.LocalPath == "" && app.RootDir == "" {
-		if err := ui.AskOne(&i.LocalPath, &survey.Input{Message: "App filepath (local)"}); err != nil {
-			return err
+	if localPath == "" && app.RootDir == "" {
+		if err := ui.AskOne(&localPath, &survey.Input{Message: "App filepath (local)"}); err != nil {
+			return local.App{}, err
 		}
 
-		app, _, err = local.FindApp(i.LocalPath)
+		app, _, err = local.FindApp(localPath)
 		if err != nil {
-			return err
+			return local.App{}, err
 		}
 	}
 
 	if app.RootDir == "" {
-		return errProjectInvalid(i.LocalPath, true)
+		return local.App{}, errProjectInvalid(localPath, true)
 	}
-	i.LocalPath = app.RootDir
 
-	if i.RemoteApp == "" && app.Meta.AppID == "" {
-		i.RemoteApp = app.Option()
+	return app, nil
+}
+
+func (i *diffInputs) resolveEnvironments() error {
+	if len(i.Environments) == 0 {
+		return nil
+	}
+	if len(i.Environments) != 2 {
+		return fmt.Errorf(`must set "%s" exactly twice to compare two environments`, flagEnv)
+	}
+	for idx, env := range i.Environments {
+		var environment realm.Environment
+		if err := environment.Set(env); err != nil {
+			return err
+		}
+		i.Environments[idx] = environment.String()
 	}
 
+	for _, flag := range []struct {
+		name string
+		set  bool
+	}{
+		{flagIncludeNodeModules, i.IncludeNodeModules},
+		{flagIncludePackageJSON, i.IncludePackageJSON},
+		{flagIncludeDependencies, i.IncludeDependencies},
+		{"include-hosting", i.IncludeHosting},
+	} {
+		if flag.set {
+			return fmt.Errorf(errDependencyFlagConflictTemplate, flagEnv, flag.name)
+		}
+	}
 	return nil
 }
 
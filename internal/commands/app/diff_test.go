@@ -1,14 +1,21 @@
 package app
 
 import (
+	"archive/zip"
+	"bytes"
+	"context"
 	"errors"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/feedback"
 	"github.com/10gen/realm-cli/internal/cli/user"
 	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/local"
 	"github.com/10gen/realm-cli/internal/utils/api"
+	u "github.com/10gen/realm-cli/internal/utils/test"
 	"github.com/10gen/realm-cli/internal/utils/test/assert"
 	"github.com/10gen/realm-cli/internal/utils/test/mock"
 
@@ -34,6 +41,7 @@ func TestAppDiffHandler(t *testing.T) {
 		expectedDiff       []string
 		expectedDiffOutput string
 		expectedErr        error
+		diffErr            error
 		appError           bool
 		skipFindApps       bool
 		path               string
@@ -42,6 +50,7 @@ func TestAppDiffHandler(t *testing.T) {
 			description:        "no project nor app flag set should diff based on input and resolve with app meta",
 			expectedDiff:       []string{"diff1"},
 			expectedDiffOutput: "The following reflects the proposed changes to your Realm app\ndiff1\n",
+			expectedErr:        feedback.ErrExitCode{Code: 2},
 			skipFindApps:       true,
 			path:               "testdata/diff-meta",
 		},
@@ -51,6 +60,7 @@ func TestAppDiffHandler(t *testing.T) {
 			expectedAppFilter:  realm.AppFilter{App: "app1"},
 			expectedDiff:       []string{"diff1"},
 			expectedDiffOutput: "The following reflects the proposed changes to your Realm app\ndiff1\n",
+			expectedErr:        feedback.ErrExitCode{Code: 2},
 			path:               "testdata/diff",
 		},
 		{
@@ -66,6 +76,7 @@ func TestAppDiffHandler(t *testing.T) {
 			expectedAppFilter:  realm.AppFilter{GroupID: groupID1},
 			expectedDiff:       []string{"diff1"},
 			expectedDiffOutput: "The following reflects the proposed changes to your Realm app\ndiff1\n",
+			expectedErr:        feedback.ErrExitCode{Code: 2},
 			path:               "testdata/diff",
 		},
 		{
@@ -73,6 +84,7 @@ func TestAppDiffHandler(t *testing.T) {
 			inputs:            diffInputs{Project: groupID1, RemoteApp: "app1"},
 			expectedAppFilter: realm.AppFilter{GroupID: groupID1, App: "app1"},
 			expectedErr:       errors.New("something went wrong"),
+			diffErr:           errors.New("something went wrong"),
 			path:              "testdata/diff",
 		},
 		{
@@ -100,13 +112,13 @@ func TestAppDiffHandler(t *testing.T) {
 				return apps, nil
 			}
 			realmClient.DiffFn = func(groupID, appID string, appData interface{}) ([]string, error) {
-				return tc.expectedDiff, tc.expectedErr
+				return tc.expectedDiff, tc.diffErr
 			}
 
 			tc.inputs.LocalPath = tc.path
 			cmd := &CommandDiff{tc.inputs}
 
-			assert.Equal(t, tc.expectedErr, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+			assert.Equal(t, tc.expectedErr, cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient}))
 			assert.Equal(t, tc.expectedAppFilter, appFilter)
 			assert.Equal(t, tc.expectedDiffOutput, out.String())
 			assert.Equal(t, tc.skipFindApps, !findAppsCalled)
@@ -117,7 +129,7 @@ func TestAppDiffHandler(t *testing.T) {
 		_, ui := mock.NewUI()
 
 		cmd := &CommandDiff{diffInputs{LocalPath: "./some/path"}}
-		assert.Equal(t, errors.New("failed to find app at ./some/path"), cmd.Handler(nil, ui, cli.Clients{}))
+		assert.Equal(t, errors.New("failed to find app at ./some/path"), cmd.Handler(context.Background(), nil, ui, cli.Clients{}))
 	})
 
 	t.Run("diff function dependencies", func(t *testing.T) {
@@ -151,7 +163,7 @@ Modified Dependencies
 		t.Run("with include node modules set it should diff function dependencies", func(t *testing.T) {
 			out, ui := mock.NewUI()
 			cmd := &CommandDiff{diffInputs{LocalPath: "testdata/dependencies", IncludeNodeModules: true}}
-			assert.Equal(t, nil, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+			assert.Equal(t, feedback.ErrExitCode{Code: 2}, cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient}))
 
 			assert.Equal(t, diffStr, out.String())
 		})
@@ -159,7 +171,7 @@ Modified Dependencies
 		t.Run("with include dependencies set it should diff function dependencies", func(t *testing.T) {
 			out, ui := mock.NewUI()
 			cmd := &CommandDiff{diffInputs{LocalPath: "testdata/dependencies", IncludeDependencies: true}}
-			assert.Equal(t, nil, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+			assert.Equal(t, feedback.ErrExitCode{Code: 2}, cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient}))
 
 			assert.Equal(t, diffStr, out.String())
 		})
@@ -167,7 +179,7 @@ Modified Dependencies
 		t.Run("with include package json set it should diff function dependencies", func(t *testing.T) {
 			out, ui := mock.NewUI()
 			cmd := &CommandDiff{diffInputs{LocalPath: "testdata/dependencies", IncludePackageJSON: true}}
-			assert.Equal(t, nil, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+			assert.Equal(t, feedback.ErrExitCode{Code: 2}, cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient}))
 
 			assert.Equal(t, diffStr, out.String())
 		})
@@ -185,6 +197,34 @@ Modified Dependencies
 		})
 	})
 
+	t.Run("should return an error with invalid environments", func(t *testing.T) {
+		for _, tc := range []struct {
+			description string
+			inputs      diffInputs
+			err         error
+		}{
+			{
+				description: "when only one environment is set",
+				inputs:      diffInputs{Environments: []string{"qa"}},
+				err:         errors.New(`must set "env" exactly twice to compare two environments`),
+			},
+			{
+				description: "when an environment is not supported",
+				inputs:      diffInputs{Environments: []string{"qa", "staging"}},
+				err:         errors.New("unsupported environment, use one of [development, testing, qa, production] instead"),
+			},
+			{
+				description: "when include hosting is also set",
+				inputs:      diffInputs{Environments: []string{"qa", "production"}, IncludeHosting: true},
+				err:         errors.New(`cannot use both "env" and "include-hosting" at the same time`),
+			},
+		} {
+			t.Run(tc.description, func(t *testing.T) {
+				assert.Equal(t, tc.err, tc.inputs.Resolve(nil, nil))
+			})
+		}
+	})
+
 	t.Run("should return an error when diff dependencies returns an error", func(t *testing.T) {
 		t.Run("when include node modules is set", func(t *testing.T) {
 			_, ui := mock.NewUI()
@@ -202,7 +242,7 @@ Modified Dependencies
 			}
 
 			cmd := &CommandDiff{diffInputs{LocalPath: "testdata/dependencies", IncludeNodeModules: true}}
-			assert.Equal(t, errors.New("realm client error"), cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+			assert.Equal(t, errors.New("realm client error"), cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient}))
 		})
 
 		t.Run("when include package json is set", func(t *testing.T) {
@@ -221,7 +261,7 @@ Modified Dependencies
 			}
 
 			cmd := &CommandDiff{diffInputs{LocalPath: "testdata/dependencies", IncludePackageJSON: true}}
-			assert.Equal(t, errors.New("realm client error"), cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+			assert.Equal(t, errors.New("realm client error"), cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient}))
 		})
 		t.Run("when include dependencies is set", func(t *testing.T) {
 			_, ui := mock.NewUI()
@@ -239,7 +279,7 @@ Modified Dependencies
 			}
 
 			cmd := &CommandDiff{diffInputs{LocalPath: "testdata/dependencies", IncludeDependencies: true}}
-			assert.Equal(t, errors.New("realm client error"), cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+			assert.Equal(t, errors.New("realm client error"), cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient}))
 		})
 	})
 
@@ -267,7 +307,7 @@ Modified Dependencies
 		}
 
 		cmd := &CommandDiff{diffInputs{LocalPath: "testdata/diff", IncludeHosting: true}}
-		assert.Equal(t, nil, cmd.Handler(profile, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, feedback.ErrExitCode{Code: 2}, cmd.Handler(context.Background(), profile, ui, cli.Clients{Realm: realmClient}))
 
 		assert.Equal(t, `The following reflects the proposed changes to your Realm app
 diff1
@@ -282,6 +322,182 @@ Modified hosting files
 	})
 }
 
+func TestAppDiffHandlerExport(t *testing.T) {
+	realmClient := mock.RealmClient{}
+	realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+		return []realm.App{{ID: "app1", GroupID: "groupID", ClientAppID: "eggcorn-abcde", Name: "eggcorn"}}, nil
+	}
+	exportLocation := "US-OR"
+	realmClient.ExportFn = func(groupID, appID string, req realm.ExportRequest) (string, *zip.Reader, error) {
+		return "eggcorn_20210101", u.NewZip(t, map[string]string{
+			"realm_config.json": `{
+    "config_version": 20210101,
+    "app_id": "eggcorn-abcde",
+    "name": "eggcorn",
+    "location": "` + exportLocation + `",
+    "deployment_model": "GLOBAL"
+}
+`,
+		}), nil
+	}
+	realmClient.DiffFn = func(groupID, appID string, appData interface{}) ([]string, error) {
+		return nil, errors.New("should not diff with the server")
+	}
+
+	t.Run("should display the structured changes with json output", func(t *testing.T) {
+		out := new(bytes.Buffer)
+		ui := mock.NewUIWithOptions(mock.UIOptions{UseJSON: true}, out)
+
+		cmd := &CommandDiff{diffInputs{LocalPath: "testdata/diff"}}
+		assert.Equal(t, feedback.ErrExitCode{Code: 2}, cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient}))
+
+		assert.Equal(t, `{"time":"1989-06-22T01:23:45Z","level":"info","message":"The following reflects the proposed changes to your Realm app","doc":[{"type":"location","operation":"modified","before":"US-OR","after":"US-VA"}]}
+`, out.String())
+	})
+
+	t.Run("should display the unified diff of the local files against the exported app", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		cmd := &CommandDiff{diffInputs{LocalPath: "testdata/diff-meta", Unified: true}}
+		assert.Equal(t, feedback.ErrExitCode{Code: 2}, cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient}))
+
+		assert.Equal(t, `The following reflects the proposed changes to your Realm app
+--- a/realm_config.json
++++ b/realm_config.json
+@@ -2,6 +2,6 @@
+     "config_version": 20210101,
+     "app_id": "eggcorn-abcde",
+     "name": "eggcorn",
+-    "location": "US-OR",
++    "location": "US-VA",
+     "deployment_model": "GLOBAL"
+ }
+`, out.String())
+	})
+
+	t.Run("should exit successfully without any changes", func(t *testing.T) {
+		exportLocation = "US-VA"
+		defer func() { exportLocation = "US-OR" }()
+
+		out := new(bytes.Buffer)
+		ui := mock.NewUIWithOptions(mock.UIOptions{UseJSON: true}, out)
+
+		cmd := &CommandDiff{diffInputs{LocalPath: "testdata/diff-meta"}}
+		assert.Nil(t, cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient}))
+
+		assert.Equal(t, `{"time":"1989-06-22T01:23:45Z","level":"info","message":"Deployed app is identical to proposed version","doc":[]}
+`, out.String())
+	})
+}
+
+func TestAppDiffHandlerEnvironments(t *testing.T) {
+	remoteApps := map[string]realm.App{
+		"qa-app":   {ID: "qa-app", GroupID: "qa-group", ClientAppID: "eggcorn-qa-fghij", Name: "eggcorn-qa"},
+		"prod-app": {ID: "prod-app", GroupID: "prod-group", ClientAppID: "eggcorn-abcde", Name: "eggcorn"},
+	}
+	exportLocations := map[string]string{"qa-app": "US-OR", "prod-app": "US-VA"}
+
+	realmClient := mock.RealmClient{}
+	realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+		return nil, errors.New("should not find apps")
+	}
+	realmClient.FindAppFn = func(groupID, appID string) (realm.App, error) {
+		return remoteApps[appID], nil
+	}
+	realmClient.ExportFn = func(groupID, appID string, req realm.ExportRequest) (string, *zip.Reader, error) {
+		return "eggcorn_20210101", u.NewZip(t, map[string]string{
+			"realm_config.json": `{
+    "config_version": 20210101,
+    "app_id": "` + remoteApps[appID].ClientAppID + `",
+    "name": "` + remoteApps[appID].Name + `",
+    "location": "` + exportLocations[appID] + `",
+    "deployment_model": "GLOBAL"
+}
+`,
+		}), nil
+	}
+
+	t.Run("should diff the first environment against the app of the second", func(t *testing.T) {
+		var diffGroupID, diffAppID string
+		var diffAppData local.AppData
+
+		client := realmClient
+		client.DiffFn = func(groupID, appID string, appData interface{}) ([]string, error) {
+			diffGroupID, diffAppID = groupID, appID
+			diffAppData = appData.(local.AppData)
+			return []string{"diff1", "diff2"}, nil
+		}
+
+		out, ui := mock.NewUI()
+
+		cmd := &CommandDiff{diffInputs{LocalPath: "testdata/diff-env", Environments: []string{"qa", "production"}}}
+		assert.Equal(t, feedback.ErrExitCode{Code: 2}, cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: client}))
+
+		assert.Equal(t, "prod-group", diffGroupID)
+		assert.Equal(t, "prod-app", diffAppID)
+		assert.Equal(t, "eggcorn-abcde", diffAppData.ID())
+		assert.Equal(t, "eggcorn", diffAppData.Name())
+		assert.Equal(t, realm.EnvironmentProduction, diffAppData.Environment())
+		assert.Equal(t, `The following reflects the proposed changes to promote the 'qa' environment to 'production'
+diff1
+diff2
+`, out.String())
+	})
+
+	t.Run("should display the structured changes between the environments with json output", func(t *testing.T) {
+		out := new(bytes.Buffer)
+		ui := mock.NewUIWithOptions(mock.UIOptions{UseJSON: true}, out)
+
+		cmd := &CommandDiff{diffInputs{LocalPath: "testdata/diff-env", Environments: []string{"qa", "production"}}}
+		assert.Equal(t, feedback.ErrExitCode{Code: 2}, cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient}))
+
+		assert.Equal(t, `{"time":"1989-06-22T01:23:45Z","level":"info","message":"The following reflects the proposed changes to promote the 'qa' environment to 'production'","doc":[{"type":"location","operation":"modified","before":"US-VA","after":"US-OR"}]}
+`, out.String())
+	})
+
+	t.Run("should display the unified diff between the environments", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		cmd := &CommandDiff{diffInputs{LocalPath: "testdata/diff-env", Environments: []string{"qa", "production"}, Unified: true}}
+		assert.Equal(t, feedback.ErrExitCode{Code: 2}, cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient}))
+
+		assert.Equal(t, `The following reflects the proposed changes to promote the 'qa' environment to 'production'
+--- a/realm_config.json
++++ b/realm_config.json
+@@ -2,7 +2,7 @@
+     "config_version": 20210101,
+     "app_id": "eggcorn-abcde",
+     "name": "eggcorn",
+-    "location": "US-VA",
++    "location": "US-OR",
+     "deployment_model": "GLOBAL",
+     "environment": "production"
+ }
+`, out.String())
+	})
+
+	t.Run("should exit successfully when the environments are identical", func(t *testing.T) {
+		out := new(bytes.Buffer)
+		ui := mock.NewUIWithOptions(mock.UIOptions{UseJSON: true}, out)
+
+		cmd := &CommandDiff{diffInputs{LocalPath: "testdata/diff-env", Environments: []string{"production", "production"}}}
+		assert.Nil(t, cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient}))
+
+		assert.Equal(t, `{"time":"1989-06-22T01:23:45Z","level":"info","message":"Deployed apps of the 'production' and 'production' environments are identical","doc":[]}
+`, out.String())
+	})
+
+	t.Run("should return an error when an environment has no remote app configured", func(t *testing.T) {
+		_, ui := mock.NewUI()
+
+		cmd := &CommandDiff{diffInputs{LocalPath: "testdata/diff-env", Environments: []string{"development", "production"}}}
+
+		err := cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient})
+		assert.NotNil(t, err)
+		assert.True(t, strings.HasPrefix(err.Error(), "no remote app is configured for the 'development' environment"), "unexpected error: "+err.Error())
+	})
+}
+
 func TestAppDiffInputs(t *testing.T) {
 	for _, tc := range []struct {
 		description    string
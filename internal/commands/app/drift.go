@@ -0,0 +1,275 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/feedback"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/local"
+	"github.com/10gen/realm-cli/internal/terminal"
+	"github.com/10gen/realm-cli/internal/utils/flags"
+)
+
+// CommandMetaDrift is the command meta
+var CommandMetaDrift = cli.CommandMeta{
+	Use:         "drift",
+	Aliases:     []string{},
+	Display:     "app drift",
+	Description: "Detect changes made to your Realm app outside of your local directory",
+	HelpText: `Compares the Realm app in your local directory against the deployed version of
+your Realm app, and reports any changes made to the deployed app out of band, such
+as from the Realm UI. If you have more than one Realm app, you will be prompted to
+select a Realm app to check. The local directory is compared as it is, so commit
+or stash any local changes first for them not to be reported as drift.
+
+With JSON output, the drift is reported as a list of the changes made to the
+deployed app, each with the type and name of the changed resource, the operation,
+and the resource as found locally and remotely. With "--patch", a patch of the
+remote changes is written to a file, which can be applied to your local directory
+with "git apply" to backport the changes. Only the files which make up the Realm
+app are patched, so other files of your local directory are left untouched.
+
+The command exits with code 2 when drift is detected, so that it can be run as a
+scheduled check.`,
+}
+
+// CommandDrift is the `app drift` command
+type CommandDrift struct {
+	inputs driftInputs
+}
+
+type driftInputs struct {
+	LocalPath          string
+	RemoteApp          string
+	Project            string
+	IncludeNodeModules bool
+	IncludePackageJSON bool
+	IncludeHosting     bool
+	Patch              string
+}
+
+// driftReport is the machine-readable report of the drift between a local and remote Realm app
+type driftReport struct {
+	Drifted bool              `json:"drifted"`
+	Changes []local.AppChange `json:"changes"`
+	Patch   string            `json:"patch,omitempty"`
+}
+
+// Flags is the command flags
+func (cmd *CommandDrift) Flags() []flags.Flag {
+	return []flags.Flag{
+		flags.StringFlag{
+			Value: &cmd.inputs.LocalPath,
+			Meta: flags.Meta{
+				Name: "local",
+				Usage: flags.Usage{
+					Description: "Specify the local filepath of a Realm app to check",
+				},
+			},
+		},
+		flags.StringFlag{
+			Value: &cmd.inputs.RemoteApp,
+			Meta: flags.Meta{
+				Name: "remote",
+				Usage: flags.Usage{
+					Description: "Specify the name or ID of a Realm app to check",
+				},
+			},
+		},
+		flags.BoolFlag{
+			Value: &cmd.inputs.IncludeNodeModules,
+			Meta: flags.Meta{
+				Name: flagIncludeNodeModules,
+				Usage: flags.Usage{
+					Description: "Include Realm app dependencies in the check from a node_modules archive",
+					Note:        "The allowed formats are as a directory or compressed into a .zip, .tar, .tar.gz, or .tgz file",
+				},
+			},
+		},
+		flags.BoolFlag{
+			Value: &cmd.inputs.IncludePackageJSON,
+			Meta: flags.Meta{
+				Name: flagIncludePackageJSON,
+				Usage: flags.Usage{
+					Description: "Include Realm app dependencies in the check from a package.json file",
+				},
+			},
+		},
+		flags.BoolFlag{
+			Value: &cmd.inputs.IncludeHosting,
+			Meta: flags.Meta{
+				Name:      "include-hosting",
+				Shorthand: "s",
+				Usage: flags.Usage{
+					Description: "Include Realm app hosting files in the check",
+				},
+			},
+		},
+		flags.StringFlag{
+			Value: &cmd.inputs.Patch,
+			Meta: flags.Meta{
+				Name: "patch",
+				Usage: flags.Usage{
+					Description: "Specify a filepath to write a patch of the remote changes to when drift is detected",
+					Note:        "The patch does not include changes to hosting files or dependencies",
+				},
+			},
+		},
+		cli.ProjectFlag(&cmd.inputs.Project),
+	}
+}
+
+// Inputs is the command inputs
+func (cmd *CommandDrift) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandDrift) Handler(ctx context.Context, profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, err := local.LoadApp(cmd.inputs.LocalPath)
+	if err != nil {
+		return err
+	}
+
+	appToCheck, err := cli.ResolveApp(ctx, ui, clients.Realm, cli.AppOptions{
+		Filter:  realm.AppFilter{GroupID: cmd.inputs.Project, App: cmd.inputs.RemoteApp},
+		AppMeta: app.Meta,
+	})
+	if err != nil {
+		return err
+	}
+
+	diffs, err := clients.Realm.Diff(ctx, appToCheck.GroupID, appToCheck.ID, app.AppData)
+	if err != nil {
+		return err
+	}
+
+	var dependenciesDiff realm.DependenciesDiff
+	if cmd.inputs.IncludeNodeModules || cmd.inputs.IncludePackageJSON {
+		appDependencies, err := cmd.inputs.resolveAppDependencies(app.RootDir)
+		if err != nil {
+			return err
+		}
+
+		uploadPath, cleanup, err := appDependencies.PrepareUpload()
+		if err != nil {
+			return err
+		}
+		defer cleanup()
+
+		dependenciesDiff, err = clients.Realm.DiffDependencies(ctx, appToCheck.GroupID, appToCheck.ID, uploadPath)
+		if err != nil {
+			return err
+		}
+		diffs = append(diffs, dependenciesDiff.Strings()...)
+	}
+
+	var hostingDiffs local.HostingDiffs
+	if cmd.inputs.IncludeHosting {
+		hosting, err := local.FindAppHosting(app.RootDir)
+		if err != nil {
+			return err
+		}
+
+		appAssets, err := clients.Realm.HostingAssets(ctx, appToCheck.GroupID, appToCheck.ID)
+		if err != nil {
+			return err
+		}
+
+		hostingDiffs, err = hosting.Diffs(profile.HostingAssetCachePath(), appToCheck.ID, appAssets)
+		if err != nil {
+			return err
+		}
+		diffs = append(diffs, hostingDiffs.Strings()...)
+	}
+
+	structured := ui.OutputFormat() == terminal.OutputFormatJSON
+
+	if len(diffs) == 0 {
+		if structured {
+			ui.Print(terminal.NewJSONLog("No drift detected between your local app and the deployed app", driftReport{Changes: []local.AppChange{}}))
+		} else {
+			ui.Print(terminal.NewTextLog("No drift detected between your local app and the deployed app"))
+		}
+		return nil
+	}
+
+	report := driftReport{Drifted: true, Patch: cmd.inputs.Patch}
+	if structured || cmd.inputs.Patch != "" {
+		remoteApp, cleanup, err := cli.ExportApp(ctx, clients.Realm, appToCheck.GroupID, appToCheck.ID, app.ConfigVersion())
+		if err != nil {
+			return err
+		}
+		defer cleanup()
+
+		if structured {
+			changes, err := local.DiffAppData(remoteApp.AppData, app.AppData)
+			if err != nil {
+				return err
+			}
+			changes = append(changes, local.ReverseChanges(local.DependenciesChanges(dependenciesDiff))...)
+			changes = append(changes, local.ReverseChanges(local.HostingChanges(hostingDiffs))...)
+			report.Changes = changes
+		}
+
+		if cmd.inputs.Patch != "" {
+			patch, err := local.RemotePatch(app.RootDir, remoteApp.RootDir)
+			if err != nil {
+				return err
+			}
+
+			var data string
+			if len(patch) > 0 {
+				data = strings.Join(patch, "\n") + "\n"
+			}
+			if err := ioutil.WriteFile(cmd.inputs.Patch, []byte(data), 0666); err != nil {
+				return fmt.Errorf("unable to write patch to disk: %s", err)
+			}
+		}
+	}
+
+	if structured {
+		ui.Print(terminal.NewJSONLog("Drift detected between your local app and the deployed app", report))
+		return feedback.ErrExitCode{Code: diffExitCode}
+	}
+
+	ui.Print(terminal.NewTextLog(
+		"Drift detected between your local app and the deployed app, which would be undone by the following changes\n%s",
+		strings.Join(diffs, "\n"),
+	))
+	if cmd.inputs.Patch != "" {
+		ui.Print(terminal.NewFollowupLog("Wrote a patch of the remote changes, which you can apply to your local app with", "git apply "+cmd.inputs.Patch))
+	}
+
+	return feedback.ErrExitCode{Code: diffExitCode}
+}
+
+func (i *driftInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	if i.IncludeNodeModules && i.IncludePackageJSON {
+		return fmt.Errorf(errDependencyFlagConflictTemplate, flagIncludeNodeModules, flagIncludePackageJSON)
+	}
+
+	app, err := resolveLocalApp(profile, ui, i.LocalPath)
+	if err != nil {
+		return err
+	}
+	i.LocalPath = app.RootDir
+
+	if i.RemoteApp == "" && app.Meta.AppID == "" {
+		i.RemoteApp = app.Option()
+	}
+
+	return nil
+}
+
+func (i *driftInputs) resolveAppDependencies(rootDir string) (local.Dependencies, error) {
+	if i.IncludePackageJSON {
+		return local.FindPackageJSON(rootDir)
+	}
+	return local.FindNodeModules(rootDir)
+}
@@ -0,0 +1,134 @@
+package app
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/feedback"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	u "github.com/10gen/realm-cli/internal/utils/test"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestAppDriftHandler(t *testing.T) {
+	newRealmClient := func(diffs []string) mock.RealmClient {
+		realmClient := mock.RealmClient{}
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{{ID: "appID", GroupID: "groupID", ClientAppID: "eggcorn-abcde", Name: "eggcorn"}}, nil
+		}
+		realmClient.DiffFn = func(groupID, appID string, appData interface{}) ([]string, error) {
+			return diffs, nil
+		}
+		realmClient.ExportFn = func(groupID, appID string, req realm.ExportRequest) (string, *zip.Reader, error) {
+			return "eggcorn_20210101", u.NewZip(t, map[string]string{
+				"realm_config.json": `{
+    "config_version": 20210101,
+    "app_id": "eggcorn-abcde",
+    "name": "eggcorn",
+    "location": "US-OR",
+    "deployment_model": "GLOBAL"
+}
+`,
+			}), nil
+		}
+		return realmClient
+	}
+
+	t.Run("should report no drift when the deployed app matches the local app", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		cmd := &CommandDrift{driftInputs{LocalPath: "testdata/diff-meta"}}
+		assert.Nil(t, cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: newRealmClient(nil)}))
+
+		assert.Equal(t, "No drift detected between your local app and the deployed app\n", out.String())
+	})
+
+	t.Run("should report the drift and exit with a non-zero exit code", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		cmd := &CommandDrift{driftInputs{LocalPath: "testdata/diff-meta"}}
+		assert.Equal(t, feedback.ErrExitCode{Code: 2}, cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: newRealmClient([]string{"diff1"})}))
+
+		assert.Equal(t, `Drift detected between your local app and the deployed app, which would be undone by the following changes
+diff1
+`, out.String())
+	})
+
+	t.Run("should report the remote changes with json output", func(t *testing.T) {
+		out := new(bytes.Buffer)
+		ui := mock.NewUIWithOptions(mock.UIOptions{UseJSON: true}, out)
+
+		cmd := &CommandDrift{driftInputs{LocalPath: "testdata/diff-meta"}}
+		assert.Equal(t, feedback.ErrExitCode{Code: 2}, cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: newRealmClient([]string{"diff1"})}))
+
+		assert.Equal(t, `{"time":"1989-06-22T01:23:45Z","level":"info","message":"Drift detected between your local app and the deployed app","doc":{"drifted":true,"changes":[{"type":"location","operation":"modified","before":"US-VA","after":"US-OR"}]}}
+`, out.String())
+	})
+
+	t.Run("should write a patch of the remote changes to the app files only", func(t *testing.T) {
+		tmpDir, teardown, err := u.NewTempDir("drift_patch")
+		assert.Nil(t, err)
+		defer teardown()
+
+		patchPath := filepath.Join(tmpDir, "drift.patch")
+
+		out, ui := mock.NewUI()
+
+		cmd := &CommandDrift{driftInputs{LocalPath: "testdata/diff-meta", Patch: patchPath}}
+		assert.Equal(t, feedback.ErrExitCode{Code: 2}, cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: newRealmClient([]string{"diff1"})}))
+
+		assert.Equal(t, `Drift detected between your local app and the deployed app, which would be undone by the following changes
+diff1
+Wrote a patch of the remote changes, which you can apply to your local app with: git apply `+patchPath+`
+`, out.String())
+
+		patch, err := ioutil.ReadFile(patchPath)
+		assert.Nil(t, err)
+		assert.Equal(t, `--- a/realm_config.json
++++ b/realm_config.json
+@@ -2,6 +2,6 @@
+     "config_version": 20210101,
+     "app_id": "eggcorn-abcde",
+     "name": "eggcorn",
+-    "location": "US-VA",
++    "location": "US-OR",
+     "deployment_model": "GLOBAL"
+ }
+`, string(patch))
+	})
+
+	t.Run("should return an error when the diff fails", func(t *testing.T) {
+		_, ui := mock.NewUI()
+
+		realmClient := newRealmClient(nil)
+		realmClient.DiffFn = func(groupID, appID string, appData interface{}) ([]string, error) {
+			return nil, errors.New("something bad happened")
+		}
+
+		cmd := &CommandDrift{driftInputs{LocalPath: "testdata/diff-meta"}}
+		assert.Equal(t, errors.New("something bad happened"), cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient}))
+	})
+}
+
+func TestAppDriftInputs(t *testing.T) {
+	t.Run("should return an error when both dependencies flags are set", func(t *testing.T) {
+		inputs := driftInputs{LocalPath: "testdata/diff-meta", IncludeNodeModules: true, IncludePackageJSON: true}
+		assert.Equal(t, errors.New(`cannot use both "include-node-modules" and "include-package-json" at the same time`), inputs.Resolve(nil, nil))
+	})
+
+	t.Run("should resolve the local path to the app root directory", func(t *testing.T) {
+		inputs := driftInputs{LocalPath: "testdata/diff-meta/.mdb"}
+		assert.Nil(t, inputs.Resolve(nil, nil))
+
+		abs, err := filepath.Abs("testdata/diff-meta")
+		assert.Nil(t, err)
+		assert.Equal(t, abs, inputs.LocalPath)
+	})
+}
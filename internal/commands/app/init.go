@@ -1,6 +1,8 @@
 package app
 
 import (
+	"context"
+
 	"github.com/10gen/realm-cli/internal/cli"
 	"github.com/10gen/realm-cli/internal/cli/user"
 	"github.com/10gen/realm-cli/internal/cloud/realm"
@@ -49,8 +51,8 @@ func (cmd *CommandInit) Inputs() cli.InputResolver {
 }
 
 // Handler is the command handler
-func (cmd *CommandInit) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
-	appRemote, err := cmd.inputs.resolveRemoteApp(ui, clients.Realm)
+func (cmd *CommandInit) Handler(ctx context.Context, profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	appRemote, err := cmd.inputs.resolveRemoteApp(ctx, ui, clients.Realm)
 	if err != nil {
 		return err
 	}
@@ -60,7 +62,7 @@ func (cmd *CommandInit) Handler(profile *user.Profile, ui terminal.UI, clients c
 			return err
 		}
 	} else {
-		if err := cmd.writeAppFromExisting(profile.WorkingDirectory, clients.Realm, appRemote.GroupID, appRemote.ID); err != nil {
+		if err := cmd.writeAppFromExisting(ctx, profile.WorkingDirectory, clients.Realm, appRemote.GroupID, appRemote.ID); err != nil {
 			return err
 		}
 	}
@@ -86,8 +88,8 @@ func (cmd *CommandInit) writeAppFromScratch(wd string) error {
 	return appLocal.Write()
 }
 
-func (cmd *CommandInit) writeAppFromExisting(wd string, realmClient realm.Client, groupID, appID string) error {
-	_, zipPkg, err := realmClient.Export(groupID, appID, realm.ExportRequest{IsTemplated: true})
+func (cmd *CommandInit) writeAppFromExisting(ctx context.Context, wd string, realmClient realm.Client, groupID, appID string) error {
+	_, zipPkg, err := realmClient.Export(ctx, groupID, appID, realm.ExportRequest{IsTemplated: true})
 	if err != nil {
 		return err
 	}
@@ -2,6 +2,7 @@ package app
 
 import (
 	"archive/zip"
+	"context"
 	"encoding/json"
 	"io/ioutil"
 	"os"
@@ -32,7 +33,7 @@ func TestAppInitHandler(t *testing.T) {
 			ConfigVersion:   realm.DefaultAppConfigVersion,
 		}}}
 
-		assert.Nil(t, cmd.Handler(profile, ui, cli.Clients{}))
+		assert.Nil(t, cmd.Handler(context.Background(), profile, ui, cli.Clients{}))
 
 		data, err := ioutil.ReadFile(filepath.Join(profile.WorkingDirectory, local.FileRealmConfig.String()))
 		assert.Nil(t, err)
@@ -150,7 +151,7 @@ func TestAppInitHandler(t *testing.T) {
 
 		cmd := &CommandInit{initInputs{newAppInputs: newAppInputs{RemoteApp: "test", ConfigVersion: realm.DefaultAppConfigVersion}}}
 
-		assert.Nil(t, cmd.Handler(profile, ui, cli.Clients{Realm: client}))
+		assert.Nil(t, cmd.Handler(context.Background(), profile, ui, cli.Clients{Realm: client}))
 
 		assert.Equal(t, "Successfully initialized app\n", out.String())
 
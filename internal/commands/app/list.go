@@ -1,6 +1,7 @@
 package app
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/10gen/realm-cli/internal/cli"
@@ -33,8 +34,8 @@ func (cmd *CommandList) Flags() []flags.Flag {
 }
 
 // Handler is the command handler
-func (cmd *CommandList) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
-	apps, err := clients.Realm.FindApps(cmd.inputs.Filter())
+func (cmd *CommandList) Handler(ctx context.Context, profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	apps, err := clients.Realm.FindApps(ctx, cmd.inputs.Filter())
 	if err != nil {
 		return err
 	}
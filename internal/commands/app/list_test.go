@@ -1,6 +1,7 @@
 package app
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -71,7 +72,7 @@ func TestAppListHandler(t *testing.T) {
 			}
 
 			cmd := &CommandList{tc.inputs}
-			assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+			assert.Nil(t, cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient}))
 
 			assert.Equal(t, tc.expectedAppFilter, appFilter)
 			assert.Equal(t, fmt.Sprintf(`Found 3 apps
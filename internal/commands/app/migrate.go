@@ -0,0 +1,147 @@
+package app
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/local"
+	"github.com/10gen/realm-cli/internal/terminal"
+	"github.com/10gen/realm-cli/internal/utils/flags"
+)
+
+// CommandMetaMigrate is the command meta
+var CommandMetaMigrate = cli.CommandMeta{
+	Use:         "migrate",
+	Aliases:     []string{},
+	Display:     "app migrate",
+	Description: "Migrate your local Realm app directory to a newer config version",
+	HelpText: `Converts the files of your local Realm app from an older config version into the
+layout of a newer one. For example, migrating to 20210101 moves MongoDB services
+into "data_sources", HTTP services and their webhooks into "http_endpoints", and
+function configs into "functions/config.json". Any configuration which cannot be
+migrated is reported so that you can update it yourself.`,
+}
+
+// CommandMigrate is the `app migrate` command
+type CommandMigrate struct {
+	inputs migrateInputs
+}
+
+type migrateInputs struct {
+	LocalPath     string
+	ConfigVersion realm.AppConfigVersion
+	DryRun        bool
+}
+
+// Flags is the command flags
+func (cmd *CommandMigrate) Flags() []flags.Flag {
+	return []flags.Flag{
+		flags.StringFlag{
+			Value: &cmd.inputs.LocalPath,
+			Meta: flags.Meta{
+				Name: "local",
+				Usage: flags.Usage{
+					Description: "Specify the local filepath of a Realm app to migrate",
+				},
+			},
+		},
+		flags.CustomFlag{
+			Value: &cmd.inputs.ConfigVersion,
+			Meta: flags.Meta{
+				Name: "to",
+				Usage: flags.Usage{
+					Description:   "Specify the config version to migrate the Realm app to",
+					DefaultValue:  realm.DefaultAppConfigVersion.String(),
+					AllowedValues: []string{realm.AppConfigVersion20210101.String()},
+				},
+			},
+		},
+		flags.BoolFlag{
+			Value: &cmd.inputs.DryRun,
+			Meta: flags.Meta{
+				Name:      "dry-run",
+				Shorthand: "x",
+				Usage: flags.Usage{
+					Description: "Show the file changes without writing them to the local filepath",
+				},
+			},
+		},
+	}
+}
+
+// Inputs is the command inputs
+func (cmd *CommandMigrate) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandMigrate) Handler(ctx context.Context, profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, err := local.LoadApp(cmd.inputs.LocalPath)
+	if err != nil {
+		return err
+	}
+
+	migration, err := local.MigrateApp(app, cmd.inputs.ConfigVersion)
+	if err != nil {
+		return err
+	}
+
+	changes := make([]interface{}, 0, len(migration.Changes))
+	for _, change := range migration.Changes {
+		changes = append(changes, change.String())
+	}
+
+	logs := []terminal.Log{terminal.NewListLog(
+		fmt.Sprintf("The following changes migrate your Realm app to config version %s", migration.ConfigVersion),
+		changes...,
+	)}
+
+	if len(migration.Unmapped) > 0 {
+		unmapped := make([]interface{}, 0, len(migration.Unmapped))
+		for _, u := range migration.Unmapped {
+			unmapped = append(unmapped, u)
+		}
+		logs = append(logs, terminal.NewListLog(
+			"The following configuration cannot be migrated and must be updated manually",
+			unmapped...,
+		))
+	}
+
+	ui.Print(logs...)
+
+	if cmd.inputs.DryRun {
+		ui.Print(terminal.NewTextLog("No changes were written to your file system"))
+		return nil
+	}
+
+	proceed, err := ui.Confirm("Would you like to migrate the app?")
+	if err != nil {
+		return err
+	}
+	if !proceed {
+		return nil
+	}
+
+	if err := migration.Apply(); err != nil {
+		return err
+	}
+
+	ui.Print(terminal.NewTextLog("Successfully migrated app to config version %s", migration.ConfigVersion))
+	return nil
+}
+
+func (i *migrateInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	if i.ConfigVersion == realm.AppConfigVersionZero {
+		i.ConfigVersion = realm.DefaultAppConfigVersion
+	}
+
+	rootDir, err := resolveAppRootDir(profile, i.LocalPath)
+	if err != nil {
+		return err
+	}
+	i.LocalPath = rootDir
+	return nil
+}
@@ -0,0 +1,103 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/local"
+	u "github.com/10gen/realm-cli/internal/utils/test"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestAppMigrateHandler(t *testing.T) {
+	setup := func(t *testing.T) (string, func()) {
+		t.Helper()
+
+		tmpDir, teardown, err := u.NewTempDir("app_migrate_test")
+		assert.Nil(t, err)
+
+		app := local.NewApp(tmpDir, "migrate-abcde", "migrate", realm.LocationVirginia, realm.DeploymentModelGlobal, realm.EnvironmentNone, realm.AppConfigVersion20200603)
+		local.AddAuthProvider(app.AppData, "api-key", map[string]interface{}{"name": "api-key", "type": "api-key", "disabled": false})
+		assert.Nil(t, app.Write())
+
+		return tmpDir, teardown
+	}
+
+	t.Run("should print the file changes without writing them with a dry run", func(t *testing.T) {
+		tmpDir, teardown := setup(t)
+		defer teardown()
+
+		out, ui := mock.NewUI()
+
+		cmd := &CommandMigrate{migrateInputs{LocalPath: tmpDir, ConfigVersion: realm.AppConfigVersion20210101, DryRun: true}}
+		assert.Nil(t, cmd.Handler(context.Background(), nil, ui, cli.Clients{}))
+
+		assert.True(t, strings.HasPrefix(out.String(), `The following changes migrate your Realm app to config version 20210101
+  write realm_config.json
+  delete config.json
+`), "unexpected output:\n%s", out.String())
+		assert.True(t, strings.Contains(out.String(), "  delete auth_providers/api-key.json\n  write auth/providers.json\n"), "unexpected output:\n%s", out.String())
+		assert.True(t, strings.HasSuffix(out.String(), "No changes were written to your file system\n"), "unexpected output:\n%s", out.String())
+
+		_, err := os.Stat(filepath.Join(tmpDir, local.FileConfig.String()))
+		assert.Nil(t, err)
+	})
+
+	t.Run("should migrate the app once confirmed", func(t *testing.T) {
+		tmpDir, teardown := setup(t)
+		defer teardown()
+
+		out := new(bytes.Buffer)
+		ui := mock.NewUIWithOptions(mock.UIOptions{AutoConfirm: true}, out)
+
+		cmd := &CommandMigrate{migrateInputs{LocalPath: tmpDir, ConfigVersion: realm.AppConfigVersion20210101}}
+		assert.Nil(t, cmd.Handler(context.Background(), nil, ui, cli.Clients{}))
+		assert.True(t, strings.HasSuffix(out.String(), "Successfully migrated app to config version 20210101\n"), "unexpected output:\n%s", out.String())
+
+		app, err := local.LoadApp(tmpDir)
+		assert.Nil(t, err)
+		assert.Equal(t, realm.AppConfigVersion20210101, app.ConfigVersion())
+
+		data, err := ioutil.ReadFile(filepath.Join(tmpDir, local.NameAuth, local.FileProviders.String()))
+		assert.Nil(t, err)
+		assert.True(t, strings.Contains(string(data), `"api-key": {`), "unexpected providers:\n%s", data)
+	})
+
+	t.Run("should report the configuration which cannot be migrated", func(t *testing.T) {
+		tmpDir, teardown := setup(t)
+		defer teardown()
+
+		assert.Nil(t, ioutil.WriteFile(filepath.Join(tmpDir, local.FileConfig.String()), []byte(`{
+    "config_version": 20200603,
+    "name": "migrate",
+    "security": {"allowed_ip_addresses": []}
+}`), 0666))
+
+		out, ui := mock.NewUI()
+
+		cmd := &CommandMigrate{migrateInputs{LocalPath: tmpDir, ConfigVersion: realm.AppConfigVersion20210101, DryRun: true}}
+		assert.Nil(t, cmd.Handler(context.Background(), nil, ui, cli.Clients{}))
+		assert.True(t, strings.Contains(out.String(), `The following configuration cannot be migrated and must be updated manually
+  security.allowed_ip_addresses in config.json
+`), "unexpected output:\n%s", out.String())
+	})
+}
+
+func TestAppMigrateInputs(t *testing.T) {
+	t.Run("should default to the latest config version", func(t *testing.T) {
+		profile := mock.NewProfile(t)
+
+		i := migrateInputs{LocalPath: "testdata/diff"}
+		assert.Nil(t, i.Resolve(profile, nil))
+		assert.Equal(t, realm.DefaultAppConfigVersion, i.ConfigVersion)
+		assert.Equal(t, filepath.Join(profile.WorkingDirectory, "testdata/diff"), i.LocalPath)
+	})
+}
@@ -1,6 +1,7 @@
 package app
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/10gen/realm-cli/internal/cli"
@@ -24,10 +25,10 @@ type newAppInputs struct {
 	ConfigVersion   realm.AppConfigVersion
 }
 
-func (i *newAppInputs) resolveRemoteApp(ui terminal.UI, rc realm.Client) (realm.App, error) {
+func (i *newAppInputs) resolveRemoteApp(ctx context.Context, ui terminal.UI, rc realm.Client) (realm.App, error) {
 	var ra realm.App
 	if i.RemoteApp != "" {
-		app, err := cli.ResolveApp(ui, rc, cli.AppOptions{Filter: realm.AppFilter{App: i.RemoteApp}})
+		app, err := cli.ResolveApp(ctx, ui, rc, cli.AppOptions{Filter: realm.AppFilter{App: i.RemoteApp}})
 		if err != nil {
 			return realm.App{}, err
 		}
@@ -39,12 +40,12 @@ func (i *newAppInputs) resolveRemoteApp(ui terminal.UI, rc realm.Client) (realm.
 // resolveTemplateID is responsible for resolving a template id from a user cli request.
 // If the --template flag is not set, the CLI will create a default app without any template,
 // otherwise the CLI will attempt to create an app based on the specified template
-func (i *newAppInputs) resolveTemplateID(client realm.Client) error {
+func (i *newAppInputs) resolveTemplateID(ctx context.Context, client realm.Client) error {
 	if i.Template == "" {
 		return nil
 	}
 
-	templates, err := client.AllTemplates()
+	templates, err := client.AllTemplates(ctx)
 	if err != nil {
 		return err
 	}
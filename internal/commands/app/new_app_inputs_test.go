@@ -1,6 +1,7 @@
 package app
 
 import (
+	"context"
 	"errors"
 	"testing"
 
@@ -14,7 +15,7 @@ import (
 func TestAppNewAppInputsResolveFrom(t *testing.T) {
 	t.Run("should do nothing if from is not set", func(t *testing.T) {
 		var i newAppInputs
-		r, err := i.resolveRemoteApp(nil, nil)
+		r, err := i.resolveRemoteApp(context.Background(), nil, nil)
 		assert.Nil(t, err)
 		assert.Equal(t, realm.App{}, r)
 	})
@@ -54,7 +55,7 @@ func TestAppNewAppInputsResolveFrom(t *testing.T) {
 				return []realm.App{testApp}, tc.expectedErr
 			}
 
-			r, err := tc.inputs.resolveRemoteApp(nil, rc)
+			r, err := tc.inputs.resolveRemoteApp(context.Background(), nil, rc)
 
 			assert.Equal(t, tc.expectedErr, err)
 			assert.Equal(t, tc.expectedRemote, r)
@@ -0,0 +1,141 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/local"
+	"github.com/10gen/realm-cli/internal/terminal"
+	"github.com/10gen/realm-cli/internal/utils/flags"
+)
+
+// CommandMetaValidate is the command meta
+var CommandMetaValidate = cli.CommandMeta{
+	Use:         "validate",
+	Aliases:     []string{},
+	Display:     "app validate",
+	Description: "Validate your local Realm app directory before pushing it",
+	HelpText: `Checks the configuration files of your local Realm app against the schemas of
+its config version, and that the functions and services referenced by triggers,
+endpoints, and other configuration are defined. This command does not connect
+to Realm, so you can run it before "push" to find any problems with the file
+and line where they occur.`,
+}
+
+// CommandValidate is the `app validate` command
+type CommandValidate struct {
+	inputs validateInputs
+}
+
+type validateInputs struct {
+	LocalPath string
+}
+
+// set of validation table headers
+const (
+	headerFile    = "File"
+	headerLine    = "Line"
+	headerColumn  = "Column"
+	headerMessage = "Message"
+)
+
+// Flags is the command flags
+func (cmd *CommandValidate) Flags() []flags.Flag {
+	return []flags.Flag{
+		flags.StringFlag{
+			Value: &cmd.inputs.LocalPath,
+			Meta: flags.Meta{
+				Name: "local",
+				Usage: flags.Usage{
+					Description: "Specify the local filepath of a Realm app to validate",
+				},
+			},
+		},
+	}
+}
+
+// Inputs is the command inputs
+func (cmd *CommandValidate) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandValidate) Handler(ctx context.Context, profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, _, err := local.FindApp(cmd.inputs.LocalPath)
+	if err != nil {
+		return err
+	}
+
+	validationErrs, err := app.Validate()
+	if err != nil {
+		return err
+	}
+
+	if len(validationErrs) > 0 {
+		rows := make([]map[string]interface{}, 0, len(validationErrs))
+		for _, validationErr := range validationErrs {
+			rows = append(rows, map[string]interface{}{
+				headerFile:    validationErr.Path,
+				headerLine:    validationErr.Line,
+				headerColumn:  validationErr.Column,
+				headerMessage: validationErr.Message,
+			})
+		}
+
+		ui.Print(terminal.NewTableLog(
+			"The following problems were found with your Realm app",
+			[]string{headerFile, headerLine, headerColumn, headerMessage},
+			rows...,
+		))
+		return errors.New("app validation failed")
+	}
+
+	// the app must still load the same way it is loaded before a push
+	if _, err := local.LoadApp(app.RootDir); err != nil {
+		return err
+	}
+
+	ui.Print(terminal.NewTextLog("Successfully validated app"))
+	return nil
+}
+
+func (i *validateInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	rootDir, err := resolveAppRootDir(profile, i.LocalPath)
+	if err != nil {
+		return err
+	}
+	i.LocalPath = rootDir
+	return nil
+}
+
+// resolveAppRootDir finds the root directory of the local Realm app
+// at the local path, or the working directory when no path is set
+func resolveAppRootDir(profile *user.Profile, localPath string) (string, error) {
+	searchPath := localPath
+	if searchPath == "" {
+		searchPath = profile.WorkingDirectory
+	}
+
+	searchPathAbs, err := filepath.Abs(searchPath)
+	if err != nil {
+		return "", err
+	}
+
+	if _, err := os.Stat(searchPathAbs); os.IsNotExist(err) {
+		return "", errProjectInvalid(searchPath, false)
+	}
+
+	app, _, err := local.FindApp(searchPathAbs)
+	if err != nil {
+		return "", err
+	}
+
+	if app.RootDir == "" {
+		return "", errProjectInvalid(searchPath, true)
+	}
+	return app.RootDir, nil
+}
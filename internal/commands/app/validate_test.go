@@ -0,0 +1,65 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/feedback"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestAppValidateHandler(t *testing.T) {
+	t.Run("should print success for a valid app", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		cmd := &CommandValidate{validateInputs{LocalPath: "testdata/diff"}}
+
+		assert.Nil(t, cmd.Handler(context.Background(), nil, ui, cli.Clients{}))
+		assert.Equal(t, "Successfully validated app\n", out.String())
+	})
+
+	t.Run("should print the problems found with an invalid app and return an error", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		cmd := &CommandValidate{validateInputs{LocalPath: "testdata/validate"}}
+
+		assert.Equal(t, errors.New("app validation failed"), cmd.Handler(context.Background(), nil, ui, cli.Clients{}))
+		assert.Equal(t, `The following problems were found with your Realm app
+  File                   Line  Column  Message                          
+  ---------------------  ----  ------  ---------------------------------
+  triggers/trigger.json  7     22      function "missing" is not defined
+  triggers/trigger.json  8     17      "disabled" must be a boolean     
+`, out.String())
+	})
+}
+
+func TestAppValidateInputs(t *testing.T) {
+	t.Run("should resolve the local path to the app root directory", func(t *testing.T) {
+		profile := mock.NewProfile(t)
+		profile.WorkingDirectory = filepath.Join(profile.WorkingDirectory, "testdata/diff/hosting")
+
+		var i validateInputs
+		assert.Nil(t, i.Resolve(profile, nil))
+		assert.Equal(t, filepath.Join(profile.WorkingDirectory, ".."), i.LocalPath)
+	})
+
+	t.Run("should return an error when the local path does not exist", func(t *testing.T) {
+		profile := mock.NewProfile(t)
+
+		i := validateInputs{LocalPath: "./some/path"}
+		assert.Equal(t, feedback.NewErr(errors.New("directory './some/path' does not exist"), feedback.ErrNoUsage{}), i.Resolve(profile, nil))
+	})
+
+	t.Run("should return an error when the local path is not an app directory", func(t *testing.T) {
+		profile, teardown := mock.NewProfileFromTmpDir(t, "app_validate_test")
+		defer teardown()
+
+		i := validateInputs{LocalPath: profile.WorkingDirectory}
+		assert.Equal(t, feedback.NewErr(fmt.Errorf("directory '%s' is not a supported Realm app project", profile.WorkingDirectory), feedback.ErrNoUsage{}), i.Resolve(profile, nil))
+	})
+}
@@ -4,6 +4,8 @@ import (
 	"github.com/10gen/realm-cli/internal/cli"
 	"github.com/10gen/realm-cli/internal/commands/accesslist"
 	"github.com/10gen/realm-cli/internal/commands/app"
+	"github.com/10gen/realm-cli/internal/commands/deployments"
+	"github.com/10gen/realm-cli/internal/commands/drafts"
 	"github.com/10gen/realm-cli/internal/commands/function"
 	"github.com/10gen/realm-cli/internal/commands/login"
 	"github.com/10gen/realm-cli/internal/commands/logout"
@@ -71,10 +73,74 @@ var (
 				Command:     &app.CommandDiff{},
 				CommandMeta: app.CommandMetaDiff,
 			},
+			{
+				Command:     &app.CommandDrift{},
+				CommandMeta: app.CommandMetaDrift,
+			},
 			{
 				Command:     &app.CommandDescribe{},
 				CommandMeta: app.CommandMetaDescribe,
 			},
+			{
+				Command:     &app.CommandValidate{},
+				CommandMeta: app.CommandMetaValidate,
+			},
+			{
+				Command:     &app.CommandMigrate{},
+				CommandMeta: app.CommandMetaMigrate,
+			},
+		},
+	}
+
+	Deployments = cli.CommandDefinition{
+		CommandMeta: cli.CommandMeta{
+			Use:         "deployments",
+			Aliases:     []string{"deployment"},
+			Description: "Manage the Deployments of your Realm app",
+		},
+		SubCommands: []cli.CommandDefinition{
+			{
+				Command:     &deployments.CommandList{},
+				CommandMeta: deployments.CommandMetaList,
+			},
+			{
+				Command:     &deployments.CommandDescribe{},
+				CommandMeta: deployments.CommandMetaDescribe,
+			},
+			{
+				Command:     &deployments.CommandRedeploy{},
+				CommandMeta: deployments.CommandMetaRedeploy,
+			},
+		},
+	}
+
+	Drafts = cli.CommandDefinition{
+		CommandMeta: cli.CommandMeta{
+			Use:         "drafts",
+			Aliases:     []string{"draft"},
+			Description: "Manage the staged changes in the Draft of your Realm app",
+		},
+		SubCommands: []cli.CommandDefinition{
+			{
+				Command:     &drafts.CommandCreate{},
+				CommandMeta: drafts.CommandMetaCreate,
+			},
+			{
+				Command:     &drafts.CommandShow{},
+				CommandMeta: drafts.CommandMetaShow,
+			},
+			{
+				Command:     &drafts.CommandDiff{},
+				CommandMeta: drafts.CommandMetaDiff,
+			},
+			{
+				Command:     &drafts.CommandDeploy{},
+				CommandMeta: drafts.CommandMetaDeploy,
+			},
+			{
+				Command:     &drafts.CommandDiscard{},
+				CommandMeta: drafts.CommandMetaDiscard,
+			},
 		},
 	}
 
@@ -163,6 +229,14 @@ var (
 				Command:     &logs.CommandList{},
 				CommandMeta: logs.CommandMetaList,
 			},
+			{
+				Command:     &logs.CommandExport{},
+				CommandMeta: logs.CommandMetaExport,
+			},
+			{
+				Command:     &logs.CommandStats{},
+				CommandMeta: logs.CommandMetaStats,
+			},
 		},
 	}
 
@@ -211,13 +285,36 @@ var (
 			Use:         "profiles",
 			Aliases:     []string{"profile"},
 			Description: "Manage the profiles of your local CLI environment",
-			Hidden:      true,
 		},
 		SubCommands: []cli.CommandDefinition{
 			{
 				Command:     &profile.CommandList{},
 				CommandMeta: profile.CommandMetaList,
 			},
+			{
+				Command:     &profile.CommandCreate{},
+				CommandMeta: profile.CommandMetaCreate,
+			},
+			{
+				Command:     &profile.CommandUse{},
+				CommandMeta: profile.CommandMetaUse,
+			},
+			{
+				Command:     &profile.CommandShow{},
+				CommandMeta: profile.CommandMetaShow,
+			},
+			{
+				Command:     &profile.CommandNetwork{},
+				CommandMeta: profile.CommandMetaNetwork,
+			},
+			{
+				Command:     &profile.CommandRename{},
+				CommandMeta: profile.CommandMetaRename,
+			},
+			{
+				Command:     &profile.CommandDelete{},
+				CommandMeta: profile.CommandMetaDelete,
+			},
 		},
 	}
 )
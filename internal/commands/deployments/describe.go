@@ -0,0 +1,68 @@
+package deployments
+
+import (
+	"context"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/terminal"
+	"github.com/10gen/realm-cli/internal/utils/flags"
+)
+
+// CommandMetaDescribe is the command meta for the `deployments describe` command
+var CommandMetaDescribe = cli.CommandMeta{
+	Use:         "describe",
+	Display:     "deployments describe",
+	Description: "Displays information about a Deployment of your Realm app",
+	HelpText: `View the status, error message, timestamp, origin, and draft of a Deployment of
+your Realm app. If you do not specify a Deployment with "--deployment", you will
+be prompted to select one of the recent Deployments to view.`,
+}
+
+// CommandDescribe is the `deployments describe` command
+type CommandDescribe struct {
+	inputs describeInputs
+}
+
+type describeInputs struct {
+	cli.ProjectInputs
+	Deployment string
+}
+
+// Flags is the command flags
+func (cmd *CommandDescribe) Flags() []flags.Flag {
+	return []flags.Flag{
+		cli.AppFlagWithContext(&cmd.inputs.App, "to describe its deployment"),
+		cli.ProjectFlag(&cmd.inputs.Project),
+		cli.ProductFlag(&cmd.inputs.Products),
+		deploymentFlag(&cmd.inputs.Deployment, "Specify the ID of the deployment to describe"),
+	}
+}
+
+// Inputs is the command inputs
+func (cmd *CommandDescribe) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandDescribe) Handler(ctx context.Context, profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, err := cli.ResolveApp(ctx, ui, clients.Realm, cli.AppOptions{
+		AppMeta: cmd.inputs.AppMeta,
+		Filter:  cmd.inputs.Filter(),
+	})
+	if err != nil {
+		return err
+	}
+
+	deployment, err := resolveDeployment(ctx, ui, clients.Realm, app, cmd.inputs.Deployment, "describe")
+	if err != nil {
+		return err
+	}
+
+	ui.Print(terminal.NewJSONLog("Deployment description", deployment))
+	return nil
+}
+
+func (i *describeInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	return i.ProjectInputs.Resolve(ui, profile.WorkingDirectory, false)
+}
@@ -0,0 +1,66 @@
+package deployments
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestDeploymentsDescribeHandler(t *testing.T) {
+	app := realm.App{ID: "appID", GroupID: "groupID", ClientAppID: "eggcorn-abcde"}
+
+	t.Run("should display the deployment", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		realmClient := mock.RealmClient{}
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{app}, nil
+		}
+		realmClient.DeploymentFn = func(groupID, appID, deploymentID string) (realm.AppDeployment, error) {
+			return realm.AppDeployment{
+				ID:                 deploymentID,
+				AppID:              appID,
+				DraftID:            "draftID",
+				DeployedAt:         1609459200,
+				Origin:             "CLI",
+				Status:             realm.DeploymentStatusFailed,
+				StatusErrorMessage: "something bad happened",
+			}, nil
+		}
+
+		cmd := &CommandDescribe{describeInputs{Deployment: "deploymentID"}}
+
+		assert.Nil(t, cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, `Deployment description
+{
+  "_id": "deploymentID",
+  "app_id": "appID",
+  "draft_id": "draftID",
+  "deployed_at": 1609459200,
+  "origin": "CLI",
+  "status": "failed",
+  "status_error_message": "something bad happened"
+}
+`, out.String())
+	})
+
+	t.Run("should return an error when finding the deployment fails", func(t *testing.T) {
+		realmClient := mock.RealmClient{}
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{app}, nil
+		}
+		realmClient.DeploymentFn = func(groupID, appID, deploymentID string) (realm.AppDeployment, error) {
+			return realm.AppDeployment{}, errors.New("something bad happened")
+		}
+
+		cmd := &CommandDescribe{describeInputs{Deployment: "deploymentID"}}
+
+		err := cmd.Handler(context.Background(), nil, nil, cli.Clients{Realm: realmClient})
+		assert.Equal(t, errors.New("something bad happened"), err)
+	})
+}
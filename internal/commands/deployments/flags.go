@@ -0,0 +1,21 @@
+package deployments
+
+import "github.com/10gen/realm-cli/internal/utils/flags"
+
+const (
+	flagDeployment      = "deployment"
+	flagDeploymentShort = "d"
+)
+
+func deploymentFlag(value *string, description string) flags.StringFlag {
+	return flags.StringFlag{
+		Value: value,
+		Meta: flags.Meta{
+			Name:      flagDeployment,
+			Shorthand: flagDeploymentShort,
+			Usage: flags.Usage{
+				Description: description,
+			},
+		},
+	}
+}
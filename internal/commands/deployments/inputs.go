@@ -0,0 +1,49 @@
+package deployments
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/terminal"
+
+	"github.com/AlecAivazis/survey/v2"
+)
+
+// resolveDeployment finds the app deployment with the specified ID,
+// or prompts the user to select a recent deployment when no ID is specified
+func resolveDeployment(ctx context.Context, ui terminal.UI, realmClient realm.Client, app realm.App, deploymentID, action string) (realm.AppDeployment, error) {
+	if deploymentID != "" {
+		return realmClient.Deployment(ctx, app.GroupID, app.ID, deploymentID)
+	}
+
+	deployments, err := realmClient.Deployments(ctx, app.GroupID, app.ID)
+	if err != nil {
+		return realm.AppDeployment{}, err
+	}
+
+	if len(deployments) == 0 {
+		return realm.AppDeployment{}, fmt.Errorf("no deployments available to %s", action)
+	}
+
+	options := make([]string, 0, len(deployments))
+	deploymentsByOption := make(map[string]realm.AppDeployment, len(deployments))
+	for _, deployment := range deployments {
+		option := displayDeploymentOption(deployment)
+
+		options = append(options, option)
+		deploymentsByOption[option] = deployment
+	}
+
+	var selection string
+	if err := ui.AskOne(
+		&selection,
+		&survey.Select{
+			Message: fmt.Sprintf("Which deployment would you like to %s?", action),
+			Options: options,
+		},
+	); err != nil {
+		return realm.AppDeployment{}, err
+	}
+	return deploymentsByOption[selection], nil
+}
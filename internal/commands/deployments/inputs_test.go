@@ -0,0 +1,71 @@
+package deployments
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestResolveDeployment(t *testing.T) {
+	app := realm.App{ID: "appID", GroupID: "groupID"}
+	deployments := []realm.AppDeployment{
+		{ID: "deployment1", Status: realm.DeploymentStatusSuccessful, DeployedAt: 1609459200},
+		{ID: "deployment2", Status: realm.DeploymentStatusFailed, StatusErrorMessage: "something bad happened"},
+	}
+
+	t.Run("should find the deployment with the specified id", func(t *testing.T) {
+		var capturedDeploymentID string
+		realmClient := mock.RealmClient{}
+		realmClient.DeploymentFn = func(groupID, appID, deploymentID string) (realm.AppDeployment, error) {
+			capturedDeploymentID = deploymentID
+			return deployments[1], nil
+		}
+
+		deployment, err := resolveDeployment(context.Background(), nil, realmClient, app, "deployment2", "describe")
+		assert.Nil(t, err)
+		assert.Equal(t, deployments[1], deployment)
+		assert.Equal(t, "deployment2", capturedDeploymentID)
+	})
+
+	t.Run("should prompt for a deployment with no id specified", func(t *testing.T) {
+		realmClient := mock.RealmClient{}
+		realmClient.DeploymentsFn = func(groupID, appID string) ([]realm.AppDeployment, error) {
+			return deployments, nil
+		}
+
+		_, console, _, ui, consoleErr := mock.NewVT10XConsole()
+		assert.Nil(t, consoleErr)
+		defer console.Close()
+
+		doneCh := make(chan struct{})
+		go func() {
+			defer close(doneCh)
+			console.ExpectString("Which deployment would you like to redeploy?")
+			console.Send("deployment2")
+			console.SendLine("")
+			console.ExpectEOF()
+		}()
+
+		deployment, err := resolveDeployment(context.Background(), ui, realmClient, app, "", "redeploy")
+
+		console.Tty().Close()
+		<-doneCh
+
+		assert.Nil(t, err)
+		assert.Equal(t, deployments[1], deployment)
+	})
+
+	t.Run("should return an error when there are no deployments to select", func(t *testing.T) {
+		realmClient := mock.RealmClient{}
+		realmClient.DeploymentsFn = func(groupID, appID string) ([]realm.AppDeployment, error) {
+			return nil, nil
+		}
+
+		_, err := resolveDeployment(context.Background(), nil, realmClient, app, "", "redeploy")
+		assert.Equal(t, errors.New("no deployments available to redeploy"), err)
+	})
+}
@@ -0,0 +1,77 @@
+package deployments
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/terminal"
+	"github.com/10gen/realm-cli/internal/utils/flags"
+)
+
+// CommandMetaList is the command meta for the `deployments list` command
+var CommandMetaList = cli.CommandMeta{
+	Use:         "list",
+	Aliases:     []string{"ls"},
+	Display:     "deployments list",
+	Description: "List the recent Deployments of your Realm app",
+	HelpText: `This will display the IDs, statuses, origins, and times of the most recent
+Deployments of your Realm app, newest first, along with the error message of
+any Deployment that failed.`,
+}
+
+// CommandList is the `deployments list` command
+type CommandList struct {
+	inputs listInputs
+}
+
+type listInputs struct {
+	cli.ProjectInputs
+}
+
+// Flags is the command flags
+func (cmd *CommandList) Flags() []flags.Flag {
+	return []flags.Flag{
+		cli.AppFlagWithContext(&cmd.inputs.App, "to list its deployments"),
+		cli.ProjectFlag(&cmd.inputs.Project),
+		cli.ProductFlag(&cmd.inputs.Products),
+	}
+}
+
+// Inputs is the command inputs
+func (cmd *CommandList) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandList) Handler(ctx context.Context, profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, err := cli.ResolveApp(ctx, ui, clients.Realm, cli.AppOptions{
+		AppMeta: cmd.inputs.AppMeta,
+		Filter:  cmd.inputs.Filter(),
+	})
+	if err != nil {
+		return err
+	}
+
+	deployments, err := clients.Realm.Deployments(ctx, app.GroupID, app.ID)
+	if err != nil {
+		return err
+	}
+
+	if len(deployments) == 0 {
+		ui.Print(terminal.NewTextLog("No available deployments to show"))
+		return nil
+	}
+
+	ui.Print(terminal.NewTableLog(
+		fmt.Sprintf("Found %d deployments", len(deployments)),
+		tableHeaders(),
+		tableRows(deployments)...,
+	))
+	return nil
+}
+
+func (i *listInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	return i.ProjectInputs.Resolve(ui, profile.WorkingDirectory, false)
+}
@@ -0,0 +1,89 @@
+package deployments
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestDeploymentsListHandler(t *testing.T) {
+	app := realm.App{
+		ID:          "appID",
+		GroupID:     "groupID",
+		ClientAppID: "eggcorn-abcde",
+		Name:        "eggcorn",
+	}
+
+	for _, tc := range []struct {
+		description    string
+		deployments    []realm.AppDeployment
+		expectedOutput string
+	}{
+		{
+			description:    "should list no deployments with no app deployments found",
+			expectedOutput: "No available deployments to show\n",
+		},
+		{
+			description: "should list the deployments found for the app",
+			deployments: []realm.AppDeployment{
+				{ID: "deployment2", Status: realm.DeploymentStatusFailed, StatusErrorMessage: "something bad happened", Origin: "CLI", DeployedAt: 1609545600},
+				{ID: "deployment1", Status: realm.DeploymentStatusSuccessful, Origin: "UI", DeployedAt: 1609459200},
+			},
+			expectedOutput: strings.Join(
+				[]string{
+					"Found 2 deployments",
+					"  ID           Status      Origin  Deployed At                    Error                 ",
+					"  -----------  ----------  ------  -----------------------------  ----------------------",
+					"  deployment2  failed      CLI     2021-01-02 00:00:00 +0000 UTC  something bad happened",
+					"  deployment1  successful  UI      2021-01-01 00:00:00 +0000 UTC                        ",
+					"",
+				},
+				"\n",
+			),
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			out, ui := mock.NewUI()
+
+			realmClient := mock.RealmClient{}
+			realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+				return []realm.App{app}, nil
+			}
+
+			var capturedGroupID, capturedAppID string
+			realmClient.DeploymentsFn = func(groupID, appID string) ([]realm.AppDeployment, error) {
+				capturedGroupID = groupID
+				capturedAppID = appID
+				return tc.deployments, nil
+			}
+
+			cmd := &CommandList{listInputs{cli.ProjectInputs{Project: app.GroupID, App: app.ClientAppID}}}
+
+			assert.Nil(t, cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient}))
+			assert.Equal(t, tc.expectedOutput, out.String())
+			assert.Equal(t, app.GroupID, capturedGroupID)
+			assert.Equal(t, app.ID, capturedAppID)
+		})
+	}
+
+	t.Run("should return an error when finding the deployments fails", func(t *testing.T) {
+		realmClient := mock.RealmClient{}
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{app}, nil
+		}
+		realmClient.DeploymentsFn = func(groupID, appID string) ([]realm.AppDeployment, error) {
+			return nil, errors.New("something bad happened")
+		}
+
+		cmd := &CommandList{}
+
+		err := cmd.Handler(context.Background(), nil, nil, cli.Clients{Realm: realmClient})
+		assert.Equal(t, errors.New("something bad happened"), err)
+	})
+}
@@ -0,0 +1,45 @@
+package deployments
+
+import (
+	"time"
+
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/terminal"
+)
+
+const (
+	headerID         = "ID"
+	headerStatus     = "Status"
+	headerOrigin     = "Origin"
+	headerDeployedAt = "Deployed At"
+	headerError      = "Error"
+)
+
+func tableHeaders() []string {
+	return []string{headerID, headerStatus, headerOrigin, headerDeployedAt, headerError}
+}
+
+func tableRows(deployments []realm.AppDeployment) []map[string]interface{} {
+	rows := make([]map[string]interface{}, 0, len(deployments))
+	for _, deployment := range deployments {
+		rows = append(rows, map[string]interface{}{
+			headerID:         deployment.ID,
+			headerStatus:     deployment.Status,
+			headerOrigin:     deployment.Origin,
+			headerDeployedAt: displayDeployedAt(deployment),
+			headerError:      deployment.StatusErrorMessage,
+		})
+	}
+	return rows
+}
+
+func displayDeployedAt(deployment realm.AppDeployment) string {
+	if deployment.DeployedAt == 0 {
+		return "n/a"
+	}
+	return time.Unix(deployment.DeployedAt, 0).UTC().String()
+}
+
+func displayDeploymentOption(deployment realm.AppDeployment) string {
+	return deployment.ID + terminal.DelimiterInline + string(deployment.Status) + terminal.DelimiterInline + displayDeployedAt(deployment)
+}
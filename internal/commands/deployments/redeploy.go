@@ -0,0 +1,189 @@
+package deployments
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/terminal"
+	"github.com/10gen/realm-cli/internal/utils/flags"
+)
+
+var (
+	// the time to wait for the redeployment to appear among the app deployments
+	redeploymentTimeout      = time.Minute
+	redeploymentPollInterval = time.Second
+)
+
+// CommandMetaRedeploy is the command meta for the `deployments redeploy` command
+var CommandMetaRedeploy = cli.CommandMeta{
+	Use:         "redeploy",
+	Display:     "deployments redeploy",
+	Description: "Redeploy a previous Deployment of your Realm app",
+	HelpText: `Rolls your Realm app back to the configuration of a previous Deployment by
+deploying it again, then waits for the new Deployment to complete. If you do not
+specify a Deployment with "--deployment", you will be prompted to select one of
+the recent Deployments to redeploy.`,
+}
+
+// CommandRedeploy is the `deployments redeploy` command
+type CommandRedeploy struct {
+	inputs redeployInputs
+}
+
+type redeployInputs struct {
+	cli.ProjectInputs
+	Deployment string
+}
+
+// Flags is the command flags
+func (cmd *CommandRedeploy) Flags() []flags.Flag {
+	return []flags.Flag{
+		cli.AppFlagWithContext(&cmd.inputs.App, "to redeploy its deployment"),
+		cli.ProjectFlag(&cmd.inputs.Project),
+		cli.ProductFlag(&cmd.inputs.Products),
+		deploymentFlag(&cmd.inputs.Deployment, "Specify the ID of the deployment to redeploy"),
+	}
+}
+
+// Inputs is the command inputs
+func (cmd *CommandRedeploy) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandRedeploy) Handler(ctx context.Context, profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, err := cli.ResolveApp(ctx, ui, clients.Realm, cli.AppOptions{
+		AppMeta: cmd.inputs.AppMeta,
+		Filter:  cmd.inputs.Filter(),
+	})
+	if err != nil {
+		return err
+	}
+
+	deployment, err := resolveDeployment(ctx, ui, clients.Realm, app, cmd.inputs.Deployment, "redeploy")
+	if err != nil {
+		return err
+	}
+
+	proceed, err := ui.Confirm("Are you sure you want to redeploy deployment '%s' from %s?", deployment.ID, displayDeployedAt(deployment))
+	if err != nil {
+		return err
+	}
+	if !proceed {
+		return nil
+	}
+
+	// the redeployment is told apart from the deployments before it by being newer than the latest one
+	deployments, err := clients.Realm.Deployments(ctx, app.GroupID, app.ID)
+	if err != nil {
+		return err
+	}
+	var latestID string
+	if len(deployments) != 0 {
+		latestID = deployments[0].ID
+	}
+
+	if err := clients.Realm.Redeploy(ctx, app.GroupID, app.ID, deployment.ID); err != nil {
+		return err
+	}
+
+	return waitForRedeployment(ctx, ui, clients.Realm, app, deployment, latestID)
+}
+
+func (i *redeployInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	return i.ProjectInputs.Resolve(ui, profile.WorkingDirectory, false)
+}
+
+// waitForRedeployment polls the app deployments until the redeployment appears among
+// the deployments newer than the latest one before the redeploy, then waits for it to finish
+func waitForRedeployment(ctx context.Context, ui terminal.UI, realmClient realm.Client, app realm.App, redeployed realm.AppDeployment, latestID string) error {
+	redeployment, err := findRedeployment(ctx, ui, realmClient, app, redeployed, latestID)
+	if err != nil {
+		return err
+	}
+
+	deployment, err := cli.WaitForDeployment(ctx, ui, realmClient, app.GroupID, app.ID, redeployment, "Redeploying app...")
+	if err != nil {
+		return err
+	}
+
+	if deployment.Status == realm.DeploymentStatusFailed {
+		ui.Print(terminal.NewWarningLog("Redeployment failed"))
+		return fmt.Errorf("failed to redeploy app: %s", deployment.StatusErrorMessage)
+	}
+
+	ui.Print(terminal.NewTextLog("Redeployment complete"))
+	return nil
+}
+
+func findRedeployment(ctx context.Context, ui terminal.UI, realmClient realm.Client, app realm.App, redeployed realm.AppDeployment, latestID string) (realm.AppDeployment, error) {
+	s := ui.Spinner("Starting redeployment...", terminal.SpinnerOptions{})
+
+	s.Start()
+	defer s.Stop()
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, redeploymentTimeout)
+	defer cancel()
+
+	for {
+		deployments, err := realmClient.Deployments(timeoutCtx, app.GroupID, app.ID)
+		if err != nil {
+			if ctx.Err() == nil && timeoutCtx.Err() != nil {
+				return realm.AppDeployment{}, errRedeploymentNotFound(redeployed.ID)
+			}
+			return realm.AppDeployment{}, err
+		}
+		if redeployment, ok := matchRedeployment(deployments, redeployed, latestID); ok {
+			return redeployment, nil
+		}
+
+		select {
+		case <-timeoutCtx.Done():
+			if ctx.Err() != nil {
+				return realm.AppDeployment{}, ctx.Err()
+			}
+			return realm.AppDeployment{}, errRedeploymentNotFound(redeployed.ID)
+		case <-time.After(redeploymentPollInterval):
+		}
+	}
+}
+
+// matchRedeployment finds the redeployment among the deployments newer than the latest deployment
+// before the redeploy by the draft it deploys, so that a deployment pushed at the same time is not
+// taken for it
+func matchRedeployment(deployments []realm.AppDeployment, redeployed realm.AppDeployment, latestID string) (realm.AppDeployment, bool) {
+	var newer []realm.AppDeployment
+	for _, deployment := range deployments {
+		if deployment.ID == latestID {
+			break
+		}
+		newer = append(newer, deployment)
+	}
+	if len(newer) == 0 {
+		return realm.AppDeployment{}, false
+	}
+
+	if redeployed.DraftID == "" {
+		// without a draft to tell it apart by, the redeployment is the first deployment after the latest one
+		return newer[len(newer)-1], true
+	}
+
+	for _, deployment := range newer {
+		if deployment.DraftID == redeployed.DraftID {
+			return deployment, true
+		}
+	}
+	return realm.AppDeployment{}, false
+}
+
+func errRedeploymentNotFound(deploymentID string) error {
+	return fmt.Errorf(
+		"failed to find the redeployment of deployment '%s' within %s, use \"realm-cli deployments list\" to check on it",
+		deploymentID,
+		redeploymentTimeout,
+	)
+}
@@ -0,0 +1,198 @@
+package deployments
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestDeploymentsRedeployHandler(t *testing.T) {
+	app := realm.App{ID: "appID", GroupID: "groupID", ClientAppID: "eggcorn-abcde"}
+	previous := realm.AppDeployment{ID: "previous", DraftID: "draft", Status: realm.DeploymentStatusSuccessful, DeployedAt: 1609459200}
+
+	setupClient := func(redeployment realm.AppDeployment) (*mock.RealmClient, *string) {
+		redeployment.DraftID = previous.DraftID
+
+		realmClient := &mock.RealmClient{}
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{app}, nil
+		}
+
+		var redeployed string
+		realmClient.RedeployFn = func(groupID, appID, deploymentID string) error {
+			redeployed = deploymentID
+			return nil
+		}
+		realmClient.DeploymentsFn = func(groupID, appID string) ([]realm.AppDeployment, error) {
+			if redeployed == "" {
+				return []realm.AppDeployment{previous}, nil
+			}
+			return []realm.AppDeployment{redeployment, previous}, nil
+		}
+		realmClient.DeploymentFn = func(groupID, appID, deploymentID string) (realm.AppDeployment, error) {
+			if deploymentID == previous.ID {
+				return previous, nil
+			}
+			return redeployment, nil
+		}
+		return realmClient, &redeployed
+	}
+
+	t.Run("should redeploy the deployment and wait for it to complete", func(t *testing.T) {
+		realmClient, redeployed := setupClient(realm.AppDeployment{ID: "redeployment", Status: realm.DeploymentStatusPending})
+
+		var polls int
+		realmClient.DeploymentFn = func(groupID, appID, deploymentID string) (realm.AppDeployment, error) {
+			if deploymentID == previous.ID {
+				return previous, nil
+			}
+			polls++
+			return realm.AppDeployment{ID: deploymentID, Status: realm.DeploymentStatusSuccessful}, nil
+		}
+
+		out := new(bytes.Buffer)
+		ui := mock.NewUIWithOptions(mock.UIOptions{AutoConfirm: true}, out)
+
+		cmd := &CommandRedeploy{redeployInputs{Deployment: previous.ID}}
+
+		assert.Nil(t, cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, "Redeployment complete\n", out.String())
+		assert.Equal(t, previous.ID, *redeployed)
+		assert.Equal(t, 1, polls)
+	})
+
+	t.Run("should wait for the redeployment to appear after the latest deployment", func(t *testing.T) {
+		realmClient, redeployed := setupClient(realm.AppDeployment{})
+
+		var polls int
+		realmClient.DeploymentsFn = func(groupID, appID string) ([]realm.AppDeployment, error) {
+			if *redeployed == "" {
+				return []realm.AppDeployment{previous}, nil
+			}
+			polls++
+			if polls == 1 {
+				return []realm.AppDeployment{previous}, nil
+			}
+			return []realm.AppDeployment{{ID: "redeployment", DraftID: previous.DraftID, Status: realm.DeploymentStatusSuccessful}, previous}, nil
+		}
+
+		out := new(bytes.Buffer)
+		ui := mock.NewUIWithOptions(mock.UIOptions{AutoConfirm: true}, out)
+
+		cmd := &CommandRedeploy{redeployInputs{Deployment: previous.ID}}
+
+		assert.Nil(t, cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, "Redeployment complete\n", out.String())
+		assert.Equal(t, 2, polls)
+	})
+
+	t.Run("should not take a deployment pushed at the same time for the redeployment", func(t *testing.T) {
+		realmClient, redeployed := setupClient(realm.AppDeployment{})
+
+		pushed := realm.AppDeployment{ID: "pushed", DraftID: "other", Status: realm.DeploymentStatusPending}
+		redeployment := realm.AppDeployment{ID: "redeployment", DraftID: previous.DraftID, Status: realm.DeploymentStatusSuccessful}
+
+		realmClient.DeploymentsFn = func(groupID, appID string) ([]realm.AppDeployment, error) {
+			if *redeployed == "" {
+				return []realm.AppDeployment{previous}, nil
+			}
+			return []realm.AppDeployment{pushed, redeployment, previous}, nil
+		}
+		realmClient.DeploymentFn = func(groupID, appID, deploymentID string) (realm.AppDeployment, error) {
+			if deploymentID == previous.ID {
+				return previous, nil
+			}
+			return realm.AppDeployment{}, errors.New("the pushed deployment must not be waited on")
+		}
+
+		out := new(bytes.Buffer)
+		ui := mock.NewUIWithOptions(mock.UIOptions{AutoConfirm: true}, out)
+
+		cmd := &CommandRedeploy{redeployInputs{Deployment: previous.ID}}
+
+		assert.Nil(t, cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, "Redeployment complete\n", out.String())
+	})
+
+	t.Run("should return an error when the redeployment does not appear in time", func(t *testing.T) {
+		origTimeout, origPollInterval := redeploymentTimeout, redeploymentPollInterval
+		defer func() { redeploymentTimeout, redeploymentPollInterval = origTimeout, origPollInterval }()
+		redeploymentTimeout, redeploymentPollInterval = 50*time.Millisecond, 10*time.Millisecond
+
+		realmClient, _ := setupClient(realm.AppDeployment{})
+		realmClient.DeploymentsFn = func(groupID, appID string) ([]realm.AppDeployment, error) {
+			return []realm.AppDeployment{previous}, nil
+		}
+
+		ui := mock.NewUIWithOptions(mock.UIOptions{AutoConfirm: true}, new(bytes.Buffer))
+
+		cmd := &CommandRedeploy{redeployInputs{Deployment: previous.ID}}
+
+		err := cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient})
+		assert.Equal(t, errors.New(`failed to find the redeployment of deployment 'previous' within 50ms, use "realm-cli deployments list" to check on it`), err)
+	})
+
+	t.Run("should not redeploy the deployment without confirmation", func(t *testing.T) {
+		realmClient, redeployed := setupClient(realm.AppDeployment{ID: "redeployment", Status: realm.DeploymentStatusSuccessful})
+
+		_, console, _, ui, consoleErr := mock.NewVT10XConsole()
+		assert.Nil(t, consoleErr)
+		defer console.Close()
+
+		doneCh := make(chan struct{})
+		go func() {
+			defer close(doneCh)
+			console.ExpectString("Are you sure you want to redeploy deployment 'previous' from 2021-01-01 00:00:00 +0000 UTC?")
+			console.SendLine("n")
+			console.ExpectEOF()
+		}()
+
+		cmd := &CommandRedeploy{redeployInputs{Deployment: previous.ID}}
+
+		err := cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient})
+
+		console.Tty().Close()
+		<-doneCh
+
+		assert.Nil(t, err)
+		assert.Equal(t, "", *redeployed)
+	})
+
+	t.Run("should return an error when the redeployment fails", func(t *testing.T) {
+		realmClient, _ := setupClient(realm.AppDeployment{
+			ID:                 "redeployment",
+			Status:             realm.DeploymentStatusFailed,
+			StatusErrorMessage: "something bad happened",
+		})
+
+		out := new(bytes.Buffer)
+		ui := mock.NewUIWithOptions(mock.UIOptions{AutoConfirm: true}, out)
+
+		cmd := &CommandRedeploy{redeployInputs{Deployment: previous.ID}}
+
+		err := cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient})
+		assert.Equal(t, errors.New("failed to redeploy app: something bad happened"), err)
+		assert.Equal(t, "Redeployment failed\n", out.String())
+	})
+
+	t.Run("should return an error when the redeploy request fails", func(t *testing.T) {
+		realmClient, _ := setupClient(realm.AppDeployment{})
+		realmClient.RedeployFn = func(groupID, appID, deploymentID string) error {
+			return errors.New("something bad happened")
+		}
+
+		ui := mock.NewUIWithOptions(mock.UIOptions{AutoConfirm: true}, new(bytes.Buffer))
+
+		cmd := &CommandRedeploy{redeployInputs{Deployment: previous.ID}}
+
+		err := cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient})
+		assert.Equal(t, errors.New("something bad happened"), err)
+	})
+}
@@ -0,0 +1,54 @@
+package drafts
+
+import (
+	"context"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/terminal"
+	"github.com/10gen/realm-cli/internal/utils/flags"
+)
+
+// CommandMetaCreate is the command meta for the `drafts create` command
+var CommandMetaCreate = cli.CommandMeta{
+	Use:         "create",
+	Display:     "drafts create",
+	Description: "Create a Draft for your Realm app",
+	HelpText: `Changes pushed to a Realm app with a Draft are staged in the Draft instead of
+being deployed, until the Draft is deployed or discarded. A Realm app can only
+have one Draft at a time.`,
+}
+
+// CommandCreate is the `drafts create` command
+type CommandCreate struct {
+	inputs inputs
+}
+
+// Flags is the command flags
+func (cmd *CommandCreate) Flags() []flags.Flag {
+	return cmd.inputs.flags("to create a draft for")
+}
+
+// Inputs is the command inputs
+func (cmd *CommandCreate) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandCreate) Handler(ctx context.Context, profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, err := cli.ResolveApp(ctx, ui, clients.Realm, cli.AppOptions{
+		AppMeta: cmd.inputs.AppMeta,
+		Filter:  cmd.inputs.Filter(),
+	})
+	if err != nil {
+		return err
+	}
+
+	draft, err := clients.Realm.CreateDraft(ctx, app.GroupID, app.ID)
+	if err != nil {
+		return err
+	}
+
+	ui.Print(terminal.NewTextLog("Successfully created draft: %s", draft.ID))
+	return nil
+}
@@ -0,0 +1,46 @@
+package drafts
+
+import (
+	"context"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestDraftsCreateHandler(t *testing.T) {
+	t.Run("should create a draft for the app", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		var capturedGroupID, capturedAppID string
+		realmClient := newDraftRealmClient()
+		realmClient.CreateDraftFn = func(groupID, appID string) (realm.AppDraft, error) {
+			capturedGroupID = groupID
+			capturedAppID = appID
+			return realm.AppDraft{ID: "draftID"}, nil
+		}
+
+		cmd := &CommandCreate{}
+
+		assert.Nil(t, cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, "Successfully created draft: draftID\n", out.String())
+		assert.Equal(t, testApp.GroupID, capturedGroupID)
+		assert.Equal(t, testApp.ID, capturedAppID)
+	})
+
+	t.Run("should return an error when a draft already exists", func(t *testing.T) {
+		draftErr := realm.ServerError{Code: realm.ErrCodeDraftAlreadyExists, Message: "a draft already exists"}
+
+		realmClient := newDraftRealmClient()
+		realmClient.CreateDraftFn = func(groupID, appID string) (realm.AppDraft, error) {
+			return realm.AppDraft{}, draftErr
+		}
+
+		cmd := &CommandCreate{}
+
+		err := cmd.Handler(context.Background(), nil, nil, cli.Clients{Realm: realmClient})
+		assert.Equal(t, draftErr, err)
+	})
+}
@@ -0,0 +1,77 @@
+package drafts
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/terminal"
+	"github.com/10gen/realm-cli/internal/utils/flags"
+)
+
+// CommandMetaDeploy is the command meta for the `drafts deploy` command
+var CommandMetaDeploy = cli.CommandMeta{
+	Use:         "deploy",
+	Display:     "drafts deploy",
+	Description: "Deploy the Draft of your Realm app",
+	HelpText: `Displays the changes staged in the Draft of your Realm app, then deploys them
+once confirmed and waits for the Deployment to complete.`,
+}
+
+// CommandDeploy is the `drafts deploy` command
+type CommandDeploy struct {
+	inputs inputs
+}
+
+// Flags is the command flags
+func (cmd *CommandDeploy) Flags() []flags.Flag {
+	return cmd.inputs.flags("to deploy its draft")
+}
+
+// Inputs is the command inputs
+func (cmd *CommandDeploy) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandDeploy) Handler(ctx context.Context, profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, draft, err := cmd.inputs.resolveDraft(ctx, ui, clients.Realm)
+	if err != nil {
+		return err
+	}
+
+	diff, err := clients.Realm.DiffDraft(ctx, app.GroupID, app.ID, draft.ID)
+	if err != nil {
+		return err
+	}
+
+	ui.Print(draftDiffLogs(diff)...)
+
+	proceed, err := ui.Confirm("Would you like to deploy this draft?")
+	if err != nil {
+		return err
+	}
+	if !proceed {
+		return nil
+	}
+
+	deployment, err := clients.Realm.DeployDraft(ctx, app.GroupID, app.ID, draft.ID)
+	if err != nil {
+		return err
+	}
+
+	deployment, err = cli.WaitForDeployment(ctx, ui, clients.Realm, app.GroupID, app.ID, deployment, "Deploying draft...")
+	if err != nil {
+		return err
+	}
+
+	if deployment.Status == realm.DeploymentStatusFailed {
+		ui.Print(terminal.NewWarningLog("Deployment failed"))
+		return fmt.Errorf("failed to deploy draft: %s", deployment.StatusErrorMessage)
+	}
+
+	ui.Print(terminal.NewTextLog("Successfully deployed draft: %s", draft.ID))
+	return nil
+}
@@ -0,0 +1,81 @@
+package drafts
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestDraftsDeployHandler(t *testing.T) {
+	t.Run("should deploy the draft once confirmed", func(t *testing.T) {
+		out := new(bytes.Buffer)
+		ui := mock.NewUIWithOptions(mock.UIOptions{AutoConfirm: true}, out)
+
+		var capturedDraftID string
+		realmClient := newDraftRealmClient()
+		realmClient.DeployDraftFn = func(groupID, appID, draftID string) (realm.AppDeployment, error) {
+			capturedDraftID = draftID
+			return realm.AppDeployment{ID: "deploymentID", Status: realm.DeploymentStatusSuccessful}, nil
+		}
+
+		cmd := &CommandDeploy{}
+
+		assert.Nil(t, cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, `The following changes are staged in the draft for your app...
+  added: values
+With changes to your static hosting files...
+  added: /index.html
+Successfully deployed draft: draftID
+`, out.String())
+		assert.Equal(t, "draftID", capturedDraftID)
+	})
+
+	t.Run("should not deploy the draft without confirmation", func(t *testing.T) {
+		_, console, _, ui, consoleErr := mock.NewVT10XConsole()
+		assert.Nil(t, consoleErr)
+		defer console.Close()
+
+		realmClient := newDraftRealmClient()
+		realmClient.DeployDraftFn = func(groupID, appID, draftID string) (realm.AppDeployment, error) {
+			return realm.AppDeployment{}, errors.New("draft should not be deployed")
+		}
+
+		doneCh := make(chan struct{})
+		go func() {
+			defer close(doneCh)
+			console.ExpectString("Would you like to deploy this draft?")
+			console.SendLine("n")
+			console.ExpectEOF()
+		}()
+
+		cmd := &CommandDeploy{}
+
+		err := cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient})
+
+		console.Tty().Close()
+		<-doneCh
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("should return an error when the deployment fails", func(t *testing.T) {
+		out := new(bytes.Buffer)
+		ui := mock.NewUIWithOptions(mock.UIOptions{AutoConfirm: true}, out)
+
+		realmClient := newDraftRealmClient()
+		realmClient.DeployDraftFn = func(groupID, appID, draftID string) (realm.AppDeployment, error) {
+			return realm.AppDeployment{ID: "deploymentID", Status: realm.DeploymentStatusFailed, StatusErrorMessage: "something bad happened"}, nil
+		}
+
+		cmd := &CommandDeploy{}
+
+		err := cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient})
+		assert.Equal(t, errors.New("failed to deploy draft: something bad happened"), err)
+	})
+}
@@ -0,0 +1,51 @@
+package drafts
+
+import (
+	"context"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/terminal"
+	"github.com/10gen/realm-cli/internal/utils/flags"
+)
+
+// CommandMetaDiff is the command meta for the `drafts diff` command
+var CommandMetaDiff = cli.CommandMeta{
+	Use:         "diff",
+	Display:     "drafts diff",
+	Description: "Show the changes staged in the Draft of your Realm app",
+	HelpText: `Displays the changes staged in the Draft of your Realm app compared to what is
+currently deployed, including changes to hosting files, dependencies, GraphQL
+configuration, and schemas.`,
+}
+
+// CommandDiff is the `drafts diff` command
+type CommandDiff struct {
+	inputs inputs
+}
+
+// Flags is the command flags
+func (cmd *CommandDiff) Flags() []flags.Flag {
+	return cmd.inputs.flags("to diff its draft")
+}
+
+// Inputs is the command inputs
+func (cmd *CommandDiff) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandDiff) Handler(ctx context.Context, profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, draft, err := cmd.inputs.resolveDraft(ctx, ui, clients.Realm)
+	if err != nil {
+		return err
+	}
+
+	diff, err := clients.Realm.DiffDraft(ctx, app.GroupID, app.ID, draft.ID)
+	if err != nil {
+		return err
+	}
+
+	ui.Print(draftDiffLogs(diff)...)
+	return nil
+}
@@ -0,0 +1,53 @@
+package drafts
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestDraftsDiffHandler(t *testing.T) {
+	t.Run("should display the changes staged in the draft", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		cmd := &CommandDiff{}
+
+		assert.Nil(t, cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: newDraftRealmClient()}))
+		assert.Equal(t, `The following changes are staged in the draft for your app...
+  added: values
+With changes to your static hosting files...
+  added: /index.html
+`, out.String())
+	})
+
+	t.Run("should display when the draft has no changes", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		realmClient := newDraftRealmClient()
+		realmClient.DiffDraftFn = func(groupID, appID, draftID string) (realm.AppDraftDiff, error) {
+			return realm.AppDraftDiff{}, nil
+		}
+
+		cmd := &CommandDiff{}
+
+		assert.Nil(t, cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, "The draft for your app has no changes\n", out.String())
+	})
+
+	t.Run("should return an error when diffing the draft fails", func(t *testing.T) {
+		realmClient := newDraftRealmClient()
+		realmClient.DiffDraftFn = func(groupID, appID, draftID string) (realm.AppDraftDiff, error) {
+			return realm.AppDraftDiff{}, errors.New("something bad happened")
+		}
+
+		cmd := &CommandDiff{}
+
+		err := cmd.Handler(context.Background(), nil, nil, cli.Clients{Realm: realmClient})
+		assert.Equal(t, errors.New("something bad happened"), err)
+	})
+}
@@ -0,0 +1,65 @@
+package drafts
+
+import (
+	"context"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/terminal"
+	"github.com/10gen/realm-cli/internal/utils/flags"
+)
+
+// CommandMetaDiscard is the command meta for the `drafts discard` command
+var CommandMetaDiscard = cli.CommandMeta{
+	Use:         "discard",
+	Aliases:     []string{"delete"},
+	Display:     "drafts discard",
+	Description: "Discard the Draft of your Realm app",
+	HelpText: `Displays the changes staged in the Draft of your Realm app, then discards them
+once confirmed. The deployed Realm app is left unchanged.`,
+}
+
+// CommandDiscard is the `drafts discard` command
+type CommandDiscard struct {
+	inputs inputs
+}
+
+// Flags is the command flags
+func (cmd *CommandDiscard) Flags() []flags.Flag {
+	return cmd.inputs.flags("to discard its draft")
+}
+
+// Inputs is the command inputs
+func (cmd *CommandDiscard) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandDiscard) Handler(ctx context.Context, profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, draft, err := cmd.inputs.resolveDraft(ctx, ui, clients.Realm)
+	if err != nil {
+		return err
+	}
+
+	diff, err := clients.Realm.DiffDraft(ctx, app.GroupID, app.ID, draft.ID)
+	if err != nil {
+		return err
+	}
+
+	ui.Print(draftDiffLogs(diff)...)
+
+	proceed, err := ui.Confirm("Would you like to discard this draft?")
+	if err != nil {
+		return err
+	}
+	if !proceed {
+		return nil
+	}
+
+	if err := clients.Realm.DiscardDraft(ctx, app.GroupID, app.ID, draft.ID); err != nil {
+		return err
+	}
+
+	ui.Print(terminal.NewTextLog("Successfully discarded draft: %s", draft.ID))
+	return nil
+}
@@ -0,0 +1,51 @@
+package drafts
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestDraftsDiscardHandler(t *testing.T) {
+	t.Run("should discard the draft once confirmed", func(t *testing.T) {
+		out := new(bytes.Buffer)
+		ui := mock.NewUIWithOptions(mock.UIOptions{AutoConfirm: true}, out)
+
+		var capturedDraftID string
+		realmClient := newDraftRealmClient()
+		realmClient.DiscardDraftFn = func(groupID, appID, draftID string) error {
+			capturedDraftID = draftID
+			return nil
+		}
+
+		cmd := &CommandDiscard{}
+
+		assert.Nil(t, cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, `The following changes are staged in the draft for your app...
+  added: values
+With changes to your static hosting files...
+  added: /index.html
+Successfully discarded draft: draftID
+`, out.String())
+		assert.Equal(t, "draftID", capturedDraftID)
+	})
+
+	t.Run("should return an error when discarding the draft fails", func(t *testing.T) {
+		realmClient := newDraftRealmClient()
+		realmClient.DiscardDraftFn = func(groupID, appID, draftID string) error {
+			return errors.New("something bad happened")
+		}
+
+		ui := mock.NewUIWithOptions(mock.UIOptions{AutoConfirm: true}, new(bytes.Buffer))
+
+		cmd := &CommandDiscard{}
+
+		err := cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient})
+		assert.Equal(t, errors.New("something bad happened"), err)
+	})
+}
@@ -0,0 +1,54 @@
+package drafts
+
+import (
+	"context"
+	"errors"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/terminal"
+	"github.com/10gen/realm-cli/internal/utils/flags"
+)
+
+var (
+	errDraftNotFound = errors.New("no draft exists for your app, stage one with 'push --no-deploy' or 'drafts create'")
+)
+
+// inputs are the inputs shared by the drafts commands,
+// as every Realm app has at most one draft
+type inputs struct {
+	cli.ProjectInputs
+}
+
+func (i *inputs) flags(context string) []flags.Flag {
+	return []flags.Flag{
+		cli.AppFlagWithContext(&i.App, context),
+		cli.ProjectFlag(&i.Project),
+		cli.ProductFlag(&i.Products),
+	}
+}
+
+func (i *inputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	return i.ProjectInputs.Resolve(ui, profile.WorkingDirectory, false)
+}
+
+// resolveDraft finds the app and its current draft
+func (i inputs) resolveDraft(ctx context.Context, ui terminal.UI, client realm.Client) (realm.App, realm.AppDraft, error) {
+	app, err := cli.ResolveApp(ctx, ui, client, cli.AppOptions{
+		AppMeta: i.AppMeta,
+		Filter:  i.Filter(),
+	})
+	if err != nil {
+		return realm.App{}, realm.AppDraft{}, err
+	}
+
+	draft, err := client.Draft(ctx, app.GroupID, app.ID)
+	if err != nil {
+		if err == realm.ErrDraftNotFound {
+			return realm.App{}, realm.AppDraft{}, errDraftNotFound
+		}
+		return realm.App{}, realm.AppDraft{}, err
+	}
+	return app, draft, nil
+}
@@ -0,0 +1,67 @@
+package drafts
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+var testApp = realm.App{ID: "appID", GroupID: "groupID", ClientAppID: "eggcorn-abcde", Name: "eggcorn"}
+
+func newDraftRealmClient() mock.RealmClient {
+	realmClient := mock.RealmClient{}
+	realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+		return []realm.App{testApp}, nil
+	}
+	realmClient.DraftFn = func(groupID, appID string) (realm.AppDraft, error) {
+		return realm.AppDraft{ID: "draftID"}, nil
+	}
+	realmClient.DiffDraftFn = func(groupID, appID, draftID string) (realm.AppDraftDiff, error) {
+		return realm.AppDraftDiff{
+			Diffs:            []string{"added: values"},
+			HostingFilesDiff: realm.HostingFilesDiff{Added: []string{"/index.html"}},
+		}, nil
+	}
+	return realmClient
+}
+
+func TestDraftsInputsResolveDraft(t *testing.T) {
+	t.Run("should find the app and its draft", func(t *testing.T) {
+		app, draft, err := inputs{cli.ProjectInputs{App: testApp.ClientAppID}}.resolveDraft(context.Background(), nil, newDraftRealmClient())
+		assert.Nil(t, err)
+		assert.Equal(t, testApp, app)
+		assert.Equal(t, realm.AppDraft{ID: "draftID"}, draft)
+	})
+
+	for _, tc := range []struct {
+		description string
+		draftErr    error
+		expectedErr error
+	}{
+		{
+			description: "should return a helpful error when the app has no draft",
+			draftErr:    realm.ErrDraftNotFound,
+			expectedErr: errDraftNotFound,
+		},
+		{
+			description: "should return the error when finding the draft fails",
+			draftErr:    errors.New("something bad happened"),
+			expectedErr: errors.New("something bad happened"),
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			realmClient := newDraftRealmClient()
+			realmClient.DraftFn = func(groupID, appID string) (realm.AppDraft, error) {
+				return realm.AppDraft{}, tc.draftErr
+			}
+
+			_, _, err := inputs{}.resolveDraft(context.Background(), nil, realmClient)
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package drafts
+
+import (
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/terminal"
+)
+
+func draftDiffLogs(diff realm.AppDraftDiff) []terminal.Log {
+	if !diff.HasChanges() {
+		return []terminal.Log{terminal.NewTextLog("The draft for your app has no changes")}
+	}
+
+	logs := []terminal.Log{terminal.NewListLog("The following changes are staged in the draft for your app...", diff.DiffList()...)}
+	if diff.HostingFilesDiff.HasChanges() {
+		logs = append(logs, terminal.NewListLog("With changes to your static hosting files...", diff.HostingFilesDiff.DiffList()...))
+	}
+	if diff.DependenciesDiff.HasChanges() {
+		logs = append(logs, terminal.NewListLog("With changes to your app dependencies...", diff.DependenciesDiff.DiffList()...))
+	}
+	if diff.GraphQLConfigDiff.HasChanges() {
+		logs = append(logs, terminal.NewListLog("With changes to your GraphQL configuration...", diff.GraphQLConfigDiff.DiffList()...))
+	}
+	if diff.SchemaOptionsDiff.HasChanges() {
+		logs = append(logs, terminal.NewListLog("With changes to your app schema...", diff.SchemaOptionsDiff.DiffList()...))
+	}
+	return logs
+}
@@ -0,0 +1,59 @@
+package drafts
+
+import (
+	"context"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/terminal"
+	"github.com/10gen/realm-cli/internal/utils/flags"
+)
+
+// CommandMetaShow is the command meta for the `drafts show` command
+var CommandMetaShow = cli.CommandMeta{
+	Use:         "show",
+	Display:     "drafts show",
+	Description: "Displays the Draft of your Realm app",
+	HelpText: `View the Draft of your Realm app, if one exists, along with a summary of the
+number of changes staged in it.`,
+}
+
+// CommandShow is the `drafts show` command
+type CommandShow struct {
+	inputs inputs
+}
+
+// Flags is the command flags
+func (cmd *CommandShow) Flags() []flags.Flag {
+	return cmd.inputs.flags("to show its draft")
+}
+
+// Inputs is the command inputs
+func (cmd *CommandShow) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandShow) Handler(ctx context.Context, profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, draft, err := cmd.inputs.resolveDraft(ctx, ui, clients.Realm)
+	if err != nil {
+		if err == errDraftNotFound {
+			ui.Print(terminal.NewTextLog("No draft exists for your app"))
+			return nil
+		}
+		return err
+	}
+
+	diff, err := clients.Realm.DiffDraft(ctx, app.GroupID, app.ID, draft.ID)
+	if err != nil {
+		return err
+	}
+
+	ui.Print(terminal.NewJSONLog("Draft", draftOutput{draft.ID, diff.Len()}))
+	return nil
+}
+
+type draftOutput struct {
+	ID      string `json:"_id"`
+	Changes int    `json:"changes"`
+}
@@ -0,0 +1,41 @@
+package drafts
+
+import (
+	"context"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestDraftsShowHandler(t *testing.T) {
+	t.Run("should show the draft with its number of changes", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		cmd := &CommandShow{}
+
+		assert.Nil(t, cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: newDraftRealmClient()}))
+		assert.Equal(t, `Draft
+{
+  "_id": "draftID",
+  "changes": 2
+}
+`, out.String())
+	})
+
+	t.Run("should show when the app has no draft", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		realmClient := newDraftRealmClient()
+		realmClient.DraftFn = func(groupID, appID string) (realm.AppDraft, error) {
+			return realm.AppDraft{}, realm.ErrDraftNotFound
+		}
+
+		cmd := &CommandShow{}
+
+		assert.Nil(t, cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, "No draft exists for your app\n", out.String())
+	})
+}
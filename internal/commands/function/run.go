@@ -1,6 +1,7 @@
 package function
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"strconv"
@@ -73,8 +74,8 @@ func (cmd *CommandRun) Inputs() cli.InputResolver {
 }
 
 // Handler is the command handler
-func (cmd *CommandRun) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
-	app, err := cli.ResolveApp(ui, clients.Realm, cli.AppOptions{
+func (cmd *CommandRun) Handler(ctx context.Context, profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, err := cli.ResolveApp(ctx, ui, clients.Realm, cli.AppOptions{
 		AppMeta: cmd.inputs.AppMeta,
 		Filter:  cmd.inputs.Filter(),
 	})
@@ -82,7 +83,7 @@ func (cmd *CommandRun) Handler(profile *user.Profile, ui terminal.UI, clients cl
 		return err
 	}
 
-	function, err := cmd.inputs.resolveFunction(ui, clients.Realm, app.GroupID, app.ID)
+	function, err := cmd.inputs.resolveFunction(ctx, ui, clients.Realm, app.GroupID, app.ID)
 	if err != nil {
 		return err
 	}
@@ -124,7 +125,7 @@ func (cmd *CommandRun) Handler(profile *user.Profile, ui terminal.UI, clients cl
 		s.Start()
 		defer s.Stop()
 
-		return clients.Realm.AppDebugExecuteFunction(app.GroupID, app.ID, cmd.inputs.User, function.Name, args)
+		return clients.Realm.AppDebugExecuteFunction(ctx, app.GroupID, app.ID, cmd.inputs.User, function.Name, args)
 	}
 
 	response, err := runFunction()
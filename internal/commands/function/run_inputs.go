@@ -1,6 +1,7 @@
 package function
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
@@ -23,8 +24,8 @@ func (i *runInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
 	return i.ProjectInputs.Resolve(ui, profile.WorkingDirectory, true)
 }
 
-func (i *runInputs) resolveFunction(ui terminal.UI, client realm.Client, groupID, appID string) (realm.Function, error) {
-	functions, err := client.Functions(groupID, appID)
+func (i *runInputs) resolveFunction(ctx context.Context, ui terminal.UI, client realm.Client, groupID, appID string) (realm.Function, error) {
+	functions, err := client.Functions(ctx, groupID, appID)
 	if err != nil {
 		return realm.Function{}, err
 	}
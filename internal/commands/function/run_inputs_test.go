@@ -1,6 +1,7 @@
 package function
 
 import (
+	"context"
 	"errors"
 	"testing"
 
@@ -23,7 +24,7 @@ func TestFunctionRunInputsResolveFunction(t *testing.T) {
 
 		var i runInputs
 
-		_, err := i.resolveFunction(nil, realmClient, "groupID", "appID")
+		_, err := i.resolveFunction(context.Background(), nil, realmClient, "groupID", "appID")
 		assert.Equal(t, errors.New("something bad happened"), err)
 
 		t.Log("and receive the expected args to the client functions call")
@@ -40,7 +41,7 @@ func TestFunctionRunInputsResolveFunction(t *testing.T) {
 
 		var i runInputs
 
-		_, err := i.resolveFunction(nil, realmClient, "groupID", "appID")
+		_, err := i.resolveFunction(context.Background(), nil, realmClient, "groupID", "appID")
 		assert.Equal(t, errors.New("no functions available to run"), err)
 	})
 
@@ -53,7 +54,7 @@ func TestFunctionRunInputsResolveFunction(t *testing.T) {
 
 		i := runInputs{Name: "uptownFunc"}
 
-		_, err := i.resolveFunction(nil, realmClient, "groupID", "appID")
+		_, err := i.resolveFunction(context.Background(), nil, realmClient, "groupID", "appID")
 		assert.Equal(t, errors.New("failed to find function 'uptownFunc'"), err)
 	})
 
@@ -81,7 +82,7 @@ func TestFunctionRunInputsResolveFunction(t *testing.T) {
 				return tc.appFunctions, nil
 			}
 
-			fn, err := tc.inputs.resolveFunction(nil, realmClient, "groupID", "appID")
+			fn, err := tc.inputs.resolveFunction(context.Background(), nil, realmClient, "groupID", "appID")
 			assert.Nil(t, err)
 			assert.Equal(t, tc.expectedFn, fn)
 		})
@@ -109,7 +110,7 @@ func TestFunctionRunInputsResolveFunction(t *testing.T) {
 
 		var i runInputs
 
-		fn, err := i.resolveFunction(ui, realmClient, "groupID", "appID")
+		fn, err := i.resolveFunction(context.Background(), ui, realmClient, "groupID", "appID")
 		assert.Nil(t, err)
 
 		console.Tty().Close() // flush the writers
@@ -2,6 +2,7 @@ package function
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"testing"
 
@@ -52,7 +53,7 @@ func TestFunctionHandler(t *testing.T) {
 			Name: "test",
 			Args: []string{"[\"hello\",1,2.1,{\"foo\": \"bar\"}]"},
 		}}
-		assert.Nil(t, cmd.Handler(profile, ui, clients))
+		assert.Nil(t, cmd.Handler(context.Background(), profile, ui, clients))
 
 		display := `Result
 {
@@ -113,7 +114,7 @@ func TestFunctionHandler(t *testing.T) {
 			Name: "test",
 			Args: []string{"{\"value1\": 1,\"abcs\": [\"x\", \"y\", \"z\"]}", "[1,2]"},
 		}}
-		assert.Nil(t, cmd.Handler(profile, ui, clients))
+		assert.Nil(t, cmd.Handler(context.Background(), profile, ui, clients))
 
 		display := `Result
 {
@@ -197,7 +198,7 @@ func TestFunctionHandler(t *testing.T) {
 				Name: "test",
 				Args: []string{"Hello world"},
 			}}
-			assert.Equal(t, tc.errorExpected, cmd.Handler(profile, ui, clients))
+			assert.Equal(t, tc.errorExpected, cmd.Handler(context.Background(), profile, ui, clients))
 		})
 	}
 }
@@ -1,6 +1,8 @@
 package login
 
 import (
+	"context"
+
 	"github.com/10gen/realm-cli/internal/cli"
 	"github.com/10gen/realm-cli/internal/cli/user"
 	"github.com/10gen/realm-cli/internal/terminal"
@@ -95,7 +97,7 @@ func (cmd *Command) Inputs() cli.InputResolver {
 }
 
 // Handler is the command handler
-func (cmd *Command) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+func (cmd *Command) Handler(ctx context.Context, profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
 	proceed, err := cmd.checkExistingUser(profile, ui)
 	if err != nil {
 		return err
@@ -112,7 +114,7 @@ func (cmd *Command) Handler(profile *user.Profile, ui terminal.UI, clients cli.C
 	}
 	profile.SetCredentials(creds)
 
-	session, err := clients.Realm.Authenticate(realmAuthType(cmd.inputs.AuthType), creds)
+	session, err := clients.Realm.Authenticate(ctx, realmAuthType(cmd.inputs.AuthType), creds)
 	if err != nil {
 		return err
 	}
@@ -127,7 +129,10 @@ func (cmd *Command) Handler(profile *user.Profile, ui terminal.UI, clients cli.C
 }
 
 func (cmd *Command) checkExistingUser(profile *user.Profile, ui terminal.UI) (bool, error) {
-	u := profile.Credentials()
+	u, err := profile.Credentials()
+	if err != nil {
+		return false, err
+	}
 
 	var existingCredentialsName, existingCredentialsSecret string
 
@@ -2,6 +2,7 @@ package login
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"io/ioutil"
 	"os"
@@ -47,13 +48,17 @@ func TestLoginHandler(t *testing.T) {
 
 		_, ui := mock.NewUI()
 
-		assert.Nil(t, cmd.Handler(profile, ui, cli.Clients{Realm: realmClient}))
+		assert.Nil(t, cmd.Handler(context.Background(), profile, ui, cli.Clients{Realm: realmClient}))
 
 		expectedUser := user.Credentials{PublicAPIKey: "publicAPIKey", PrivateAPIKey: "privateAPIKey"}
 		expectedSession := user.Session{"accessToken", "refreshToken"}
 
-		assert.Equal(t, expectedUser, profile.Credentials())
-		assert.Equal(t, expectedSession, profile.Session())
+		credentials, credentialsErr := profile.Credentials()
+		assert.Nil(t, credentialsErr)
+		assert.Equal(t, expectedUser, credentials)
+		session, sessionErr := profile.Session()
+		assert.Nil(t, sessionErr)
+		assert.Equal(t, expectedSession, session)
 
 		ensureProfileContents(t, profile, expectedUser, expectedSession)
 	})
@@ -88,13 +93,17 @@ func TestLoginHandler(t *testing.T) {
 
 		_, ui := mock.NewUI()
 
-		assert.Nil(t, cmd.Handler(profile, ui, cli.Clients{Realm: realmClient}))
+		assert.Nil(t, cmd.Handler(context.Background(), profile, ui, cli.Clients{Realm: realmClient}))
 
 		expectedUser := user.Credentials{Username: "username", Password: "password"}
 		expectedSession := user.Session{"accessToken", "refreshToken"}
 
-		assert.Equal(t, expectedUser, profile.Credentials())
-		assert.Equal(t, expectedSession, profile.Session())
+		credentials, credentialsErr := profile.Credentials()
+		assert.Nil(t, credentialsErr)
+		assert.Equal(t, expectedUser, credentials)
+		session, sessionErr := profile.Session()
+		assert.Nil(t, sessionErr)
+		assert.Equal(t, expectedSession, session)
 
 		ensureProfileContents(t, profile, expectedUser, expectedSession)
 	})
@@ -138,13 +147,17 @@ func TestLoginHandler(t *testing.T) {
 
 			_, ui := mock.NewUI()
 
-			assert.Nil(t, cmd.Handler(profile, ui, cli.Clients{Realm: realmClient}))
+			assert.Nil(t, cmd.Handler(context.Background(), profile, ui, cli.Clients{Realm: realmClient}))
 
 			expectedUser := user.Credentials{PublicAPIKey: "existingUser", PrivateAPIKey: "existing-password"}
 			expectedSession := user.Session{"newAccessToken", "newRefreshToken"}
 
-			assert.Equal(t, expectedUser, profile.Credentials())
-			assert.Equal(t, expectedSession, profile.Session())
+			credentials, credentialsErr := profile.Credentials()
+			assert.Nil(t, credentialsErr)
+			assert.Equal(t, expectedUser, credentials)
+			session, sessionErr := profile.Session()
+			assert.Nil(t, sessionErr)
+			assert.Equal(t, expectedSession, session)
 
 			ensureProfileContents(t, profile, expectedUser, expectedSession)
 		})
@@ -192,14 +205,18 @@ func TestLoginHandler(t *testing.T) {
 						console.ExpectEOF()
 					}()
 
-					err := cmd.Handler(profile, ui, cli.Clients{Realm: realmClient})
+					err := cmd.Handler(context.Background(), profile, ui, cli.Clients{Realm: realmClient})
 					assert.Nil(t, err)
 
 					assert.Nil(t, console.Tty().Close())
 					<-doneCh
 
-					assert.Equal(t, tc.expectedUser, profile.Credentials())
-					assert.Equal(t, tc.expectedSession, profile.Session())
+					credentials, credentialsErr := profile.Credentials()
+					assert.Nil(t, credentialsErr)
+					assert.Equal(t, tc.expectedUser, credentials)
+					session, sessionErr := profile.Session()
+					assert.Nil(t, sessionErr)
+					assert.Equal(t, tc.expectedSession, session)
 					ensureProfileContents(t, profile, tc.expectedUser, tc.expectedSession)
 				})
 			}
@@ -245,13 +262,17 @@ func TestLoginHandler(t *testing.T) {
 
 			_, ui := mock.NewUI()
 
-			assert.Nil(t, cmd.Handler(profile, ui, cli.Clients{Realm: realmClient}))
+			assert.Nil(t, cmd.Handler(context.Background(), profile, ui, cli.Clients{Realm: realmClient}))
 
 			expectedUser := user.Credentials{Username: "existingUser", Password: "existing-password"}
 			expectedSession := user.Session{"newAccessToken", "newRefreshToken"}
 
-			assert.Equal(t, expectedUser, profile.Credentials())
-			assert.Equal(t, expectedSession, profile.Session())
+			credentials, credentialsErr := profile.Credentials()
+			assert.Nil(t, credentialsErr)
+			assert.Equal(t, expectedUser, credentials)
+			session, sessionErr := profile.Session()
+			assert.Nil(t, sessionErr)
+			assert.Equal(t, expectedSession, session)
 
 			ensureProfileContents(t, profile, expectedUser, expectedSession)
 		})
@@ -299,14 +320,18 @@ func TestLoginHandler(t *testing.T) {
 						console.ExpectEOF()
 					}()
 
-					err := cmd.Handler(profile, ui, cli.Clients{Realm: realmClient})
+					err := cmd.Handler(context.Background(), profile, ui, cli.Clients{Realm: realmClient})
 					assert.Nil(t, err)
 
 					assert.Nil(t, console.Tty().Close())
 					<-doneCh
 
-					assert.Equal(t, tc.expectedUser, profile.Credentials())
-					assert.Equal(t, tc.expectedSession, profile.Session())
+					credentials, credentialsErr := profile.Credentials()
+					assert.Nil(t, credentialsErr)
+					assert.Equal(t, tc.expectedUser, credentials)
+					session, sessionErr := profile.Session()
+					assert.Nil(t, sessionErr)
+					assert.Equal(t, tc.expectedSession, session)
 					ensureProfileContents(t, profile, tc.expectedUser, tc.expectedSession)
 				})
 			}
@@ -324,7 +349,7 @@ func TestLoginFeedback(t *testing.T) {
 
 		cmd := &Command{inputs: inputs{AuthType: authTypeCloud}}
 
-		err := cmd.Handler(tc.profile, ui, cli.Clients{Realm: tc.realmClient})
+		err := cmd.Handler(context.Background(), tc.profile, ui, cli.Clients{Realm: tc.realmClient})
 		assert.Nil(t, err)
 
 		assert.Equal(t, "Successfully logged in\n", out.String())
@@ -340,27 +365,27 @@ func ensureProfileContents(t *testing.T, profile *user.Profile, user user.Creden
 	if publicAPIKey == "" {
 		publicAPIKey = `""`
 	}
-	privateAPIKey := user.PrivateAPIKey
-	if privateAPIKey == "" {
-		privateAPIKey = `""`
-	}
 	username := user.Username
 	if username == "" {
 		username = `""`
 	}
-	password := user.Password
-	if password == "" {
-		password = `""`
-	}
 
+	// secrets are held by the credential store rather than the profile
 	assert.True(t, strings.Contains(string(contents), fmt.Sprintf(`%s:
-  access_token: %s
-  password: %s
-  private_api_key: %s
+  access_token: ""
+  password: ""
+  private_api_key: ""
   public_api_key: %s
-  refresh_token: %s
+  refresh_token: ""
   username: %s
-`, profile.Name, session.AccessToken, password, privateAPIKey, publicAPIKey, session.RefreshToken, username)), "profile must contain the expected contents")
+`, profile.Name, publicAPIKey, username)), "profile must contain the expected contents")
+
+	credentials, credentialsErr := profile.Credentials()
+	assert.Nil(t, credentialsErr)
+	assert.Equal(t, user, credentials)
+	session, sessionErr := profile.Session()
+	assert.Nil(t, sessionErr)
+	assert.Equal(t, session, session)
 }
 
 type testContext struct {
@@ -34,7 +34,11 @@ type inputs struct {
 }
 
 func (i *inputs) Resolve(profile *user.Profile, ui terminal.UI) error {
-	u := profile.Credentials()
+	u, err := profile.Credentials()
+	if err != nil {
+		return err
+	}
+
 	credentialsProvided := (i.Username != "" && i.Password != "") || (i.PublicAPIKey != "" && i.PrivateAPIKey != "")
 
 	if i.Browser && credentialsProvided {
@@ -1,6 +1,8 @@
 package logout
 
 import (
+	"context"
+
 	"github.com/10gen/realm-cli/internal/cli"
 	"github.com/10gen/realm-cli/internal/cli/user"
 	"github.com/10gen/realm-cli/internal/terminal"
@@ -18,7 +20,7 @@ re-authenticate, you must call Login with your Atlas programmatic API Key.`,
 type Command struct{}
 
 // Handler is the command handler
-func (cmd *Command) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+func (cmd *Command) Handler(ctx context.Context, profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
 	profile.ClearCredentials()
 	profile.ClearSession()
 
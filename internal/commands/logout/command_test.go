@@ -1,6 +1,7 @@
 package logout
 
 import (
+	"context"
 	"fmt"
 	"io/ioutil"
 	"strings"
@@ -37,19 +38,21 @@ func TestLogoutHandler(t *testing.T) {
 		profile.SetSession(existingSess)
 		assert.Nil(t, profile.Save())
 
-		creds := profile.Credentials()
-		session := profile.Session()
+		creds, err := profile.Credentials()
+		assert.Nil(t, err)
+		session, err := profile.Session()
+		assert.Nil(t, err)
 		assert.Equal(t, existingCreds, creds)
 		assert.Equal(t, existingSess, session)
 
 		out, err := ioutil.ReadFile(profile.Path())
 		assert.Nil(t, err)
 		assert.True(t, strings.Contains(string(out), fmt.Sprintf(`%s:
-  access_token: accessToken
-  password: password
-  private_api_key: privateAPIKey
+  access_token: ""
+  password: ""
+  private_api_key: ""
   public_api_key: publicAPIKey
-  refresh_token: refreshToken
+  refresh_token: ""
   username: username
 `, profile.Name)), "profile must contain the expected contents")
 
@@ -57,10 +60,15 @@ func TestLogoutHandler(t *testing.T) {
 
 		cmd := &Command{}
 
-		assert.Nil(t, cmd.Handler(profile, ui, cli.Clients{}))
+		assert.Nil(t, cmd.Handler(context.Background(), profile, ui, cli.Clients{}))
+
+		creds, err = profile.Credentials()
+		assert.Nil(t, err)
+		assert.Equal(t, user.Credentials{}, creds)
 
-		assert.Equal(t, user.Credentials{}, profile.Credentials())
-		assert.Equal(t, user.Session{}, profile.Session())
+		session, err = profile.Session()
+		assert.Nil(t, err)
+		assert.Equal(t, user.Session{}, session)
 
 		out, err = ioutil.ReadFile(profile.Path())
 		assert.Nil(t, err)
@@ -83,7 +91,7 @@ func TestLogoutFeedback(t *testing.T) {
 
 		cmd := &Command{}
 
-		assert.Nil(t, cmd.Handler(profile, ui, cli.Clients{}))
+		assert.Nil(t, cmd.Handler(context.Background(), profile, ui, cli.Clients{}))
 
 		assert.Equal(t, "Successfully logged out\n", out.String())
 	})
@@ -0,0 +1,445 @@
+package logs
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/terminal"
+	"github.com/10gen/realm-cli/internal/utils/flags"
+)
+
+// CommandMetaExport is the command meta for the `logs export` command
+var CommandMetaExport = cli.CommandMeta{
+	Use:         "export",
+	Aliases:     []string{},
+	Display:     "logs export",
+	Description: "Export the Logs of your Realm app to a file",
+	HelpText: `Writes every Log of your Realm app within a date range to a file, following
+each page of Logs until the date range is exhausted. Logs are written from most
+to least recent, either as one JSON document per line or as CSV.
+
+If the file already exists, you will be asked to confirm overwriting it. If the
+export is interrupted, run the command again with "--resume" to continue from
+the last Log written to the file instead.`,
+}
+
+// CommandExport is the `logs export` command
+type CommandExport struct {
+	inputs exportInputs
+}
+
+// Flags is the command flags
+func (cmd *CommandExport) Flags() []flags.Flag {
+	return []flags.Flag{
+		cli.AppFlagWithContext(&cmd.inputs.App, "to export its logs"),
+		cli.ProjectFlag(&cmd.inputs.Project),
+		cli.ProductFlag(&cmd.inputs.Products),
+		flags.NewStringSetFlag(
+			&cmd.inputs.Types,
+			flags.StringSetOptions{
+				Meta: flags.Meta{
+					Name: "type",
+					Usage: flags.Usage{
+						Description: "Specify the type(s) of logs to export",
+					},
+				},
+				ValidValues: logTypeValues,
+			},
+		),
+		flags.BoolFlag{
+			Value: &cmd.inputs.Errors,
+			Meta: flags.Meta{
+				Name: "errors",
+				Usage: flags.Usage{
+					Description: "Export your Realm app's error logs",
+				},
+			},
+		},
+		flags.CustomFlag{
+			Value: &cmd.inputs.Start,
+			Meta: flags.Meta{
+				Name: flagStart,
+				Usage: flags.Usage{
+					Description:   "Specify when to begin exporting logs",
+					Note:          flags.DateNote,
+					AllowedFormat: flags.DateAllowedFormat,
+				},
+			},
+		},
+		flags.CustomFlag{
+			Value: &cmd.inputs.End,
+			Meta: flags.Meta{
+				Name: flagEnd,
+				Usage: flags.Usage{
+					Description:   "Specify when to finish exporting logs",
+					Note:          flags.DateNote,
+					AllowedFormat: flags.DateAllowedFormat,
+				},
+			},
+		},
+		flags.CustomFlag{
+			Value: &cmd.inputs.Since,
+			Meta: flags.Meta{
+				Name: flagSince,
+				Usage: flags.Usage{
+					Description:   `Specify when to begin exporting logs, such as "15m" for the last 15 minutes`,
+					AllowedFormat: flags.DateAllowedFormat,
+				},
+			},
+		},
+		flags.CustomFlag{
+			Value: &cmd.inputs.Until,
+			Meta: flags.Meta{
+				Name: flagUntil,
+				Usage: flags.Usage{
+					Description:   `Specify when to finish exporting logs, such as "10m" for up to 10 minutes ago`,
+					AllowedFormat: flags.DateAllowedFormat,
+				},
+			},
+		},
+		flags.StringFlag{
+			Value: &cmd.inputs.Out,
+			Meta: flags.Meta{
+				Name:      flagOut,
+				Shorthand: "o",
+				Usage: flags.Usage{
+					Description: "Specify the filepath to export the logs to",
+				},
+			},
+		},
+		flags.StringFlag{
+			Value: &cmd.inputs.Format,
+			Meta: flags.Meta{
+				Name: flagFormat,
+				Usage: flags.Usage{
+					Description:   "Specify the format to export the logs as",
+					Note:          `Defaults to "csv" for a .csv file, and otherwise "ndjson"`,
+					AllowedValues: []string{exportFormatNDJSON, exportFormatCSV},
+				},
+			},
+		},
+		flags.BoolFlag{
+			Value: &cmd.inputs.Resume,
+			Meta: flags.Meta{
+				Name: flagResume,
+				Usage: flags.Usage{
+					Description: "Resume an interrupted export from the last log written to the file",
+				},
+			},
+		},
+	}
+}
+
+// Inputs is the command inputs
+func (cmd *CommandExport) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandExport) Handler(ctx context.Context, profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, err := cli.ResolveApp(ctx, ui, clients.Realm, cli.AppOptions{
+		AppMeta: cmd.inputs.AppMeta,
+		Filter:  cmd.inputs.Filter(),
+	})
+	if err != nil {
+		return err
+	}
+
+	opts := realm.LogsOptions{
+		Types:      realmLogTypes(cmd.inputs.Types),
+		ErrorsOnly: cmd.inputs.Errors,
+		Start:      cmd.inputs.Start.Time,
+		End:        cmd.inputs.End.Time,
+	}
+
+	var cursor exportCursor
+	if cmd.inputs.Resume {
+		cursor, err = readExportCursor(cmd.inputs.Out, cmd.inputs.Format)
+		if err != nil {
+			return fmt.Errorf("failed to resume export from %s: %s", cmd.inputs.Out, err)
+		}
+		if cursor.count > 0 {
+			opts.End = cursor.end
+			opts.Skip = cursor.skip
+		}
+		if cursor.partial {
+			ui.Print(terminal.NewWarningLog("Discarding the last log in %s as it was only partially written", cmd.inputs.Out))
+		}
+	} else {
+		proceed, err := confirmOverwrite(ui, cmd.inputs.Out)
+		if err != nil {
+			return err
+		}
+		if !proceed {
+			return nil
+		}
+	}
+
+	fileFlags := os.O_CREATE | os.O_WRONLY | os.O_TRUNC
+	if cursor.count > 0 {
+		fileFlags = os.O_CREATE | os.O_WRONLY | os.O_APPEND
+	}
+
+	file, err := os.OpenFile(cmd.inputs.Out, fileFlags, 0666)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	if cursor.partial && cursor.count > 0 {
+		if err := file.Truncate(cursor.size); err != nil {
+			return err
+		}
+	}
+
+	w := newLogsWriter(file, cmd.inputs.Format, cursor.count == 0)
+
+	count := cursor.count
+	if err := eachLogsPage(ctx, clients.Realm, app.GroupID, app.ID, opts, func(logs realm.Logs) error {
+		for _, log := range logs {
+			if err := w.Write(log); err != nil {
+				return err
+			}
+		}
+		if err := w.Flush(); err != nil {
+			return err
+		}
+		count += len(logs)
+		return nil
+	}); err != nil {
+		if ctx.Err() != nil {
+			return cmd.interrupted(ctx, ui, count) // if interrupted before or during API call
+		}
+		return err
+	}
+
+	ui.Print(terminal.NewTextLog("Successfully exported %d log(s) to %s", count, cmd.inputs.Out))
+	return nil
+}
+
+// interrupted prints how to resume the export, and returns the interruption
+// so the command still fails
+func (cmd *CommandExport) interrupted(ctx context.Context, ui terminal.UI, count int) error {
+	resumeInputs := cmd.inputs
+	resumeInputs.Resume = true
+
+	ui.Print(
+		terminal.NewWarningLog("Export interrupted after %d log(s) were written to %s", count, cmd.inputs.Out),
+		terminal.NewFollowupLog("To resume the export run", cli.CommandDisplay(CommandMetaExport.Display, resumeInputs.args())),
+	)
+	return ctx.Err()
+}
+
+// confirmOverwrite asks before truncating an existing, non-empty file
+func confirmOverwrite(ui terminal.UI, path string) (bool, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return true, nil
+		}
+		return false, err
+	}
+	if info.IsDir() || info.Size() == 0 {
+		return true, nil
+	}
+	return ui.Confirm("File '%s' already exists, do you wish to overwrite it? (use \"--resume\" to continue an interrupted export instead)", path)
+}
+
+// exportCursor is the position to resume an export from, found from the
+// least recent logs written to the file, which all share the end date
+type exportCursor struct {
+	count int
+	end   time.Time
+	skip  int
+
+	// size is the size of the complete records in the file, and partial is
+	// whether the file continues past them with a cut off record
+	size    int64
+	partial bool
+}
+
+func readExportCursor(path, format string) (exportCursor, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return exportCursor{}, nil
+		}
+		return exportCursor{}, err
+	}
+	defer file.Close()
+
+	size, partial, err := completeRecordsSize(file, format)
+	if err != nil {
+		return exportCursor{}, err
+	}
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return exportCursor{}, err
+	}
+
+	var started []time.Time
+	if format == exportFormatCSV {
+		started, err = readCSVStarted(io.LimitReader(file, size))
+	} else {
+		started, err = readNDJSONStarted(io.LimitReader(file, size))
+	}
+	if err != nil {
+		return exportCursor{}, err
+	}
+
+	cursor := exportCursor{count: len(started), size: size, partial: partial}
+	if len(started) == 0 {
+		return cursor, nil
+	}
+
+	cursor.end = started[len(started)-1]
+	for i := len(started) - 1; i >= 0 && started[i].Equal(cursor.end); i-- {
+		cursor.skip++
+	}
+	return cursor, nil
+}
+
+// completeRecordsSize finds the size of the records in an export which were
+// written in full, as the last record is cut off if the export was killed
+// while writing it. Every record ends with a newline, which is only part of
+// a record itself within a quoted CSV field.
+func completeRecordsSize(r io.Reader, format string) (int64, bool, error) {
+	buf := bufio.NewReader(r)
+
+	var size, offset int64
+	var quoted bool
+	for {
+		b, err := buf.ReadByte()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return size, offset > size, nil
+			}
+			return 0, false, err
+		}
+		offset++
+
+		switch {
+		case b == '"' && format == exportFormatCSV:
+			quoted = !quoted // an escaped quote toggles this twice
+		case b == '\n' && !quoted:
+			size = offset
+		}
+	}
+}
+
+func readNDJSONStarted(r io.Reader) ([]time.Time, error) {
+	var started []time.Time
+
+	dec := json.NewDecoder(r)
+	for {
+		var log realm.Log
+		if err := dec.Decode(&log); err != nil {
+			if errors.Is(err, io.EOF) {
+				return started, nil
+			}
+			return nil, err
+		}
+		started = append(started, log.Started)
+	}
+}
+
+func readCSVStarted(r io.Reader) ([]time.Time, error) {
+	records, err := csv.NewReader(r).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, nil
+	}
+
+	started := make([]time.Time, 0, len(records)-1)
+	for _, record := range records[1:] { // skip the header
+		t, err := time.Parse(time.RFC3339Nano, record[0])
+		if err != nil {
+			return nil, err
+		}
+		started = append(started, t)
+	}
+	return started, nil
+}
+
+// logsWriter writes logs to a file in an export format
+type logsWriter interface {
+	Write(log realm.Log) error
+	Flush() error
+}
+
+func newLogsWriter(w io.Writer, format string, header bool) logsWriter {
+	if format == exportFormatCSV {
+		return &csvLogsWriter{w: csv.NewWriter(w), header: header}
+	}
+	buf := bufio.NewWriter(w)
+	return &ndjsonLogsWriter{buf, json.NewEncoder(buf)}
+}
+
+type ndjsonLogsWriter struct {
+	buf *bufio.Writer
+	enc *json.Encoder
+}
+
+func (w *ndjsonLogsWriter) Write(log realm.Log) error {
+	return w.enc.Encode(log)
+}
+
+func (w *ndjsonLogsWriter) Flush() error {
+	return w.buf.Flush()
+}
+
+var csvLogsHeader = []string{"started", "completed", "type", "name", "error_code", "error", "messages"}
+
+type csvLogsWriter struct {
+	w      *csv.Writer
+	header bool
+}
+
+func (w *csvLogsWriter) Write(log realm.Log) error {
+	if err := w.writeHeader(); err != nil {
+		return err
+	}
+
+	messages := make([]string, len(log.Messages))
+	for i, message := range log.Messages {
+		messages[i] = fmt.Sprint(message)
+	}
+
+	return w.w.Write([]string{
+		log.Started.Format(time.RFC3339Nano),
+		log.Completed.Format(time.RFC3339Nano),
+		log.Type,
+		strings.TrimSpace(logNameDisplay(log)),
+		log.ErrorCode,
+		log.Error,
+		strings.Join(messages, "\n"),
+	})
+}
+
+func (w *csvLogsWriter) Flush() error {
+	if err := w.writeHeader(); err != nil {
+		return err
+	}
+	w.w.Flush()
+	return w.w.Error()
+}
+
+func (w *csvLogsWriter) writeHeader() error {
+	if !w.header {
+		return nil
+	}
+	w.header = false
+	return w.w.Write(csvLogsHeader)
+}
@@ -0,0 +1,89 @@
+package logs
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/terminal"
+	"github.com/10gen/realm-cli/internal/utils/flags"
+)
+
+const (
+	flagOut    = "out"
+	flagFormat = "format"
+	flagResume = "resume"
+
+	exportFormatNDJSON = "ndjson"
+	exportFormatCSV    = "csv"
+)
+
+type exportInputs struct {
+	cli.ProjectInputs
+	Types  []string
+	Errors bool
+	Start  flags.Date
+	End    flags.Date
+	Since  flags.Date
+	Until  flags.Date
+	Out    string
+	Format string
+	Resume bool
+}
+
+func (i *exportInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	if i.Out == "" {
+		return errors.New(`must specify a file to export the logs to with "--out"`)
+	}
+
+	if err := resolveDateRange(&i.Start, &i.End, i.Since, i.Until); err != nil {
+		return err
+	}
+
+	switch i.Format {
+	case "":
+		if strings.EqualFold(filepath.Ext(i.Out), "."+exportFormatCSV) {
+			i.Format = exportFormatCSV
+		} else {
+			i.Format = exportFormatNDJSON
+		}
+	case exportFormatNDJSON, exportFormatCSV:
+	default:
+		return fmt.Errorf("unsupported format '%s', use one of [%s, %s] instead", i.Format, exportFormatNDJSON, exportFormatCSV)
+	}
+
+	return i.ProjectInputs.Resolve(ui, profile.WorkingDirectory, true)
+}
+
+func (i exportInputs) args() []flags.Arg {
+	args := make([]flags.Arg, 0, 7+len(i.Products)+len(i.Types))
+	if i.Project != "" {
+		args = append(args, flags.Arg{cli.ProjectFlagName, i.Project})
+	}
+	if i.App != "" {
+		args = append(args, flags.Arg{cli.AppFlagName, i.App})
+	}
+	for _, product := range i.Products {
+		args = append(args, flags.Arg{cli.ProductFlagName, product})
+	}
+	for _, t := range i.Types {
+		args = append(args, flags.Arg{"type", t})
+	}
+	if i.Errors {
+		args = append(args, flags.Arg{Name: "errors"})
+	}
+	if !i.Start.Time.IsZero() {
+		args = append(args, flags.Arg{flagStart, i.Start.String()})
+	}
+	if !i.End.Time.IsZero() {
+		args = append(args, flags.Arg{flagEnd, i.End.String()})
+	}
+	args = append(args, flags.Arg{flagOut, i.Out}, flags.Arg{flagFormat, i.Format})
+	if i.Resume {
+		args = append(args, flags.Arg{Name: flagResume})
+	}
+	return args
+}
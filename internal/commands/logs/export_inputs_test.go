@@ -0,0 +1,55 @@
+package logs
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/10gen/realm-cli/internal/utils/flags"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestLogsExportInputsResolve(t *testing.T) {
+	t.Run("should return an error without an output file", func(t *testing.T) {
+		var i exportInputs
+		assert.Equal(t, errors.New(`must specify a file to export the logs to with "--out"`), i.Resolve(nil, nil))
+	})
+
+	t.Run("should return an error with an unsupported format", func(t *testing.T) {
+		i := exportInputs{Out: "logs.json", Format: "json"}
+		assert.Equal(t, errors.New("unsupported format 'json', use one of [ndjson, csv] instead"), i.Resolve(nil, nil))
+	})
+
+	t.Run("should resolve the start date from since", func(t *testing.T) {
+		profile, teardown := mock.NewProfileFromTmpDir(t, "logs_export_inputs_test")
+		defer teardown()
+
+		since := flags.Date{time.Date(2021, time.June, 22, 7, 0, 0, 0, time.UTC)}
+
+		i := exportInputs{Out: "logs.ndjson", Since: since}
+		assert.Nil(t, i.Resolve(profile, nil))
+		assert.Equal(t, since, i.Start)
+	})
+
+	for _, tc := range []struct {
+		out            string
+		format         string
+		expectedFormat string
+	}{
+		{out: "logs.ndjson", expectedFormat: exportFormatNDJSON},
+		{out: "logs.csv", expectedFormat: exportFormatCSV},
+		{out: "logs.CSV", expectedFormat: exportFormatCSV},
+		{out: "logs.txt", expectedFormat: exportFormatNDJSON},
+		{out: "logs.txt", format: exportFormatCSV, expectedFormat: exportFormatCSV},
+	} {
+		t.Run("should resolve the format for "+tc.out+" with format '"+tc.format+"'", func(t *testing.T) {
+			profile, teardown := mock.NewProfileFromTmpDir(t, "logs_export_inputs_test")
+			defer teardown()
+
+			i := exportInputs{Out: tc.out, Format: tc.format}
+			assert.Nil(t, i.Resolve(profile, nil))
+			assert.Equal(t, tc.expectedFormat, i.Format)
+		})
+	}
+}
@@ -0,0 +1,374 @@
+package logs
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/utils/flags"
+	u "github.com/10gen/realm-cli/internal/utils/test"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestLogsExport(t *testing.T) {
+	testLogs := realm.Logs{
+		{
+			Type:         realm.LogTypeFunction,
+			Messages:     []interface{}{"third log"},
+			Started:      time.Date(2021, time.June, 22, 7, 54, 44, 0, time.UTC),
+			Completed:    time.Date(2021, time.June, 22, 7, 54, 44, 5_000_000, time.UTC),
+			FunctionName: "func0",
+		},
+		{
+			Type:      realm.LogTypeAuth,
+			Error:     "something bad happened",
+			ErrorCode: "Test",
+			Started:   time.Date(2021, time.June, 22, 7, 54, 43, 0, time.UTC),
+			Completed: time.Date(2021, time.June, 22, 7, 54, 43, 5_000_000, time.UTC),
+		},
+		{
+			Type:      realm.LogTypeAuth,
+			Messages:  []interface{}{"first log", "with two messages"},
+			Started:   time.Date(2021, time.June, 22, 7, 54, 43, 0, time.UTC),
+			Completed: time.Date(2021, time.June, 22, 7, 54, 43, 5_000_000, time.UTC),
+		},
+	}
+
+	// pages the test logs two at a time, as the server would
+	logsPageFn := func(calls *[]realm.LogsOptions) func(groupID, appID string, opts realm.LogsOptions) (realm.LogsPage, error) {
+		return func(groupID, appID string, opts realm.LogsOptions) (realm.LogsPage, error) {
+			*calls = append(*calls, opts)
+
+			var start int
+			if !opts.End.IsZero() {
+				for start < len(testLogs) && testLogs[start].Started.After(opts.End) {
+					start++
+				}
+				start += opts.Skip
+			}
+			end := start + 2
+			if end >= len(testLogs) {
+				return realm.LogsPage{Logs: testLogs[start:]}, nil
+			}
+
+			var nextSkip int
+			for _, log := range testLogs[start:end] {
+				if log.Started.Equal(testLogs[end-1].Started) {
+					nextSkip++
+				}
+			}
+			return realm.LogsPage{Logs: testLogs[start:end], NextEndDate: testLogs[end-1].Started, NextSkip: nextSkip}, nil
+		}
+	}
+
+	t.Run("should return an error when client fails to get logs", func(t *testing.T) {
+		tmpDir, teardown, err := u.NewTempDir("logs_export_test")
+		assert.Nil(t, err)
+		defer teardown()
+
+		realmClient := mock.RealmClient{}
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{{}}, nil
+		}
+		realmClient.LogsPageFn = func(groupID, appID string, opts realm.LogsOptions) (realm.LogsPage, error) {
+			return realm.LogsPage{}, errors.New("something bad happened")
+		}
+
+		cmd := &CommandExport{exportInputs{Out: filepath.Join(tmpDir, "logs.ndjson"), Format: exportFormatNDJSON}}
+
+		err = cmd.Handler(context.Background(), nil, nil, cli.Clients{Realm: realmClient})
+		assert.Equal(t, errors.New("something bad happened"), err)
+	})
+
+	t.Run("should follow each page of logs and write them as ndjson", func(t *testing.T) {
+		tmpDir, teardown, err := u.NewTempDir("logs_export_test")
+		assert.Nil(t, err)
+		defer teardown()
+
+		var calls []realm.LogsOptions
+
+		realmClient := mock.RealmClient{}
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{{GroupID: "groupID", ID: "appID"}}, nil
+		}
+		realmClient.LogsPageFn = logsPageFn(&calls)
+
+		out, ui := mock.NewUI()
+
+		start := time.Date(2021, time.June, 22, 0, 0, 0, 0, time.UTC)
+		outPath := filepath.Join(tmpDir, "logs.ndjson")
+
+		cmd := &CommandExport{exportInputs{
+			Types:  []string{logTypeAuth, logTypeFunction},
+			Start:  flagDate(start),
+			Out:    outPath,
+			Format: exportFormatNDJSON,
+		}}
+
+		assert.Nil(t, cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, "Successfully exported 3 log(s) to "+outPath+"\n", out.String())
+
+		types := []string{realm.LogTypeAuth, realm.LogTypeAPIKey, realm.LogTypeFunction}
+		assert.Equal(t, []realm.LogsOptions{
+			{Types: types, Start: start},
+			{Types: types, Start: start, End: testLogs[1].Started, Skip: 1},
+		}, calls)
+
+		data, err := ioutil.ReadFile(outPath)
+		assert.Nil(t, err)
+		assert.Equal(t, `{"_id":"","messages":["third log"],"type":"FUNCTION","started":"2021-06-22T07:54:44Z","completed":"2021-06-22T07:54:44.005Z","mem_time_usage":0,"error":"","error_code":"","auth_event":{"failed":false,"type":"","provider":""},"event_subscription_id":"","event_subscription_name":"","function_id":"","function_name":"func0","incoming_webhook_id":"","incoming_webhook_name":""}
+{"_id":"","messages":null,"type":"AUTH","started":"2021-06-22T07:54:43Z","completed":"2021-06-22T07:54:43.005Z","mem_time_usage":0,"error":"something bad happened","error_code":"Test","auth_event":{"failed":false,"type":"","provider":""},"event_subscription_id":"","event_subscription_name":"","function_id":"","function_name":"","incoming_webhook_id":"","incoming_webhook_name":""}
+{"_id":"","messages":["first log","with two messages"],"type":"AUTH","started":"2021-06-22T07:54:43Z","completed":"2021-06-22T07:54:43.005Z","mem_time_usage":0,"error":"","error_code":"","auth_event":{"failed":false,"type":"","provider":""},"event_subscription_id":"","event_subscription_name":"","function_id":"","function_name":"","incoming_webhook_id":"","incoming_webhook_name":""}
+`, string(data))
+	})
+
+	t.Run("should write the logs as csv", func(t *testing.T) {
+		tmpDir, teardown, err := u.NewTempDir("logs_export_test")
+		assert.Nil(t, err)
+		defer teardown()
+
+		var calls []realm.LogsOptions
+
+		realmClient := mock.RealmClient{}
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{{GroupID: "groupID", ID: "appID"}}, nil
+		}
+		realmClient.LogsPageFn = logsPageFn(&calls)
+
+		_, ui := mock.NewUI()
+
+		outPath := filepath.Join(tmpDir, "logs.csv")
+
+		cmd := &CommandExport{exportInputs{Out: outPath, Format: exportFormatCSV}}
+		assert.Nil(t, cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient}))
+
+		data, err := ioutil.ReadFile(outPath)
+		assert.Nil(t, err)
+		assert.Equal(t, `started,completed,type,name,error_code,error,messages
+2021-06-22T07:54:44Z,2021-06-22T07:54:44.005Z,FUNCTION,func0,,,third log
+2021-06-22T07:54:43Z,2021-06-22T07:54:43.005Z,AUTH,,Test,something bad happened,
+2021-06-22T07:54:43Z,2021-06-22T07:54:43.005Z,AUTH,,,,"first log
+with two messages"
+`, string(data))
+	})
+
+	t.Run("should write only the csv header without any logs", func(t *testing.T) {
+		tmpDir, teardown, err := u.NewTempDir("logs_export_test")
+		assert.Nil(t, err)
+		defer teardown()
+
+		realmClient := mock.RealmClient{}
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{{GroupID: "groupID", ID: "appID"}}, nil
+		}
+		realmClient.LogsPageFn = func(groupID, appID string, opts realm.LogsOptions) (realm.LogsPage, error) {
+			return realm.LogsPage{}, nil
+		}
+
+		out, ui := mock.NewUI()
+
+		outPath := filepath.Join(tmpDir, "logs.csv")
+
+		cmd := &CommandExport{exportInputs{Out: outPath, Format: exportFormatCSV}}
+		assert.Nil(t, cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, "Successfully exported 0 log(s) to "+outPath+"\n", out.String())
+
+		data, err := ioutil.ReadFile(outPath)
+		assert.Nil(t, err)
+		assert.Equal(t, "started,completed,type,name,error_code,error,messages\n", string(data))
+	})
+
+	t.Run("should not overwrite an existing file without confirmation", func(t *testing.T) {
+		tmpDir, teardown, err := u.NewTempDir("logs_export_test")
+		assert.Nil(t, err)
+		defer teardown()
+
+		outPath := filepath.Join(tmpDir, "logs.ndjson")
+		assert.Nil(t, ioutil.WriteFile(outPath, []byte("existing logs\n"), 0666))
+
+		var calls []realm.LogsOptions
+
+		realmClient := mock.RealmClient{}
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{{GroupID: "groupID", ID: "appID"}}, nil
+		}
+		realmClient.LogsPageFn = logsPageFn(&calls)
+
+		_, console, _, ui, consoleErr := mock.NewVT10XConsole()
+		assert.Nil(t, consoleErr)
+		defer console.Close()
+
+		doneCh := make(chan struct{})
+		go func() {
+			defer close(doneCh)
+			console.ExpectString("File '" + outPath + "' already exists, do you wish to overwrite it?")
+			console.SendLine("n")
+			console.ExpectEOF()
+		}()
+
+		cmd := &CommandExport{exportInputs{Out: outPath, Format: exportFormatNDJSON}}
+
+		err = cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient})
+
+		console.Tty().Close()
+		<-doneCh
+
+		assert.Nil(t, err)
+		assert.Equal(t, 0, len(calls))
+
+		data, err := ioutil.ReadFile(outPath)
+		assert.Nil(t, err)
+		assert.Equal(t, "existing logs\n", string(data))
+	})
+
+	t.Run("should overwrite an existing file once confirmed", func(t *testing.T) {
+		tmpDir, teardown, err := u.NewTempDir("logs_export_test")
+		assert.Nil(t, err)
+		defer teardown()
+
+		outPath := filepath.Join(tmpDir, "logs.csv")
+		assert.Nil(t, ioutil.WriteFile(outPath, []byte("existing logs\n"), 0666))
+
+		realmClient := mock.RealmClient{}
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{{GroupID: "groupID", ID: "appID"}}, nil
+		}
+		realmClient.LogsPageFn = func(groupID, appID string, opts realm.LogsOptions) (realm.LogsPage, error) {
+			return realm.LogsPage{}, nil
+		}
+
+		out := new(bytes.Buffer)
+		ui := mock.NewUIWithOptions(mock.UIOptions{AutoConfirm: true}, out)
+
+		cmd := &CommandExport{exportInputs{Out: outPath, Format: exportFormatCSV}}
+		assert.Nil(t, cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient}))
+
+		data, err := ioutil.ReadFile(outPath)
+		assert.Nil(t, err)
+		assert.Equal(t, "started,completed,type,name,error_code,error,messages\n", string(data))
+	})
+
+	for _, tc := range []struct {
+		format  string
+		partial string
+	}{
+		{exportFormatNDJSON, `{"_id":"","messages":["first log",`},
+		{exportFormatCSV, `2021-06-22T07:54:43Z,2021-06-22T07:54:43.005Z,AUTH,,,,"first log`},
+	} {
+		t.Run("should resume a "+tc.format+" export which was cut off while writing a log", func(t *testing.T) {
+			tmpDir, teardown, err := u.NewTempDir("logs_export_test")
+			assert.Nil(t, err)
+			defer teardown()
+
+			var calls []realm.LogsOptions
+
+			realmClient := mock.RealmClient{}
+			realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+				return []realm.App{{GroupID: "groupID", ID: "appID"}}, nil
+			}
+			realmClient.LogsPageFn = logsPageFn(&calls)
+
+			out, ui := mock.NewUI()
+
+			full := filepath.Join(tmpDir, "full."+tc.format)
+			cmd := &CommandExport{exportInputs{Out: full, Format: tc.format}}
+			assert.Nil(t, cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient}))
+
+			expected, err := ioutil.ReadFile(full)
+			assert.Nil(t, err)
+
+			// keep the first two logs, and only part of the third
+			prefix := string(expected)[:strings.Index(string(expected), tc.partial)]
+			outPath := filepath.Join(tmpDir, "logs."+tc.format)
+			assert.Nil(t, ioutil.WriteFile(outPath, []byte(prefix+tc.partial), 0666))
+
+			calls = nil
+			out.Reset()
+
+			cmd = &CommandExport{exportInputs{Out: outPath, Format: tc.format, Resume: true}}
+			assert.Nil(t, cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient}))
+			assert.Equal(t, `Discarding the last log in `+outPath+` as it was only partially written
+Successfully exported 3 log(s) to `+outPath+`
+`, out.String())
+
+			assert.Equal(t, []realm.LogsOptions{{End: testLogs[1].Started, Skip: 1}}, calls)
+
+			resumed, err := ioutil.ReadFile(outPath)
+			assert.Nil(t, err)
+			assert.Equal(t, string(expected), string(resumed))
+		})
+	}
+
+	for _, format := range []string{exportFormatNDJSON, exportFormatCSV} {
+		t.Run("should resume an interrupted "+format+" export from the last log written", func(t *testing.T) {
+			tmpDir, teardown, err := u.NewTempDir("logs_export_test")
+			assert.Nil(t, err)
+			defer teardown()
+
+			var calls []realm.LogsOptions
+
+			ctx, cancel := context.WithCancel(context.Background())
+
+			realmClient := mock.RealmClient{}
+			realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+				return []realm.App{{GroupID: "groupID", ID: "appID"}}, nil
+			}
+			realmClient.LogsPageFn = func(groupID, appID string, opts realm.LogsOptions) (realm.LogsPage, error) {
+				page, err := logsPageFn(&calls)(groupID, appID, opts)
+				cancel() // interrupt after the first page
+				return page, err
+			}
+
+			out, ui := mock.NewUI()
+
+			outPath := filepath.Join(tmpDir, "logs."+format)
+
+			cmd := &CommandExport{exportInputs{ProjectInputs: cli.ProjectInputs{Products: []string{"atlas"}}, Out: outPath, Format: format}}
+			assert.Equal(t, context.Canceled, cmd.Handler(ctx, nil, ui, cli.Clients{Realm: realmClient}))
+			assert.Equal(t, `Export interrupted after 2 log(s) were written to `+outPath+`
+To resume the export run: realm-cli logs export --product atlas --out `+outPath+` --format `+format+` --resume
+`, out.String())
+
+			interrupted, err := ioutil.ReadFile(outPath)
+			assert.Nil(t, err)
+
+			realmClient.LogsPageFn = logsPageFn(&calls)
+			out.Reset()
+
+			cmd = &CommandExport{exportInputs{Out: outPath, Format: format, Resume: true}}
+			assert.Nil(t, cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient}))
+			assert.Equal(t, "Successfully exported 3 log(s) to "+outPath+"\n", out.String())
+
+			assert.Equal(t, []realm.LogsOptions{
+				{},
+				{End: testLogs[1].Started, Skip: 1},
+			}, calls)
+
+			resumed, err := ioutil.ReadFile(outPath)
+			assert.Nil(t, err)
+
+			calls = nil
+
+			full := filepath.Join(tmpDir, "full."+format)
+			cmd = &CommandExport{exportInputs{Out: full, Format: format}}
+			assert.Nil(t, cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient}))
+
+			expected, err := ioutil.ReadFile(full)
+			assert.Nil(t, err)
+			assert.Equal(t, string(expected), string(resumed))
+			assert.True(t, len(resumed) > len(interrupted), "expected the resumed export to append logs")
+		})
+	}
+}
+
+func flagDate(t time.Time) flags.Date {
+	return flags.Date{Time: t}
+}
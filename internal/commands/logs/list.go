@@ -1,6 +1,7 @@
 package logs
 
 import (
+	"context"
 	"fmt"
 	"sort"
 	"time"
@@ -18,6 +19,19 @@ const (
 
 var (
 	tailLookBehind = 5
+
+	// the interval to poll for logs with while tailing starts at the initial interval,
+	// halving when new logs are found and doubling when none are, within the bounds
+	tailInterval    = 5 * time.Second
+	tailMinInterval = 1 * time.Second
+	tailMaxInterval = 30 * time.Second
+
+	// the period before the latest log seen to poll for logs from, so that logs which
+	// are written late or share a timestamp are not dropped
+	tailOverlap = 5 * time.Second
+
+	// the number of consecutive failures to poll for logs to retry before giving up
+	tailMaxRetries = 5
 )
 
 // CommandMetaList is the command meta for the `logs list` command
@@ -28,7 +42,19 @@ var CommandMetaList = cli.CommandMeta{
 	Description: "Lists the Logs in your Realm app",
 	HelpText: `Displays a list of your Realm app’s Logs sorted by recentness, with most recent
 Logs appearing towards the bottom. You can specify a "--tail" flag to monitor
-your Logs and follow any newly created Logs in real-time.`,
+your Logs and follow any newly created Logs in real-time. While following your
+Logs, they are polled for more often when busy and less often when idle, and
+each Log is printed only once.
+
+Dates can be given relative to now, such as "--since 15m" to list the Logs of the
+last 15 minutes, or "--since yesterday --until today" to list yesterday's Logs.
+
+To narrow down your Logs beyond their type, filter them by the function, trigger,
+webhook endpoint, or authentication provider they were logged for, or by a
+regular expression with "--grep". The filters apply both when listing and when
+following your Logs. When listing, every Log within the date range is searched
+for the Logs matching the filters, so narrow down the date range to list them
+sooner.`,
 }
 
 // CommandList is the `logs list` command
@@ -51,16 +77,7 @@ func (cmd *CommandList) Flags() []flags.Flag {
 						Description: "Specify the type(s) of logs to list",
 					},
 				},
-				ValidValues: []string{
-					logTypeAuth,
-					logTypeFunction,
-					logTypePush,
-					logTypeService,
-					logTypeTrigger,
-					logTypeGraphQL,
-					logTypeSync,
-					logTypeSchema,
-				},
+				ValidValues: logTypeValues,
 			},
 		),
 		flags.BoolFlag{
@@ -75,10 +92,11 @@ func (cmd *CommandList) Flags() []flags.Flag {
 		flags.CustomFlag{
 			Value: &cmd.inputs.Start,
 			Meta: flags.Meta{
-				Name: "start",
+				Name: flagStart,
 				Usage: flags.Usage{
 					Description:   "Specify when to begin listing logs",
-					AllowedFormat: "2006-01-02[T15:04:05.000-0700]",
+					Note:          flags.DateNote,
+					AllowedFormat: flags.DateAllowedFormat,
 					DocsLink:      "https://docs.mongodb.com/realm/logs/cli/#view-logs-for-a-date-range",
 				},
 			},
@@ -86,10 +104,33 @@ func (cmd *CommandList) Flags() []flags.Flag {
 		flags.CustomFlag{
 			Value: &cmd.inputs.End,
 			Meta: flags.Meta{
-				Name: "end",
+				Name: flagEnd,
 				Usage: flags.Usage{
 					Description:   "Specify when to finish listing logs",
-					AllowedFormat: "2006-01-02[T15:04:05.000-0700]",
+					Note:          flags.DateNote,
+					AllowedFormat: flags.DateAllowedFormat,
+					DocsLink:      "https://docs.mongodb.com/realm/logs/cli/#view-logs-for-a-date-range",
+				},
+			},
+		},
+		flags.CustomFlag{
+			Value: &cmd.inputs.Since,
+			Meta: flags.Meta{
+				Name: flagSince,
+				Usage: flags.Usage{
+					Description:   `Specify when to begin listing logs, such as "15m" for the last 15 minutes`,
+					AllowedFormat: flags.DateAllowedFormat,
+					DocsLink:      "https://docs.mongodb.com/realm/logs/cli/#view-logs-for-a-date-range",
+				},
+			},
+		},
+		flags.CustomFlag{
+			Value: &cmd.inputs.Until,
+			Meta: flags.Meta{
+				Name: flagUntil,
+				Usage: flags.Usage{
+					Description:   `Specify when to finish listing logs, such as "10m" for up to 10 minutes ago`,
+					AllowedFormat: flags.DateAllowedFormat,
 					DocsLink:      "https://docs.mongodb.com/realm/logs/cli/#view-logs-for-a-date-range",
 				},
 			},
@@ -100,7 +141,52 @@ func (cmd *CommandList) Flags() []flags.Flag {
 				Name: "tail",
 				Usage: flags.Usage{
 					Description: "View your Realm app's logs in real-time",
-					Note:        `"--start" and "--end" flags do not apply here`,
+					Note:        `"--start", "--end", "--since", and "--until" flags do not apply here`,
+				},
+			},
+		},
+		flags.StringSliceFlag{
+			Value: &cmd.inputs.Functions,
+			Meta: flags.Meta{
+				Name: "function",
+				Usage: flags.Usage{
+					Description: "Filter your Realm app's logs by function name or ID",
+				},
+			},
+		},
+		flags.StringSliceFlag{
+			Value: &cmd.inputs.Triggers,
+			Meta: flags.Meta{
+				Name: "trigger",
+				Usage: flags.Usage{
+					Description: "Filter your Realm app's logs by trigger name or ID",
+				},
+			},
+		},
+		flags.StringSliceFlag{
+			Value: &cmd.inputs.Endpoints,
+			Meta: flags.Meta{
+				Name: "endpoint",
+				Usage: flags.Usage{
+					Description: "Filter your Realm app's logs by webhook endpoint name or ID",
+				},
+			},
+		},
+		flags.StringSliceFlag{
+			Value: &cmd.inputs.Providers,
+			Meta: flags.Meta{
+				Name: "provider",
+				Usage: flags.Usage{
+					Description: "Filter your Realm app's logs by authentication provider",
+				},
+			},
+		},
+		flags.StringFlag{
+			Value: &cmd.inputs.Grep,
+			Meta: flags.Meta{
+				Name: "grep",
+				Usage: flags.Usage{
+					Description: "Filter your Realm app's logs by a regular expression matching their messages or error",
 				},
 			},
 		},
@@ -113,10 +199,10 @@ func (cmd *CommandList) Inputs() cli.InputResolver {
 }
 
 // Handler is the command handler
-func (cmd *CommandList) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+func (cmd *CommandList) Handler(ctx context.Context, profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
 	cmdStart := time.Now() // for use with tail later
 
-	app, err := cli.ResolveApp(ui, clients.Realm, cli.AppOptions{
+	app, err := cli.ResolveApp(ctx, ui, clients.Realm, cli.AppOptions{
 		AppMeta: cmd.inputs.AppMeta,
 		Filter:  cmd.inputs.Filter(),
 	})
@@ -133,10 +219,26 @@ func (cmd *CommandList) Handler(profile *user.Profile, ui terminal.UI, clients c
 		opts.End = cmd.inputs.End.Time
 	}
 
-	logs, err := clients.Realm.Logs(app.GroupID, app.ID, opts)
+	if !cmd.inputs.Tail && cmd.inputs.filtered() {
+		// a single page of logs may hold few or none of the logs matching the filters,
+		// so every page of logs within the date range is filtered instead
+		var logs realm.Logs
+		if err := eachLogsPage(ctx, clients.Realm, app.GroupID, app.ID, opts, func(page realm.Logs) error {
+			logs = append(logs, cmd.inputs.filterLogs(page)...)
+			return nil
+		}); err != nil {
+			return err
+		}
+
+		printLogs(ui, logs)
+		return nil
+	}
+
+	fetched, err := clients.Realm.Logs(ctx, app.GroupID, app.ID, opts)
 	if err != nil {
 		return err
 	}
+	logs := cmd.inputs.filterLogs(fetched)
 
 	if cmd.inputs.Tail && len(logs) > tailLookBehind {
 		logs = logs[0:tailLookBehind]
@@ -150,49 +252,180 @@ func (cmd *CommandList) Handler(profile *user.Profile, ui terminal.UI, clients c
 	logsCh, errCh, closeCh := make(chan realm.Logs), make(chan error), make(chan struct{})
 	defer close(closeCh)
 
-	opts.Start = cmdStart
-	go pollForLogs(clients.Realm, app.GroupID, app.ID, opts, logsCh, errCh, closeCh)
+	tail := newLogsTail(cmdStart, fetched)
+	go pollForLogs(ctx, clients.Realm, app.GroupID, app.ID, opts, tail, logsCh, errCh, closeCh)
 
 	for {
 		select {
 		case logs := <-logsCh:
-			printLogs(ui, logs)
+			printLogs(ui, cmd.inputs.filterLogs(logs))
 		case err := <-errCh:
+			if ctx.Err() != nil {
+				return nil // if interrupted during API call
+			}
 			return err
-		case <-cmd.inputs.sigShutdown:
+		case <-ctx.Done():
 			return nil
 		}
 	}
 }
 
-func pollForLogs(realmClient realm.Client, groupID, appID string, opts realm.LogsOptions, logsCh chan<- realm.Logs, errCh chan<- error, closeCh <-chan struct{}) {
-	ticker := time.NewTicker(5 * time.Second)
-	defer ticker.Stop()
+func pollForLogs(ctx context.Context, realmClient realm.Client, groupID, appID string, opts realm.LogsOptions, tail *logsTail, logsCh chan<- realm.Logs, errCh chan<- error, closeCh <-chan struct{}) {
+	interval := tailInterval
+	timer := time.NewTimer(interval)
+	defer timer.Stop()
 
+	var failures int
 	for {
 		select {
 		case <-closeCh:
 			return
-		case <-ticker.C:
-			startNext := time.Now()
+		case <-timer.C:
+		}
+
+		opts.Start = tail.start()
 
-			logs, err := realmClient.Logs(groupID, appID, opts)
-			if err != nil {
+		// a busy app may log more than a page of logs between polls
+		var logs realm.Logs
+		err := eachLogsPage(ctx, realmClient, groupID, appID, opts, func(page realm.Logs) error {
+			logs = append(logs, page...)
+			return nil
+		})
+		if err != nil {
+			failures++
+			if failures > tailMaxRetries || ctx.Err() != nil {
 				select {
 				case <-closeCh: // if closed during API call
 				case errCh <- err:
 				}
 				return
 			}
+
+			// back off before retrying, in case the error is transient
+			interval = backOffInterval(interval)
+			timer.Reset(interval)
+			continue
+		}
+		failures = 0
+
+		logs = tail.unseen(logs)
+		if len(logs) == 0 {
+			interval = backOffInterval(interval)
+		} else {
+			interval = speedUpInterval(interval)
+
 			select {
 			case <-closeCh:
 				return // if closed during API call
 			case logsCh <- logs:
 			}
+		}
+		timer.Reset(interval)
+	}
+}
+
+// eachLogsPage follows each page of logs until there are no more or the next page
+// would begin before the start date, handing each page of logs to fn as it is fetched
+func eachLogsPage(ctx context.Context, realmClient realm.Client, groupID, appID string, opts realm.LogsOptions, fn func(logs realm.Logs) error) error {
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		page, err := realmClient.LogsPage(ctx, groupID, appID, opts)
+		if err != nil {
+			return err
+		}
+		if err := fn(page.Logs); err != nil {
+			return err
+		}
+
+		if len(page.Logs) == 0 || page.NextEndDate.IsZero() {
+			return nil
+		}
+		if !opts.Start.IsZero() && page.NextEndDate.Before(opts.Start) {
+			return nil
+		}
+		opts.End = page.NextEndDate
+		opts.Skip = page.NextSkip
+	}
+}
+
+func backOffInterval(interval time.Duration) time.Duration {
+	if interval *= 2; interval > tailMaxInterval {
+		return tailMaxInterval
+	}
+	return interval
+}
 
-			opts.Start = startNext
+func speedUpInterval(interval time.Duration) time.Duration {
+	if interval /= 2; interval < tailMinInterval {
+		return tailMinInterval
+	}
+	return interval
+}
+
+// logsTail tracks the latest log seen while tailing logs, along with the logs
+// seen since shortly before it, so that polled logs are only printed once
+type logsTail struct {
+	highWaterMark time.Time
+	seen          map[string]time.Time
+}
+
+func newLogsTail(start time.Time, logs realm.Logs) *logsTail {
+	tail := logsTail{highWaterMark: start, seen: map[string]time.Time{}}
+	tail.unseen(logs)
+	return &tail
+}
+
+// start returns the date to poll for logs from
+func (t *logsTail) start() time.Time {
+	return t.highWaterMark.Add(-tailOverlap)
+}
+
+// unseen returns the logs which have not been seen before, and marks them as seen
+func (t *logsTail) unseen(logs realm.Logs) realm.Logs {
+	unseen := make(realm.Logs, 0, len(logs))
+	for _, log := range logs {
+		key := logKey(log)
+		if _, ok := t.seen[key]; ok {
+			continue
+		}
+		t.seen[key] = log.Started
+		unseen = append(unseen, log)
+
+		if log.Started.After(t.highWaterMark) {
+			t.highWaterMark = log.Started
 		}
 	}
+
+	// forget the logs which can no longer be polled for again
+	start := t.start()
+	for key, started := range t.seen {
+		if started.Before(start) {
+			delete(t.seen, key)
+		}
+	}
+	return unseen
+}
+
+// logKey returns the key which identifies a log, from its id when present
+// or else from its contents
+func logKey(log realm.Log) string {
+	if log.ID != "" {
+		return log.ID
+	}
+	return fmt.Sprintf(
+		"%d|%d|%s|%s|%s|%s|%s|%v",
+		log.Started.UnixNano(),
+		log.Completed.UnixNano(),
+		log.Type,
+		log.FunctionID,
+		log.EventSubscriptionID,
+		log.IncomingWebhookID,
+		log.Error,
+		log.Messages,
+	)
 }
 
 func printLogs(ui terminal.UI, logs realm.Logs) {
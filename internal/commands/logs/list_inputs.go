@@ -1,9 +1,8 @@
 package logs
 
 import (
-	"os"
-	"os/signal"
-	"syscall"
+	"fmt"
+	"regexp"
 
 	"github.com/10gen/realm-cli/internal/cli"
 	"github.com/10gen/realm-cli/internal/cli/user"
@@ -24,26 +23,145 @@ const (
 	logTypeSchema   = "schema"
 )
 
+const (
+	flagStart = "start"
+	flagEnd   = "end"
+	flagSince = "since"
+	flagUntil = "until"
+
+	errFlagConflictTemplate = `cannot use both "%s" and "%s" at the same time`
+)
+
+var logTypeValues = []string{
+	logTypeAuth,
+	logTypeFunction,
+	logTypePush,
+	logTypeService,
+	logTypeTrigger,
+	logTypeGraphQL,
+	logTypeSync,
+	logTypeSchema,
+}
+
 type listInputs struct {
 	cli.ProjectInputs
-	Types       []string
-	Errors      bool
-	Start       flags.Date
-	End         flags.Date
-	Tail        bool
-	sigShutdown chan os.Signal
+	Types     []string
+	Errors    bool
+	Start     flags.Date
+	End       flags.Date
+	Since     flags.Date
+	Until     flags.Date
+	Tail      bool
+	Functions []string
+	Triggers  []string
+	Endpoints []string
+	Providers []string
+	Grep      string
+
+	grep *regexp.Regexp
 }
 
 func (i *listInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
-	i.sigShutdown = make(chan os.Signal, 1)
-	signal.Notify(i.sigShutdown, syscall.SIGTERM, syscall.SIGINT)
+	if err := resolveDateRange(&i.Start, &i.End, i.Since, i.Until); err != nil {
+		return err
+	}
+
+	if i.Grep != "" {
+		grep, err := regexp.Compile(i.Grep)
+		if err != nil {
+			return fmt.Errorf("invalid grep pattern: %s", err)
+		}
+		i.grep = grep
+	}
 
 	return i.ProjectInputs.Resolve(ui, profile.WorkingDirectory, true)
 }
 
+// resolveDateRange sets the start and end dates from the since and until dates,
+// which are the same dates by names that read better with relative dates
+func resolveDateRange(start, end *flags.Date, since, until flags.Date) error {
+	if !since.Time.IsZero() {
+		if !start.Time.IsZero() {
+			return fmt.Errorf(errFlagConflictTemplate, flagStart, flagSince)
+		}
+		*start = since
+	}
+	if !until.Time.IsZero() {
+		if !end.Time.IsZero() {
+			return fmt.Errorf(errFlagConflictTemplate, flagEnd, flagUntil)
+		}
+		*end = until
+	}
+	if !start.Time.IsZero() && !end.Time.IsZero() && start.Time.After(end.Time) {
+		return fmt.Errorf("the start date %s must not be after the end date %s", start, end)
+	}
+	return nil
+}
+
+// filterLogs returns the logs which match every filter set, where each filter
+// matches a log by any of its values
+// filtered returns true if any filters are set to narrow down the logs by
+func (i *listInputs) filtered() bool {
+	return len(i.Functions) != 0 || len(i.Triggers) != 0 || len(i.Endpoints) != 0 || len(i.Providers) != 0 || i.grep != nil
+}
+
+func (i *listInputs) filterLogs(logs realm.Logs) realm.Logs {
+	if !i.filtered() {
+		return logs
+	}
+
+	filtered := make(realm.Logs, 0, len(logs))
+	for _, log := range logs {
+		if matchesAny(i.Functions, log.FunctionName, log.FunctionID) &&
+			matchesAny(i.Triggers, log.EventSubscriptionName, log.EventSubscriptionID) &&
+			matchesAny(i.Endpoints, log.IncomingWebhookName, log.IncomingWebhookID) &&
+			matchesAny(i.Providers, log.AuthEvent.Provider) &&
+			i.matchesGrep(log) {
+			filtered = append(filtered, log)
+		}
+	}
+	return filtered
+}
+
+func (i *listInputs) matchesGrep(log realm.Log) bool {
+	if i.grep == nil {
+		return true
+	}
+	if log.Error != "" && i.grep.MatchString(log.Error) {
+		return true
+	}
+	for _, message := range log.Messages {
+		if i.grep.MatchString(fmt.Sprint(message)) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesAny returns true if there are no filter values,
+// or if any of the log values are one of the filter values
+func matchesAny(filter []string, values ...string) bool {
+	if len(filter) == 0 {
+		return true
+	}
+	for _, f := range filter {
+		for _, value := range values {
+			if value != "" && value == f {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func (i *listInputs) logTypes() []string {
+	return realmLogTypes(i.Types)
+}
+
+// realmLogTypes returns the Realm app log types of the log type flags
+func realmLogTypes(logTypes []string) []string {
 	var types []string
-	for _, lt := range i.Types {
+	for _, lt := range logTypes {
 		switch lt {
 		case logTypeAuth:
 			types = append(types, realm.LogTypeAuth, realm.LogTypeAPIKey)
@@ -1,10 +1,15 @@
 package logs
 
 import (
+	"errors"
+	"regexp"
 	"testing"
+	"time"
 
 	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/utils/flags"
 	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
 )
 
 func TestLogTypes(t *testing.T) {
@@ -29,3 +34,107 @@ func TestLogTypes(t *testing.T) {
 		})
 	}
 }
+
+func TestLogsListInputsResolve(t *testing.T) {
+	since := flags.Date{time.Date(2021, time.June, 22, 7, 0, 0, 0, time.UTC)}
+	until := flags.Date{time.Date(2021, time.June, 22, 8, 0, 0, 0, time.UTC)}
+
+	t.Run("should resolve the start and end dates from since and until", func(t *testing.T) {
+		profile, teardown := mock.NewProfileFromTmpDir(t, "logs_list_inputs_test")
+		defer teardown()
+
+		i := listInputs{Since: since, Until: until}
+		assert.Nil(t, i.Resolve(profile, nil))
+
+		assert.Equal(t, since, i.Start)
+		assert.Equal(t, until, i.End)
+	})
+
+	t.Run("should return an error when start and since are both set", func(t *testing.T) {
+		i := listInputs{Start: since, Since: since}
+		assert.Equal(t, errors.New(`cannot use both "start" and "since" at the same time`), i.Resolve(nil, nil))
+	})
+
+	t.Run("should return an error when end and until are both set", func(t *testing.T) {
+		i := listInputs{End: until, Until: until}
+		assert.Equal(t, errors.New(`cannot use both "end" and "until" at the same time`), i.Resolve(nil, nil))
+	})
+
+	t.Run("should return an error when the start date is after the end date", func(t *testing.T) {
+		i := listInputs{Since: until, Until: since}
+		assert.Equal(t, errors.New("the start date 2021-06-22T08:00:00.000+0000 must not be after the end date 2021-06-22T07:00:00.000+0000"), i.Resolve(nil, nil))
+	})
+
+	t.Run("should return an error with an invalid grep pattern", func(t *testing.T) {
+		i := listInputs{Grep: "("}
+		assert.Equal(t, errors.New("invalid grep pattern: error parsing regexp: missing closing ): `(`"), i.Resolve(nil, nil))
+	})
+}
+
+func TestLogsListInputsFilterLogs(t *testing.T) {
+	testLogs := realm.Logs{
+		{Type: realm.LogTypeFunction, FunctionName: "noisy", FunctionID: "func0", Messages: []interface{}{"retrying request"}},
+		{Type: realm.LogTypeFunction, FunctionName: "quiet", FunctionID: "func1", Messages: []interface{}{"done", 42}},
+		{Type: realm.LogTypeDBTrigger, EventSubscriptionName: "onInsert", EventSubscriptionID: "trigger0", FunctionName: "noisy"},
+		{Type: realm.LogTypeWebhook, IncomingWebhookName: "hook", IncomingWebhookID: "webhook0", Error: "request timed out"},
+		{Type: realm.LogTypeAuth, AuthEvent: realm.LogAuthEvent{Provider: "anon-user"}},
+	}
+
+	for _, tc := range []struct {
+		description string
+		inputs      listInputs
+		expected    realm.Logs
+	}{
+		{
+			description: "should return all logs without any filters",
+			expected:    testLogs,
+		},
+		{
+			description: "should filter by function name",
+			inputs:      listInputs{Functions: []string{"noisy"}},
+			expected:    realm.Logs{testLogs[0], testLogs[2]},
+		},
+		{
+			description: "should filter by any of the function names or ids",
+			inputs:      listInputs{Functions: []string{"func0", "quiet"}},
+			expected:    realm.Logs{testLogs[0], testLogs[1]},
+		},
+		{
+			description: "should filter by trigger id",
+			inputs:      listInputs{Triggers: []string{"trigger0"}},
+			expected:    realm.Logs{testLogs[2]},
+		},
+		{
+			description: "should filter by endpoint name",
+			inputs:      listInputs{Endpoints: []string{"hook"}},
+			expected:    realm.Logs{testLogs[3]},
+		},
+		{
+			description: "should filter by provider",
+			inputs:      listInputs{Providers: []string{"anon-user"}},
+			expected:    realm.Logs{testLogs[4]},
+		},
+		{
+			description: "should filter by a pattern matching the messages or error",
+			inputs:      listInputs{Grep: "^re"},
+			expected:    realm.Logs{testLogs[0], testLogs[3]},
+		},
+		{
+			description: "should filter by a pattern matching non-string messages",
+			inputs:      listInputs{Grep: "4[0-9]"},
+			expected:    realm.Logs{testLogs[1]},
+		},
+		{
+			description: "should filter by every filter set",
+			inputs:      listInputs{Functions: []string{"noisy"}, Triggers: []string{"onInsert"}},
+			expected:    realm.Logs{testLogs[2]},
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			if tc.inputs.Grep != "" {
+				tc.inputs.grep = regexp.MustCompile(tc.inputs.Grep)
+			}
+			assert.Equal(t, tc.expected, tc.inputs.filterLogs(testLogs))
+		})
+	}
+}
@@ -1,9 +1,9 @@
 package logs
 
 import (
+	"context"
 	"errors"
 	"fmt"
-	"os"
 	"strings"
 	"sync"
 	"testing"
@@ -11,6 +11,7 @@ import (
 
 	"github.com/10gen/realm-cli/internal/cli"
 	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/utils/flags"
 	"github.com/10gen/realm-cli/internal/utils/test/assert"
 	"github.com/10gen/realm-cli/internal/utils/test/mock"
 )
@@ -28,7 +29,7 @@ func TestLogsList(t *testing.T) {
 
 		cmd := &CommandList{listInputs{}}
 
-		err := cmd.Handler(nil, nil, cli.Clients{Realm: realmClient})
+		err := cmd.Handler(context.Background(), nil, nil, cli.Clients{Realm: realmClient})
 		assert.Equal(t, errors.New("something bad happened"), err)
 	})
 
@@ -43,7 +44,7 @@ func TestLogsList(t *testing.T) {
 
 		cmd := &CommandList{}
 
-		err := cmd.Handler(nil, nil, cli.Clients{Realm: realmClient})
+		err := cmd.Handler(context.Background(), nil, nil, cli.Clients{Realm: realmClient})
 		assert.Equal(t, errors.New("something bad happened"), err)
 	})
 
@@ -82,7 +83,7 @@ func TestLogsList(t *testing.T) {
 
 		cmd := &CommandList{listInputs{ProjectInputs: cli.ProjectInputs{Project: "project", App: "test-app"}}}
 
-		err := cmd.Handler(nil, ui, cli.Clients{Realm: realmClient})
+		err := cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient})
 		assert.Nil(t, err)
 
 		assert.Equal(t, `2019-06-22T07:54:42.000+0000     [5ms]             Authentication: TestError - something bad happened
@@ -97,8 +98,141 @@ func TestLogsList(t *testing.T) {
 	})
 }
 
+func TestLogsListFilters(t *testing.T) {
+	testLogs := realm.Logs{
+		{
+			Type:         realm.LogTypeFunction,
+			Messages:     []interface{}{"retrying request"},
+			Started:      time.Date(2021, time.June, 22, 7, 54, 44, 0, time.UTC),
+			Completed:    time.Date(2021, time.June, 22, 7, 54, 44, 5_000_000, time.UTC),
+			FunctionName: "noisy",
+		},
+		{
+			Type:         realm.LogTypeFunction,
+			Messages:     []interface{}{"request succeeded"},
+			Started:      time.Date(2021, time.June, 22, 7, 54, 43, 0, time.UTC),
+			Completed:    time.Date(2021, time.June, 22, 7, 54, 43, 5_000_000, time.UTC),
+			FunctionName: "noisy",
+		},
+		{
+			Type:         realm.LogTypeFunction,
+			Messages:     []interface{}{"retrying request"},
+			Started:      time.Date(2021, time.June, 22, 7, 54, 42, 0, time.UTC),
+			Completed:    time.Date(2021, time.June, 22, 7, 54, 42, 5_000_000, time.UTC),
+			FunctionName: "quiet",
+		},
+	}
+
+	t.Run("should print only the logs matching the filters", func(t *testing.T) {
+		realmClient := mock.RealmClient{}
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{{}}, nil
+		}
+		realmClient.LogsPageFn = func(groupID, appID string, opts realm.LogsOptions) (realm.LogsPage, error) {
+			return realm.LogsPage{Logs: testLogs}, nil
+		}
+
+		out, ui := mock.NewUI()
+
+		cmd := &CommandList{listInputs{Functions: []string{"noisy"}, Grep: "^retry"}}
+		assert.Nil(t, cmd.inputs.Resolve(mock.NewProfileFromWd(t), ui))
+
+		assert.Nil(t, cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, `2021-06-22T07:54:44.000+0000     [5ms]                   Function noisy: OK
+  retrying request
+`, out.String())
+	})
+
+	t.Run("should print the logs matching the filters from every page of logs within the date range", func(t *testing.T) {
+		start := time.Date(2021, time.June, 22, 7, 54, 0, 0, time.UTC)
+
+		realmClient := mock.RealmClient{}
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{{}}, nil
+		}
+
+		var pages []realm.LogsOptions
+		realmClient.LogsPageFn = func(groupID, appID string, opts realm.LogsOptions) (realm.LogsPage, error) {
+			pages = append(pages, opts)
+			switch len(pages) {
+			case 1:
+				return realm.LogsPage{Logs: testLogs[:1], NextEndDate: testLogs[1].Started}, nil
+			case 2:
+				return realm.LogsPage{Logs: testLogs[1:], NextEndDate: start.Add(-time.Second), NextSkip: 1}, nil
+			}
+			return realm.LogsPage{}, errors.New("must not page before the start date")
+		}
+
+		out, ui := mock.NewUI()
+
+		cmd := &CommandList{listInputs{Start: flags.Date{Time: start}, Functions: []string{"noisy"}}}
+		assert.Nil(t, cmd.inputs.Resolve(mock.NewProfileFromWd(t), ui))
+
+		assert.Nil(t, cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, 2, len(pages))
+		assert.Equal(t, testLogs[1].Started, pages[1].End)
+		assert.Equal(t, `2021-06-22T07:54:43.000+0000     [5ms]                   Function noisy: OK
+  request succeeded
+2021-06-22T07:54:44.000+0000     [5ms]                   Function noisy: OK
+  retrying request
+`, out.String())
+	})
+
+	t.Run("should print only the logs matching the filters while tailing", func(t *testing.T) {
+		origInterval, origMinInterval := tailInterval, tailMinInterval
+		defer func() { tailInterval, tailMinInterval = origInterval, origMinInterval }()
+		tailInterval, tailMinInterval = 10*time.Millisecond, 10*time.Millisecond
+
+		var wg sync.WaitGroup
+		wg.Add(3)
+
+		realmClient := mock.RealmClient{}
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{{}}, nil
+		}
+
+		var calls int
+		setLogsFn(&realmClient, func(groupID, appID string, opts realm.LogsOptions) (realm.Logs, error) {
+			calls++
+			if calls <= 3 {
+				defer wg.Done() // cancel once the tailed logs are printed and polled for again
+			}
+			switch calls {
+			case 1:
+				return testLogs[2:], nil
+			case 2:
+				return testLogs[:2], nil
+			}
+			return nil, nil
+		})
+
+		out, ui := mock.NewUI()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		go func() {
+			wg.Wait()
+			cancel()
+		}()
+
+		cmd := &CommandList{listInputs{Tail: true, Functions: []string{"noisy"}}}
+
+		assert.Nil(t, cmd.Handler(ctx, nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, `2021-06-22T07:54:43.000+0000     [5ms]                   Function noisy: OK
+  request succeeded
+2021-06-22T07:54:44.000+0000     [5ms]                   Function noisy: OK
+  retrying request
+`, out.String())
+	})
+}
+
 func TestLogsListTail(t *testing.T) {
-	t.Run("should poll for logs until a shutdown signal is received", func(t *testing.T) {
+	origInterval, origMinInterval, origMaxInterval := tailInterval, tailMinInterval, tailMaxInterval
+	defer func() {
+		tailInterval, tailMinInterval, tailMaxInterval = origInterval, origMinInterval, origMaxInterval
+	}()
+	tailInterval, tailMinInterval, tailMaxInterval = 10*time.Millisecond, 10*time.Millisecond, 40*time.Millisecond
+
+	t.Run("should poll for logs until the context is cancelled", func(t *testing.T) {
 		var logIdx int
 		testLogs := []realm.Logs{
 			{{
@@ -131,7 +265,10 @@ func TestLogsListTail(t *testing.T) {
 			return []realm.App{{}}, nil
 		}
 
-		realmClient.LogsFn = func(groupID, appID string, opts realm.LogsOptions) (realm.Logs, error) {
+		setLogsFn(&realmClient, func(groupID, appID string, opts realm.LogsOptions) (realm.Logs, error) {
+			if logIdx == len(testLogs) {
+				return nil, nil // if polled again before the context is cancelled
+			}
 			logs := testLogs[logIdx]
 			startDates[logIdx] = opts.Start
 
@@ -139,20 +276,20 @@ func TestLogsListTail(t *testing.T) {
 			logIdx++
 
 			return logs, nil
-		}
+		})
 
 		out, ui := mock.NewUI()
 
-		sigShutdown := make(chan os.Signal, 1)
+		ctx, cancel := context.WithCancel(context.Background())
 		go func() {
 			wg.Wait()
-			sigShutdown <- os.Interrupt
+			cancel()
 		}()
 
-		cmd := &CommandList{listInputs{sigShutdown: sigShutdown, Tail: true}}
+		cmd := &CommandList{listInputs{Tail: true}}
 
 		cmdStart := time.Now()
-		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Nil(t, cmd.Handler(ctx, nil, ui, cli.Clients{Realm: realmClient}))
 
 		assert.Equal(t, `2019-06-22T07:54:42.000+0000     [5ms]             Authentication: OK
   initial log
@@ -163,9 +300,9 @@ func TestLogsListTail(t *testing.T) {
 `, out.String())
 
 		assert.Equal(t, time.Time{}, startDates[0])
-		for i := 0; i < len(startDates)-1; i++ {
-			expectedStart := cmdStart.Add(time.Duration(5*i) * time.Second).Format(testDateFormat)
-			actualStart := startDates[i+1].Format(testDateFormat)
+		for i := 1; i < len(startDates); i++ {
+			expectedStart := cmdStart.Add(-tailOverlap).Format(testDateFormat)
+			actualStart := startDates[i].Format(testDateFormat)
 
 			assert.Equal(t, expectedStart, actualStart)
 		}
@@ -212,19 +349,19 @@ func TestLogsListTail(t *testing.T) {
 		}
 
 		var counter int
-		realmClient.LogsFn = func(groupID, appID string, opts realm.LogsOptions) (realm.Logs, error) {
+		setLogsFn(&realmClient, func(groupID, appID string, opts realm.LogsOptions) (realm.Logs, error) {
 			defer func() { counter++ }()
 			if counter < len(testLogs) {
 				return testLogs[counter], nil
 			}
 			return nil, errors.New("something bad happened")
-		}
+		})
 
 		out, ui := mock.NewUI()
 
 		cmd := &CommandList{listInputs{Tail: true}}
 
-		err := cmd.Handler(nil, ui, cli.Clients{Realm: realmClient})
+		err := cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient})
 		assert.Equal(t, errors.New("something bad happened"), err)
 
 		assert.Equal(t, `2020-06-22T07:54:42.000+0000     [5ms]             Authentication: OK
@@ -235,6 +372,174 @@ func TestLogsListTail(t *testing.T) {
   tailed log
 `, out.String())
 	})
+
+	t.Run("should retry polling for logs when an api call returns a transient error", func(t *testing.T) {
+		tailedLog := realm.Log{
+			ID:        "tailed",
+			Type:      realm.LogTypeAuth,
+			Started:   time.Date(2022, time.June, 22, 7, 54, 42, 0, time.UTC),
+			Completed: time.Date(2022, time.June, 22, 7, 54, 42, 5_000_000, time.UTC),
+			Messages:  []interface{}{"tailed log"},
+		}
+
+		realmClient := mock.RealmClient{}
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{{}}, nil
+		}
+
+		var calls int
+		setLogsFn(&realmClient, func(groupID, appID string, opts realm.LogsOptions) (realm.Logs, error) {
+			calls++
+			switch calls {
+			case 1:
+				return nil, nil
+			case 2, 3:
+				return nil, errors.New("something transient happened")
+			case 4:
+				return realm.Logs{tailedLog}, nil
+			}
+			return nil, errors.New("something bad happened")
+		})
+
+		out, ui := mock.NewUI()
+
+		cmd := &CommandList{listInputs{Tail: true}}
+
+		err := cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient})
+		assert.Equal(t, errors.New("something bad happened"), err)
+		assert.Equal(t, 5+tailMaxRetries, calls)
+
+		assert.Equal(t, `2022-06-22T07:54:42.000+0000     [5ms]             Authentication: OK
+  tailed log
+`, out.String())
+	})
+
+	t.Run("should poll for every page of logs since shortly before the latest log seen", func(t *testing.T) {
+		newLog := func(started time.Time, message string) realm.Log {
+			return realm.Log{
+				Type:      realm.LogTypeAuth,
+				Started:   started,
+				Completed: started.Add(5 * time.Millisecond),
+				Messages:  []interface{}{message},
+			}
+		}
+
+		realmClient := mock.RealmClient{}
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{{}}, nil
+		}
+		realmClient.LogsFn = func(groupID, appID string, opts realm.LogsOptions) (realm.Logs, error) {
+			return nil, nil
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		var pages []realm.LogsOptions
+		realmClient.LogsPageFn = func(groupID, appID string, opts realm.LogsOptions) (realm.LogsPage, error) {
+			pages = append(pages, opts)
+
+			latest := opts.Start.Add(time.Minute)
+			switch len(pages) {
+			case 1:
+				return realm.LogsPage{
+					Logs:        realm.Logs{newLog(latest, "latest log")},
+					NextEndDate: latest.Add(-time.Second),
+					NextSkip:    1,
+				}, nil
+			case 2:
+				return realm.LogsPage{
+					Logs:        realm.Logs{newLog(opts.End, "earlier log")},
+					NextEndDate: opts.Start.Add(-time.Second),
+					NextSkip:    1,
+				}, nil
+			}
+			cancel()
+			return realm.LogsPage{}, nil
+		}
+
+		out, ui := mock.NewUI()
+
+		cmd := &CommandList{listInputs{Tail: true}}
+
+		assert.Nil(t, cmd.Handler(ctx, nil, ui, cli.Clients{Realm: realmClient}))
+
+		assert.Equal(t, 3, len(pages))
+		assert.Equal(t, pages[0].Start, pages[1].Start)
+		assert.Equal(t, pages[1].Start.Add(time.Minute-time.Second), pages[1].End)
+		assert.Equal(t, 1, pages[1].Skip)
+
+		lines := strings.Split(out.String(), "\n")
+		assert.Equal(t, "  earlier log", lines[1])
+		assert.Equal(t, "  latest log", lines[3])
+	})
+}
+
+// setLogsFn mocks both listing the logs and polling for each page of logs with fn,
+// returning the logs of fn as a single page
+func setLogsFn(realmClient *mock.RealmClient, fn func(groupID, appID string, opts realm.LogsOptions) (realm.Logs, error)) {
+	realmClient.LogsFn = fn
+	realmClient.LogsPageFn = func(groupID, appID string, opts realm.LogsOptions) (realm.LogsPage, error) {
+		logs, err := fn(groupID, appID, opts)
+		return realm.LogsPage{Logs: logs}, err
+	}
+}
+
+func TestLogsTail(t *testing.T) {
+	start := time.Date(2022, time.June, 22, 7, 54, 0, 0, time.UTC)
+
+	newLog := func(id string, started time.Time, message string) realm.Log {
+		return realm.Log{ID: id, Type: realm.LogTypeFunction, Started: started, Completed: started, Messages: []interface{}{message}}
+	}
+
+	t.Run("should poll from shortly before the latest log seen", func(t *testing.T) {
+		tail := newLogsTail(start, nil)
+		assert.Equal(t, start.Add(-tailOverlap), tail.start())
+
+		tail.unseen(realm.Logs{newLog("a", start.Add(time.Minute), "a")})
+		assert.Equal(t, start.Add(time.Minute-tailOverlap), tail.start())
+
+		tail.unseen(realm.Logs{newLog("b", start.Add(30*time.Second), "b")})
+		assert.Equal(t, start.Add(time.Minute-tailOverlap), tail.start())
+	})
+
+	t.Run("should return only the logs not seen before", func(t *testing.T) {
+		logA := newLog("a", start.Add(time.Second), "a")
+		logB := newLog("b", start.Add(time.Second), "b")
+		logC := newLog("", start.Add(2*time.Second), "c")
+		logD := newLog("", start.Add(2*time.Second), "d")
+
+		tail := newLogsTail(start, realm.Logs{logA, logC})
+
+		assert.Equal(t, realm.Logs{logB, logD}, tail.unseen(realm.Logs{logA, logB, logC, logD}))
+		assert.Equal(t, realm.Logs{}, tail.unseen(realm.Logs{logA, logB, logC, logD}))
+	})
+
+	t.Run("should forget the logs which can no longer be polled for", func(t *testing.T) {
+		tail := newLogsTail(start, realm.Logs{newLog("a", start, "a")})
+		assert.Equal(t, 1, len(tail.seen))
+
+		tail.unseen(realm.Logs{newLog("b", start.Add(time.Minute), "b")})
+		assert.Equal(t, map[string]time.Time{"b": start.Add(time.Minute)}, tail.seen)
+	})
+}
+
+func TestLogsTailInterval(t *testing.T) {
+	origMinInterval, origMaxInterval := tailMinInterval, tailMaxInterval
+	defer func() { tailMinInterval, tailMaxInterval = origMinInterval, origMaxInterval }()
+	tailMinInterval, tailMaxInterval = time.Second, 30*time.Second
+
+	t.Run("should back off up to the max interval", func(t *testing.T) {
+		assert.Equal(t, 10*time.Second, backOffInterval(5*time.Second))
+		assert.Equal(t, 30*time.Second, backOffInterval(20*time.Second))
+		assert.Equal(t, 30*time.Second, backOffInterval(30*time.Second))
+	})
+
+	t.Run("should speed up down to the min interval", func(t *testing.T) {
+		assert.Equal(t, 5*time.Second, speedUpInterval(10*time.Second))
+		assert.Equal(t, time.Second, speedUpInterval(1500*time.Millisecond))
+		assert.Equal(t, time.Second, speedUpInterval(time.Second))
+	})
 }
 
 func TestLogNameDisplay(t *testing.T) {
@@ -403,7 +708,7 @@ func TestLogTypeFormat(t *testing.T) {
 		logTypes := []string{realm.LogTypeSchemaAdditiveChange, realm.LogTypeSchemaGeneration, realm.LogTypeSchemaValidation}
 
 		cmd := &CommandList{typeInputs}
-		assert.Nil(t, cmd.Handler(nil, nil, cli.Clients{Realm: realmClient}))
+		assert.Nil(t, cmd.Handler(context.Background(), nil, nil, cli.Clients{Realm: realmClient}))
 
 		assert.Equal(t, logTypes, logsOpts.Types)
 	})
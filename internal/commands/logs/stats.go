@@ -0,0 +1,338 @@
+package logs
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/terminal"
+	"github.com/10gen/realm-cli/internal/utils/flags"
+)
+
+const (
+	headerType         = "Type"
+	headerFunction     = "Function"
+	headerErrorCode    = "Error Code"
+	headerCount        = "Count"
+	headerErrors       = "Errors"
+	headerErrorRate    = "Error Rate"
+	headerDurationP50  = "p50"
+	headerDurationP95  = "p95"
+	headerDurationMax  = "Max"
+	headerMemTimeUsage = "Mem Time Usage"
+)
+
+// CommandMetaStats is the command meta for the `logs stats` command
+var CommandMetaStats = cli.CommandMeta{
+	Use:         "stats",
+	Aliases:     []string{},
+	Display:     "logs stats",
+	Description: "Summarize the Logs in your Realm app",
+	HelpText: `Fetches every Log of your Realm app within a date range, by default the last
+hour, and reports statistics on them grouped by their type, function, and error
+code. For each group, the report includes the count of Logs, the count and rate
+of errors, the p50, p95, and max execution duration, and the total memory time
+usage.
+
+Set "--by" to group the Logs by fewer of these, such as "--by function" to find
+which function is failing and how slow it is.`,
+}
+
+// CommandStats is the `logs stats` command
+type CommandStats struct {
+	inputs statsInputs
+}
+
+// logStats are the statistics of a group of Realm app logs
+type logStats struct {
+	Type          string  `json:"type,omitempty"`
+	Function      string  `json:"function,omitempty"`
+	ErrorCode     string  `json:"error_code,omitempty"`
+	Count         int     `json:"count"`
+	Errors        int     `json:"errors"`
+	ErrorRate     float64 `json:"error_rate"`
+	DurationP50MS *int64  `json:"duration_p50_ms,omitempty"` // nil without any completed logs
+	DurationP95MS *int64  `json:"duration_p95_ms,omitempty"`
+	DurationMaxMS *int64  `json:"duration_max_ms,omitempty"`
+	MemTimeUsage  int64   `json:"mem_time_usage"`
+
+	durations []time.Duration
+}
+
+// Flags is the command flags
+func (cmd *CommandStats) Flags() []flags.Flag {
+	return []flags.Flag{
+		cli.AppFlagWithContext(&cmd.inputs.App, "to summarize its logs"),
+		cli.ProjectFlag(&cmd.inputs.Project),
+		cli.ProductFlag(&cmd.inputs.Products),
+		flags.NewStringSetFlag(
+			&cmd.inputs.Types,
+			flags.StringSetOptions{
+				Meta: flags.Meta{
+					Name: "type",
+					Usage: flags.Usage{
+						Description: "Specify the type(s) of logs to summarize",
+					},
+				},
+				ValidValues: logTypeValues,
+			},
+		),
+		flags.BoolFlag{
+			Value: &cmd.inputs.Errors,
+			Meta: flags.Meta{
+				Name: "errors",
+				Usage: flags.Usage{
+					Description: "Summarize your Realm app's error logs",
+				},
+			},
+		},
+		flags.CustomFlag{
+			Value: &cmd.inputs.Start,
+			Meta: flags.Meta{
+				Name: flagStart,
+				Usage: flags.Usage{
+					Description:   "Specify when to begin summarizing logs",
+					Note:          flags.DateNote,
+					AllowedFormat: flags.DateAllowedFormat,
+				},
+			},
+		},
+		flags.CustomFlag{
+			Value: &cmd.inputs.End,
+			Meta: flags.Meta{
+				Name: flagEnd,
+				Usage: flags.Usage{
+					Description:   "Specify when to finish summarizing logs",
+					Note:          flags.DateNote,
+					AllowedFormat: flags.DateAllowedFormat,
+				},
+			},
+		},
+		flags.CustomFlag{
+			Value: &cmd.inputs.Since,
+			Meta: flags.Meta{
+				Name: flagSince,
+				Usage: flags.Usage{
+					Description:   `Specify when to begin summarizing logs, such as "15m" for the last 15 minutes`,
+					AllowedFormat: flags.DateAllowedFormat,
+				},
+			},
+		},
+		flags.CustomFlag{
+			Value: &cmd.inputs.Until,
+			Meta: flags.Meta{
+				Name: flagUntil,
+				Usage: flags.Usage{
+					Description:   `Specify when to finish summarizing logs, such as "10m" for up to 10 minutes ago`,
+					AllowedFormat: flags.DateAllowedFormat,
+				},
+			},
+		},
+		flags.NewStringSetFlag(
+			&cmd.inputs.By,
+			flags.StringSetOptions{
+				Meta: flags.Meta{
+					Name: "by",
+					Usage: flags.Usage{
+						Description: "Specify what to group the logs by",
+						Note:        "The logs are grouped by all of these by default",
+					},
+				},
+				ValidValues: statsByValues,
+			},
+		),
+	}
+}
+
+// Inputs is the command inputs
+func (cmd *CommandStats) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandStats) Handler(ctx context.Context, profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, err := cli.ResolveApp(ctx, ui, clients.Realm, cli.AppOptions{
+		AppMeta: cmd.inputs.AppMeta,
+		Filter:  cmd.inputs.Filter(),
+	})
+	if err != nil {
+		return err
+	}
+
+	aggregator := newLogsAggregator(cmd.inputs)
+	if err := eachLogsPage(ctx, clients.Realm, app.GroupID, app.ID, realm.LogsOptions{
+		Types:      realmLogTypes(cmd.inputs.Types),
+		ErrorsOnly: cmd.inputs.Errors,
+		Start:      cmd.inputs.Start.Time,
+		End:        cmd.inputs.End.Time,
+	}, func(logs realm.Logs) error {
+		for _, log := range logs {
+			aggregator.add(log)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	if aggregator.count == 0 {
+		ui.Print(terminal.NewTextLog("No logs found to summarize"))
+		return nil
+	}
+
+	stats := aggregator.stats()
+
+	message := fmt.Sprintf("Summarized %d log(s)", aggregator.count)
+	if !cmd.inputs.Start.Time.IsZero() {
+		message += " since " + cmd.inputs.Start.String()
+	}
+	if !cmd.inputs.End.Time.IsZero() {
+		message += " until " + cmd.inputs.End.String()
+	}
+
+	if ui.OutputFormat() == terminal.OutputFormatJSON {
+		ui.Print(terminal.NewJSONLog(message, stats))
+		return nil
+	}
+
+	var headers []string
+	if cmd.inputs.groupBy(statsByType) {
+		headers = append(headers, headerType)
+	}
+	if cmd.inputs.groupBy(statsByFunction) {
+		headers = append(headers, headerFunction)
+	}
+	if cmd.inputs.groupBy(statsByErrorCode) {
+		headers = append(headers, headerErrorCode)
+	}
+	headers = append(headers,
+		headerCount,
+		headerErrors,
+		headerErrorRate,
+		headerDurationP50,
+		headerDurationP95,
+		headerDurationMax,
+		headerMemTimeUsage,
+	)
+
+	rows := make([]map[string]interface{}, 0, len(stats))
+	for _, s := range stats {
+		rows = append(rows, map[string]interface{}{
+			headerType:         logTypeDisplay(realm.Log{Type: s.Type}),
+			headerFunction:     s.Function,
+			headerErrorCode:    s.ErrorCode,
+			headerCount:        s.Count,
+			headerErrors:       s.Errors,
+			headerErrorRate:    fmt.Sprintf("%.1f%%", s.ErrorRate*100),
+			headerDurationP50:  durationDisplay(s.DurationP50MS),
+			headerDurationP95:  durationDisplay(s.DurationP95MS),
+			headerDurationMax:  durationDisplay(s.DurationMaxMS),
+			headerMemTimeUsage: s.MemTimeUsage,
+		})
+	}
+
+	ui.Print(terminal.NewTableLog(message, headers, rows...))
+	return nil
+}
+
+func durationDisplay(ms *int64) interface{} {
+	if ms == nil {
+		return "-"
+	}
+	return time.Duration(*ms) * time.Millisecond
+}
+
+type statsKey struct{ logType, function, errorCode string }
+
+// logsAggregator groups the logs as they are fetched, so only the statistics of
+// each group are kept rather than every log
+type logsAggregator struct {
+	inputs     statsInputs
+	count      int
+	statsByKey map[statsKey]*logStats
+}
+
+func newLogsAggregator(inputs statsInputs) *logsAggregator {
+	return &logsAggregator{inputs: inputs, statsByKey: map[statsKey]*logStats{}}
+}
+
+func (a *logsAggregator) add(log realm.Log) {
+	var key statsKey
+	if a.inputs.groupBy(statsByType) {
+		key.logType = log.Type
+	}
+	if a.inputs.groupBy(statsByFunction) {
+		key.function = log.FunctionName
+		if key.function == "" {
+			key.function = log.FunctionID
+		}
+	}
+	if a.inputs.groupBy(statsByErrorCode) {
+		key.errorCode = log.ErrorCode
+	}
+
+	s, ok := a.statsByKey[key]
+	if !ok {
+		s = &logStats{Type: key.logType, Function: key.function, ErrorCode: key.errorCode}
+		a.statsByKey[key] = s
+	}
+
+	a.count++
+	s.Count++
+	if log.Error != "" {
+		s.Errors++
+	}
+	s.MemTimeUsage += log.MemTimeUsage
+	if !log.Completed.IsZero() { // logs without a completion time have no duration
+		s.durations = append(s.durations, log.Completed.Sub(log.Started))
+	}
+}
+
+// stats computes the statistics of each group, sorted by the most logs first
+func (a *logsAggregator) stats() []logStats {
+	stats := make([]logStats, 0, len(a.statsByKey))
+	for _, s := range a.statsByKey {
+		s.ErrorRate = float64(s.Errors) / float64(s.Count)
+		if len(s.durations) != 0 {
+			sort.Slice(s.durations, func(i, j int) bool { return s.durations[i] < s.durations[j] })
+
+			s.DurationP50MS = milliseconds(percentile(s.durations, 0.5))
+			s.DurationP95MS = milliseconds(percentile(s.durations, 0.95))
+			s.DurationMaxMS = milliseconds(s.durations[len(s.durations)-1])
+		}
+
+		stats = append(stats, *s)
+	}
+
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].Count != stats[j].Count {
+			return stats[i].Count > stats[j].Count
+		}
+		if stats[i].Type != stats[j].Type {
+			return stats[i].Type < stats[j].Type
+		}
+		if stats[i].Function != stats[j].Function {
+			return stats[i].Function < stats[j].Function
+		}
+		return stats[i].ErrorCode < stats[j].ErrorCode
+	})
+	return stats
+}
+
+func milliseconds(d time.Duration) *int64 {
+	ms := d.Milliseconds()
+	return &ms
+}
+
+// percentile returns the nearest-rank percentile of the sorted durations
+func percentile(durations []time.Duration, p float64) time.Duration {
+	rank := int(math.Ceil(p * float64(len(durations))))
+	if rank < 1 {
+		rank = 1
+	}
+	return durations[rank-1]
+}
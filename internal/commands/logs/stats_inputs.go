@@ -0,0 +1,70 @@
+package logs
+
+import (
+	"time"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/terminal"
+	"github.com/10gen/realm-cli/internal/utils/flags"
+)
+
+// set of supported log stats groupings
+const (
+	statsByType      = "type"
+	statsByFunction  = "function"
+	statsByErrorCode = "error-code"
+)
+
+const (
+	statsDefaultWindow = time.Hour
+)
+
+var statsByValues = []string{statsByType, statsByFunction, statsByErrorCode}
+
+type statsInputs struct {
+	cli.ProjectInputs
+	Types  []string
+	Errors bool
+	Start  flags.Date
+	End    flags.Date
+	Since  flags.Date
+	Until  flags.Date
+	By     []string
+}
+
+func (i *statsInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	if i.Start.Time.IsZero() && i.Since.Time.IsZero() {
+		// default to the window before the end date, or else before now
+		end := i.End.Time
+		if end.IsZero() {
+			end = i.Until.Time
+		}
+		if end.IsZero() {
+			if err := i.Since.Set(statsDefaultWindow.String()); err != nil {
+				return err
+			}
+		} else {
+			i.Since = flags.Date{end.Add(-statsDefaultWindow)}
+		}
+	}
+
+	if err := resolveDateRange(&i.Start, &i.End, i.Since, i.Until); err != nil {
+		return err
+	}
+
+	if len(i.By) == 0 {
+		i.By = statsByValues
+	}
+
+	return i.ProjectInputs.Resolve(ui, profile.WorkingDirectory, true)
+}
+
+func (i statsInputs) groupBy(by string) bool {
+	for _, b := range i.By {
+		if b == by {
+			return true
+		}
+	}
+	return false
+}
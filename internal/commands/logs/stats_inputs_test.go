@@ -0,0 +1,53 @@
+package logs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/10gen/realm-cli/internal/utils/flags"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestLogsStatsInputsResolve(t *testing.T) {
+	t.Run("should default to grouping by everything over the last hour", func(t *testing.T) {
+		profile, teardown := mock.NewProfileFromTmpDir(t, "logs_stats_inputs_test")
+		defer teardown()
+
+		var i statsInputs
+
+		before := time.Now()
+		assert.Nil(t, i.Resolve(profile, nil))
+
+		assert.Equal(t, []string{statsByType, statsByFunction, statsByErrorCode}, i.By)
+		assert.True(t, !i.Start.Time.Before(before.Add(-time.Hour).Truncate(time.Millisecond)), "expected the start to be within the last hour")
+		assert.True(t, i.Start.Time.Before(time.Now().Add(-time.Hour).Add(time.Second)), "expected the start to be an hour ago")
+		assert.True(t, i.End.Time.IsZero(), "expected no end")
+	})
+
+	t.Run("should default to the hour before the end date", func(t *testing.T) {
+		profile, teardown := mock.NewProfileFromTmpDir(t, "logs_stats_inputs_test")
+		defer teardown()
+
+		until := flags.Date{time.Date(2021, time.June, 22, 8, 0, 0, 0, time.UTC)}
+
+		i := statsInputs{Until: until, By: []string{statsByFunction}}
+		assert.Nil(t, i.Resolve(profile, nil))
+
+		assert.Equal(t, []string{statsByFunction}, i.By)
+		assert.Equal(t, flags.Date{time.Date(2021, time.June, 22, 7, 0, 0, 0, time.UTC)}, i.Start)
+		assert.Equal(t, until, i.End)
+	})
+
+	t.Run("should keep the start date when set", func(t *testing.T) {
+		profile, teardown := mock.NewProfileFromTmpDir(t, "logs_stats_inputs_test")
+		defer teardown()
+
+		start := flags.Date{time.Date(2021, time.June, 21, 0, 0, 0, 0, time.UTC)}
+
+		i := statsInputs{Start: start}
+		assert.Nil(t, i.Resolve(profile, nil))
+
+		assert.Equal(t, start, i.Start)
+	})
+}
@@ -0,0 +1,183 @@
+package logs
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/utils/flags"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestLogsStats(t *testing.T) {
+	started := time.Date(2021, time.June, 22, 7, 54, 42, 0, time.UTC)
+
+	testLog := func(logType, functionName, errorCode string, duration time.Duration, memTimeUsage int64) realm.Log {
+		log := realm.Log{
+			Type:         logType,
+			FunctionName: functionName,
+			Started:      started,
+			Completed:    started.Add(duration),
+			MemTimeUsage: memTimeUsage,
+		}
+		if errorCode != "" {
+			log.ErrorCode = errorCode
+			log.Error = "something bad happened"
+		}
+		return log
+	}
+
+	testPages := []realm.LogsPage{
+		{
+			Logs: realm.Logs{
+				testLog(realm.LogTypeFunction, "noisy", "", 10*time.Millisecond, 100),
+				testLog(realm.LogTypeFunction, "noisy", "", 20*time.Millisecond, 100),
+				testLog(realm.LogTypeFunction, "noisy", "FunctionExecutionError", 1*time.Second, 300),
+			},
+			NextEndDate: started,
+			NextSkip:    3,
+		},
+		{
+			Logs: realm.Logs{
+				testLog(realm.LogTypeFunction, "noisy", "", 30*time.Millisecond, 100),
+				testLog(realm.LogTypeFunction, "quiet", "", 5*time.Millisecond, 50),
+				testLog(realm.LogTypeDBTrigger, "noisy", "", 40*time.Millisecond, 200),
+			},
+		},
+	}
+
+	newRealmClient := func(calls *[]realm.LogsOptions) mock.RealmClient {
+		realmClient := mock.RealmClient{}
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{{GroupID: "groupID", ID: "appID"}}, nil
+		}
+		realmClient.LogsPageFn = func(groupID, appID string, opts realm.LogsOptions) (realm.LogsPage, error) {
+			*calls = append(*calls, opts)
+			return testPages[len(*calls)-1], nil
+		}
+		return realmClient
+	}
+
+	start := flags.Date{time.Date(2021, time.June, 22, 7, 0, 0, 0, time.UTC)}
+
+	t.Run("should return an error when client fails to get logs", func(t *testing.T) {
+		realmClient := mock.RealmClient{}
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{{}}, nil
+		}
+		realmClient.LogsPageFn = func(groupID, appID string, opts realm.LogsOptions) (realm.LogsPage, error) {
+			return realm.LogsPage{}, errors.New("something bad happened")
+		}
+
+		cmd := &CommandStats{}
+
+		err := cmd.Handler(context.Background(), nil, nil, cli.Clients{Realm: realmClient})
+		assert.Equal(t, errors.New("something bad happened"), err)
+	})
+
+	t.Run("should print a message without any logs", func(t *testing.T) {
+		realmClient := mock.RealmClient{}
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{{}}, nil
+		}
+		realmClient.LogsPageFn = func(groupID, appID string, opts realm.LogsOptions) (realm.LogsPage, error) {
+			return realm.LogsPage{}, nil
+		}
+
+		out, ui := mock.NewUI()
+
+		cmd := &CommandStats{}
+		assert.Nil(t, cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, "No logs found to summarize\n", out.String())
+	})
+
+	t.Run("should follow each page of logs and print a table of their statistics", func(t *testing.T) {
+		var calls []realm.LogsOptions
+
+		out, ui := mock.NewUI()
+
+		cmd := &CommandStats{statsInputs{Start: start, By: statsByValues}}
+		assert.Nil(t, cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: newRealmClient(&calls)}))
+
+		assert.Equal(t, []realm.LogsOptions{
+			{Start: start.Time},
+			{Start: start.Time, End: started, Skip: 3},
+		}, calls)
+
+		assert.Equal(t, `Summarized 6 log(s) since 2021-06-22T07:00:00.000+0000
+  Type                 Function  Error Code              Count  Errors  Error Rate  p50   p95   Max   Mem Time Usage
+  -------------------  --------  ----------------------  -----  ------  ----------  ----  ----  ----  --------------
+  Function             noisy                             3      0       0.0%        20ms  30ms  30ms  300           
+  Trigger -> Database  noisy                             1      0       0.0%        40ms  40ms  40ms  200           
+  Function             noisy     FunctionExecutionError  1      1       100.0%      1s    1s    1s    300           
+  Function             quiet                             1      0       0.0%        5ms   5ms   5ms   50            
+`, out.String())
+	})
+
+	t.Run("should group the logs by function", func(t *testing.T) {
+		var calls []realm.LogsOptions
+
+		out := new(bytes.Buffer)
+		ui := mock.NewUIWithOptions(mock.UIOptions{UseJSON: true}, out)
+
+		cmd := &CommandStats{statsInputs{Start: start, By: []string{statsByFunction}}}
+		assert.Nil(t, cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: newRealmClient(&calls)}))
+
+		assert.Equal(t, `{"time":"1989-06-22T01:23:45Z","level":"info","message":"Summarized 6 log(s) since 2021-06-22T07:00:00.000+0000","doc":[{"function":"noisy","count":5,"errors":1,"error_rate":0.2,"duration_p50_ms":30,"duration_p95_ms":1000,"duration_max_ms":1000,"mem_time_usage":800},{"function":"quiet","count":1,"errors":0,"error_rate":0,"duration_p50_ms":5,"duration_p95_ms":5,"duration_max_ms":5,"mem_time_usage":50}]}
+`, out.String())
+	})
+
+	t.Run("should not compute the duration of logs without a completion time", func(t *testing.T) {
+		realmClient := mock.RealmClient{}
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{{}}, nil
+		}
+		realmClient.LogsPageFn = func(groupID, appID string, opts realm.LogsOptions) (realm.LogsPage, error) {
+			return realm.LogsPage{Logs: realm.Logs{
+				testLog(realm.LogTypeFunction, "running", "", 10*time.Millisecond, 100),
+				{Type: realm.LogTypeFunction, FunctionName: "running", Started: started},
+				{Type: realm.LogTypeFunction, FunctionName: "pending", Started: started},
+			}}, nil
+		}
+
+		out := new(bytes.Buffer)
+		ui := mock.NewUIWithOptions(mock.UIOptions{UseJSON: true}, out)
+
+		cmd := &CommandStats{statsInputs{By: []string{statsByFunction}}}
+		assert.Nil(t, cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient}))
+
+		assert.Equal(t, `{"time":"1989-06-22T01:23:45Z","level":"info","message":"Summarized 3 log(s)","doc":[{"function":"running","count":2,"errors":0,"error_rate":0,"duration_p50_ms":10,"duration_p95_ms":10,"duration_max_ms":10,"mem_time_usage":100},{"function":"pending","count":1,"errors":0,"error_rate":0,"mem_time_usage":0}]}
+`, out.String())
+
+		out, ui = mock.NewUI()
+
+		assert.Nil(t, cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, `Summarized 3 log(s)
+  Function  Count  Errors  Error Rate  p50   p95   Max   Mem Time Usage
+  --------  -----  ------  ----------  ----  ----  ----  --------------
+  running   2      0       0.0%        10ms  10ms  10ms  100           
+  pending   1      0       0.0%        -     -     -     0             
+`, out.String())
+	})
+}
+
+func TestLogsStatsPercentile(t *testing.T) {
+	durations := []time.Duration{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+
+	for _, tc := range []struct {
+		p        float64
+		expected time.Duration
+	}{
+		{0, 1},
+		{0.5, 5},
+		{0.95, 10},
+		{1, 10},
+	} {
+		assert.Equal(t, tc.expected, percentile(durations, tc.p))
+	}
+}
@@ -0,0 +1,95 @@
+package profile
+
+import (
+	"context"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/terminal"
+	"github.com/10gen/realm-cli/internal/utils/flags"
+
+	"github.com/AlecAivazis/survey/v2"
+)
+
+// CommandMetaCreate is the command meta for the `profiles create` command
+var CommandMetaCreate = cli.CommandMeta{
+	Use:         "create",
+	Display:     "profiles create",
+	Description: "Create a profile for your local CLI environment",
+	HelpText: `Creates a new profile with the default Realm and Atlas base URLs. To start
+using the profile for all subsequent commands, pass the "--use" flag or run
+"realm-cli profiles use".
+
+NOTE: To log in with the new profile, use "realm-cli login --profile <name>"`,
+}
+
+type createInputs struct {
+	Name string
+	Use  bool
+}
+
+func (i *createInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	if i.Name == "" {
+		if err := ui.AskOne(&i.Name, &survey.Input{Message: "Profile Name"}); err != nil {
+			return err
+		}
+	}
+	return user.ValidateProfileName(i.Name)
+}
+
+// CommandCreate is the `profiles create` command
+type CommandCreate struct {
+	inputs createInputs
+}
+
+// Flags is the command flags
+func (cmd *CommandCreate) Flags() []flags.Flag {
+	return []flags.Flag{
+		nameFlag(&cmd.inputs.Name, "Name the profile"),
+		flags.BoolFlag{
+			Value: &cmd.inputs.Use,
+			Meta: flags.Meta{
+				Name: "use",
+				Usage: flags.Usage{
+					Description: "Use the profile for all subsequent commands",
+				},
+			},
+		},
+	}
+}
+
+// Inputs is the command inputs
+func (cmd *CommandCreate) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandCreate) Handler(ctx context.Context, profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	newProfile, err := user.NewProfile(cmd.inputs.Name)
+	if err != nil {
+		return err
+	}
+
+	exists, err := newProfile.Exists()
+	if err != nil {
+		return err
+	}
+	if exists || newProfile.Name == profile.Name {
+		return errProfileExists(newProfile.Name)
+	}
+
+	if err := newProfile.ResolveFlags(); err != nil {
+		return err
+	}
+
+	if cmd.inputs.Use {
+		if err := user.SetCurrentProfileName(newProfile.Name); err != nil {
+			return err
+		}
+		ui.Print(terminal.NewTextLog("Successfully created profile: %s (now in use)", newProfile.Name))
+		return nil
+	}
+
+	ui.Print(terminal.NewTextLog("Successfully created profile: %s", newProfile.Name))
+	return nil
+}
@@ -0,0 +1,74 @@
+package profile
+
+import (
+	"context"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	u "github.com/10gen/realm-cli/internal/utils/test"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestProfileCreateHandler(t *testing.T) {
+	tmpDir, teardownTmpDir, tmpDirErr := u.NewTempDir("home")
+	assert.Nil(t, tmpDirErr)
+	defer teardownTmpDir()
+
+	_, teardownHomeDir := u.SetupHomeDir(tmpDir)
+	defer teardownHomeDir()
+
+	profile, profileErr := user.NewDefaultProfile()
+	assert.Nil(t, profileErr)
+	assert.Nil(t, profile.Save())
+
+	t.Run("should create a new profile with the default settings", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		cmd := &CommandCreate{createInputs{Name: "staging"}}
+		assert.Nil(t, cmd.Handler(context.Background(), profile, ui, cli.Clients{}))
+
+		assert.Equal(t, "Successfully created profile: staging\n", out.String())
+
+		created, err := user.NewProfile("staging")
+		assert.Nil(t, err)
+		assert.Nil(t, created.Load())
+
+		assert.Equal(t, "https://realm.mongodb.com", created.RealmBaseURL())
+		assert.Equal(t, "https://cloud.mongodb.com", created.AtlasBaseURL())
+
+		current, err := user.CurrentProfileName()
+		assert.Nil(t, err)
+		assert.Equal(t, user.DefaultProfile, current)
+	})
+
+	t.Run("should create a new profile and use it", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		cmd := &CommandCreate{createInputs{Name: "prod", Use: true}}
+		assert.Nil(t, cmd.Handler(context.Background(), profile, ui, cli.Clients{}))
+
+		assert.Equal(t, "Successfully created profile: prod (now in use)\n", out.String())
+
+		current, err := user.CurrentProfileName()
+		assert.Nil(t, err)
+		assert.Equal(t, "prod", current)
+	})
+
+	t.Run("should fail to create a profile that already exists", func(t *testing.T) {
+		_, ui := mock.NewUI()
+
+		cmd := &CommandCreate{createInputs{Name: "staging"}}
+		assert.Equal(t, errProfileExists("staging"), cmd.Handler(context.Background(), profile, ui, cli.Clients{}))
+	})
+}
+
+func TestProfileCreateInputs(t *testing.T) {
+	t.Run("should fail with an invalid profile name", func(t *testing.T) {
+		_, ui := mock.NewUI()
+
+		inputs := createInputs{Name: "../staging"}
+		assert.NotNil(t, inputs.Resolve(nil, ui))
+	})
+}
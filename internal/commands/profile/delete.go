@@ -0,0 +1,76 @@
+package profile
+
+import (
+	"context"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/terminal"
+	"github.com/10gen/realm-cli/internal/utils/flags"
+)
+
+// CommandMetaDelete is the command meta for the `profiles delete` command
+var CommandMetaDelete = cli.CommandMeta{
+	Use:         "delete",
+	Display:     "profiles delete",
+	Description: "Delete a profile from your local CLI environment",
+	HelpText: `Deletes the profile along with its stored credentials and hosting asset cache.
+If the deleted profile is currently in use, the default profile is used instead.`,
+}
+
+type deleteInputs struct {
+	Name string
+}
+
+func (i *deleteInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	if i.Name == "" {
+		name, err := resolveProfileName(ui, "Which profile would you like to delete?")
+		if err != nil {
+			return err
+		}
+		i.Name = name
+	}
+	return nil
+}
+
+// CommandDelete is the `profiles delete` command
+type CommandDelete struct {
+	inputs deleteInputs
+}
+
+// Flags is the command flags
+func (cmd *CommandDelete) Flags() []flags.Flag {
+	return []flags.Flag{
+		nameFlag(&cmd.inputs.Name, "Specify the name of the profile to delete"),
+	}
+}
+
+// Inputs is the command inputs
+func (cmd *CommandDelete) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandDelete) Handler(ctx context.Context, profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	p, err := loadProfile(profile, cmd.inputs.Name)
+	if err != nil {
+		return err
+	}
+
+	if !ui.AutoConfirm() {
+		proceed, err := ui.Confirm("Are you sure you want to delete profile '%s'?", p.Name)
+		if err != nil {
+			return err
+		}
+		if !proceed {
+			return nil
+		}
+	}
+
+	if err := p.Delete(); err != nil {
+		return err
+	}
+
+	ui.Print(terminal.NewTextLog("Successfully deleted profile: %s", p.Name))
+	return nil
+}
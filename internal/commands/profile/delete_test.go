@@ -0,0 +1,61 @@
+package profile
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	u "github.com/10gen/realm-cli/internal/utils/test"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestProfileDeleteHandler(t *testing.T) {
+	tmpDir, teardownTmpDir, tmpDirErr := u.NewTempDir("home")
+	assert.Nil(t, tmpDirErr)
+	defer teardownTmpDir()
+
+	_, teardownHomeDir := u.SetupHomeDir(tmpDir)
+	defer teardownHomeDir()
+
+	profile, profileErr := user.NewDefaultProfile()
+	assert.Nil(t, profileErr)
+	assert.Nil(t, profile.Save())
+
+	t.Run("should delete the profile along with its secrets", func(t *testing.T) {
+		staging, err := user.NewProfile("staging")
+		assert.Nil(t, err)
+		staging.SetCredentials(user.Credentials{PublicAPIKey: "public", PrivateAPIKey: "private"})
+		assert.Nil(t, staging.Save())
+		assert.Nil(t, user.SetCurrentProfileName("staging"))
+
+		out := new(bytes.Buffer)
+		ui := mock.NewUIWithOptions(mock.UIOptions{AutoConfirm: true}, out)
+
+		cmd := &CommandDelete{deleteInputs{Name: "staging"}}
+		assert.Nil(t, cmd.Handler(context.Background(), profile, ui, cli.Clients{}))
+
+		_, err = os.Stat(staging.Path())
+		assert.True(t, os.IsNotExist(err), "profile must be removed")
+
+		stored, err := user.StoredCredentials(user.CredentialStoreTypeVault, "staging")
+		assert.Nil(t, err)
+		assert.Equal(t, user.Credentials{}, stored)
+
+		current, err := user.CurrentProfileName()
+		assert.Nil(t, err)
+		assert.Equal(t, user.DefaultProfile, current)
+
+		assert.Equal(t, "Successfully deleted profile: staging\n", out.String())
+	})
+
+	t.Run("should fail to delete a profile that does not exist", func(t *testing.T) {
+		_, ui := mock.NewUI()
+
+		cmd := &CommandDelete{deleteInputs{Name: "prod"}}
+		assert.Equal(t, errProfileNotFound("prod"), cmd.Handler(context.Background(), profile, ui, cli.Clients{}))
+	})
+}
@@ -0,0 +1,18 @@
+package profile
+
+import (
+	"fmt"
+
+	"github.com/10gen/realm-cli/internal/cli/feedback"
+)
+
+func errProfileNotFound(name string) error {
+	return feedback.NewErr(
+		fmt.Errorf("profile '%s' does not exist", name),
+		feedback.ErrSuggestion{"Use \"realm-cli profiles list\" to see your existing profiles"},
+	)
+}
+
+func errProfileExists(name string) error {
+	return fmt.Errorf("profile '%s' already exists", name)
+}
@@ -0,0 +1,23 @@
+package profile
+
+import "github.com/10gen/realm-cli/internal/utils/flags"
+
+const (
+	flagName      = "name"
+	flagNameShort = "n"
+
+	flagNewName = "new-name"
+)
+
+func nameFlag(value *string, description string) flags.StringFlag {
+	return flags.StringFlag{
+		Value: value,
+		Meta: flags.Meta{
+			Name:      flagName,
+			Shorthand: flagNameShort,
+			Usage: flags.Usage{
+				Description: description,
+			},
+		},
+	}
+}
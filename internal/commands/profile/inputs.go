@@ -0,0 +1,53 @@
+package profile
+
+import (
+	"errors"
+
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/terminal"
+
+	"github.com/AlecAivazis/survey/v2"
+)
+
+// resolveProfileName prompts the user to select one of the existing profiles
+func resolveProfileName(ui terminal.UI, message string) (string, error) {
+	names, err := user.ProfileNames()
+	if err != nil {
+		return "", err
+	}
+
+	if len(names) == 0 {
+		return "", errors.New("no profiles found")
+	}
+
+	var name string
+	if err := ui.AskOne(&name, &survey.Select{Message: message, Options: names}); err != nil {
+		return "", err
+	}
+	return name, nil
+}
+
+// loadProfile loads the named profile, reusing the active profile when the names match
+func loadProfile(active *user.Profile, name string) (*user.Profile, error) {
+	if active != nil && active.Name == name {
+		return active, nil
+	}
+
+	profile, err := user.NewProfile(name)
+	if err != nil {
+		return nil, err
+	}
+
+	exists, err := profile.Exists()
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errProfileNotFound(name)
+	}
+
+	if err := profile.Load(); err != nil {
+		return nil, err
+	}
+	return profile, nil
+}
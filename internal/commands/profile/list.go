@@ -1,6 +1,7 @@
 package profile
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/10gen/realm-cli/internal/cli"
@@ -26,7 +27,7 @@ var CommandMetaList = cli.CommandMeta{
 type CommandList struct{}
 
 // Handler is the command handler
-func (cmd *CommandList) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+func (cmd *CommandList) Handler(ctx context.Context, profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
 	environments, err := local.LoadEnvironments()
 	if err != nil {
 		return err
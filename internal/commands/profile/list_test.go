@@ -1,6 +1,7 @@
 package profile
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -33,7 +34,7 @@ func TestProfileList(t *testing.T) {
 		out, ui := mock.NewUI()
 
 		cmd := &CommandList{}
-		assert.Nil(t, cmd.Handler(profile1, ui, cli.Clients{}))
+		assert.Nil(t, cmd.Handler(context.Background(), profile1, ui, cli.Clients{}))
 
 		assert.Equal(t,
 			fmt.Sprintf(`Found 2 profile(s)
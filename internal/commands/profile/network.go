@@ -0,0 +1,226 @@
+package profile
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/terminal"
+	"github.com/10gen/realm-cli/internal/utils/flags"
+)
+
+const (
+	flagUnset = "unset"
+
+	// the flags to set the network settings with are named apart from the global network flags,
+	// which apply the same settings to a single command instead
+	flagSetPrefix = "set-"
+)
+
+// CommandMetaNetwork is the command meta for the `profiles network` command
+var CommandMetaNetwork = cli.CommandMeta{
+	Use:         "network",
+	Display:     "profiles network",
+	Description: "Save the network settings of a profile of your local CLI environment",
+	HelpText: `Saves the proxy, CA bundle, client certificate, TLS verification, request retry
+and request timeout settings of the profile currently in use, or of the profile
+specified with the "--name" flag. Every subsequent command run with the profile
+uses these settings, unless overridden by the matching global flag for that
+command.
+
+Use "--set-<setting>", such as "--set-proxy", to save a setting to the profile,
+and "--unset" to remove settings from the profile.`,
+}
+
+type networkInputs struct {
+	Name               string
+	ProxyURL           user.ProxyURL
+	CABundle           user.FilePath
+	ClientCert         user.FilePath
+	ClientKey          user.FilePath
+	InsecureSkipVerify bool
+	MaxRetries         user.MaxRetries
+	RetryMaxWait       user.RetryMaxWait
+	Timeout            user.Timeout
+	Unset              []string
+}
+
+func (i *networkInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	if i.Name == "" {
+		i.Name = profile.Name
+	}
+
+	settings := i.settings()
+	if len(settings) == 0 && len(i.Unset) == 0 {
+		return errors.New("must set or unset at least one network setting")
+	}
+
+	for _, setting := range i.Unset {
+		if _, ok := settings[setting]; ok {
+			return fmt.Errorf("cannot both set and unset the %s setting", setting)
+		}
+	}
+	return nil
+}
+
+// settings returns the network settings to save, keyed by their flag name
+func (i networkInputs) settings() map[string]string {
+	settings := map[string]string{}
+	if i.ProxyURL != "" {
+		settings[user.FlagProxy] = i.ProxyURL.String()
+	}
+	if i.CABundle != "" {
+		settings[user.FlagCABundle] = i.CABundle.String()
+	}
+	if i.ClientCert != "" {
+		settings[user.FlagClientCert] = i.ClientCert.String()
+	}
+	if i.ClientKey != "" {
+		settings[user.FlagClientKey] = i.ClientKey.String()
+	}
+	if i.InsecureSkipVerify {
+		settings[user.FlagInsecureSkipVerify] = strconv.FormatBool(true)
+	}
+	if i.MaxRetries != "" {
+		settings[user.FlagMaxRetries] = i.MaxRetries.String()
+	}
+	if i.RetryMaxWait != "" {
+		settings[user.FlagRetryMaxWait] = i.RetryMaxWait.String()
+	}
+	if i.Timeout != "" {
+		settings[user.FlagTimeout] = i.Timeout.String()
+	}
+	return settings
+}
+
+// CommandNetwork is the `profiles network` command
+type CommandNetwork struct {
+	inputs networkInputs
+}
+
+// Flags is the command flags
+func (cmd *CommandNetwork) Flags() []flags.Flag {
+	return []flags.Flag{
+		nameFlag(&cmd.inputs.Name, "Specify the name of the profile to save the network settings of"),
+		flags.CustomFlag{
+			Value: &cmd.inputs.ProxyURL,
+			Meta: flags.Meta{
+				Name: flagSetPrefix + user.FlagProxy,
+				Usage: flags.Usage{
+					Description: "Save the URL of the HTTP proxy to send requests through",
+				},
+			},
+		},
+		flags.CustomFlag{
+			Value: &cmd.inputs.CABundle,
+			Meta: flags.Meta{
+				Name: flagSetPrefix + user.FlagCABundle,
+				Usage: flags.Usage{
+					Description: "Save the path to a PEM file of additional root CA certificates to trust",
+				},
+			},
+		},
+		flags.CustomFlag{
+			Value: &cmd.inputs.ClientCert,
+			Meta: flags.Meta{
+				Name: flagSetPrefix + user.FlagClientCert,
+				Usage: flags.Usage{
+					Description: "Save the path to a PEM client certificate to present to servers",
+				},
+			},
+		},
+		flags.CustomFlag{
+			Value: &cmd.inputs.ClientKey,
+			Meta: flags.Meta{
+				Name: flagSetPrefix + user.FlagClientKey,
+				Usage: flags.Usage{
+					Description: "Save the path to the PEM private key of the client certificate",
+				},
+			},
+		},
+		flags.BoolFlag{
+			Value: &cmd.inputs.InsecureSkipVerify,
+			Meta: flags.Meta{
+				Name: flagSetPrefix + user.FlagInsecureSkipVerify,
+				Usage: flags.Usage{
+					Description: "Save TLS certificate verification as disabled, only use this with local test servers",
+				},
+			},
+		},
+		flags.CustomFlag{
+			Value: &cmd.inputs.MaxRetries,
+			Meta: flags.Meta{
+				Name: flagSetPrefix + user.FlagMaxRetries,
+				Usage: flags.Usage{
+					Description: "Save the maximum number of times a failed request is retried",
+				},
+			},
+		},
+		flags.CustomFlag{
+			Value: &cmd.inputs.RetryMaxWait,
+			Meta: flags.Meta{
+				Name: flagSetPrefix + user.FlagRetryMaxWait,
+				Usage: flags.Usage{
+					Description: "Save the maximum time to wait before retrying a failed request",
+				},
+			},
+		},
+		flags.CustomFlag{
+			Value: &cmd.inputs.Timeout,
+			Meta: flags.Meta{
+				Name: flagSetPrefix + user.FlagTimeout,
+				Usage: flags.Usage{
+					Description: `Save the maximum time to wait for a request to complete, where "0s" disables the timeout`,
+				},
+			},
+		},
+		flags.NewStringSetFlag(
+			&cmd.inputs.Unset,
+			flags.StringSetOptions{
+				Meta: flags.Meta{
+					Name: flagUnset,
+					Usage: flags.Usage{
+						Description: "Specify the network setting(s) to remove from the profile",
+					},
+				},
+				ValidValues: user.NetworkSettings,
+			},
+		),
+	}
+}
+
+// Inputs is the command inputs
+func (cmd *CommandNetwork) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandNetwork) Handler(ctx context.Context, profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	p, err := loadProfile(profile, cmd.inputs.Name)
+	if err != nil {
+		return err
+	}
+
+	settings := cmd.inputs.settings()
+	for _, setting := range user.NetworkSettings {
+		if value, ok := settings[setting]; ok {
+			p.SetNetworkSetting(setting, value)
+		}
+	}
+	for _, setting := range cmd.inputs.Unset {
+		p.SetNetworkSetting(setting, "")
+	}
+
+	if err := p.Save(); err != nil {
+		return err
+	}
+
+	ui.Print(terminal.NewTextLog("Successfully saved the network settings of profile: %s", p.Name))
+	if insecure, _ := strconv.ParseBool(p.NetworkSetting(user.FlagInsecureSkipVerify)); insecure {
+		ui.Print(terminal.NewWarningLog("TLS certificate verification is disabled for every command run with profile: %s", p.Name))
+	}
+	return nil
+}
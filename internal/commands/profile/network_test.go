@@ -0,0 +1,139 @@
+package profile
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	u "github.com/10gen/realm-cli/internal/utils/test"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+
+	"github.com/spf13/pflag"
+)
+
+func TestProfileNetworkInputs(t *testing.T) {
+	profile := &user.Profile{Name: "default"}
+
+	t.Run("should default to the profile in use", func(t *testing.T) {
+		inputs := networkInputs{ProxyURL: "http://proxy.example.com:8080"}
+		assert.Nil(t, inputs.Resolve(profile, nil))
+		assert.Equal(t, "default", inputs.Name)
+	})
+
+	t.Run("should fail without any settings to set or unset", func(t *testing.T) {
+		inputs := networkInputs{}
+		assert.Equal(t, errors.New("must set or unset at least one network setting"), inputs.Resolve(profile, nil))
+	})
+
+	t.Run("should fail to both set and unset a setting", func(t *testing.T) {
+		inputs := networkInputs{ProxyURL: "http://proxy.example.com:8080", Unset: []string{user.FlagProxy}}
+		assert.Equal(t, errors.New("cannot both set and unset the proxy setting"), inputs.Resolve(profile, nil))
+	})
+}
+
+func TestProfileNetworkFlags(t *testing.T) {
+	t.Run("should name the flags of each setting apart from the global network flags", func(t *testing.T) {
+		fs := pflag.NewFlagSet("network", pflag.ContinueOnError)
+
+		cmd := &CommandNetwork{}
+		for _, flag := range cmd.Flags() {
+			flag.Register(fs)
+		}
+
+		for _, setting := range user.NetworkSettings {
+			assert.True(t, fs.Lookup(setting) == nil, "flag must not be named the same as the global %s flag", setting)
+			assert.True(t, fs.Lookup("set-"+setting) != nil, "flag must be registered to set the %s setting", setting)
+		}
+	})
+}
+
+func TestProfileNetworkHandler(t *testing.T) {
+	tmpDir, teardownTmpDir, tmpDirErr := u.NewTempDir("home")
+	assert.Nil(t, tmpDirErr)
+	defer teardownTmpDir()
+
+	_, teardownHomeDir := u.SetupHomeDir(tmpDir)
+	defer teardownHomeDir()
+
+	profile, profileErr := user.NewDefaultProfile()
+	assert.Nil(t, profileErr)
+	assert.Nil(t, profile.Save())
+
+	t.Run("should save the network settings and warn when tls verification is disabled", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		cmd := &CommandNetwork{networkInputs{
+			Name:               "default",
+			ProxyURL:           "http://proxy.example.com:8080",
+			CABundle:           "/certs/ca.pem",
+			InsecureSkipVerify: true,
+		}}
+		assert.Nil(t, cmd.Handler(context.Background(), profile, ui, cli.Clients{}))
+
+		assert.Equal(t, `Successfully saved the network settings of profile: default
+TLS certificate verification is disabled for every command run with profile: default
+`, out.String())
+
+		saved, err := user.NewProfile("default")
+		assert.Nil(t, err)
+		assert.Nil(t, saved.Load())
+
+		assert.Equal(t, "http://proxy.example.com:8080", saved.NetworkSetting(user.FlagProxy))
+		assert.Equal(t, "/certs/ca.pem", saved.NetworkSetting(user.FlagCABundle))
+		assert.Equal(t, "true", saved.NetworkSetting(user.FlagInsecureSkipVerify))
+	})
+
+	t.Run("should unset the network settings", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		cmd := &CommandNetwork{networkInputs{
+			Name:  "default",
+			Unset: []string{user.FlagProxy, user.FlagInsecureSkipVerify},
+		}}
+		assert.Nil(t, cmd.Handler(context.Background(), profile, ui, cli.Clients{}))
+
+		assert.Equal(t, "Successfully saved the network settings of profile: default\n", out.String())
+
+		saved, err := user.NewProfile("default")
+		assert.Nil(t, err)
+		assert.Nil(t, saved.Load())
+
+		assert.Equal(t, "", saved.NetworkSetting(user.FlagProxy))
+		assert.Equal(t, "/certs/ca.pem", saved.NetworkSetting(user.FlagCABundle))
+		assert.Equal(t, "", saved.NetworkSetting(user.FlagInsecureSkipVerify))
+	})
+
+	t.Run("should save the request settings", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		cmd := &CommandNetwork{networkInputs{
+			Name:         "default",
+			MaxRetries:   "5",
+			RetryMaxWait: "10s",
+			Timeout:      "1m",
+		}}
+		assert.Nil(t, cmd.Handler(context.Background(), profile, ui, cli.Clients{}))
+
+		assert.Equal(t, "Successfully saved the network settings of profile: default\n", out.String())
+
+		saved, err := user.NewProfile("default")
+		assert.Nil(t, err)
+		assert.Nil(t, saved.Load())
+		assert.Nil(t, saved.ResolveFlags())
+
+		assert.Equal(t, "5", saved.NetworkSetting(user.FlagMaxRetries))
+		assert.Equal(t, "10s", saved.NetworkSetting(user.FlagRetryMaxWait))
+		assert.Equal(t, time.Minute, saved.RequestTimeout())
+	})
+
+	t.Run("should fail to save the network settings of a profile that does not exist", func(t *testing.T) {
+		_, ui := mock.NewUI()
+
+		cmd := &CommandNetwork{networkInputs{Name: "prod", ProxyURL: "http://proxy.example.com:8080"}}
+		assert.Equal(t, errProfileNotFound("prod"), cmd.Handler(context.Background(), profile, ui, cli.Clients{}))
+	})
+}
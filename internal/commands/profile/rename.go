@@ -0,0 +1,96 @@
+package profile
+
+import (
+	"context"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/terminal"
+	"github.com/10gen/realm-cli/internal/utils/flags"
+
+	"github.com/AlecAivazis/survey/v2"
+)
+
+// CommandMetaRename is the command meta for the `profiles rename` command
+var CommandMetaRename = cli.CommandMeta{
+	Use:         "rename",
+	Display:     "profiles rename",
+	Description: "Rename a profile of your local CLI environment",
+	HelpText:    `The profile keeps its settings and credentials under its new name.`,
+}
+
+type renameInputs struct {
+	Name    string
+	NewName string
+}
+
+func (i *renameInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	if i.Name == "" {
+		name, err := resolveProfileName(ui, "Which profile would you like to rename?")
+		if err != nil {
+			return err
+		}
+		i.Name = name
+	}
+
+	if i.NewName == "" {
+		if err := ui.AskOne(&i.NewName, &survey.Input{Message: "New Profile Name"}); err != nil {
+			return err
+		}
+	}
+	return user.ValidateProfileName(i.NewName)
+}
+
+// CommandRename is the `profiles rename` command
+type CommandRename struct {
+	inputs renameInputs
+}
+
+// Flags is the command flags
+func (cmd *CommandRename) Flags() []flags.Flag {
+	return []flags.Flag{
+		nameFlag(&cmd.inputs.Name, "Specify the name of the profile to rename"),
+		flags.StringFlag{
+			Value: &cmd.inputs.NewName,
+			Meta: flags.Meta{
+				Name: flagNewName,
+				Usage: flags.Usage{
+					Description: "Specify the new name of the profile",
+				},
+			},
+		},
+	}
+}
+
+// Inputs is the command inputs
+func (cmd *CommandRename) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandRename) Handler(ctx context.Context, profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	target, err := user.NewProfile(cmd.inputs.NewName)
+	if err != nil {
+		return err
+	}
+
+	exists, err := target.Exists()
+	if err != nil {
+		return err
+	}
+	if exists {
+		return errProfileExists(cmd.inputs.NewName)
+	}
+
+	p, err := loadProfile(profile, cmd.inputs.Name)
+	if err != nil {
+		return err
+	}
+
+	if err := p.Rename(cmd.inputs.NewName); err != nil {
+		return err
+	}
+
+	ui.Print(terminal.NewTextLog("Successfully renamed profile: %s to %s", cmd.inputs.Name, cmd.inputs.NewName))
+	return nil
+}
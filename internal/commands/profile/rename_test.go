@@ -0,0 +1,69 @@
+package profile
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	u "github.com/10gen/realm-cli/internal/utils/test"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestProfileRenameHandler(t *testing.T) {
+	tmpDir, teardownTmpDir, tmpDirErr := u.NewTempDir("home")
+	assert.Nil(t, tmpDirErr)
+	defer teardownTmpDir()
+
+	_, teardownHomeDir := u.SetupHomeDir(tmpDir)
+	defer teardownHomeDir()
+
+	profile, profileErr := user.NewDefaultProfile()
+	assert.Nil(t, profileErr)
+	assert.Nil(t, profile.Save())
+
+	staging, profileErr := user.NewProfile("staging")
+	assert.Nil(t, profileErr)
+	staging.SetRealmBaseURL("https://realm-staging.mongodb.com")
+	staging.SetCredentials(user.Credentials{PublicAPIKey: "public", PrivateAPIKey: "private"})
+	assert.Nil(t, staging.Save())
+	assert.Nil(t, user.SetCurrentProfileName("staging"))
+
+	t.Run("should move the profile settings and secrets to the new name", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		cmd := &CommandRename{renameInputs{Name: "staging", NewName: "qa"}}
+		assert.Nil(t, cmd.Handler(context.Background(), profile, ui, cli.Clients{}))
+
+		assert.Equal(t, "Successfully renamed profile: staging to qa\n", out.String())
+
+		_, err := os.Stat(staging.Path())
+		assert.True(t, os.IsNotExist(err), "old profile must be removed")
+
+		renamed, err := user.NewProfile("qa")
+		assert.Nil(t, err)
+		assert.Nil(t, renamed.Load())
+
+		assert.Equal(t, "https://realm-staging.mongodb.com", renamed.RealmBaseURL())
+		credentials, credentialsErr := renamed.Credentials()
+		assert.Nil(t, credentialsErr)
+		assert.Equal(t, user.Credentials{PublicAPIKey: "public", PrivateAPIKey: "private"}, credentials)
+
+		stored, err := user.StoredCredentials(user.CredentialStoreTypeVault, "staging")
+		assert.Nil(t, err)
+		assert.Equal(t, user.Credentials{}, stored)
+
+		current, err := user.CurrentProfileName()
+		assert.Nil(t, err)
+		assert.Equal(t, "qa", current)
+	})
+
+	t.Run("should fail to rename a profile to an existing name", func(t *testing.T) {
+		_, ui := mock.NewUI()
+
+		cmd := &CommandRename{renameInputs{Name: "qa", NewName: user.DefaultProfile}}
+		assert.Equal(t, errProfileExists(user.DefaultProfile), cmd.Handler(context.Background(), profile, ui, cli.Clients{}))
+	})
+}
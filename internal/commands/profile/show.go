@@ -0,0 +1,101 @@
+package profile
+
+import (
+	"context"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/terminal"
+	"github.com/10gen/realm-cli/internal/utils/flags"
+)
+
+// CommandMetaShow is the command meta for the `profiles show` command
+var CommandMetaShow = cli.CommandMeta{
+	Use:         "show",
+	Display:     "profiles show",
+	Description: "Show the settings of a profile of your local CLI environment",
+	HelpText: `Displays the base URLs, telemetry mode, credential store and network settings
+of the profile currently in use, or of the profile specified with the "--name"
+flag. Secrets are never displayed.`,
+}
+
+type showInputs struct {
+	Name string
+}
+
+// CommandShow is the `profiles show` command
+type CommandShow struct {
+	inputs showInputs
+}
+
+// Flags is the command flags
+func (cmd *CommandShow) Flags() []flags.Flag {
+	return []flags.Flag{
+		nameFlag(&cmd.inputs.Name, "Specify the name of the profile to show"),
+	}
+}
+
+type profileDescription struct {
+	Name            string `json:"name"`
+	Current         bool   `json:"current"`
+	RealmBaseURL    string `json:"realmBaseUrl"`
+	AtlasBaseURL    string `json:"atlasBaseUrl"`
+	TelemetryMode   string `json:"telemetryMode"`
+	CredentialStore string `json:"credentialStore"`
+
+	Proxy              string `json:"proxy,omitempty"`
+	CABundle           string `json:"caBundle,omitempty"`
+	ClientCert         string `json:"clientCert,omitempty"`
+	ClientKey          string `json:"clientKey,omitempty"`
+	InsecureSkipVerify string `json:"insecureSkipVerify,omitempty"`
+	MaxRetries         string `json:"maxRetries,omitempty"`
+	RetryMaxWait       string `json:"retryMaxWait,omitempty"`
+	Timeout            string `json:"timeout,omitempty"`
+}
+
+// Handler is the command handler
+func (cmd *CommandShow) Handler(ctx context.Context, profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	name := cmd.inputs.Name
+	if name == "" {
+		name = profile.Name
+	}
+
+	p, err := loadProfile(profile, name)
+	if err != nil {
+		return err
+	}
+
+	current, err := user.CurrentProfileName()
+	if err != nil {
+		return err
+	}
+
+	telemetryMode := p.TelemetryMode().String()
+	if telemetryMode == "" {
+		telemetryMode = "on"
+	}
+
+	credentialStore := p.CredentialStoreType().String()
+	if credentialStore == "" {
+		credentialStore = user.CredentialStoreTypeVault.String()
+	}
+
+	ui.Print(terminal.NewJSONLog("Profile description", profileDescription{
+		Name:            p.Name,
+		Current:         p.Name == current,
+		RealmBaseURL:    p.RealmBaseURL(),
+		AtlasBaseURL:    p.AtlasBaseURL(),
+		TelemetryMode:   telemetryMode,
+		CredentialStore: credentialStore,
+
+		Proxy:              p.NetworkSetting(user.FlagProxy),
+		CABundle:           p.NetworkSetting(user.FlagCABundle),
+		ClientCert:         p.NetworkSetting(user.FlagClientCert),
+		ClientKey:          p.NetworkSetting(user.FlagClientKey),
+		InsecureSkipVerify: p.NetworkSetting(user.FlagInsecureSkipVerify),
+		MaxRetries:         p.NetworkSetting(user.FlagMaxRetries),
+		RetryMaxWait:       p.NetworkSetting(user.FlagRetryMaxWait),
+		Timeout:            p.NetworkSetting(user.FlagTimeout),
+	}))
+	return nil
+}
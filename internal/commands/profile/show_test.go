@@ -0,0 +1,77 @@
+package profile
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	u "github.com/10gen/realm-cli/internal/utils/test"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestProfileShowHandler(t *testing.T) {
+	tmpDir, teardownTmpDir, tmpDirErr := u.NewTempDir("home")
+	assert.Nil(t, tmpDirErr)
+	defer teardownTmpDir()
+
+	_, teardownHomeDir := u.SetupHomeDir(tmpDir)
+	defer teardownHomeDir()
+
+	profile, profileErr := user.NewDefaultProfile()
+	assert.Nil(t, profileErr)
+	assert.Nil(t, profile.ResolveFlags())
+
+	staging, profileErr := user.NewProfile("staging")
+	assert.Nil(t, profileErr)
+	staging.Flags.RealmBaseURL = "https://realm-staging.mongodb.com"
+	assert.Nil(t, staging.ResolveFlags())
+	staging.SetCredentials(user.Credentials{PublicAPIKey: "public", PrivateAPIKey: "my-private-key"})
+	assert.Nil(t, staging.Save())
+
+	t.Run("should show the settings of the profile in use", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		cmd := &CommandShow{}
+		assert.Nil(t, cmd.Handler(context.Background(), profile, ui, cli.Clients{}))
+
+		assert.Equal(t, `Profile description
+{
+  "name": "default",
+  "current": true,
+  "realmBaseUrl": "https://realm.mongodb.com",
+  "atlasBaseUrl": "https://cloud.mongodb.com",
+  "telemetryMode": "on",
+  "credentialStore": "vault"
+}
+`, out.String())
+	})
+
+	t.Run("should show the settings of the named profile without its secrets", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		cmd := &CommandShow{showInputs{Name: "staging"}}
+		assert.Nil(t, cmd.Handler(context.Background(), profile, ui, cli.Clients{}))
+
+		assert.Equal(t, `Profile description
+{
+  "name": "staging",
+  "current": false,
+  "realmBaseUrl": "https://realm-staging.mongodb.com",
+  "atlasBaseUrl": "https://cloud.mongodb.com",
+  "telemetryMode": "on",
+  "credentialStore": "vault"
+}
+`, out.String())
+		assert.False(t, strings.Contains(out.String(), "my-private-key"), "output must not contain secrets")
+	})
+
+	t.Run("should fail to show a profile that does not exist", func(t *testing.T) {
+		_, ui := mock.NewUI()
+
+		cmd := &CommandShow{showInputs{Name: "prod"}}
+		assert.Equal(t, errProfileNotFound("prod"), cmd.Handler(context.Background(), profile, ui, cli.Clients{}))
+	})
+}
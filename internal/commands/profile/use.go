@@ -0,0 +1,65 @@
+package profile
+
+import (
+	"context"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/terminal"
+	"github.com/10gen/realm-cli/internal/utils/flags"
+)
+
+// CommandMetaUse is the command meta for the `profiles use` command
+var CommandMetaUse = cli.CommandMeta{
+	Use:         "use",
+	Display:     "profiles use",
+	Description: "Select the profile used by your local CLI environment",
+	HelpText: `The selected profile is used for all subsequent commands unless another
+profile is specified with the "--profile" flag.`,
+}
+
+type useInputs struct {
+	Name string
+}
+
+func (i *useInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	if i.Name == "" {
+		name, err := resolveProfileName(ui, "Which profile would you like to use?")
+		if err != nil {
+			return err
+		}
+		i.Name = name
+	}
+	return nil
+}
+
+// CommandUse is the `profiles use` command
+type CommandUse struct {
+	inputs useInputs
+}
+
+// Flags is the command flags
+func (cmd *CommandUse) Flags() []flags.Flag {
+	return []flags.Flag{
+		nameFlag(&cmd.inputs.Name, "Specify the name of the profile to use"),
+	}
+}
+
+// Inputs is the command inputs
+func (cmd *CommandUse) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandUse) Handler(ctx context.Context, profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	if _, err := loadProfile(profile, cmd.inputs.Name); err != nil {
+		return err
+	}
+
+	if err := user.SetCurrentProfileName(cmd.inputs.Name); err != nil {
+		return err
+	}
+
+	ui.Print(terminal.NewTextLog("Now using profile: %s", cmd.inputs.Name))
+	return nil
+}
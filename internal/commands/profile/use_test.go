@@ -0,0 +1,53 @@
+package profile
+
+import (
+	"context"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	u "github.com/10gen/realm-cli/internal/utils/test"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestProfileUseHandler(t *testing.T) {
+	tmpDir, teardownTmpDir, tmpDirErr := u.NewTempDir("home")
+	assert.Nil(t, tmpDirErr)
+	defer teardownTmpDir()
+
+	_, teardownHomeDir := u.SetupHomeDir(tmpDir)
+	defer teardownHomeDir()
+
+	profile, profileErr := user.NewDefaultProfile()
+	assert.Nil(t, profileErr)
+	assert.Nil(t, profile.Save())
+
+	staging, profileErr := user.NewProfile("staging")
+	assert.Nil(t, profileErr)
+	assert.Nil(t, staging.Save())
+
+	t.Run("should set the current profile which is then used as the default profile", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		cmd := &CommandUse{useInputs{Name: "staging"}}
+		assert.Nil(t, cmd.Handler(context.Background(), profile, ui, cli.Clients{}))
+
+		assert.Equal(t, "Now using profile: staging\n", out.String())
+
+		defaultProfile, err := user.NewDefaultProfile()
+		assert.Nil(t, err)
+		assert.Equal(t, "staging", defaultProfile.Name)
+	})
+
+	t.Run("should fail to use a profile that does not exist", func(t *testing.T) {
+		_, ui := mock.NewUI()
+
+		cmd := &CommandUse{useInputs{Name: "prod"}}
+		assert.Equal(t, errProfileNotFound("prod"), cmd.Handler(context.Background(), profile, ui, cli.Clients{}))
+
+		current, err := user.CurrentProfileName()
+		assert.Nil(t, err)
+		assert.Equal(t, "staging", current)
+	})
+}
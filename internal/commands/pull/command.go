@@ -2,6 +2,7 @@ package pull
 
 import (
 	"archive/zip"
+	"context"
 	"fmt"
 	"io"
 	"os"
@@ -29,7 +30,17 @@ var CommandMeta = cli.CommandMeta{
 	Description: "Exports the latest version of your Realm app into your local directory",
 	HelpText: `Pulls changes from your remote Realm app into your local directory. If
 applicable, Hosting Files and/or Dependencies associated with your Realm app will be
-exported as well.`,
+exported as well.
+
+With "--merge", changes from your remote Realm app are merged into your local
+directory instead of overwriting it. The app as it was last pulled is kept under
+the ".mdb" directory and used as the base of the merge: files changed only
+remotely are updated, files changed only locally are kept, and files changed on
+both sides are merged line by line. Conflicting changes are written with conflict
+markers, or alongside the local file with a ".remote" extension. Without the app
+as it was last pulled, every file that differs from your remote Realm app is a
+conflict. Dependencies, Hosting Files and templates cannot be merged, so they are
+not pulled with "--merge".`,
 }
 
 // Command is the `pull` command
@@ -110,6 +121,15 @@ func (cmd *Command) Flags() []flags.Flag {
 				},
 			},
 		},
+		flags.BoolFlag{
+			Value: &cmd.inputs.Merge,
+			Meta: flags.Meta{
+				Name: "merge",
+				Usage: flags.Usage{
+					Description: "Merge the remote Realm app into the local filepath instead of overwriting it",
+				},
+			},
+		},
 		flags.StringSliceFlag{
 			Value: &cmd.inputs.TemplateIDs,
 			Meta: flags.Meta{
@@ -133,31 +153,34 @@ func (cmd *Command) Inputs() cli.InputResolver {
 }
 
 // Handler is the command handler
-func (cmd *Command) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
-	app, err := cmd.inputs.resolveRemoteApp(ui, clients)
+func (cmd *Command) Handler(ctx context.Context, profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, err := cmd.inputs.resolveRemoteApp(ctx, ui, clients)
 	if err != nil {
 		return err
 	}
 
 	var clientTemplates []clientTemplate
 	if app.TemplateID != "" {
-		clientTemplates, err = cmd.inputs.resolveClientTemplates(clients.Realm, app.GroupID, app.ID)
+		clientTemplates, err = cmd.inputs.resolveClientTemplates(ctx, clients.Realm, app.GroupID, app.ID)
 		if err != nil {
 			return err
 		}
 	}
 
-	pathProject, zipPkg, err := cmd.doExport(profile, clients.Realm, app.GroupID, app.ID)
+	pathProject, zipPkg, err := cmd.doExport(ctx, profile, clients.Realm, app.GroupID, app.ID)
 	if err != nil {
 		return err
 	}
 
 	// App path
-	proceed, err := checkPathDestination(ui, pathProject)
-	if err != nil {
-		return err
-	} else if !proceed {
-		return nil
+	merge := cmd.inputs.Merge && pathExists(pathProject)
+	if !merge {
+		proceed, err := checkPathDestination(ui, pathProject)
+		if err != nil {
+			return err
+		} else if !proceed {
+			return nil
+		}
 	}
 
 	pathRelative, err := filepath.Rel(profile.WorkingDirectory, pathProject)
@@ -189,12 +212,32 @@ func (cmd *Command) Handler(profile *user.Profile, ui terminal.UI, clients cli.C
 		return nil
 	}
 
-	if err := local.WriteZip(pathBackend, zipPkg); err != nil {
-		return fmt.Errorf("unable to write app to disk: %s", err)
+	if merge {
+		if !pathExists(local.SnapshotPath(pathBackend)) {
+			ui.Print(terminal.NewWarningLog(
+				"The app as it was last pulled was not found at %s, so every file that differs from your remote app will be conflicted",
+				filepath.Join(local.NameDotMDB, local.NameSnapshot),
+			))
+		}
+		result, err := local.MergeZip(pathBackend, zipPkg)
+		if err != nil {
+			return fmt.Errorf("unable to merge app to disk: %s", err)
+		}
+		ui.Print(mergeLogs(result)...)
+	} else {
+		if err := local.WriteZip(pathBackend, zipPkg); err != nil {
+			return fmt.Errorf("unable to write app to disk: %s", err)
+		}
+		if err := local.WriteSnapshot(pathBackend, zipPkg); err != nil {
+			return fmt.Errorf("unable to write app to disk: %s", err)
+		}
+		ui.Print(terminal.NewTextLog("Saved app to disk"))
 	}
-	ui.Print(terminal.NewTextLog("Saved app to disk"))
 
-	if cmd.inputs.IncludeNodeModules || cmd.inputs.IncludePackageJSON || cmd.inputs.IncludeDependencies {
+	includeDependencies := cmd.inputs.IncludeNodeModules || cmd.inputs.IncludePackageJSON || cmd.inputs.IncludeDependencies
+	if includeDependencies && merge {
+		ui.Print(terminal.NewWarningLog(mergeSkippedMessage("Dependencies")))
+	} else if includeDependencies {
 		logStr := "as a node_modules archive"
 		if cmd.inputs.IncludePackageJSON {
 			logStr = "as a package.json file"
@@ -208,7 +251,7 @@ func (cmd *Command) Handler(profile *user.Profile, ui terminal.UI, clients cli.C
 			s.Start()
 			defer s.Stop()
 
-			fileName, file, err := cmd.exportDependencies(clients, app)
+			fileName, file, err := cmd.exportDependencies(ctx, clients, app)
 			if err != nil {
 				return err
 			}
@@ -237,14 +280,16 @@ func (cmd *Command) Handler(profile *user.Profile, ui terminal.UI, clients cli.C
 		}
 	}
 
-	if cmd.inputs.IncludeHosting {
+	if cmd.inputs.IncludeHosting && merge {
+		ui.Print(terminal.NewWarningLog(mergeSkippedMessage("Hosting files")))
+	} else if cmd.inputs.IncludeHosting {
 		s := ui.Spinner("Fetching hosting assets...", terminal.SpinnerOptions{})
 
 		exportHostingAssets := func() error {
 			s.Start()
 			defer s.Stop()
 
-			appAssets, err := clients.Realm.HostingAssets(app.GroupID, app.ID)
+			appAssets, err := clients.Realm.HostingAssets(ctx, app.GroupID, app.ID)
 			if err != nil {
 				return err
 			}
@@ -258,6 +303,11 @@ func (cmd *Command) Handler(profile *user.Profile, ui terminal.UI, clients cli.C
 		ui.Print(terminal.NewDebugLog("Fetched hosting assets"))
 	}
 
+	if len(clientTemplates) != 0 && merge {
+		ui.Print(terminal.NewWarningLog(mergeSkippedMessage("Templates")))
+		clientTemplates = nil
+	}
+
 	successfulTemplateWrites := make([]interface{}, 0, len(clientTemplates))
 	for _, ct := range clientTemplates {
 		if err := local.WriteZip(filepath.Join(pathFrontend, ct.id), ct.zipPkg); err != nil {
@@ -279,8 +329,9 @@ func (cmd *Command) Handler(profile *user.Profile, ui terminal.UI, clients cli.C
 	return nil
 }
 
-func (cmd *Command) doExport(profile *user.Profile, realmClient realm.Client, groupID, appID string) (string, *zip.Reader, error) {
+func (cmd *Command) doExport(ctx context.Context, profile *user.Profile, realmClient realm.Client, groupID, appID string) (string, *zip.Reader, error) {
 	name, zipPkg, err := realmClient.Export(
+		ctx,
 		groupID,
 		appID,
 		realm.ExportRequest{ConfigVersion: cmd.inputs.AppVersion},
@@ -329,9 +380,50 @@ func checkPathDestination(ui terminal.UI, path string) (bool, error) {
 	return ui.Confirm("Directory '%s' already exists, do you still wish to proceed?", path)
 }
 
-func (cmd *Command) exportDependencies(clients cli.Clients, app realm.App) (string, io.ReadCloser, error) {
+func mergeSkippedMessage(name string) string {
+	return fmt.Sprintf(`%s were not pulled since they cannot be merged, pull without "--merge" to overwrite them`, name)
+}
+
+func pathExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
+
+func mergeLogs(result local.MergeResult) []terminal.Log {
+	if result.Len() == 0 {
+		return []terminal.Log{terminal.NewTextLog("No remote changes to merge into your local app")}
+	}
+
+	logs := []terminal.Log{terminal.NewTextLog("Merged app to disk")}
+	for _, files := range []struct {
+		message string
+		paths   []string
+	}{
+		{"Added files", result.Added},
+		{"Updated files", result.Updated},
+		{"Deleted files", result.Deleted},
+		{"Merged files", result.Merged},
+		{"Conflicted files", result.Conflicted},
+	} {
+		if len(files.paths) == 0 {
+			continue
+		}
+		items := make([]interface{}, len(files.paths))
+		for i, path := range files.paths {
+			items[i] = path
+		}
+		logs = append(logs, terminal.NewListLog(files.message, items...))
+	}
+
+	if len(result.Conflicted) > 0 {
+		logs = append(logs, terminal.NewWarningLog("Resolve the conflict markers in the conflicted files, or compare them against their .remote copies"))
+	}
+	return logs
+}
+
+func (cmd *Command) exportDependencies(ctx context.Context, clients cli.Clients, app realm.App) (string, io.ReadCloser, error) {
 	if cmd.inputs.IncludePackageJSON {
-		return clients.Realm.ExportDependencies(app.GroupID, app.ID)
+		return clients.Realm.ExportDependencies(ctx, app.GroupID, app.ID)
 	}
-	return clients.Realm.ExportDependenciesArchive(app.GroupID, app.ID)
+	return clients.Realm.ExportDependenciesArchive(ctx, app.GroupID, app.ID)
 }
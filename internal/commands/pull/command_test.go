@@ -3,6 +3,7 @@ package pull
 import (
 	"archive/zip"
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"io"
@@ -30,7 +31,7 @@ func TestPullHandler(t *testing.T) {
 
 		cmd := &Command{inputs{Project: "elsewhere", RemoteApp: "somewhere"}}
 
-		err := cmd.Handler(nil, nil, cli.Clients{Realm: realmClient})
+		err := cmd.Handler(context.Background(), nil, nil, cli.Clients{Realm: realmClient})
 		assert.Equal(t, errors.New("something bad happened"), err)
 	})
 
@@ -52,7 +53,7 @@ func TestPullHandler(t *testing.T) {
 		}
 		cmd := &Command{inputs{Project: "elsewhere", RemoteApp: "somewhere"}}
 
-		err := cmd.Handler(nil, ui, cli.Clients{Realm: realmClient})
+		err := cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient})
 		assert.Equal(t, errors.New("something bad happened"), err)
 	})
 
@@ -85,7 +86,7 @@ func TestPullHandler(t *testing.T) {
 
 			cmd := &Command{inputs{Project: "elsewhere", DryRun: true, LocalPath: "app"}}
 
-			assert.Nil(t, cmd.Handler(profile, ui, cli.Clients{Realm: realmClient}))
+			assert.Nil(t, cmd.Handler(context.Background(), profile, ui, cli.Clients{Realm: realmClient}))
 			destination := filepath.Join(profile.WorkingDirectory, "app")
 
 			assert.Equal(t, `No changes were written to your file system
@@ -119,7 +120,7 @@ Contents would have been written to: app
 
 			cmd := &Command{inputs{Project: "elsewhere", DryRun: true, LocalPath: "app", TemplateIDs: []string{"some-template-id"}}}
 
-			assert.Nil(t, cmd.Handler(profile, ui, cli.Clients{Realm: realmClient}))
+			assert.Nil(t, cmd.Handler(context.Background(), profile, ui, cli.Clients{Realm: realmClient}))
 			backendDestination := filepath.Join(profile.WorkingDirectory, "app", local.BackendPath)
 			frontendDestination := filepath.Join(profile.WorkingDirectory, "app", local.FrontendPath)
 
@@ -142,7 +143,7 @@ Template contents would have been written to: app/frontend
 
 			cmd := &Command{inputs{Project: "elsewhere", LocalPath: "app"}}
 
-			assert.Nil(t, cmd.Handler(profile, ui, cli.Clients{Realm: realmClient}))
+			assert.Nil(t, cmd.Handler(context.Background(), profile, ui, cli.Clients{Realm: realmClient}))
 			destination := filepath.Join(profile.WorkingDirectory, "app")
 
 			assert.Equal(t, `Saved app to disk
@@ -156,6 +157,96 @@ Successfully pulled app down: app
 			assert.Nil(t, readErr)
 			assert.Equal(t, `{"egg":"corn"}
 `, string(testData))
+
+			snapshotData, readErr := ioutil.ReadFile(filepath.Join(local.SnapshotPath(destination), "test.json"))
+			assert.Nil(t, readErr)
+			assert.Equal(t, string(testData), string(snapshotData))
+		})
+
+		t.Run("should merge the received zip package into the destination", func(t *testing.T) {
+			profile, teardown := mock.NewProfileFromTmpDir(t, "pull_handler_test")
+			defer teardown()
+
+			destination := filepath.Join(profile.WorkingDirectory, "app")
+
+			_, ui := mock.NewUI()
+
+			cmd := &Command{inputs{Project: "elsewhere", LocalPath: "app"}}
+			assert.Nil(t, cmd.Handler(context.Background(), profile, ui, cli.Clients{Realm: realmClient}))
+
+			t.Run("and keep the local changes without any remote changes", func(t *testing.T) {
+				assert.Nil(t, ioutil.WriteFile(filepath.Join(destination, "test.json"), []byte(`{"egg":"local"}`+"\n"), 0666))
+
+				out, ui := mock.NewUI()
+
+				cmd := &Command{inputs{Project: "elsewhere", LocalPath: "app", Merge: true}}
+				assert.Nil(t, cmd.Handler(context.Background(), profile, ui, cli.Clients{Realm: realmClient}))
+
+				assert.Equal(t, `No remote changes to merge into your local app
+Successfully pulled app down: app
+`, out.String())
+
+				testData, readErr := ioutil.ReadFile(filepath.Join(destination, "test.json"))
+				assert.Nil(t, readErr)
+				assert.Equal(t, `{"egg":"local"}
+`, string(testData))
+			})
+
+			t.Run("and mark the conflicts with the remote changes", func(t *testing.T) {
+				assert.Nil(t, ioutil.WriteFile(filepath.Join(local.SnapshotPath(destination), "test.json"), []byte(`{"egg":"base"}`+"\n"), 0666))
+
+				out, ui := mock.NewUI()
+
+				cmd := &Command{inputs{Project: "elsewhere", LocalPath: "app", Merge: true}}
+				assert.Nil(t, cmd.Handler(context.Background(), profile, ui, cli.Clients{Realm: realmClient}))
+
+				assert.Equal(t, `Merged app to disk
+Conflicted files
+  test.json
+Resolve the conflict markers in the conflicted files, or compare them against their .remote copies
+Successfully pulled app down: app
+`, out.String())
+
+				testData, readErr := ioutil.ReadFile(filepath.Join(destination, "test.json"))
+				assert.Nil(t, readErr)
+				assert.Equal(t, `<<<<<<< local
+{"egg":"local"}
+=======
+{"egg":"corn"}
+>>>>>>> remote
+`, string(testData))
+			})
+
+			t.Run("and not pull the dependencies or hosting files", func(t *testing.T) {
+				out, ui := mock.NewUI()
+
+				cmd := &Command{inputs{Project: "elsewhere", LocalPath: "app", Merge: true, IncludeNodeModules: true, IncludeHosting: true}}
+				assert.Nil(t, cmd.Handler(context.Background(), profile, ui, cli.Clients{Realm: realmClient}))
+
+				assert.Equal(t, `No remote changes to merge into your local app
+Dependencies were not pulled since they cannot be merged, pull without "--merge" to overwrite them
+Hosting files were not pulled since they cannot be merged, pull without "--merge" to overwrite them
+Successfully pulled app down: app
+`, out.String())
+			})
+
+			t.Run("and warn that every differing file is conflicted without the app as it was last pulled", func(t *testing.T) {
+				assert.Nil(t, os.RemoveAll(local.SnapshotPath(destination)))
+				assert.Nil(t, ioutil.WriteFile(filepath.Join(destination, "test.json"), []byte(`{"egg":"local"}`+"\n"), 0666))
+
+				out, ui := mock.NewUI()
+
+				cmd := &Command{inputs{Project: "elsewhere", LocalPath: "app", Merge: true}}
+				assert.Nil(t, cmd.Handler(context.Background(), profile, ui, cli.Clients{Realm: realmClient}))
+
+				assert.Equal(t, `The app as it was last pulled was not found at .mdb/snapshot, so every file that differs from your remote app will be conflicted
+Merged app to disk
+Conflicted files
+  test.json
+Resolve the conflict markers in the conflicted files, or compare them against their .remote copies
+Successfully pulled app down: app
+`, out.String())
+			})
 		})
 	})
 
@@ -192,7 +283,7 @@ Successfully pulled app down: app
 
 			cmd := &Command{inputs{Project: "elsewhere", LocalPath: "app"}}
 
-			assert.Nil(t, cmd.Handler(profile, ui, cli.Clients{Realm: realmClient}))
+			assert.Nil(t, cmd.Handler(context.Background(), profile, ui, cli.Clients{Realm: realmClient}))
 
 			assert.Equal(t, `Saved app to disk
 Successfully pulled app down: app
@@ -208,7 +299,7 @@ Successfully pulled app down: app
 
 				cmd := &Command{inputs{Project: "elsewhere", LocalPath: "app", IncludePackageJSON: true}}
 
-				err := cmd.Handler(profile, ui, cli.Clients{Realm: realmClient})
+				err := cmd.Handler(context.Background(), profile, ui, cli.Clients{Realm: realmClient})
 				assert.Equal(t, errors.New("something bad happened with package json input"), err)
 
 			})
@@ -221,7 +312,7 @@ Successfully pulled app down: app
 
 				cmd := &Command{inputs{Project: "elsewhere", LocalPath: "app", IncludeNodeModules: true}}
 
-				err := cmd.Handler(profile, ui, cli.Clients{Realm: realmClient})
+				err := cmd.Handler(context.Background(), profile, ui, cli.Clients{Realm: realmClient})
 				assert.Equal(t, errors.New("something bad happened with node modules input"), err)
 
 			})
@@ -234,7 +325,7 @@ Successfully pulled app down: app
 
 				cmd := &Command{inputs{Project: "elsewhere", LocalPath: "app", IncludeDependencies: true}}
 
-				err := cmd.Handler(profile, ui, cli.Clients{Realm: realmClient})
+				err := cmd.Handler(context.Background(), profile, ui, cli.Clients{Realm: realmClient})
 				assert.Equal(t, errors.New("something bad happened with node modules input"), err)
 
 			})
@@ -279,7 +370,7 @@ Successfully pulled app down: app
 			out, ui := mock.NewUI()
 			cmd := &Command{inputs{Project: "elsewhere", LocalPath: "app", IncludeNodeModules: true}}
 
-			assert.Nil(t, cmd.Handler(profile, ui, cli.Clients{Realm: realmClient}))
+			assert.Nil(t, cmd.Handler(context.Background(), profile, ui, cli.Clients{Realm: realmClient}))
 			assert.Equal(t, message, out.String())
 
 			_, pkgErr := os.Stat(filepath.Join(profile.WorkingDirectory, "app", local.NameFunctions, "node_modules.zip"))
@@ -293,7 +384,7 @@ Successfully pulled app down: app
 			out, ui := mock.NewUI()
 			cmd := &Command{inputs{Project: "elsewhere", LocalPath: "app", IncludeDependencies: true}}
 
-			assert.Nil(t, cmd.Handler(profile, ui, cli.Clients{Realm: realmClient}))
+			assert.Nil(t, cmd.Handler(context.Background(), profile, ui, cli.Clients{Realm: realmClient}))
 			assert.Equal(t, message, out.String())
 
 			_, pkgErr := os.Stat(filepath.Join(profile.WorkingDirectory, "app", local.NameFunctions, "node_modules.zip"))
@@ -337,7 +428,7 @@ Successfully pulled app down: app
 
 			cmd := &Command{inputs{Project: "elsewhere", LocalPath: "app", IncludePackageJSON: true}}
 
-			assert.Nil(t, cmd.Handler(profile, ui, cli.Clients{Realm: realmClient}))
+			assert.Nil(t, cmd.Handler(context.Background(), profile, ui, cli.Clients{Realm: realmClient}))
 			assert.Equal(t, `Saved app to disk
 Fetched dependencies as a package.json file
 Successfully pulled app down: app
@@ -363,7 +454,7 @@ Successfully pulled app down: app
 
 			cmd := &Command{inputs{Project: "elsewhere", LocalPath: "app", IncludePackageJSON: true}}
 
-			assert.Nil(t, cmd.Handler(profile, ui, cli.Clients{Realm: realmClient}))
+			assert.Nil(t, cmd.Handler(context.Background(), profile, ui, cli.Clients{Realm: realmClient}))
 
 			assert.Equal(t, `Saved app to disk
 Fetched dependencies as a node_modules archive
@@ -406,7 +497,7 @@ Successfully pulled app down: app
 
 			cmd := &Command{inputs{Project: "elsewhere", LocalPath: "app"}}
 
-			assert.Nil(t, cmd.Handler(profile, ui, cli.Clients{Realm: realmClient}))
+			assert.Nil(t, cmd.Handler(context.Background(), profile, ui, cli.Clients{Realm: realmClient}))
 
 			assert.Equal(t, `Saved app to disk
 Successfully pulled app down: app
@@ -421,7 +512,7 @@ Successfully pulled app down: app
 
 			cmd := &Command{inputs{Project: "elsewhere", LocalPath: "app", IncludeHosting: true}}
 
-			err := cmd.Handler(profile, ui, cli.Clients{Realm: realmClient})
+			err := cmd.Handler(context.Background(), profile, ui, cli.Clients{Realm: realmClient})
 			assert.Equal(t, errors.New("something bad happened"), err)
 		})
 	})
@@ -494,7 +585,7 @@ Successfully pulled app down: app
 
 		cmd := &Command{inputs{Project: "elsewhere", LocalPath: "app", IncludeHosting: true}}
 
-		assert.Nil(t, cmd.Handler(profile, ui, cli.Clients{Realm: realmClient, HostingAsset: hostingAssetClient}))
+		assert.Nil(t, cmd.Handler(context.Background(), profile, ui, cli.Clients{Realm: realmClient, HostingAsset: hostingAssetClient}))
 		assert.Equal(t, `Saved app to disk
 Fetched hosting assets
 Successfully pulled app down: app
@@ -567,7 +658,7 @@ Successfully pulled app down: app
 			_, ui := mock.NewUI()
 
 			cmd := &Command{inputs{Project: "some_project", LocalPath: "app", TemplateIDs: []string{templateID}}}
-			assert.Nil(t, cmd.Handler(profile, ui, cli.Clients{Realm: realmClient}))
+			assert.Nil(t, cmd.Handler(context.Background(), profile, ui, cli.Clients{Realm: realmClient}))
 
 			destination := filepath.Join(profile.WorkingDirectory, "app", local.FrontendPath, templateID)
 
@@ -601,7 +692,7 @@ Successfully pulled app down: app
 			_, ui := mock.NewUI()
 
 			cmd := &Command{input}
-			assert.Nil(t, cmd.Handler(profile, ui, cli.Clients{Realm: realmClient}))
+			assert.Nil(t, cmd.Handler(context.Background(), profile, ui, cli.Clients{Realm: realmClient}))
 			expected := map[string]string{
 				"template_1": `{
   "egg": "over easy",
@@ -647,7 +738,7 @@ Successfully pulled app down: app
 			out, ui := mock.NewUI()
 
 			cmd := &Command{inputs{Project: "some_project", LocalPath: "app", TemplateIDs: []string{templateID}}}
-			assert.Nil(t, cmd.Handler(profile, ui, cli.Clients{Realm: realmClient}))
+			assert.Nil(t, cmd.Handler(context.Background(), profile, ui, cli.Clients{Realm: realmClient}))
 
 			readme := filepath.Join(profile.WorkingDirectory, "app", local.FrontendPath, templateID, "README.md")
 			_, err = os.Stat(readme)
@@ -674,7 +765,7 @@ Navigate to the saved directory to view directions on how to run the template ap
 
 			cmd := &Command{inputs{Project: "elsewhere", LocalPath: "app", TemplateIDs: []string{"some-template-id"}}}
 
-			err := cmd.Handler(profile, ui, cli.Clients{Realm: realmClient})
+			err := cmd.Handler(context.Background(), profile, ui, cli.Clients{Realm: realmClient})
 			assert.Equal(t, errors.New("some kind of error"), err)
 		})
 
@@ -691,7 +782,7 @@ Navigate to the saved directory to view directions on how to run the template ap
 
 			cmd := &Command{inputs{Project: "elsewhere", LocalPath: "app", TemplateIDs: []string{"some-template-id"}}}
 
-			err := cmd.Handler(profile, ui, cli.Clients{Realm: realmClient})
+			err := cmd.Handler(context.Background(), profile, ui, cli.Clients{Realm: realmClient})
 			assert.Equal(t, errors.New("frontend template 'some-template-id' is not compatible with this app"), err)
 		})
 
@@ -708,7 +799,7 @@ Navigate to the saved directory to view directions on how to run the template ap
 
 			cmd := &Command{inputs{Project: "elsewhere", LocalPath: "app"}}
 
-			err := cmd.Handler(profile, ui, cli.Clients{Realm: realmClient})
+			err := cmd.Handler(context.Background(), profile, ui, cli.Clients{Realm: realmClient})
 			assert.Nil(t, err)
 
 			// Check to see that export went as normal
@@ -721,6 +812,44 @@ Navigate to the saved directory to view directions on how to run the template ap
 			assert.Nil(t, readErr)
 			assert.Equal(t, `{"egg":"corn"}
 `, string(testData))
+
+			snapshotData, readErr := ioutil.ReadFile(filepath.Join(local.SnapshotPath(destination), "test.json"))
+			assert.Nil(t, readErr)
+			assert.Equal(t, string(testData), string(snapshotData))
+		})
+
+		t.Run("should not write the templates when merging into the destination", func(t *testing.T) {
+			templateID := "template_2"
+			realmClient.CompatibleTemplatesFn = func(groupID, appID string) ([]realm.Template, error) {
+				return []realm.Template{{ID: templateID, Name: "some template"}}, nil
+			}
+			realmClient.ClientTemplateFn = func(groupID, appID, templateID string) (*zip.Reader, bool, error) {
+				return &templateZipPkg2.Reader, true, nil
+			}
+
+			profile, teardown := mock.NewProfileFromTmpDir(t, "pull_handler_test")
+			defer teardown()
+
+			_, ui := mock.NewUI()
+
+			cmd := &Command{inputs{Project: "some_project", LocalPath: "app", TemplateIDs: []string{templateID}}}
+			assert.Nil(t, cmd.Handler(context.Background(), profile, ui, cli.Clients{Realm: realmClient}))
+
+			destination := filepath.Join(profile.WorkingDirectory, "app", local.FrontendPath, templateID)
+			assert.Nil(t, os.RemoveAll(destination))
+
+			out, ui := mock.NewUI()
+
+			cmd = &Command{inputs{Project: "some_project", LocalPath: "app", TemplateIDs: []string{templateID}, Merge: true}}
+			assert.Nil(t, cmd.Handler(context.Background(), profile, ui, cli.Clients{Realm: realmClient}))
+
+			assert.Equal(t, `No remote changes to merge into your local app
+Templates were not pulled since they cannot be merged, pull without "--merge" to overwrite them
+Successfully pulled app down: app
+`, out.String())
+
+			_, err := os.Stat(destination)
+			assert.True(t, os.IsNotExist(err), "expected %s to not exist, but instead: %s", destination, err)
 		})
 	})
 }
@@ -742,7 +871,7 @@ func TestPullCommandDoExport(t *testing.T) {
 
 		cmd := &Command{inputs{AppVersion: realm.AppConfigVersion20210101}}
 
-		_, _, err := cmd.doExport(nil, realmClient, groupID, appID)
+		_, _, err := cmd.doExport(context.Background(), nil, realmClient, groupID, appID)
 		assert.Equal(t, errors.New("something bad happened"), err)
 
 		t.Log("and should properly pass through the expected args")
@@ -790,7 +919,7 @@ func TestPullCommandDoExport(t *testing.T) {
 
 				cmd := &Command{inputs{LocalPath: tc.flagLocal}}
 
-				path, zipPkg, err := cmd.doExport(profile, realmClient, "", "")
+				path, zipPkg, err := cmd.doExport(context.Background(), profile, realmClient, "", "")
 				assert.Nil(t, err)
 				assert.NotNil(t, zipPkg)
 				assert.Equal(t, tc.expectedPath, path)
@@ -2,6 +2,7 @@ package pull
 
 import (
 	"archive/zip"
+	"context"
 	"errors"
 	"fmt"
 
@@ -32,6 +33,7 @@ type inputs struct {
 	IncludePackageJSON  bool
 	IncludeHosting      bool
 	DryRun              bool
+	Merge               bool
 	TemplateIDs         []string
 
 	// derived inputs
@@ -86,16 +88,16 @@ func (i *inputs) Resolve(profile *user.Profile, ui terminal.UI) error {
 	return nil
 }
 
-func (i *inputs) resolveRemoteApp(ui terminal.UI, clients cli.Clients) (realm.App, error) {
+func (i *inputs) resolveRemoteApp(ctx context.Context, ui terminal.UI, clients cli.Clients) (realm.App, error) {
 	if i.Project == "" && i.appMeta.GroupID == "" {
-		groupID, err := cli.ResolveGroupID(ui, clients.Atlas)
+		groupID, err := cli.ResolveGroupID(ctx, ui, clients.Atlas)
 		if err != nil {
 			return realm.App{}, err
 		}
 		i.Project = groupID
 	}
 
-	app, err := cli.ResolveApp(ui, clients.Realm, cli.AppOptions{
+	app, err := cli.ResolveApp(ctx, ui, clients.Realm, cli.AppOptions{
 		Filter:  realm.AppFilter{GroupID: i.Project, App: i.RemoteApp},
 		AppMeta: i.appMeta,
 	})
@@ -107,7 +109,7 @@ func (i *inputs) resolveRemoteApp(ui terminal.UI, clients cli.Clients) (realm.Ap
 	}
 
 	// TODO(REALMC-9462): remove this once /apps has "template_id" in the payload
-	app, err = clients.Realm.FindApp(app.GroupID, app.ID)
+	app, err = clients.Realm.FindApp(ctx, app.GroupID, app.ID)
 	if err != nil {
 		return realm.App{}, err
 	}
@@ -120,12 +122,12 @@ type clientTemplate struct {
 	zipPkg *zip.Reader
 }
 
-func (i *inputs) resolveClientTemplates(realmClient realm.Client, groupID, appID string) ([]clientTemplate, error) {
+func (i *inputs) resolveClientTemplates(ctx context.Context, realmClient realm.Client, groupID, appID string) ([]clientTemplate, error) {
 	if len(i.TemplateIDs) == 0 {
 		return nil, nil
 	}
 
-	compatibleTemplates, err := realmClient.CompatibleTemplates(groupID, appID)
+	compatibleTemplates, err := realmClient.CompatibleTemplates(ctx, groupID, appID)
 	if err != nil {
 		return nil, err
 	}
@@ -134,7 +136,7 @@ func (i *inputs) resolveClientTemplates(realmClient realm.Client, groupID, appID
 	templateFrontends := make([]clientTemplate, 0, len(i.TemplateIDs))
 	for _, requestedTemplate := range i.TemplateIDs {
 		if template, ok := compatibleTemplatesByID[requestedTemplate]; ok {
-			templateZip, ok, err := realmClient.ClientTemplate(groupID, appID, template.ID)
+			templateZip, ok, err := realmClient.ClientTemplate(ctx, groupID, appID, template.ID)
 			if err != nil {
 				return nil, err
 			} else if !ok {
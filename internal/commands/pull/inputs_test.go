@@ -2,6 +2,7 @@ package pull
 
 import (
 	"archive/zip"
+	"context"
 	"errors"
 	"io/ioutil"
 	"os"
@@ -125,7 +126,7 @@ func TestPullInputsResolveRemoteApp(t *testing.T) {
 			return realm.App{GroupID: "group-id", ID: "app-id"}, nil
 		}
 
-		app, err := i.resolveRemoteApp(nil, cli.Clients{Realm: realmClient})
+		app, err := i.resolveRemoteApp(context.Background(), nil, cli.Clients{Realm: realmClient})
 		assert.Nil(t, err)
 		assert.Equal(t, realm.App{GroupID: "group-id", ID: "app-id"}, app)
 
@@ -142,7 +143,7 @@ func TestPullInputsResolveRemoteApp(t *testing.T) {
 			return realm.App{GroupID: groupID, ID: appID, Name: "some-name"}, nil
 		}
 
-		app, err := i.resolveRemoteApp(nil, cli.Clients{Realm: realmClient})
+		app, err := i.resolveRemoteApp(context.Background(), nil, cli.Clients{Realm: realmClient})
 		assert.Nil(t, err)
 		assert.Equal(t, realm.App{GroupID: "some-group", ID: "some-app", Name: "some-name"}, app)
 		assert.Equal(t, 1, findAppCalls)
@@ -167,7 +168,7 @@ func TestPullInputsResolveRemoteApp(t *testing.T) {
 			return realm.App{GroupID: "group-id", ID: "app-id"}, nil
 		}
 
-		app, err := i.resolveRemoteApp(nil, cli.Clients{Atlas: atlasClient, Realm: realmClient})
+		app, err := i.resolveRemoteApp(context.Background(), nil, cli.Clients{Atlas: atlasClient, Realm: realmClient})
 		assert.Nil(t, err)
 		assert.Equal(t, realm.App{GroupID: "group-id", ID: "app-id"}, app)
 
@@ -185,7 +186,7 @@ func TestPullInputsResolveRemoteApp(t *testing.T) {
 			return realm.App{}, cli.ErrAppNotFound{}
 		}
 
-		_, err := i.resolveRemoteApp(nil, cli.Clients{Realm: realmClient})
+		_, err := i.resolveRemoteApp(context.Background(), nil, cli.Clients{Realm: realmClient})
 		assert.Equal(t, errProjectNotFound, err)
 	})
 
@@ -197,7 +198,7 @@ func TestPullInputsResolveRemoteApp(t *testing.T) {
 			return atlas.Groups{}, errors.New("something bad happened")
 		}
 
-		_, err := i.resolveRemoteApp(nil, cli.Clients{Atlas: atlasClient})
+		_, err := i.resolveRemoteApp(context.Background(), nil, cli.Clients{Atlas: atlasClient})
 		assert.Equal(t, errors.New("something bad happened"), err)
 	})
 
@@ -209,7 +210,7 @@ func TestPullInputsResolveRemoteApp(t *testing.T) {
 			return nil, errors.New("something bad happened")
 		}
 
-		_, err := i.resolveRemoteApp(nil, cli.Clients{Realm: realmClient})
+		_, err := i.resolveRemoteApp(context.Background(), nil, cli.Clients{Realm: realmClient})
 		assert.Equal(t, errors.New("something bad happened"), err)
 	})
 }
@@ -227,7 +228,7 @@ func TestPullTemplatesResolve(t *testing.T) {
 		var realmClient mock.RealmClient
 
 		input := inputs{}
-		out, err := input.resolveClientTemplates(realmClient, "some-group-id", "some-app-id")
+		out, err := input.resolveClientTemplates(context.Background(), realmClient, "some-group-id", "some-app-id")
 		assert.Nil(t, err)
 		assert.Equal(t, 0, len(out))
 	})
@@ -240,7 +241,7 @@ func TestPullTemplatesResolve(t *testing.T) {
 		}
 
 		input := inputs{TemplateIDs: []string{"test-template-id"}}
-		_, err := input.resolveClientTemplates(realmClient, "some-group-id", "some-app-id")
+		_, err := input.resolveClientTemplates(context.Background(), realmClient, "some-group-id", "some-app-id")
 		assert.Equal(t, errors.New("something went wrong"), err)
 	})
 
@@ -255,7 +256,7 @@ func TestPullTemplatesResolve(t *testing.T) {
 				return nil, false, errors.New("something went wrong with client")
 			}
 			input := inputs{TemplateIDs: []string{"some-template-id"}}
-			_, err := input.resolveClientTemplates(realmClient, "some-group-id", "some-app-id")
+			_, err := input.resolveClientTemplates(context.Background(), realmClient, "some-group-id", "some-app-id")
 			assert.Equal(t, errors.New("something went wrong with client"), err)
 		})
 
@@ -267,7 +268,7 @@ func TestPullTemplatesResolve(t *testing.T) {
 			}
 
 			input := inputs{TemplateIDs: []string{"wrong-template-id"}}
-			_, err := input.resolveClientTemplates(realmClient, "some-group-id", "some-app-id")
+			_, err := input.resolveClientTemplates(context.Background(), realmClient, "some-group-id", "some-app-id")
 			assert.Equal(t, errors.New("frontend template 'wrong-template-id' is not compatible with this app"), err)
 		})
 	})
@@ -280,7 +281,7 @@ func TestPullTemplatesResolve(t *testing.T) {
 			realmClient.CompatibleTemplatesFn = func(groupID, appID string) ([]realm.Template, error) {
 				return nil, nil
 			}
-			result, err := input.resolveClientTemplates(realmClient, "some-group-id", "some-app-id")
+			result, err := input.resolveClientTemplates(context.Background(), realmClient, "some-group-id", "some-app-id")
 			assert.Nil(t, err)
 			assert.Equal(t, 0, len(result))
 
@@ -292,7 +293,7 @@ func TestPullTemplatesResolve(t *testing.T) {
 				return []realm.Template{{ID: "some-template-id", Name: "some name"}}, nil
 			}
 
-			result, err := input.resolveClientTemplates(realmClient, "some-group-id", "some-group-app")
+			result, err := input.resolveClientTemplates(context.Background(), realmClient, "some-group-id", "some-group-app")
 			assert.Nil(t, err)
 			assert.Equal(t, 0, len(result))
 		})
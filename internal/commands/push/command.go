@@ -1,11 +1,13 @@
 package push
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
 
 	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/feedback"
 	"github.com/10gen/realm-cli/internal/cli/user"
 	"github.com/10gen/realm-cli/internal/cloud/realm"
 	"github.com/10gen/realm-cli/internal/local"
@@ -24,10 +26,24 @@ const (
 	flagIncludeHosting      = "include-hosting"
 	flagResetCDNCache       = "reset-cdn-cache"
 	flagDryRun              = "dry-run"
+	flagUnified             = "unified"
+	flagNoDeploy            = "no-deploy"
+	flagWatch               = "watch"
+	flagOnly                = "only"
+	flagExclude             = "exclude"
+	flagShowIgnored         = "show-ignored"
+	flagEnv                 = "env"
+
+	discardDraftTimeout = 30 * time.Second
+
+	diffExitCode = 2
 )
 
 var (
 	warnFailedToDiscardDraft = terminal.NewWarningLog("Failed to discard the draft created for your deployment")
+
+	// watchDebounce is how long a watched app must go without file changes before they are pushed
+	watchDebounce = 500 * time.Millisecond
 )
 
 // CommandMeta is the command meta for the 'push' command
@@ -39,7 +55,33 @@ var CommandMeta = cli.CommandMeta{
 that you would like changes pushed to. This input can be either the application
 Client App ID of an existing Realm app you would like to update, or the Name of
 a new Realm app you would like to create. Changes pushed are automatically
-deployed.`,
+deployed, unless "--no-deploy" is set to leave them staged in a Draft which can
+be reviewed and deployed later with "drafts deploy". Pushing with "--no-deploy"
+again stages the changes in the same Draft. With "--watch", the local directory
+is pushed again each time its files change, until interrupted.
+
+To push only part of your app, select sections with "--only" or leave them out
+with "--exclude", as either a whole section (e.g. "triggers") or a single named
+entry of one (e.g. "functions/foo" or "data_sources/mongodb-atlas"). Endpoints
+are named by their route, which selects every HTTP method of it, or by their HTTP
+method and route (e.g. "endpoints/GET /hello"). The rest of the remote app is left
+untouched. The "hosting" and "dependencies" sections also
+select the hosting files and dependencies included by their flags.
+
+With "--dry-run", the changes are displayed without being pushed, as a list of
+changes with JSON output, or as a unified diff of your local files against a
+fresh export of your Realm app with "--unified". The command then exits with
+code 2 when there are changes to push, so that scripts can check for them.
+
+Files matched by the gitignore-style patterns of a ".realmignore" file at the
+root of your local directory are left out of the push, including hosting files
+and dependencies. Set "--show-ignored" to list the files which are left out.
+
+To promote one local directory through the environments of several Realm apps,
+map each environment to its remote Realm app with the "group_id" and "app_id"
+of the environment in the ".realm-cli.yaml" project config. With "--env", the
+Realm app of the selected environment is pushed to, using the values of that
+environment.`,
 }
 
 // Command is the `push` command
@@ -130,6 +172,75 @@ func (cmd *Command) Flags() []flags.Flag {
 				},
 			},
 		},
+		flags.BoolFlag{
+			Value: &cmd.inputs.Unified,
+			Meta: flags.Meta{
+				Name: flagUnified,
+				Usage: flags.Usage{
+					Description: "Show the changes of a dry run as a unified diff of your local files against an export of your Realm app",
+					Note:        `Cannot be used with "--env"`,
+				},
+			},
+		},
+		flags.BoolFlag{
+			Value: &cmd.inputs.NoDeploy,
+			Meta: flags.Meta{
+				Name: flagNoDeploy,
+				Usage: flags.Usage{
+					Description: "Stage the changes in a draft without deploying them",
+					Note:        "Hosting files and dependencies cannot be staged in a draft",
+				},
+			},
+		},
+		flags.BoolFlag{
+			Value: &cmd.inputs.Watch,
+			Meta: flags.Meta{
+				Name:      flagWatch,
+				Shorthand: "w",
+				Usage: flags.Usage{
+					Description: "Keep watching the local filepath after the push, and push the changes whenever files change",
+				},
+			},
+		},
+		flags.StringSliceFlag{
+			Value: &cmd.inputs.Only,
+			Meta: flags.Meta{
+				Name: flagOnly,
+				Usage: flags.Usage{
+					Description: "Push only the selected app sections, as <section> or <section>/<name>",
+					Note:        "The allowed sections are: " + strings.Join(local.AppSections(), ", "),
+				},
+			},
+		},
+		flags.StringSliceFlag{
+			Value: &cmd.inputs.Exclude,
+			Meta: flags.Meta{
+				Name: flagExclude,
+				Usage: flags.Usage{
+					Description: "Push everything except the selected app sections, as <section> or <section>/<name>",
+				},
+			},
+		},
+		flags.BoolFlag{
+			Value: &cmd.inputs.ShowIgnored,
+			Meta: flags.Meta{
+				Name: flagShowIgnored,
+				Usage: flags.Usage{
+					Description: "List the files of the local filepath which are ignored by its .realmignore file",
+				},
+			},
+		},
+		flags.CustomFlag{
+			Value: &cmd.inputs.Environment,
+			Meta: flags.Meta{
+				Name: flagEnv,
+				Usage: flags.Usage{
+					Description:   "Push to the Realm app of the environment, as configured in the project config",
+					Note:          "The Realm app of the environment is used over any remote or project flags",
+					AllowedValues: realm.EnvironmentValues,
+				},
+			},
+		},
 		cli.ProjectFlag(&cmd.inputs.Project),
 	}
 }
@@ -139,20 +250,39 @@ func (cmd *Command) Inputs() cli.InputResolver {
 	return &cmd.inputs
 }
 
+// SetFlagsChanged sets the flags explicitly provided to the command
+func (cmd *Command) SetFlagsChanged(changed map[string]bool) {
+	cmd.inputs.flagsChanged = changed
+}
+
 // Handler is the command handler
-func (cmd *Command) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+func (cmd *Command) Handler(ctx context.Context, profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
 	app, err := local.LoadApp(cmd.inputs.LocalPath)
 	if err != nil {
 		return err
 	}
 
-	appRemote, err := cmd.inputs.resolveRemoteApp(ui, clients.Realm, app.Meta)
+	if cmd.inputs.ShowIgnored {
+		if err := showIgnored(ui, app.RootDir); err != nil {
+			return err
+		}
+	}
+
+	if cmd.inputs.Environment != realm.EnvironmentNone {
+		meta, err := app.EnvironmentMeta(cmd.inputs.Environment)
+		if err != nil {
+			return err
+		}
+		app.Meta = meta
+	}
+
+	appRemote, err := cmd.inputs.resolveRemoteApp(ctx, ui, clients.Realm, app.Meta)
 	if err != nil {
 		return err
 	}
 
 	if appRemote.GroupID == "" {
-		groupID, err := cli.ResolveGroupID(ui, clients.Atlas)
+		groupID, err := cli.ResolveGroupID(ctx, ui, clients.Atlas)
 		if err != nil {
 			return err
 		}
@@ -168,7 +298,7 @@ func (cmd *Command) Handler(profile *user.Profile, ui terminal.UI, clients cli.C
 			return nil
 		}
 
-		newApp, proceed, err := createNewApp(ui, clients.Realm, app.RootDir, appRemote.GroupID, app.AppData)
+		newApp, proceed, err := createNewApp(ctx, ui, clients.Realm, app.RootDir, appRemote.GroupID, app.AppData)
 		if err != nil {
 			return err
 		}
@@ -182,15 +312,31 @@ func (cmd *Command) Handler(profile *user.Profile, ui terminal.UI, clients cli.C
 		appRemote.ClientAppID = newApp.ClientAppID
 	}
 
+	if err := cmd.push(ctx, profile, ui, clients, app, appRemote); err != nil {
+		return err
+	}
+
+	if cmd.inputs.Watch {
+		return cmd.watch(ctx, profile, ui, clients, app.RootDir, appRemote)
+	}
+	return nil
+}
+
+func (cmd *Command) push(ctx context.Context, profile *user.Profile, ui terminal.UI, clients cli.Clients, app local.App, appRemote appRemote) error {
 	ui.Print(terminal.NewTextLog("Determining changes"))
-	appDiffs, err := clients.Realm.Diff(appRemote.GroupID, appRemote.AppID, app.AppData)
+	appData, err := cmd.appData(ctx, clients.Realm, app, appRemote)
+	if err != nil {
+		return err
+	}
+
+	appDiffs, err := clients.Realm.Diff(ctx, appRemote.GroupID, appRemote.AppID, appData)
 	if err != nil {
 		return err
 	}
 
 	var uploadPathDependencies string
 	var dependenciesDiffs realm.DependenciesDiff
-	if cmd.inputs.IncludeNodeModules || cmd.inputs.IncludePackageJSON || cmd.inputs.IncludeDependencies {
+	if cmd.inputs.includeDependencies() {
 		appDependencies, err := cmd.inputs.resolveAppDependencies(app.RootDir)
 		if err != nil {
 			return err
@@ -202,7 +348,7 @@ func (cmd *Command) Handler(profile *user.Profile, ui terminal.UI, clients cli.C
 		}
 		defer cleanup()
 
-		dependenciesDiffs, err = clients.Realm.DiffDependencies(appRemote.GroupID, appRemote.AppID, uploadPath)
+		dependenciesDiffs, err = clients.Realm.DiffDependencies(ctx, appRemote.GroupID, appRemote.AppID, uploadPath)
 		if err != nil {
 			return err
 		}
@@ -215,8 +361,8 @@ func (cmd *Command) Handler(profile *user.Profile, ui terminal.UI, clients cli.C
 	}
 
 	var hostingDiffs local.HostingDiffs
-	if cmd.inputs.IncludeHosting {
-		appAssets, err := clients.Realm.HostingAssets(appRemote.GroupID, appRemote.AppID)
+	if cmd.inputs.includeHosting() {
+		appAssets, err := clients.Realm.HostingAssets(ctx, appRemote.GroupID, appRemote.AppID)
 		if err != nil {
 			return err
 		}
@@ -232,12 +378,16 @@ func (cmd *Command) Handler(profile *user.Profile, ui terminal.UI, clients cli.C
 		return nil
 	}
 
+	if cmd.inputs.DryRun && (ui.OutputFormat() == terminal.OutputFormatJSON || cmd.inputs.Unified) {
+		return cmd.printChanges(ctx, ui, clients.Realm, app, appRemote, appData, dependenciesDiffs, hostingDiffs)
+	}
+
 	if !ui.AutoConfirm() {
 		diffs := make([]string, 0, len(appDiffs)+1+hostingDiffs.Cap())
 
 		diffs = append(diffs, appDiffs...)
 
-		if cmd.inputs.IncludeNodeModules || cmd.inputs.IncludePackageJSON || cmd.inputs.IncludeDependencies {
+		if cmd.inputs.includeDependencies() {
 			diffs = append(diffs, dependenciesDiffs.Strings()...)
 		}
 
@@ -256,7 +406,7 @@ func (cmd *Command) Handler(profile *user.Profile, ui terminal.UI, clients cli.C
 			terminal.NewTextLog("To push these changes, you must omit the 'dry-run' flag to proceed"),
 			terminal.NewFollowupLog(terminal.MsgSuggestions, cmd.display(true)),
 		)
-		return nil
+		return feedback.ErrExitCode{Code: diffExitCode}
 	}
 
 	proceed, err := ui.Confirm("Please confirm the changes shown above")
@@ -267,113 +417,356 @@ func (cmd *Command) Handler(profile *user.Profile, ui terminal.UI, clients cli.C
 		return nil
 	}
 
-	if len(appDiffs) > 0 {
-		ui.Print(terminal.NewTextLog("Creating draft"))
-		draft, proceed, err := createNewDraft(ui, clients.Realm, appRemote)
-		if err != nil {
-			return err
+	var draftID string
+	if len(appDiffs) > 0 || cmd.inputs.NoDeploy {
+		var draft realm.AppDraft
+		var draftExists bool
+		if cmd.inputs.NoDeploy {
+			// changes are staged into the existing draft, so they can be built up across pushes
+			draft, draftExists, err = findOrCreateDraft(ctx, clients.Realm, appRemote)
+			if err != nil {
+				return err
+			}
+			if draftExists {
+				ui.Print(terminal.NewTextLog("Staging changes in existing draft: %s", draft.ID))
+			} else {
+				ui.Print(terminal.NewTextLog("Creating draft"))
+			}
+		} else {
+			ui.Print(terminal.NewTextLog("Creating draft"))
+			var proceed bool
+			draft, proceed, err = createNewDraft(ctx, ui, clients.Realm, appRemote)
+			if err != nil {
+				return err
+			}
+			if !proceed {
+				return nil
+			}
 		}
-		if !proceed {
-			return nil
+
+		if len(appDiffs) > 0 {
+			ui.Print(terminal.NewTextLog("Pushing changes"))
+			if err := clients.Realm.Import(ctx, appRemote.GroupID, appRemote.AppID, appData); err != nil {
+				if !draftExists {
+					discardDraft(ctx, ui, clients.Realm, appRemote, draft.ID)
+				}
+				return err
+			}
 		}
 
-		ui.Print(terminal.NewTextLog("Pushing changes"))
-		if err := clients.Realm.Import(appRemote.GroupID, appRemote.AppID, app.AppData); err != nil {
-			if err := clients.Realm.DiscardDraft(appRemote.GroupID, appRemote.AppID, draft.ID); err != nil {
-				ui.Print(warnFailedToDiscardDraft)
+		if !cmd.inputs.NoDeploy {
+			ui.Print(terminal.NewTextLog("Deploying draft"))
+			if err := deployDraftAndWait(ctx, ui, clients.Realm, appRemote, draft.ID); err != nil {
+				discardDraft(ctx, ui, clients.Realm, appRemote, draft.ID)
+				return err
 			}
+		}
+		draftID = draft.ID
+	}
+
+	if cmd.inputs.includeDependencies() {
+		if err := installDependencies(ctx, ui, clients.Realm, appRemote, uploadPathDependencies); err != nil {
 			return err
 		}
+	}
 
-		ui.Print(terminal.NewTextLog("Deploying draft"))
-		if err := deployDraftAndWait(ui, clients.Realm, appRemote, draft.ID); err != nil {
-			if err := clients.Realm.DiscardDraft(appRemote.GroupID, appRemote.AppID, draft.ID); err != nil {
-				ui.Print(warnFailedToDiscardDraft)
-			}
+	if cmd.inputs.includeHosting() {
+		if err := cmd.importHosting(ctx, ui, clients.Realm, appRemote, hosting, hostingDiffs); err != nil {
 			return err
 		}
 	}
 
-	if cmd.inputs.IncludePackageJSON || cmd.inputs.IncludeNodeModules || cmd.inputs.IncludeDependencies {
-		installDependencies := func() error {
-			s := ui.Spinner("Installing dependencies: starting...", terminal.SpinnerOptions{})
+	if cmd.inputs.NoDeploy {
+		draftArgs := []flags.Arg{{cli.ProjectFlagName, appRemote.GroupID}, {cli.AppFlagName, appRemote.ClientAppID}}
+		ui.Print(
+			terminal.NewTextLog("Successfully staged changes in draft: %s", draftID),
+			terminal.NewFollowupLog(
+				"To review and deploy the staged changes, run",
+				cli.CommandDisplay("drafts diff", draftArgs),
+				cli.CommandDisplay("drafts deploy", draftArgs),
+			),
+		)
+		return nil
+	}
 
-			s.Start()
-			defer s.Stop()
+	ui.Print(terminal.NewTextLog("Successfully pushed app up: %s", appRemote.ClientAppID))
+	return nil
+}
 
-			if err := clients.Realm.ImportDependencies(appRemote.GroupID, appRemote.AppID, uploadPathDependencies); err != nil {
-				return err
-			}
+func (cmd *Command) watch(ctx context.Context, profile *user.Profile, ui terminal.UI, clients cli.Clients, rootDir string, appRemote appRemote) error {
+	ui.Print(terminal.NewTextLog("Watching for changes in %s, press Ctrl+C to stop", rootDir))
 
-			status := realm.DependenciesStatus{State: realm.DependenciesStateCreated}
-			for status.State == realm.DependenciesStateCreated {
-				var err error
-				status, err = clients.Realm.DependenciesStatus(appRemote.GroupID, appRemote.AppID)
-				if err != nil {
-					return err
-				}
+	return local.WatchApp(ctx, rootDir, watchDebounce, func(changes local.AppChanges) {
+		if err := cmd.pushChanges(ctx, profile, ui, clients, rootDir, appRemote, changes); err != nil && ctx.Err() == nil {
+			// a failed push should not stop the watch, so the next change can fix it
+			ui.Print(terminal.NewWarningLog("Failed to push changes: %s", err))
+		}
+	})
+}
 
-				if status.State == realm.DependenciesStateSuccessful || status.State == realm.DependenciesStateFailed {
-					break
-				}
+// pushChanges pushes only the parts of the app affected by the changed files:
+// hosting files are uploaded without an app import, and dependencies
+// are uploaded only when the package.json file changes
+func (cmd *Command) pushChanges(ctx context.Context, profile *user.Profile, ui terminal.UI, clients cli.Clients, rootDir string, appRemote appRemote, changes local.AppChanges) error {
+	ui.Print(terminal.NewTextLog("Detected changes to %s", strings.Join(changes.Paths, ", ")))
+
+	var pushed bool
+
+	if changes.Config {
+		app, err := local.LoadApp(rootDir)
+		if err != nil {
+			return err
+		}
+
+		appData, err := cmd.appData(ctx, clients.Realm, app, appRemote)
+		if err != nil {
+			return err
+		}
+
+		appDiffs, err := clients.Realm.Diff(ctx, appRemote.GroupID, appRemote.AppID, appData)
+		if err != nil {
+			return err
+		}
+
+		if len(appDiffs) > 0 {
+			draft, proceed, err := createNewDraft(ctx, ui, clients.Realm, appRemote)
+			if err != nil {
+				return err
+			}
+			if !proceed {
+				return nil
+			}
 
-				s.SetMessage(fmt.Sprintf("Installing dependencies: %s...", status.Message))
-				time.Sleep(time.Second)
+			if err := clients.Realm.Import(ctx, appRemote.GroupID, appRemote.AppID, appData); err != nil {
+				discardDraft(ctx, ui, clients.Realm, appRemote, draft.ID)
+				return err
 			}
-			if status.State == realm.DependenciesStateFailed {
-				return fmt.Errorf("failed to install dependencies: %s", status.Message)
+
+			if err := deployDraftAndWait(ctx, ui, clients.Realm, appRemote, draft.ID); err != nil {
+				discardDraft(ctx, ui, clients.Realm, appRemote, draft.ID)
+				return err
 			}
-			return nil
+			pushed = true
 		}
+	}
 
-		if err := installDependencies(); err != nil {
+	if changes.Dependencies && cmd.inputs.includeDependencies() {
+		appDependencies, err := cmd.inputs.resolveAppDependencies(rootDir)
+		if err != nil {
 			return err
 		}
 
-		ui.Print(terminal.NewTextLog("Installed dependencies"))
+		uploadPath, cleanup, err := appDependencies.PrepareUpload()
+		if err != nil {
+			return err
+		}
+		defer cleanup()
+
+		if err := installDependencies(ctx, ui, clients.Realm, appRemote, uploadPath); err != nil {
+			return err
+		}
+		pushed = true
 	}
 
-	if cmd.inputs.IncludeHosting {
-		s := ui.Spinner("Importing hosting assets...", terminal.SpinnerOptions{})
+	if changes.Hosting && cmd.inputs.includeHosting() {
+		hosting, err := local.FindAppHosting(rootDir)
+		if err != nil {
+			return err
+		}
 
-		importHosting := func() error {
-			s.Start()
-			defer s.Stop()
+		appAssets, err := clients.Realm.HostingAssets(ctx, appRemote.GroupID, appRemote.AppID)
+		if err != nil {
+			return err
+		}
 
-			return hosting.UploadHostingAssets(
-				clients.Realm,
-				appRemote.GroupID,
-				appRemote.AppID,
-				hostingDiffs,
-				func(err error) {
-					ui.Print(terminal.NewWarningLog("An error occurred while uploading hosting assets: %s", err.Error()))
-				},
-			)
+		hostingDiffs, err := hosting.Diffs(profile.HostingAssetCachePath(), appRemote.AppID, appAssets)
+		if err != nil {
+			return err
+		}
+
+		if hostingDiffs.Size() > 0 {
+			if err := cmd.importHosting(ctx, ui, clients.Realm, appRemote, hosting, hostingDiffs); err != nil {
+				return err
+			}
+			pushed = true
 		}
+	}
+
+	if !pushed {
+		ui.Print(terminal.NewTextLog("Deployed app is identical to proposed version, nothing to do"))
+		return nil
+	}
+
+	ui.Print(terminal.NewTextLog("Successfully pushed app up: %s", appRemote.ClientAppID))
+	return nil
+}
+
+func showIgnored(ui terminal.UI, rootDir string) error {
+	ignored, err := local.IgnoredFiles(rootDir)
+	if err != nil {
+		return err
+	}
+
+	if len(ignored) == 0 {
+		ui.Print(terminal.NewTextLog("No files are ignored by %s", local.NameRealmIgnore))
+		return nil
+	}
+
+	paths := make([]interface{}, 0, len(ignored))
+	for _, path := range ignored {
+		paths = append(paths, path)
+	}
+	ui.Print(terminal.NewListLog(fmt.Sprintf("The following files are ignored by %s", local.NameRealmIgnore), paths...))
+	return nil
+}
+
+// appData returns the app data to push, which is the whole local app unless app
+// sections are selected: then the remote app is exported, and only its selected
+// sections are replaced with the local ones
+func (cmd *Command) appData(ctx context.Context, realmClient realm.Client, app local.App, remote appRemote) (interface{}, error) {
+	if cmd.inputs.Environment != realm.EnvironmentNone {
+		// the app is pushed as the app of the environment
+		app.AppData.SetID(remote.ClientAppID)
+		app.AppData.SetName(remote.Name)
+		app.AppData.SetEnvironment(cmd.inputs.Environment)
+	}
+
+	if cmd.inputs.selection.IsZero() {
+		return app.AppData, nil
+	}
+
+	remoteApp, cleanup, err := cli.ExportApp(ctx, realmClient, remote.GroupID, remote.AppID, app.ConfigVersion())
+	if err != nil {
+		return nil, err
+	}
+	defer cleanup()
 
-		if err := importHosting(); err != nil {
+	return local.SelectAppData(app.AppData, remoteApp.AppData, cmd.inputs.selection)
+}
+
+// printChanges prints the changes the push would make to the Realm app, found by
+// comparing the app to push against a fresh export of the app: either as structured
+// changes, or as a unified diff of the files
+func (cmd *Command) printChanges(ctx context.Context, ui terminal.UI, realmClient realm.Client, app local.App, remote appRemote, appData interface{}, dependenciesDiffs realm.DependenciesDiff, hostingDiffs local.HostingDiffs) error {
+	remoteApp, cleanup, err := cli.ExportApp(ctx, realmClient, remote.GroupID, remote.AppID, app.ConfigVersion())
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	if ui.OutputFormat() != terminal.OutputFormatJSON {
+		diffs, err := local.UnifiedDiff(remoteApp.RootDir, app.RootDir)
+		if err != nil {
 			return err
 		}
-		ui.Print(terminal.NewTextLog("Import hosting assets"))
+		diffs = append(diffs, dependenciesDiffs.Strings()...)
+		diffs = append(diffs, hostingDiffs.Strings()...)
+
+		if len(diffs) == 0 {
+			ui.Print(terminal.NewTextLog("Deployed app is identical to proposed version, nothing to do"))
+			return nil
+		}
+		ui.Print(terminal.NewTextLog(
+			"The following reflects the proposed changes to your Realm app\n%s",
+			strings.Join(diffs, "\n"),
+		))
+		return feedback.ErrExitCode{Code: diffExitCode}
+	}
 
-		if cmd.inputs.ResetCDNCache {
-			s := ui.Spinner("Resetting CDN cache...", terminal.SpinnerOptions{})
+	changes, err := local.DiffAppData(appData, remoteApp.AppData)
+	if err != nil {
+		return err
+	}
+	changes = append(changes, local.DependenciesChanges(dependenciesDiffs)...)
+	changes = append(changes, local.HostingChanges(hostingDiffs)...)
+
+	if len(changes) == 0 {
+		ui.Print(terminal.NewJSONLog("Deployed app is identical to proposed version, nothing to do", []local.AppChange{}))
+		return nil
+	}
+	ui.Print(terminal.NewJSONLog("The following reflects the proposed changes to your Realm app", changes))
+	return feedback.ErrExitCode{Code: diffExitCode}
+}
 
-			invalidateCache := func() error {
-				s.Start()
-				defer s.Stop()
+func installDependencies(ctx context.Context, ui terminal.UI, realmClient realm.Client, remote appRemote, uploadPath string) error {
+	install := func() error {
+		s := ui.Spinner("Installing dependencies: starting...", terminal.SpinnerOptions{})
 
-				return clients.Realm.HostingCacheInvalidate(appRemote.GroupID, appRemote.AppID, "/*")
-			}
+		s.Start()
+		defer s.Stop()
 
-			if err := invalidateCache(); err != nil {
+		if err := realmClient.ImportDependencies(ctx, remote.GroupID, remote.AppID, uploadPath); err != nil {
+			return err
+		}
+
+		status := realm.DependenciesStatus{State: realm.DependenciesStateCreated}
+		for status.State == realm.DependenciesStateCreated {
+			var err error
+			status, err = realmClient.DependenciesStatus(ctx, remote.GroupID, remote.AppID)
+			if err != nil {
 				return err
 			}
-			ui.Print(terminal.NewTextLog("Reset CDN cache"))
+
+			if status.State == realm.DependenciesStateSuccessful || status.State == realm.DependenciesStateFailed {
+				break
+			}
+
+			s.SetMessage(fmt.Sprintf("Installing dependencies: %s...", status.Message))
+			time.Sleep(time.Second)
 		}
+		if status.State == realm.DependenciesStateFailed {
+			return fmt.Errorf("failed to install dependencies: %s", status.Message)
+		}
+		return nil
 	}
 
-	ui.Print(terminal.NewTextLog("Successfully pushed app up: %s", appRemote.ClientAppID))
+	if err := install(); err != nil {
+		return err
+	}
+
+	ui.Print(terminal.NewTextLog("Installed dependencies"))
+	return nil
+}
+
+func (cmd *Command) importHosting(ctx context.Context, ui terminal.UI, realmClient realm.Client, remote appRemote, hosting local.Hosting, hostingDiffs local.HostingDiffs) error {
+	s := ui.Spinner("Importing hosting assets...", terminal.SpinnerOptions{})
+
+	importHosting := func() error {
+		s.Start()
+		defer s.Stop()
+
+		return hosting.UploadHostingAssets(
+			ctx,
+			realmClient,
+			remote.GroupID,
+			remote.AppID,
+			hostingDiffs,
+			func(err error) {
+				ui.Print(terminal.NewWarningLog("An error occurred while uploading hosting assets: %s", err.Error()))
+			},
+		)
+	}
+
+	if err := importHosting(); err != nil {
+		return err
+	}
+	ui.Print(terminal.NewTextLog("Import hosting assets"))
+
+	if cmd.inputs.ResetCDNCache {
+		s := ui.Spinner("Resetting CDN cache...", terminal.SpinnerOptions{})
+
+		invalidateCache := func() error {
+			s.Start()
+			defer s.Stop()
+
+			return realmClient.HostingCacheInvalidate(ctx, remote.GroupID, remote.AppID, "/*")
+		}
+
+		if err := invalidateCache(); err != nil {
+			return err
+		}
+		ui.Print(terminal.NewTextLog("Reset CDN cache"))
+	}
 	return nil
 }
 
@@ -394,7 +787,7 @@ type deploymentModeler interface{ DeploymentModel() realm.DeploymentModel }
 type environmenter interface{ Environment() realm.Environment }
 type configVersioner interface{ ConfigVersion() realm.AppConfigVersion }
 
-func createNewApp(ui terminal.UI, realmClient realm.Client, appDirectory, groupID string, appData interface{}) (realm.App, bool, error) {
+func createNewApp(ctx context.Context, ui terminal.UI, realmClient realm.Client, appDirectory, groupID string, appData interface{}) (realm.App, bool, error) {
 	if proceed, err := ui.Confirm("Do you wish to create a new app?"); err != nil {
 		return realm.App{}, false, err
 	} else if !proceed {
@@ -475,6 +868,7 @@ func createNewApp(ui terminal.UI, realmClient realm.Client, appDirectory, groupI
 	}
 
 	app, err := realmClient.CreateApp(
+		ctx,
 		groupID,
 		name,
 		realm.AppMeta{
@@ -493,8 +887,8 @@ func createNewApp(ui terminal.UI, realmClient realm.Client, appDirectory, groupI
 	return app, true, nil
 }
 
-func createNewDraft(ui terminal.UI, realmClient realm.Client, remote appRemote) (realm.AppDraft, bool, error) {
-	draft, draftErr := realmClient.CreateDraft(remote.GroupID, remote.AppID)
+func createNewDraft(ctx context.Context, ui terminal.UI, realmClient realm.Client, remote appRemote) (realm.AppDraft, bool, error) {
+	draft, draftErr := realmClient.CreateDraft(ctx, remote.GroupID, remote.AppID)
 	if draftErr == nil {
 		return draft, true, nil
 	}
@@ -503,13 +897,13 @@ func createNewDraft(ui terminal.UI, realmClient realm.Client, remote appRemote)
 		return realm.AppDraft{}, false, draftErr
 	}
 
-	existingDraft, existingDraftErr := realmClient.Draft(remote.GroupID, remote.AppID)
+	existingDraft, existingDraftErr := realmClient.Draft(ctx, remote.GroupID, remote.AppID)
 	if existingDraftErr != nil {
 		return realm.AppDraft{}, false, existingDraftErr
 	}
 
 	if !ui.AutoConfirm() {
-		if err := diffDraft(ui, realmClient, remote, existingDraft.ID); err != nil {
+		if err := diffDraft(ctx, ui, realmClient, remote, existingDraft.ID); err != nil {
 			return realm.AppDraft{}, false, err
 		}
 
@@ -522,16 +916,35 @@ func createNewDraft(ui terminal.UI, realmClient realm.Client, remote appRemote)
 		}
 	}
 
-	if err := realmClient.DiscardDraft(remote.GroupID, remote.AppID, existingDraft.ID); err != nil {
+	if err := realmClient.DiscardDraft(ctx, remote.GroupID, remote.AppID, existingDraft.ID); err != nil {
 		return realm.AppDraft{}, false, err
 	}
 
-	draft, draftErr = realmClient.CreateDraft(remote.GroupID, remote.AppID)
+	draft, draftErr = realmClient.CreateDraft(ctx, remote.GroupID, remote.AppID)
 	return draft, true, draftErr
 }
 
-func diffDraft(ui terminal.UI, realmClient realm.Client, remote appRemote, draftID string) error {
-	diff, diffErr := realmClient.DiffDraft(remote.GroupID, remote.AppID, draftID)
+// findOrCreateDraft returns the draft already staged for the app,
+// or creates a new draft when there is none
+func findOrCreateDraft(ctx context.Context, realmClient realm.Client, remote appRemote) (realm.AppDraft, bool, error) {
+	draft, draftErr := realmClient.CreateDraft(ctx, remote.GroupID, remote.AppID)
+	if draftErr == nil {
+		return draft, false, nil
+	}
+
+	if err, ok := draftErr.(realm.ServerError); !ok || err.Code != realm.ErrCodeDraftAlreadyExists {
+		return realm.AppDraft{}, false, draftErr
+	}
+
+	existingDraft, err := realmClient.Draft(ctx, remote.GroupID, remote.AppID)
+	if err != nil {
+		return realm.AppDraft{}, false, err
+	}
+	return existingDraft, true, nil
+}
+
+func diffDraft(ctx context.Context, ui terminal.UI, realmClient realm.Client, remote appRemote, draftID string) error {
+	diff, diffErr := realmClient.DiffDraft(ctx, remote.GroupID, remote.AppID, draftID)
 	if diffErr != nil {
 		return diffErr
 	}
@@ -558,31 +971,28 @@ func diffDraft(ui terminal.UI, realmClient realm.Client, remote appRemote, draft
 	return nil
 }
 
-func deployDraftAndWait(ui terminal.UI, realmClient realm.Client, remote appRemote, draftID string) error {
-	deployment, err := realmClient.DeployDraft(remote.GroupID, remote.AppID, draftID)
-	if err != nil {
-		return err
+// discardDraft discards the draft created for the push, using a new context
+// if the push was interrupted so the draft is not left behind
+func discardDraft(ctx context.Context, ui terminal.UI, realmClient realm.Client, remote appRemote, draftID string) {
+	if ctx.Err() != nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(context.Background(), discardDraftTimeout)
+		defer cancel()
 	}
 
-	s := ui.Spinner("Deploying app changes...", terminal.SpinnerOptions{})
-
-	waitForDeployment := func() error {
-		s.Start()
-		defer s.Stop()
-
-		for deployment.Status == realm.DeploymentStatusCreated || deployment.Status == realm.DeploymentStatusPending {
-			time.Sleep(time.Second)
-
-			deployment, err = realmClient.Deployment(remote.GroupID, remote.AppID, deployment.ID)
-			if err != nil {
-				return err
-			}
-		}
+	if err := realmClient.DiscardDraft(ctx, remote.GroupID, remote.AppID, draftID); err != nil {
+		ui.Print(warnFailedToDiscardDraft)
+	}
+}
 
-		return nil
+func deployDraftAndWait(ctx context.Context, ui terminal.UI, realmClient realm.Client, remote appRemote, draftID string) error {
+	deployment, err := realmClient.DeployDraft(ctx, remote.GroupID, remote.AppID, draftID)
+	if err != nil {
+		return err
 	}
 
-	if err := waitForDeployment(); err != nil {
+	deployment, err = cli.WaitForDeployment(ctx, ui, realmClient, remote.GroupID, remote.AppID, deployment, "Deploying app changes...")
+	if err != nil {
 		return err
 	}
 
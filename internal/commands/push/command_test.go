@@ -1,7 +1,9 @@
 package push
 
 import (
+	"archive/zip"
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"io/ioutil"
@@ -9,8 +11,10 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/feedback"
 	"github.com/10gen/realm-cli/internal/cloud/atlas"
 	"github.com/10gen/realm-cli/internal/cloud/realm"
 	"github.com/10gen/realm-cli/internal/local"
@@ -54,7 +58,7 @@ func TestPushHandler(t *testing.T) {
 
 		cmd := &Command{inputs{LocalPath: "testdata/project", Project: "groupID", RemoteApp: "appID"}}
 
-		err := cmd.Handler(nil, nil, cli.Clients{Realm: realmClient})
+		err := cmd.Handler(context.Background(), nil, nil, cli.Clients{Realm: realmClient})
 		assert.Equal(t, errors.New("something bad happened"), err)
 
 		t.Log("and should properly pass through the expected inputs")
@@ -74,7 +78,7 @@ func TestPushHandler(t *testing.T) {
 
 		cmd := &Command{inputs{LocalPath: "testdata/project"}}
 
-		err := cmd.Handler(nil, nil, cli.Clients{
+		err := cmd.Handler(context.Background(), nil, nil, cli.Clients{
 			Realm: realmClient,
 			Atlas: atlasClient,
 		})
@@ -103,7 +107,7 @@ func TestPushHandler(t *testing.T) {
 
 		cmd := &Command{inputs{LocalPath: "testdata/project", RemoteApp: "appID"}}
 
-		assert.Equal(t, errors.New("something bad happened"), cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, errors.New("something bad happened"), cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient}))
 
 		t.Log("and should properly pass through the expected inputs")
 		assert.Equal(t, "groupID", capturedGroupID)
@@ -131,7 +135,7 @@ func TestPushHandler(t *testing.T) {
 
 		cmd := &Command{inputs{LocalPath: "testdata/project", RemoteApp: "appID"}}
 
-		err := cmd.Handler(nil, ui, cli.Clients{Realm: realmClient})
+		err := cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient})
 		assert.Equal(t, errors.New("something bad happened"), err)
 
 		t.Log("and should properly pass through the expected inputs")
@@ -162,7 +166,7 @@ func TestPushHandler(t *testing.T) {
 
 		cmd := &Command{inputs{LocalPath: "testdata/project", RemoteApp: "appID"}}
 
-		err := cmd.Handler(nil, ui, cli.Clients{Realm: realmClient})
+		err := cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient})
 		assert.Equal(t, errors.New("something bad happened"), err)
 
 		t.Log("and should properly pass through the expected inputs")
@@ -205,7 +209,7 @@ func TestPushHandler(t *testing.T) {
 
 		cmd := &Command{inputs{LocalPath: "testdata/project", RemoteApp: "appID"}}
 
-		err := cmd.Handler(nil, ui, cli.Clients{Realm: realmClient})
+		err := cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient})
 		assert.Equal(t, errors.New("something bad happened"), err)
 
 		t.Log("and should properly pass through the expected inputs")
@@ -217,6 +221,40 @@ func TestPushHandler(t *testing.T) {
 		assert.True(t, discardDraftCalled, "expected (realm.Client).DiscardDraft to be called")
 	})
 
+	t.Run("should discard the created draft with a new context if the command is interrupted", func(t *testing.T) {
+		out := new(bytes.Buffer)
+		ui := mock.NewUIWithOptions(mock.UIOptions{AutoConfirm: true}, out)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		var realmClient mock.RealmClient
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{{ID: "appID", GroupID: "groupID"}}, nil
+		}
+		realmClient.DiffFn = func(groupID, appID string, appData interface{}) ([]string, error) {
+			return []string{"diff1"}, nil
+		}
+		realmClient.CreateDraftFn = func(groupID, appID string) (realm.AppDraft, error) {
+			return realm.AppDraft{ID: "draftID"}, nil
+		}
+		realmClient.ImportFn = func(groupID, appID string, appData interface{}) error {
+			cancel()
+			return context.Canceled
+		}
+
+		client := discardDraftRealmClient{RealmClient: realmClient}
+
+		cmd := &Command{inputs{LocalPath: "testdata/project", RemoteApp: "appID"}}
+
+		err := cmd.Handler(ctx, nil, ui, cli.Clients{Realm: &client})
+		assert.Equal(t, context.Canceled, err)
+
+		t.Log("and should discard the created draft with a context that is not cancelled")
+		assert.Equal(t, "draftID", client.draftID)
+		assert.Nil(t, client.ctxErr)
+	})
+
 	t.Run("should return an error if the command fails to import and report when a draft is not discarded", func(t *testing.T) {
 		out := new(bytes.Buffer)
 		ui := mock.NewUIWithOptions(mock.UIOptions{AutoConfirm: true}, out)
@@ -243,7 +281,7 @@ func TestPushHandler(t *testing.T) {
 
 		cmd := &Command{inputs{LocalPath: "testdata/project", RemoteApp: "appID"}}
 
-		err := cmd.Handler(nil, ui, cli.Clients{Realm: realmClient})
+		err := cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient})
 		assert.Equal(t, errors.New("something bad happened"), err)
 
 		assert.Equal(t, `Determining changes
@@ -290,7 +328,7 @@ Failed to discard the draft created for your deployment
 
 		cmd := &Command{inputs{LocalPath: "testdata/project", RemoteApp: "appID"}}
 
-		err := cmd.Handler(nil, ui, cli.Clients{Realm: realmClient})
+		err := cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient})
 		assert.Equal(t, errors.New("something bad happened"), err)
 
 		t.Log("and should properly pass through the expected inputs")
@@ -331,7 +369,7 @@ Failed to discard the draft created for your deployment
 
 		cmd := &Command{inputs{LocalPath: "testdata/project", RemoteApp: "appID"}}
 
-		err := cmd.Handler(nil, ui, cli.Clients{Realm: realmClient})
+		err := cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient})
 		assert.Equal(t, errors.New("something bad happened"), err)
 
 		assert.Equal(t, `Determining changes
@@ -374,7 +412,7 @@ Failed to discard the draft created for your deployment
 
 		cmd := &Command{inputs{LocalPath: "testdata/project", RemoteApp: "appID"}}
 
-		err := cmd.Handler(nil, ui, cli.Clients{Realm: realmClient})
+		err := cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient})
 		assert.Equal(t, errors.New("failed to deploy app: something bad happened"), err)
 
 		t.Log("and should attempt to discard the created draft")
@@ -410,7 +448,7 @@ Failed to discard the draft created for your deployment
 
 		cmd := &Command{inputs{LocalPath: "testdata/project", RemoteApp: "appID"}}
 
-		err := cmd.Handler(nil, ui, cli.Clients{Realm: realmClient})
+		err := cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient})
 		assert.Equal(t, errors.New("failed to deploy app: something bad happened"), err)
 
 		assert.Equal(t, `Determining changes
@@ -451,6 +489,100 @@ Failed to discard the draft created for your deployment
 			runImport(t, realmClient, "testdata/project-alt") // specifies a different app id
 		})
 
+		t.Run("should stage the changes in a draft without deploying them with no deploy set", func(t *testing.T) {
+			noDeployClient := realmClient
+			noDeployClient.DeployDraftFn = func(groupID, appID, draftID string) (realm.AppDeployment, error) {
+				return realm.AppDeployment{}, errors.New("draft should not be deployed")
+			}
+
+			out := new(bytes.Buffer)
+			ui := mock.NewUIWithOptions(mock.UIOptions{AutoConfirm: true}, out)
+
+			cmd := &Command{inputs{LocalPath: "testdata/project", RemoteApp: "appID", NoDeploy: true}}
+
+			assert.Nil(t, cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: noDeployClient}))
+			assert.Equal(t, `Determining changes
+Creating draft
+Pushing changes
+Successfully staged changes in draft: draftID
+To review and deploy the staged changes, run
+  realm-cli drafts diff --project groupID --app eggcorn-abcde
+  realm-cli drafts deploy --project groupID --app eggcorn-abcde
+`, out.String())
+		})
+
+		t.Run("should stage the changes in the existing draft with no deploy set", func(t *testing.T) {
+			noDeployClient := realmClient
+			noDeployClient.CreateDraftFn = func(groupID, appID string) (realm.AppDraft, error) {
+				return realm.AppDraft{}, realm.ServerError{Code: realm.ErrCodeDraftAlreadyExists, Message: "a draft already exists"}
+			}
+			noDeployClient.DraftFn = func(groupID, appID string) (realm.AppDraft, error) {
+				return realm.AppDraft{ID: "existingDraftID"}, nil
+			}
+			noDeployClient.DiscardDraftFn = func(groupID, appID, draftID string) error {
+				return errors.New("draft should not be discarded")
+			}
+			noDeployClient.DeployDraftFn = func(groupID, appID, draftID string) (realm.AppDeployment, error) {
+				return realm.AppDeployment{}, errors.New("draft should not be deployed")
+			}
+
+			var importCalled bool
+			noDeployClient.ImportFn = func(groupID, appID string, appData interface{}) error {
+				importCalled = true
+				return nil
+			}
+
+			out := new(bytes.Buffer)
+			ui := mock.NewUIWithOptions(mock.UIOptions{AutoConfirm: true}, out)
+
+			cmd := &Command{inputs{LocalPath: "testdata/project", RemoteApp: "appID", NoDeploy: true}}
+
+			assert.Nil(t, cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: noDeployClient}))
+			assert.True(t, importCalled, "expected the changes to be imported into the existing draft")
+			assert.Equal(t, `Determining changes
+Staging changes in existing draft: existingDraftID
+Pushing changes
+Successfully staged changes in draft: existingDraftID
+To review and deploy the staged changes, run
+  realm-cli drafts diff --project groupID --app eggcorn-abcde
+  realm-cli drafts deploy --project groupID --app eggcorn-abcde
+`, out.String())
+		})
+
+		t.Run("should push the app as the app of the environment with an environment set", func(t *testing.T) {
+			envClient := realmClient
+			envClient.FindAppFn = func(groupID, appID string) (realm.App, error) {
+				return realm.App{ID: appID, GroupID: groupID, ClientAppID: "eggcorn-prod-fghij", Name: "eggcorn-prod"}, nil
+			}
+
+			var importGroupID, importAppID string
+			var importAppData local.AppData
+			envClient.ImportFn = func(groupID, appID string, appData interface{}) error {
+				importGroupID, importAppID = groupID, appID
+				importAppData = appData.(local.AppData)
+				return nil
+			}
+
+			out := new(bytes.Buffer)
+			ui := mock.NewUIWithOptions(mock.UIOptions{AutoConfirm: true}, out)
+
+			cmd := &Command{inputs{LocalPath: "testdata/project-env", Environment: realm.EnvironmentProduction}}
+
+			assert.Nil(t, cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: envClient}))
+			assert.Equal(t, "prod-group", importGroupID)
+			assert.Equal(t, "prod-app", importAppID)
+			assert.Equal(t, "eggcorn-prod-fghij", importAppData.ID())
+			assert.Equal(t, "eggcorn-prod", importAppData.Name())
+			assert.Equal(t, realm.EnvironmentProduction, importAppData.Environment())
+			assert.Equal(t, `Determining changes
+Creating draft
+Pushing changes
+Deploying draft
+Deployment complete
+Successfully pushed app up: eggcorn-prod-fghij
+`, out.String())
+		})
+
 		t.Run("but fails to upload a hosting asset", func(t *testing.T) {
 			profile, teardown := mock.NewProfileFromTmpDir(t, "push-handler")
 			defer teardown()
@@ -473,7 +605,7 @@ Failed to discard the draft created for your deployment
 
 				cmd := &Command{inputs{LocalPath: "testdata/hosting", RemoteApp: "appID", IncludeHosting: true}}
 
-				err := cmd.Handler(profile, ui, cli.Clients{Realm: realmClient})
+				err := cmd.Handler(context.Background(), profile, ui, cli.Clients{Realm: realmClient})
 				assert.Equal(t, errors.New("2 error(s) occurred while importing hosting assets"), err)
 				output := out.String()
 				for _, line := range []string{
@@ -502,7 +634,7 @@ Deployment complete`,
 
 				cmd := &Command{inputs{LocalPath: "testdata/hosting", RemoteApp: "appID", IncludeHosting: true}}
 
-				err := cmd.Handler(profile, ui, cli.Clients{Realm: realmClient})
+				err := cmd.Handler(context.Background(), profile, ui, cli.Clients{Realm: realmClient})
 				assert.Equal(t, errors.New("2 error(s) occurred while importing hosting assets"), err)
 				output := out.String()
 				for _, line := range []string{
@@ -547,7 +679,7 @@ Deployment complete`,
 
 				cmd := &Command{inputs{LocalPath: "testdata/hosting", RemoteApp: "appID", IncludeHosting: true}}
 
-				err := cmd.Handler(profile, ui, cli.Clients{Realm: realmClient})
+				err := cmd.Handler(context.Background(), profile, ui, cli.Clients{Realm: realmClient})
 				assert.Equal(t, errors.New("1 error(s) occurred while importing hosting assets"), err)
 				assert.Equal(t, `Determining changes
 Creating draft
@@ -590,7 +722,7 @@ An error occurred while uploading hosting assets: failed to remove /deleteme.htm
 
 				cmd := &Command{inputs{LocalPath: "testdata/hosting", RemoteApp: "appID", IncludeHosting: true}}
 
-				err := cmd.Handler(profile, ui, cli.Clients{Realm: realmClient})
+				err := cmd.Handler(context.Background(), profile, ui, cli.Clients{Realm: realmClient})
 				assert.Equal(t, errors.New("2 error(s) occurred while importing hosting assets"), err)
 
 				output := out.String()
@@ -641,7 +773,7 @@ Deployment complete`,
 
 			cmd := &Command{inputs{LocalPath: "testdata/hosting", RemoteApp: "appID", IncludeHosting: true}}
 
-			err := cmd.Handler(profile, ui, cli.Clients{Realm: realmClient})
+			err := cmd.Handler(context.Background(), profile, ui, cli.Clients{Realm: realmClient})
 			assert.Nil(t, err)
 			assert.Equal(t, `Determining changes
 Creating draft
@@ -682,7 +814,7 @@ Successfully pushed app up: eggcorn-abcde
 
 			cmd := &Command{inputs{LocalPath: "testdata/hosting", RemoteApp: "appID", IncludeHosting: true, ResetCDNCache: true}}
 
-			err := cmd.Handler(profile, ui, cli.Clients{Realm: realmClient})
+			err := cmd.Handler(context.Background(), profile, ui, cli.Clients{Realm: realmClient})
 			assert.Equal(t, errors.New("something bad happened"), err)
 		})
 
@@ -711,7 +843,7 @@ Successfully pushed app up: eggcorn-abcde
 
 			cmd := &Command{inputs{LocalPath: "testdata/hosting", RemoteApp: "appID", IncludeHosting: true, ResetCDNCache: true}}
 
-			err := cmd.Handler(profile, ui, cli.Clients{Realm: realmClient})
+			err := cmd.Handler(context.Background(), profile, ui, cli.Clients{Realm: realmClient})
 			assert.Nil(t, err)
 			assert.Equal(t, `Determining changes
 Creating draft
@@ -738,7 +870,7 @@ Successfully pushed app up: eggcorn-abcde
 
 				cmd := &Command{inputs{LocalPath: "testdata/project", RemoteApp: "appID"}}
 
-				assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+				assert.Nil(t, cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient}))
 				assert.Equal(t, `Determining changes
 Creating draft
 Pushing changes
@@ -754,7 +886,7 @@ Successfully pushed app up: eggcorn-abcde
 
 				cmd := &Command{inputs{LocalPath: "testdata/project", RemoteApp: "appID", IncludeNodeModules: true}}
 
-				err := cmd.Handler(nil, ui, cli.Clients{Realm: realmClient})
+				err := cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient})
 				assert.Equal(t, errors.New("something bad happened"), err)
 			})
 		})
@@ -776,7 +908,7 @@ Successfully pushed app up: eggcorn-abcde
 
 				cmd := &Command{inputs{LocalPath: "testdata/project", RemoteApp: "appID", IncludeNodeModules: true}}
 
-				err := cmd.Handler(nil, ui, cli.Clients{Realm: realmClient})
+				err := cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient})
 				assert.Equal(t, errors.New("something bad happened"), err)
 			})
 			t.Run("because of an installation problem", func(t *testing.T) {
@@ -788,7 +920,7 @@ Successfully pushed app up: eggcorn-abcde
 
 				cmd := &Command{inputs{LocalPath: "testdata/project", RemoteApp: "appID", IncludeNodeModules: true}}
 
-				err := cmd.Handler(nil, ui, cli.Clients{Realm: realmClient})
+				err := cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient})
 				assert.Equal(t, errors.New("failed to install dependencies: something bad happened"), err)
 			})
 		})
@@ -806,7 +938,7 @@ Successfully pushed app up: eggcorn-abcde
 
 			cmd := &Command{inputs{LocalPath: "testdata/project", RemoteApp: "appID", IncludeNodeModules: true}}
 
-			assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+			assert.Nil(t, cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient}))
 			assert.Equal(t, `Determining changes
 Creating draft
 Pushing changes
@@ -850,7 +982,7 @@ Successfully pushed app up: eggcorn-abcde
 
 				out, ui := mock.NewUI()
 
-				err := cmd.Handler(nil, ui, cli.Clients{
+				err := cmd.Handler(context.Background(), nil, ui, cli.Clients{
 					Realm: realmClient,
 					Atlas: atlasClient,
 				})
@@ -885,7 +1017,7 @@ Try instead: realm-cli push --local testdata/project --remote appID
 
 		cmd := &Command{inputs{LocalPath: "testdata/project", RemoteApp: "appID"}}
 
-		err := cmd.Handler(nil, ui, cli.Clients{Realm: realmClient})
+		err := cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient})
 
 		console.Tty().Close() // flush the writers
 		<-doneCh              // wait for procedure to complete
@@ -906,7 +1038,7 @@ Try instead: realm-cli push --local testdata/project --remote appID
 
 		cmd := &Command{inputs{LocalPath: "testdata/project", DryRun: true, RemoteApp: "appID"}}
 
-		err := cmd.Handler(nil, ui, cli.Clients{Realm: realmClient})
+		err := cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient})
 		assert.Nil(t, err)
 		assert.Equal(t, `Determining changes
 Deployed app is identical to proposed version, nothing to do
@@ -935,7 +1067,7 @@ Deployed app is identical to proposed version, nothing to do
 
 		cmd := &Command{inputs{LocalPath: "testdata/project-meta", DryRun: true}}
 
-		err := cmd.Handler(nil, ui, cli.Clients{Realm: realmClient, Atlas: atlasClient})
+		err := cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient, Atlas: atlasClient})
 		assert.Nil(t, err)
 		assert.False(t, findAppsCalled, "Expected to skip resolve app ID")
 		assert.False(t, groupsCalled, "Expected to skip resolve group ID")
@@ -944,6 +1076,55 @@ Deployed app is identical to proposed version, nothing to do
 `, out.String())
 	})
 
+	t.Run("with an environment should push to the remote app of the environment", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		var realmClient mock.RealmClient
+		var findAppsCalled bool
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			findAppsCalled = true
+			return []realm.App{{ID: "appID", GroupID: "groupID"}}, nil
+		}
+		realmClient.FindAppFn = func(groupID, appID string) (realm.App, error) {
+			return realm.App{ID: appID, GroupID: groupID, ClientAppID: "eggcorn-prod-fghij", Name: "eggcorn-prod"}, nil
+		}
+
+		var diffGroupID, diffAppID string
+		var diffEnvironment realm.Environment
+		realmClient.DiffFn = func(groupID, appID string, appData interface{}) ([]string, error) {
+			diffGroupID, diffAppID = groupID, appID
+			diffEnvironment = appData.(local.AppData).Environment()
+			return []string{}, nil
+		}
+
+		cmd := &Command{inputs{LocalPath: "testdata/project-env", RemoteApp: "appID", DryRun: true, Environment: realm.EnvironmentProduction}}
+
+		err := cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient})
+		assert.Nil(t, err)
+		assert.False(t, findAppsCalled, "Expected to skip resolve app ID")
+		assert.Equal(t, "prod-group", diffGroupID)
+		assert.Equal(t, "prod-app", diffAppID)
+		assert.Equal(t, realm.EnvironmentProduction, diffEnvironment)
+		assert.Equal(t, `Determining changes
+Deployed app is identical to proposed version, nothing to do
+`, out.String())
+	})
+
+	t.Run("with an environment that has no remote app configured should return an error", func(t *testing.T) {
+		_, ui := mock.NewUI()
+
+		wd, err := os.Getwd()
+		assert.Nil(t, err)
+
+		cmd := &Command{inputs{LocalPath: "testdata/project-env", DryRun: true, Environment: realm.EnvironmentQA}}
+
+		err = cmd.Handler(context.Background(), nil, ui, cli.Clients{})
+		assert.Equal(t, fmt.Errorf(
+			"no remote app is configured for the 'qa' environment, set its group_id and app_id in %s",
+			filepath.Join(wd, "testdata/project-env", local.FileProjectConfig.String()),
+		), err)
+	})
+
 	t.Run("with diffs generated from the app but is a dry run", func(t *testing.T) {
 		var realmClient mock.RealmClient
 		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
@@ -957,9 +1138,9 @@ Deployed app is identical to proposed version, nothing to do
 
 		cmd := &Command{inputs{LocalPath: "testdata/project", DryRun: true, RemoteApp: "appID"}}
 
-		err := cmd.Handler(nil, ui, cli.Clients{Realm: realmClient})
+		err := cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient})
 
-		assert.Nil(t, err)
+		assert.Equal(t, feedback.ErrExitCode{Code: diffExitCode}, err)
 		assert.Equal(t, `Determining changes
 The following reflects the proposed changes to your Realm app
 diff1
@@ -989,9 +1170,9 @@ Try instead: realm-cli push --local testdata/project --remote appID
 
 		cmd := &Command{inputs{LocalPath: "testdata/dependencies", DryRun: true, RemoteApp: "appID", IncludeNodeModules: true}}
 
-		err := cmd.Handler(nil, ui, cli.Clients{Realm: realmClient})
+		err := cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient})
 
-		assert.Nil(t, err)
+		assert.Equal(t, feedback.ErrExitCode{Code: diffExitCode}, err)
 		assert.Equal(t, `Determining changes
 The following reflects the proposed changes to your Realm app
 diff1
@@ -1023,7 +1204,7 @@ Try instead: realm-cli push --local testdata/dependencies --remote appID --inclu
 
 		cmd := &Command{inputs{LocalPath: "testdata/dependencies", RemoteApp: "appID", IncludeNodeModules: true}}
 
-		assert.Equal(t, errors.New("realm client error"), cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, errors.New("realm client error"), cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient}))
 	})
 
 	t.Run("with diffs generated from the app but the user rejects them", func(t *testing.T) {
@@ -1050,7 +1231,7 @@ Try instead: realm-cli push --local testdata/dependencies --remote appID --inclu
 
 		cmd := &Command{inputs{LocalPath: "testdata/project", RemoteApp: "appID"}}
 
-		err := cmd.Handler(nil, ui, cli.Clients{Realm: realmClient})
+		err := cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient})
 
 		console.Tty().Close() // flush the writers
 		<-doneCh              // wait for procedure to complete
@@ -1181,7 +1362,7 @@ func TestPushHandlerCreateNewApp(t *testing.T) {
 				defer console.Close()
 
 				cmd := &Command{inputs{LocalPath: filepath.Join(tmpDir, "nested"), RemoteApp: "appID"}}
-				assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+				assert.Nil(t, cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient}))
 
 				configData, readErr := ioutil.ReadFile(filepath.Join(tmpDir, tc.appConfig.String()))
 				assert.Nil(t, readErr)
@@ -1282,7 +1463,7 @@ func TestPushHandlerCreateNewApp(t *testing.T) {
 				}()
 
 				cmd := &Command{inputs{LocalPath: tmpDir, RemoteApp: "appID"}}
-				assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+				assert.Nil(t, cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient}))
 
 				console.Tty().Close() // flush the writers
 				<-doneCh              // wait for procedure to complete
@@ -1461,7 +1642,7 @@ func TestPushCommandCreateNewApp(t *testing.T) {
 						tc.procedure(console)
 					}()
 
-					app, proceed, err := createNewApp(ui, realmClient, tmpDir, groupID, map[string]interface{}{})
+					app, proceed, err := createNewApp(context.Background(), ui, realmClient, tmpDir, groupID, map[string]interface{}{})
 
 					console.Tty().Close() // flush the writers
 					<-doneCh              // wait for procedure to complete
@@ -1513,7 +1694,7 @@ func TestPushCommandCreateNewApp(t *testing.T) {
 						AppMeta: tc.expectedAppMeta,
 					}
 
-					app, proceed, err := createNewApp(ui, realmClient, tmpDir, "groupID", tc.appData)
+					app, proceed, err := createNewApp(context.Background(), ui, realmClient, tmpDir, "groupID", tc.appData)
 					assert.Nil(t, err)
 					assert.True(t, proceed, "should proceed")
 					assert.Equal(t, expectedApp, app)
@@ -1563,7 +1744,7 @@ func TestPushCommandCreateNewApp(t *testing.T) {
 						console.ExpectEOF()
 					}()
 
-					app, proceed, err := createNewApp(ui, realmClient, tmpDir, groupID, tc.appData)
+					app, proceed, err := createNewApp(context.Background(), ui, realmClient, tmpDir, groupID, tc.appData)
 					assert.Nil(t, err)
 
 					console.Tty().Close() // flush the writers
@@ -1592,7 +1773,7 @@ func TestPushCommandCreateNewApp(t *testing.T) {
 		out := new(bytes.Buffer)
 		ui := mock.NewUIWithOptions(mock.UIOptions{AutoConfirm: true}, out)
 
-		_, _, err := createNewApp(ui, realmClient, "", "groupID", fullPkg)
+		_, _, err := createNewApp(context.Background(), ui, realmClient, "", "groupID", fullPkg)
 		assert.Equal(t, errors.New("something bad happened"), err)
 	})
 }
@@ -1611,7 +1792,7 @@ func TestPushCommandCreateNewDraft(t *testing.T) {
 			return testDraft, nil
 		}
 
-		draft, proceed, err := createNewDraft(nil, realmClient, appRemote{groupID, appID, clientAppID})
+		draft, proceed, err := createNewDraft(context.Background(), nil, realmClient, appRemote{GroupID: groupID, AppID: appID, ClientAppID: clientAppID})
 		assert.Nil(t, err)
 		assert.Equal(t, testDraft, draft)
 		assert.True(t, proceed, "expected draft to be created successfully")
@@ -1627,7 +1808,7 @@ func TestPushCommandCreateNewDraft(t *testing.T) {
 			return realm.AppDraft{}, errors.New("something bad happened while creating a draft")
 		}
 
-		_, _, err := createNewDraft(nil, realmClient, appRemote{})
+		_, _, err := createNewDraft(context.Background(), nil, realmClient, appRemote{})
 		assert.Equal(t, errors.New("something bad happened while creating a draft"), err)
 	})
 
@@ -1644,7 +1825,7 @@ func TestPushCommandCreateNewDraft(t *testing.T) {
 				return realm.AppDraft{}, errors.New("something bad happened while getting a draft")
 			}
 
-			_, _, err := createNewDraft(nil, realmClient, appRemote{})
+			_, _, err := createNewDraft(context.Background(), nil, realmClient, appRemote{})
 			assert.Equal(t, errors.New("something bad happened while getting a draft"), err)
 		})
 
@@ -1670,7 +1851,7 @@ func TestPushCommandCreateNewDraft(t *testing.T) {
 						return errors.New("something bad happened while discarding the draft")
 					}
 
-					_, _, err := createNewDraft(ui, realmClient, appRemote{})
+					_, _, err := createNewDraft(context.Background(), ui, realmClient, appRemote{})
 					assert.Equal(t, errors.New("something bad happened while discarding the draft"), err)
 
 					t.Log("and should properly pass through the expected inputs")
@@ -1687,7 +1868,7 @@ func TestPushCommandCreateNewDraft(t *testing.T) {
 							return realm.AppDraft{}, errDraftAlreadyExists
 						}
 
-						_, _, err := createNewDraft(ui, realmClient, appRemote{})
+						_, _, err := createNewDraft(context.Background(), ui, realmClient, appRemote{})
 						assert.Equal(t, errDraftAlreadyExists, err)
 					})
 
@@ -1700,7 +1881,7 @@ func TestPushCommandCreateNewDraft(t *testing.T) {
 							return testDraft, nil
 						}
 
-						draft, proceed, err := createNewDraft(nil, realmClient, appRemote{})
+						draft, proceed, err := createNewDraft(context.Background(), nil, realmClient, appRemote{})
 						assert.Nil(t, err)
 						assert.Equal(t, testDraft, draft)
 						assert.True(t, proceed, "expected draft to be created successfully")
@@ -1723,7 +1904,7 @@ func TestPushCommandCreateNewDraft(t *testing.T) {
 						console.ExpectEOF()
 					}()
 
-					draft, proceed, err := createNewDraft(ui, realmClient, appRemote{})
+					draft, proceed, err := createNewDraft(context.Background(), ui, realmClient, appRemote{})
 
 					console.Tty().Close() // flush the writers
 					<-doneCh              // wait for procedure to complete
@@ -1757,7 +1938,7 @@ func TestPushCommandCreateNewDraft(t *testing.T) {
 						console.ExpectEOF()
 					}()
 
-					draft, proceed, err := createNewDraft(ui, realmClient, appRemote{})
+					draft, proceed, err := createNewDraft(context.Background(), ui, realmClient, appRemote{})
 
 					console.Tty().Close() // flush the writers
 					<-doneCh              // wait for procedure to complete
@@ -1785,7 +1966,7 @@ func TestPushCommandDiffDraft(t *testing.T) {
 			return realm.AppDraftDiff{}, errors.New("something bad happened")
 		}
 
-		err := diffDraft(nil, realmClient, appRemote{groupID, appID, clientAppID}, draftID)
+		err := diffDraft(context.Background(), nil, realmClient, appRemote{GroupID: groupID, AppID: appID, ClientAppID: clientAppID}, draftID)
 		assert.Equal(t, errors.New("something bad happened"), err)
 
 		t.Log("and should properly pass through the expected inputs")
@@ -1871,7 +2052,7 @@ func TestPushCommandDiffDraft(t *testing.T) {
 
 				out, ui := mock.NewUI()
 
-				assert.Nil(t, diffDraft(ui, realmClient, appRemote{}, ""))
+				assert.Nil(t, diffDraft(context.Background(), ui, realmClient, appRemote{}, ""))
 				assert.Equal(t, tc.expectedContents, out.String())
 			})
 		}
@@ -1891,7 +2072,7 @@ func TestPushCommandDeployDraftAndWait(t *testing.T) {
 			return realm.AppDeployment{}, errors.New("something bad happened")
 		}
 
-		err := deployDraftAndWait(nil, realmClient, appRemote{groupID, appID, clientAppID}, draftID)
+		err := deployDraftAndWait(context.Background(), nil, realmClient, appRemote{GroupID: groupID, AppID: appID, ClientAppID: clientAppID}, draftID)
 		assert.Equal(t, errors.New("something bad happened"), err)
 
 		t.Log("and should properly pass through the expected inputs")
@@ -1913,7 +2094,7 @@ func TestPushCommandDeployDraftAndWait(t *testing.T) {
 
 			_, ui := mock.NewUI()
 
-			err := deployDraftAndWait(ui, realmClient, appRemote{groupID, appID, clientAppID}, draftID)
+			err := deployDraftAndWait(context.Background(), ui, realmClient, appRemote{GroupID: groupID, AppID: appID, ClientAppID: clientAppID}, draftID)
 			assert.Equal(t, errors.New("something bad happened"), err)
 		})
 
@@ -1931,7 +2112,7 @@ func TestPushCommandDeployDraftAndWait(t *testing.T) {
 
 			out, ui := mock.NewUI()
 
-			err := deployDraftAndWait(ui, realmClient, appRemote{groupID, appID, clientAppID}, draftID)
+			err := deployDraftAndWait(context.Background(), ui, realmClient, appRemote{GroupID: groupID, AppID: appID, ClientAppID: clientAppID}, draftID)
 			assert.Nil(t, err)
 
 			assert.Equal(t, "Deployment complete\n", out.String())
@@ -1939,6 +2120,344 @@ func TestPushCommandDeployDraftAndWait(t *testing.T) {
 	})
 }
 
+func TestPushHandlerWatch(t *testing.T) {
+	setupApp := func(t *testing.T) (string, func()) {
+		t.Helper()
+
+		tmpDir, teardown, err := u.NewTempDir("push_watch_test")
+		assert.Nil(t, err)
+
+		app := local.NewApp(tmpDir, "eggcorn-abcde", "eggcorn", realm.LocationVirginia, realm.DeploymentModelGlobal, realm.EnvironmentNone, realm.DefaultAppConfigVersion)
+		assert.Nil(t, app.Write())
+		assert.Nil(t, ioutil.WriteFile(filepath.Join(tmpDir, local.NameFunctions, local.NamePackageJSON), []byte(`{"dependencies": {}}`), 0666))
+
+		return tmpDir, teardown
+	}
+
+	remote := appRemote{GroupID: "groupID", AppID: "appID", ClientAppID: "eggcorn-abcde"}
+
+	t.Run("should push the app again when its files change until interrupted", func(t *testing.T) {
+		defer func(debounce time.Duration) { watchDebounce = debounce }(watchDebounce)
+		watchDebounce = 50 * time.Millisecond
+
+		tmpDir, teardown := setupApp(t)
+		defer teardown()
+
+		imports := make(chan interface{}, 10)
+
+		var realmClient mock.RealmClient
+		realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+			return []realm.App{{ID: remote.AppID, GroupID: remote.GroupID, ClientAppID: remote.ClientAppID}}, nil
+		}
+		realmClient.DiffFn = func(groupID, appID string, appData interface{}) ([]string, error) {
+			return []string{"diff1"}, nil
+		}
+		realmClient.CreateDraftFn = func(groupID, appID string) (realm.AppDraft, error) {
+			return realm.AppDraft{ID: "draftID"}, nil
+		}
+		realmClient.ImportFn = func(groupID, appID string, appData interface{}) error {
+			imports <- appData
+			return nil
+		}
+		realmClient.DeployDraftFn = func(groupID, appID, draftID string) (realm.AppDeployment, error) {
+			return realm.AppDeployment{Status: realm.DeploymentStatusSuccessful}, nil
+		}
+
+		out := new(bytes.Buffer)
+		ui := mock.NewUIWithOptions(mock.UIOptions{AutoConfirm: true}, out)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		cmd := &Command{inputs{LocalPath: tmpDir, RemoteApp: remote.AppID, Watch: true}}
+
+		errCh := make(chan error)
+		go func() {
+			errCh <- cmd.Handler(ctx, nil, ui, cli.Clients{Realm: realmClient})
+		}()
+
+		select {
+		case <-imports:
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for the initial push")
+		}
+
+		// allow the watcher to add the app directories before any files change
+		time.Sleep(100 * time.Millisecond)
+		assert.Nil(t, ioutil.WriteFile(filepath.Join(tmpDir, local.NameFunctions, "hello.js"), []byte("exports = () => 'hello'"), 0666))
+
+		select {
+		case <-imports:
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for the push of the changed files")
+		}
+
+		cancel()
+		assert.Nil(t, <-errCh)
+
+		assert.True(t, strings.HasSuffix(out.String(), fmt.Sprintf(`Watching for changes in %s, press Ctrl+C to stop
+Detected changes to %s
+Deployment complete
+Successfully pushed app up: eggcorn-abcde
+`, tmpDir, filepath.Join(local.NameFunctions, "hello.js"))), "unexpected output:\n%s", out.String())
+	})
+
+	t.Run("should upload only the hosting files with hosting changes", func(t *testing.T) {
+		profile, teardown := mock.NewProfileFromTmpDir(t, "push_watch_test")
+		defer teardown()
+
+		var uploaded []string
+
+		var realmClient mock.RealmClient
+		realmClient.DiffFn = func(groupID, appID string, appData interface{}) ([]string, error) {
+			return nil, errors.New("app should not be diffed")
+		}
+		realmClient.HostingAssetsFn = func(groupID, appID string) ([]realm.HostingAsset, error) {
+			return nil, nil
+		}
+		realmClient.HostingAssetUploadFn = func(groupID, appID, rootDir string, asset realm.HostingAsset) error {
+			uploaded = append(uploaded, asset.FilePath)
+			return nil
+		}
+
+		out := new(bytes.Buffer)
+		ui := mock.NewUIWithOptions(mock.UIOptions{AutoConfirm: true}, out)
+
+		cmd := &Command{inputs{IncludeHosting: true}}
+
+		changes := local.AppChanges{Paths: []string{filepath.Join(local.NameHosting, local.NameFiles, "index.html")}, Hosting: true}
+		assert.Nil(t, cmd.pushChanges(context.Background(), profile, ui, cli.Clients{Realm: realmClient}, "testdata/hosting", remote, changes))
+
+		assert.True(t, len(uploaded) > 0, "expected hosting assets to be uploaded")
+		assert.True(t, strings.HasSuffix(out.String(), "Import hosting assets\nSuccessfully pushed app up: eggcorn-abcde\n"), "unexpected output:\n%s", out.String())
+	})
+
+	t.Run("should upload the dependencies only when the package.json file changes", func(t *testing.T) {
+		tmpDir, teardown := setupApp(t)
+		defer teardown()
+
+		var uploads int
+
+		var realmClient mock.RealmClient
+		realmClient.DiffFn = func(groupID, appID string, appData interface{}) ([]string, error) {
+			return nil, nil
+		}
+		realmClient.ImportDependenciesFn = func(groupID, appID, uploadPath string) error {
+			uploads++
+			return nil
+		}
+		realmClient.DependenciesStatusFn = func(groupID, appID string) (realm.DependenciesStatus, error) {
+			return realm.DependenciesStatus{State: realm.DependenciesStateSuccessful}, nil
+		}
+
+		cmd := &Command{inputs{IncludePackageJSON: true}}
+
+		for _, tc := range []struct {
+			changes         local.AppChanges
+			expectedUploads int
+			expectedOutput  string
+		}{
+			{
+				changes:         local.AppChanges{Paths: []string{filepath.Join(local.NameFunctions, "hello.js")}, Config: true},
+				expectedUploads: 0,
+				expectedOutput:  "Deployed app is identical to proposed version, nothing to do\n",
+			},
+			{
+				changes:         local.AppChanges{Paths: []string{filepath.Join(local.NameFunctions, local.NamePackageJSON)}, Dependencies: true},
+				expectedUploads: 1,
+				expectedOutput:  "Installed dependencies\nSuccessfully pushed app up: eggcorn-abcde\n",
+			},
+		} {
+			out := new(bytes.Buffer)
+			ui := mock.NewUIWithOptions(mock.UIOptions{AutoConfirm: true}, out)
+
+			assert.Nil(t, cmd.pushChanges(context.Background(), nil, ui, cli.Clients{Realm: realmClient}, tmpDir, remote, tc.changes))
+			assert.Equal(t, tc.expectedUploads, uploads)
+			assert.True(t, strings.HasSuffix(out.String(), tc.expectedOutput), "unexpected output:\n%s", out.String())
+		}
+	})
+}
+
+func TestPushHandlerSelection(t *testing.T) {
+	zipPkg := u.NewZip(t, map[string]string{
+		"config.json": `{
+    "config_version": 20200603,
+    "app_id": "eggcorn-abcde",
+    "name": "eggcorn",
+    "location": "US-VA",
+    "deployment_model": "GLOBAL",
+    "security": {},
+    "custom_user_data_config": {"enabled": false},
+    "sync": {"development_mode_enabled": true}
+}`,
+		"triggers/trigger.json": `{"name": "trigger", "type": "SCHEDULED", "disabled": false}`,
+	})
+
+	var importedData map[string]interface{}
+
+	var realmClient mock.RealmClient
+	realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+		return []realm.App{{ID: "appID", GroupID: "groupID", ClientAppID: "eggcorn-abcde"}}, nil
+	}
+	realmClient.ExportFn = func(groupID, appID string, req realm.ExportRequest) (string, *zip.Reader, error) {
+		assert.Equal(t, realm.AppConfigVersion20200603, req.ConfigVersion)
+		return "eggcorn_20200603", zipPkg, nil
+	}
+	realmClient.DiffFn = func(groupID, appID string, appData interface{}) ([]string, error) {
+		return []string{"diff1"}, nil
+	}
+	realmClient.CreateDraftFn = func(groupID, appID string) (realm.AppDraft, error) {
+		return realm.AppDraft{ID: "draftID"}, nil
+	}
+	realmClient.ImportFn = func(groupID, appID string, appData interface{}) error {
+		importedData = appData.(map[string]interface{})
+		return nil
+	}
+	realmClient.DeployDraftFn = func(groupID, appID, draftID string) (realm.AppDeployment, error) {
+		return realm.AppDeployment{Status: realm.DeploymentStatusSuccessful}, nil
+	}
+	realmClient.HostingAssetsFn = func(groupID, appID string) ([]realm.HostingAsset, error) {
+		return nil, errors.New("hosting should not be included")
+	}
+
+	t.Run("should import only the selected sections of the local app", func(t *testing.T) {
+		out := new(bytes.Buffer)
+		ui := mock.NewUIWithOptions(mock.UIOptions{AutoConfirm: true}, out)
+
+		cmd := &Command{inputs{
+			LocalPath:      "testdata/project",
+			RemoteApp:      "appID",
+			IncludeHosting: true,
+			selection:      local.AppSelection{Only: []local.AppSelector{{Section: local.NameSync}}},
+		}}
+
+		assert.Nil(t, cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, `Determining changes
+Creating draft
+Pushing changes
+Deploying draft
+Deployment complete
+Successfully pushed app up: eggcorn-abcde
+`, out.String())
+
+		assert.Equal(t, map[string]interface{}{"development_mode_enabled": false}, importedData[local.NameSync])
+		assert.Equal(t, map[string]interface{}{"enabled": false}, importedData["custom_user_data_config"])
+
+		triggers, ok := importedData[local.NameTriggers].([]interface{})
+		assert.True(t, ok, "expected remote triggers to be imported")
+		assert.Equal(t, 1, len(triggers))
+	})
+}
+
+func TestPushHandlerDryRunJSON(t *testing.T) {
+	var realmClient mock.RealmClient
+	realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+		return []realm.App{{ID: "appID", GroupID: "groupID", ClientAppID: "eggcorn-abcde"}}, nil
+	}
+	realmClient.ExportFn = func(groupID, appID string, req realm.ExportRequest) (string, *zip.Reader, error) {
+		return "eggcorn_20200603", u.NewZip(t, map[string]string{
+			"config.json": `{
+    "config_version": 20200603,
+    "app_id": "eggcorn-abcde",
+    "name": "eggcorn",
+    "location": "US-VA",
+    "deployment_model": "GLOBAL",
+    "security": {},
+    "custom_user_data_config": {"enabled": true},
+    "sync": {"development_mode_enabled": true}
+}`,
+		}), nil
+	}
+	realmClient.DiffFn = func(groupID, appID string, appData interface{}) ([]string, error) {
+		return []string{"diff1"}, nil
+	}
+
+	t.Run("should print the structured changes of a dry run with json output", func(t *testing.T) {
+		out := new(bytes.Buffer)
+		ui := mock.NewUIWithOptions(mock.UIOptions{UseJSON: true}, out)
+
+		cmd := &Command{inputs{LocalPath: "testdata/project", DryRun: true, RemoteApp: "appID"}}
+
+		err := cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient})
+		assert.Equal(t, feedback.ErrExitCode{Code: diffExitCode}, err)
+		assert.Equal(t, `{"time":"1989-06-22T01:23:45Z","level":"info","message":"Determining changes"}
+{"time":"1989-06-22T01:23:45Z","level":"info","message":"The following reflects the proposed changes to your Realm app","doc":[{"type":"sync","name":"development_mode_enabled","operation":"modified","before":true,"after":false}]}
+`, out.String())
+	})
+
+	t.Run("should print the unified diff of a dry run with the unified flag", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		cmd := &Command{inputs{LocalPath: "testdata/project", DryRun: true, Unified: true, RemoteApp: "appID"}}
+
+		err := cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient})
+		assert.Equal(t, feedback.ErrExitCode{Code: diffExitCode}, err)
+		assert.Equal(t, `Determining changes
+The following reflects the proposed changes to your Realm app
+--- a/config.json
++++ b/config.json
+@@ -5,6 +5,10 @@
+     "location": "US-VA",
+     "deployment_model": "GLOBAL",
+     "security": {},
+-    "custom_user_data_config": {"enabled": true},
+-    "sync": {"development_mode_enabled": true}
++    "custom_user_data_config": {
++        "enabled": true
++    },
++    "sync": {
++        "development_mode_enabled": false
++    }
+ }
+`, out.String())
+	})
+}
+
+func TestPushHandlerShowIgnored(t *testing.T) {
+	tmpDir, teardown, err := u.NewTempDir("push_show_ignored_test")
+	assert.Nil(t, err)
+	defer teardown()
+
+	app := local.NewApp(tmpDir, "eggcorn-abcde", "eggcorn", realm.LocationVirginia, realm.DeploymentModelGlobal, realm.EnvironmentNone, realm.DefaultAppConfigVersion)
+	assert.Nil(t, app.Write())
+
+	var realmClient mock.RealmClient
+	realmClient.FindAppsFn = func(filter realm.AppFilter) ([]realm.App, error) {
+		return []realm.App{{ID: "appID", GroupID: "groupID", ClientAppID: "eggcorn-abcde"}}, nil
+	}
+	realmClient.DiffFn = func(groupID, appID string, appData interface{}) ([]string, error) {
+		return nil, nil
+	}
+
+	t.Run("should report when no files are ignored", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		cmd := &Command{inputs{LocalPath: tmpDir, RemoteApp: "appID", ShowIgnored: true}}
+
+		assert.Nil(t, cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, `No files are ignored by .realmignore
+Determining changes
+Deployed app is identical to proposed version, nothing to do
+`, out.String())
+	})
+
+	t.Run("should list the files ignored by the .realmignore file", func(t *testing.T) {
+		assert.Nil(t, ioutil.WriteFile(filepath.Join(tmpDir, local.NameRealmIgnore), []byte("*.swp\n"), 0666))
+		assert.Nil(t, ioutil.WriteFile(filepath.Join(tmpDir, local.NameFunctions, "foo.js.swp"), []byte("swap"), 0666))
+
+		out, ui := mock.NewUI()
+
+		cmd := &Command{inputs{LocalPath: tmpDir, RemoteApp: "appID", ShowIgnored: true}}
+
+		assert.Nil(t, cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Equal(t, `The following files are ignored by .realmignore
+  functions/foo.js.swp
+Determining changes
+Deployed app is identical to proposed version, nothing to do
+`, out.String())
+	})
+}
+
 func TestPushCommandDisplay(t *testing.T) {
 	for _, tc := range []struct {
 		description string
@@ -1970,9 +2489,13 @@ func TestPushCommandDisplay(t *testing.T) {
 				IncludeNodeModules: true,
 				IncludeHosting:     true,
 				ResetCDNCache:      true,
+				NoDeploy:           true,
 				DryRun:             true,
+				Only:               []string{"functions/foo", "triggers"},
+				Exclude:            []string{"triggers/bar"},
+				ShowIgnored:        true,
 			},
-			display: "realm-cli push --project project --local directory --remote remote --include-node-modules --include-hosting --reset-cdn-cache --dry-run",
+			display: "realm-cli push --project project --local directory --remote remote --include-node-modules --include-hosting --reset-cdn-cache --no-deploy --only functions/foo --only triggers --exclude triggers/bar --show-ignored --dry-run",
 		},
 	} {
 		t.Run(tc.description, func(t *testing.T) {
@@ -1990,7 +2513,7 @@ func runImport(t *testing.T, realmClient realm.Client, appDirectory string) {
 
 	cmd := &Command{inputs{LocalPath: appDirectory, RemoteApp: "appID"}}
 
-	assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+	assert.Nil(t, cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient}))
 	assert.Equal(t, `Determining changes
 Creating draft
 Pushing changes
@@ -1999,3 +2522,15 @@ Deployment complete
 Successfully pushed app up: eggcorn-abcde
 `, out.String())
 }
+
+type discardDraftRealmClient struct {
+	mock.RealmClient
+	draftID string
+	ctxErr  error
+}
+
+func (rc *discardDraftRealmClient) DiscardDraft(ctx context.Context, groupID, appID, draftID string) error {
+	rc.draftID = draftID
+	rc.ctxErr = ctx.Err()
+	return nil
+}
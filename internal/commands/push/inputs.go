@@ -1,9 +1,11 @@
 package push
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/10gen/realm-cli/internal/cli"
 	"github.com/10gen/realm-cli/internal/cli/user"
@@ -14,13 +16,14 @@ import (
 )
 
 const (
-	errDependencyFlagConflictTemplate = `cannot use both "%s" and "%s" at the same time`
+	errFlagConflictTemplate = `cannot use both "%s" and "%s" at the same time`
 )
 
 type appRemote struct {
 	GroupID     string
 	AppID       string
 	ClientAppID string
+	Name        string
 }
 
 type inputs struct {
@@ -33,18 +36,91 @@ type inputs struct {
 	IncludeHosting      bool
 	ResetCDNCache       bool
 	DryRun              bool
+	Unified             bool
+	NoDeploy            bool
+	Watch               bool
+	Only                []string
+	Exclude             []string
+	ShowIgnored         bool
+	Environment         realm.Environment
+
+	flagsChanged map[string]bool
+	selection    local.AppSelection
 }
 
 func (i *inputs) Resolve(profile *user.Profile, ui terminal.UI) error {
 	if i.IncludePackageJSON {
 		if i.IncludeNodeModules {
-			return fmt.Errorf(errDependencyFlagConflictTemplate, flagIncludeNodeModules, flagIncludePackageJSON)
+			return fmt.Errorf(errFlagConflictTemplate, flagIncludeNodeModules, flagIncludePackageJSON)
 		}
 		if i.IncludeDependencies {
-			return fmt.Errorf(errDependencyFlagConflictTemplate, flagIncludeDependencies, flagIncludePackageJSON)
+			return fmt.Errorf(errFlagConflictTemplate, flagIncludeDependencies, flagIncludePackageJSON)
+		}
+	}
+
+	if i.Watch {
+		if i.DryRun {
+			return fmt.Errorf(errFlagConflictTemplate, flagWatch, flagDryRun)
+		}
+		if i.NoDeploy {
+			return fmt.Errorf(errFlagConflictTemplate, flagWatch, flagNoDeploy)
 		}
 	}
 
+	if i.NoDeploy {
+		// hosting files and dependencies are imported into the deployed app, so they cannot be staged in a draft
+		var dropped []string
+		for _, include := range []struct {
+			flag  string
+			value *bool
+		}{
+			{flagIncludeHosting, &i.IncludeHosting},
+			{flagIncludeDependencies, &i.IncludeDependencies},
+			{flagIncludeNodeModules, &i.IncludeNodeModules},
+			{flagIncludePackageJSON, &i.IncludePackageJSON},
+		} {
+			if !*include.value {
+				continue
+			}
+			if i.flagsChanged[include.flag] {
+				return fmt.Errorf(errFlagConflictTemplate, flagNoDeploy, include.flag)
+			}
+			// the include is a project config default, so it is dropped rather than rejected
+			*include.value = false
+			dropped = append(dropped, include.flag)
+		}
+		if len(dropped) > 0 {
+			ui.Print(terminal.NewWarningLog(
+				`Ignoring the project config default(s) for "%s" with "%s", since hosting files and dependencies cannot be staged in a draft`,
+				strings.Join(dropped, `", "`),
+				flagNoDeploy,
+			))
+		}
+	}
+
+	if i.Unified {
+		if !i.DryRun {
+			return fmt.Errorf(`cannot use "%s" without "%s"`, flagUnified, flagDryRun)
+		}
+		// the unified diff compares the whole local directory
+		if len(i.Only) > 0 {
+			return fmt.Errorf(errFlagConflictTemplate, flagUnified, flagOnly)
+		}
+		if len(i.Exclude) > 0 {
+			return fmt.Errorf(errFlagConflictTemplate, flagUnified, flagExclude)
+		}
+		// the local files are not rewritten as the app of the environment, so their config would always differ
+		if i.Environment != realm.EnvironmentNone {
+			return fmt.Errorf(errFlagConflictTemplate, flagUnified, flagEnv)
+		}
+	}
+
+	selection, err := resolveAppSelection(i.Only, i.Exclude)
+	if err != nil {
+		return err
+	}
+	i.selection = selection
+
 	searchPath := i.LocalPath
 	if searchPath == "" {
 		searchPath = profile.WorkingDirectory
@@ -72,16 +148,25 @@ func (i *inputs) Resolve(profile *user.Profile, ui terminal.UI) error {
 		i.LocalPath = app.RootDir
 	}
 
-	if i.RemoteApp == "" && app.Meta.AppID == "" {
+	if i.RemoteApp == "" && app.Meta.AppID == "" && i.Environment == realm.EnvironmentNone {
 		i.RemoteApp = app.Option()
 	}
 
 	return nil
 }
 
-func (i inputs) resolveRemoteApp(ui terminal.UI, client realm.Client, appMeta local.AppMeta) (appRemote, error) {
+func (i inputs) resolveRemoteApp(ctx context.Context, ui terminal.UI, client realm.Client, appMeta local.AppMeta) (appRemote, error) {
+	if i.Environment != realm.EnvironmentNone {
+		// the remote app of the environment takes precedence over any flags
+		app, err := client.FindApp(ctx, appMeta.GroupID, appMeta.AppID)
+		if err != nil {
+			return appRemote{}, err
+		}
+		return appRemote{GroupID: app.GroupID, AppID: app.ID, ClientAppID: app.ClientAppID, Name: app.Name}, nil
+	}
+
 	r := appRemote{GroupID: i.Project}
-	app, err := cli.ResolveApp(ui, client, cli.AppOptions{
+	app, err := cli.ResolveApp(ctx, ui, client, cli.AppOptions{
 		Filter:  realm.AppFilter{GroupID: i.Project, App: i.RemoteApp},
 		AppMeta: appMeta,
 	})
@@ -98,8 +183,38 @@ func (i inputs) resolveRemoteApp(ui terminal.UI, client realm.Client, appMeta lo
 	return r, nil
 }
 
+func (i inputs) includeDependencies() bool {
+	if !i.IncludeNodeModules && !i.IncludePackageJSON && !i.IncludeDependencies {
+		return false
+	}
+	return i.selection.Includes(local.SectionDependencies, "")
+}
+
+func (i inputs) includeHosting() bool {
+	return i.IncludeHosting && i.selection.Includes(local.NameHosting, "")
+}
+
+func resolveAppSelection(only, exclude []string) (local.AppSelection, error) {
+	var selection local.AppSelection
+	for _, s := range only {
+		selector, err := local.ParseAppSelector(s)
+		if err != nil {
+			return local.AppSelection{}, err
+		}
+		selection.Only = append(selection.Only, selector)
+	}
+	for _, s := range exclude {
+		selector, err := local.ParseAppSelector(s)
+		if err != nil {
+			return local.AppSelection{}, err
+		}
+		selection.Exclude = append(selection.Exclude, selector)
+	}
+	return selection, nil
+}
+
 func (i inputs) args(omitDryRun bool) []flags.Arg {
-	args := make([]flags.Arg, 0, 7)
+	args := make([]flags.Arg, 0, 11+len(i.Only)+len(i.Exclude))
 	if i.Project != "" {
 		args = append(args, flags.Arg{cli.ProjectFlagName, i.Project})
 	}
@@ -124,8 +239,29 @@ func (i inputs) args(omitDryRun bool) []flags.Arg {
 	if i.ResetCDNCache {
 		args = append(args, flags.Arg{Name: flagResetCDNCache})
 	}
+	if i.NoDeploy {
+		args = append(args, flags.Arg{Name: flagNoDeploy})
+	}
+	if i.Watch {
+		args = append(args, flags.Arg{Name: flagWatch})
+	}
+	for _, only := range i.Only {
+		args = append(args, flags.Arg{flagOnly, only})
+	}
+	for _, exclude := range i.Exclude {
+		args = append(args, flags.Arg{flagExclude, exclude})
+	}
+	if i.ShowIgnored {
+		args = append(args, flags.Arg{Name: flagShowIgnored})
+	}
+	if i.Environment != realm.EnvironmentNone {
+		args = append(args, flags.Arg{flagEnv, i.Environment.String()})
+	}
 	if i.DryRun && !omitDryRun {
 		args = append(args, flags.Arg{Name: flagDryRun})
+		if i.Unified {
+			args = append(args, flags.Arg{Name: flagUnified})
+		}
 	}
 	return args
 }
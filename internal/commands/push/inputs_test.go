@@ -1,6 +1,7 @@
 package push
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io/ioutil"
@@ -37,6 +38,87 @@ func TestPushInputsResolve(t *testing.T) {
 		})
 	})
 
+	t.Run("should return an error when watch is set with a flag it cannot be used with", func(t *testing.T) {
+		t.Run("when watch and dry run are both set", func(t *testing.T) {
+			i := inputs{Watch: true, DryRun: true}
+			assert.Equal(t, errors.New(`cannot use both "watch" and "dry-run" at the same time`), i.Resolve(nil, nil))
+		})
+
+		t.Run("when watch and no deploy are both set", func(t *testing.T) {
+			i := inputs{Watch: true, NoDeploy: true}
+			assert.Equal(t, errors.New(`cannot use both "watch" and "no-deploy" at the same time`), i.Resolve(nil, nil))
+		})
+	})
+
+	t.Run("should return an error when no deploy is set with a flag to include hosting files or dependencies", func(t *testing.T) {
+		for _, tc := range []struct {
+			flag   string
+			inputs inputs
+		}{
+			{flagIncludeHosting, inputs{NoDeploy: true, IncludeHosting: true}},
+			{flagIncludeDependencies, inputs{NoDeploy: true, IncludeDependencies: true}},
+			{flagIncludeNodeModules, inputs{NoDeploy: true, IncludeNodeModules: true}},
+			{flagIncludePackageJSON, inputs{NoDeploy: true, IncludePackageJSON: true}},
+		} {
+			t.Run("when no deploy and "+tc.flag+" are both set", func(t *testing.T) {
+				tc.inputs.flagsChanged = map[string]bool{flagNoDeploy: true, tc.flag: true}
+				assert.Equal(t, fmt.Errorf(`cannot use both "no-deploy" and "%s" at the same time`, tc.flag), tc.inputs.Resolve(nil, nil))
+			})
+		}
+	})
+
+	t.Run("should drop the project config defaults to include hosting files or dependencies when no deploy is set", func(t *testing.T) {
+		out, ui := mock.NewUI()
+
+		i := inputs{
+			LocalPath:          "testdata/project",
+			NoDeploy:           true,
+			IncludeHosting:     true,
+			IncludePackageJSON: true,
+			flagsChanged:       map[string]bool{flagNoDeploy: true},
+		}
+		assert.Nil(t, i.Resolve(nil, ui))
+
+		assert.False(t, i.IncludeHosting, "include hosting should be dropped")
+		assert.False(t, i.IncludePackageJSON, "include package json should be dropped")
+		assert.Equal(t, `Ignoring the project config default(s) for "include-hosting", "include-package-json" with "no-deploy", since hosting files and dependencies cannot be staged in a draft
+`, out.String())
+	})
+
+	t.Run("should return an error when unified is set with flags it cannot be used with", func(t *testing.T) {
+		t.Run("when unified is set without dry run", func(t *testing.T) {
+			i := inputs{Unified: true}
+			assert.Equal(t, errors.New(`cannot use "unified" without "dry-run"`), i.Resolve(nil, nil))
+		})
+
+		t.Run("when unified and only are both set", func(t *testing.T) {
+			i := inputs{Unified: true, DryRun: true, Only: []string{"functions"}}
+			assert.Equal(t, errors.New(`cannot use both "unified" and "only" at the same time`), i.Resolve(nil, nil))
+		})
+
+		t.Run("when unified and env are both set", func(t *testing.T) {
+			i := inputs{Unified: true, DryRun: true, Environment: realm.EnvironmentProduction}
+			assert.Equal(t, errors.New(`cannot use both "unified" and "env" at the same time`), i.Resolve(nil, nil))
+		})
+	})
+
+	t.Run("should return an error with an invalid app selector", func(t *testing.T) {
+		i := inputs{Only: []string{"functions/foo"}, Exclude: []string{"nope"}}
+		assert.Equal(t, errors.New("invalid app selector 'nope': unknown section 'nope'"), i.Resolve(nil, nil))
+	})
+
+	t.Run("should resolve the app selection", func(t *testing.T) {
+		i := inputs{LocalPath: "testdata/project", Only: []string{"functions/foo", "hosting"}, Exclude: []string{"dependencies"}, IncludeHosting: true, IncludeNodeModules: true}
+		assert.Nil(t, i.Resolve(nil, nil))
+
+		assert.Equal(t, local.AppSelection{
+			Only:    []local.AppSelector{{Section: local.NameFunctions, Name: "foo"}, {Section: local.NameHosting}},
+			Exclude: []local.AppSelector{{Section: local.SectionDependencies}},
+		}, i.selection)
+		assert.True(t, i.includeHosting(), "expected hosting to be included")
+		assert.False(t, i.includeDependencies(), "expected dependencies to be excluded")
+	})
+
 	t.Run("should return an error when specified local path does not exist", func(t *testing.T) {
 		profile, teardown := mock.NewProfileFromTmpDir(t, "app_init_input_test")
 		defer teardown()
@@ -128,7 +210,7 @@ func TestPushInputsResolveTo(t *testing.T) {
 
 		i := inputs{Project: app.GroupID, RemoteApp: app.ClientAppID}
 
-		f, err := i.resolveRemoteApp(nil, client, local.AppMeta{})
+		f, err := i.resolveRemoteApp(context.Background(), nil, client, local.AppMeta{})
 		assert.Nil(t, err)
 
 		assert.Equal(t, appRemote{GroupID: app.GroupID, AppID: app.ID, ClientAppID: app.ClientAppID}, f)
@@ -151,7 +233,7 @@ func TestPushInputsResolveTo(t *testing.T) {
 		}
 
 		var i inputs
-		remoteApp, err := i.resolveRemoteApp(nil, client, local.AppMeta{AppID: app.ID, GroupID: app.GroupID, ConfigVersion: realm.AppConfigVersion20210101})
+		remoteApp, err := i.resolveRemoteApp(context.Background(), nil, client, local.AppMeta{AppID: app.ID, GroupID: app.GroupID, ConfigVersion: realm.AppConfigVersion20210101})
 		assert.Nil(t, err)
 
 		assert.Equal(t, appRemote{AppID: app.ID, GroupID: app.GroupID}, remoteApp)
@@ -165,7 +247,7 @@ func TestPushInputsResolveTo(t *testing.T) {
 		}
 
 		i := inputs{Project: "groupID", RemoteApp: "appID"}
-		tt, err := i.resolveRemoteApp(nil, client, local.AppMeta{})
+		tt, err := i.resolveRemoteApp(context.Background(), nil, client, local.AppMeta{})
 		assert.Nil(t, err)
 		assert.Equal(t, appRemote{GroupID: "groupID"}, tt)
 	})
@@ -177,7 +259,7 @@ func TestPushInputsResolveTo(t *testing.T) {
 		}
 
 		var i inputs
-		_, err := i.resolveRemoteApp(nil, client, local.AppMeta{})
+		_, err := i.resolveRemoteApp(context.Background(), nil, client, local.AppMeta{})
 		assert.Equal(t, cli.ErrGroupNotFound, err)
 	})
 }
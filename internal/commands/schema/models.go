@@ -1,6 +1,7 @@
 package schema
 
 import (
+	"context"
 	"fmt"
 	"sort"
 	"strings"
@@ -84,8 +85,8 @@ func (cmd *CommandDatamodels) Inputs() cli.InputResolver {
 }
 
 // Handler is the command handler
-func (cmd *CommandDatamodels) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
-	app, err := cli.ResolveApp(ui, clients.Realm, cli.AppOptions{
+func (cmd *CommandDatamodels) Handler(ctx context.Context, profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, err := cli.ResolveApp(ctx, ui, clients.Realm, cli.AppOptions{
 		AppMeta: cmd.inputs.AppMeta,
 		Filter:  cmd.inputs.Filter(),
 	})
@@ -93,7 +94,7 @@ func (cmd *CommandDatamodels) Handler(profile *user.Profile, ui terminal.UI, cli
 		return err
 	}
 
-	models, err := clients.Realm.SchemaModels(app.GroupID, app.ID, languageType(cmd.inputs.Language))
+	models, err := clients.Realm.SchemaModels(ctx, app.GroupID, app.ID, languageType(cmd.inputs.Language))
 	if err != nil {
 		return err
 	}
@@ -1,6 +1,7 @@
 package schema
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strings"
@@ -23,7 +24,7 @@ func TestSchemaModels(t *testing.T) {
 
 		assert.Equal(t,
 			errors.New("something bad happened"),
-			cmd.Handler(nil, nil, cli.Clients{Realm: realmClient}),
+			cmd.Handler(context.Background(), nil, nil, cli.Clients{Realm: realmClient}),
 		)
 	})
 
@@ -40,7 +41,7 @@ func TestSchemaModels(t *testing.T) {
 
 		assert.Equal(t,
 			errors.New("something bad happened"),
-			cmd.Handler(nil, nil, cli.Clients{Realm: realmClient}),
+			cmd.Handler(context.Background(), nil, nil, cli.Clients{Realm: realmClient}),
 		)
 	})
 
@@ -174,7 +175,7 @@ export const schema2 = {}
 				ProjectInputs: cli.ProjectInputs{Project: "project", App: "test-app"},
 			}}
 
-			err := cmd.Handler(profile, ui, cli.Clients{Realm: realmClient})
+			err := cmd.Handler(context.Background(), profile, ui, cli.Clients{Realm: realmClient})
 			assert.Nil(t, err)
 
 			assert.Equal(t, "groupId", clientArgs.groupID)
@@ -319,7 +320,7 @@ The following collections have schemas with warnings
 				ProjectInputs: cli.ProjectInputs{Project: "project", App: "test-app"},
 			}}
 
-			err := cmd.Handler(profile, ui, cli.Clients{Realm: realmClient})
+			err := cmd.Handler(context.Background(), profile, ui, cli.Clients{Realm: realmClient})
 			assert.Nil(t, err)
 
 			assert.Equal(t, tc.output, out.String())
@@ -405,7 +406,7 @@ export const schemaThree = {}
 
 				cmd := &CommandDatamodels{i}
 
-				err := cmd.Handler(profile, ui, cli.Clients{Realm: realmClient})
+				err := cmd.Handler(context.Background(), profile, ui, cli.Clients{Realm: realmClient})
 				assert.Nil(t, err)
 
 				assert.Equal(t, tc.output, out.String())
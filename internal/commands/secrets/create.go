@@ -1,6 +1,8 @@
 package secrets
 
 import (
+	"context"
+
 	"github.com/10gen/realm-cli/internal/cli"
 	"github.com/10gen/realm-cli/internal/cli/user"
 	"github.com/10gen/realm-cli/internal/terminal"
@@ -37,8 +39,8 @@ func (cmd *CommandCreate) Inputs() cli.InputResolver {
 }
 
 // Handler is the command handler
-func (cmd *CommandCreate) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
-	app, err := cli.ResolveApp(ui, clients.Realm, cli.AppOptions{
+func (cmd *CommandCreate) Handler(ctx context.Context, profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, err := cli.ResolveApp(ctx, ui, clients.Realm, cli.AppOptions{
 		AppMeta: cmd.inputs.AppMeta,
 		Filter:  cmd.inputs.Filter(),
 	})
@@ -46,7 +48,7 @@ func (cmd *CommandCreate) Handler(profile *user.Profile, ui terminal.UI, clients
 		return err
 	}
 
-	secret, err := clients.Realm.CreateSecret(app.GroupID, app.ID, cmd.inputs.Name, cmd.inputs.Value)
+	secret, err := clients.Realm.CreateSecret(ctx, app.GroupID, app.ID, cmd.inputs.Name, cmd.inputs.Value)
 	if err != nil {
 		return err
 	}
@@ -1,6 +1,7 @@
 package secrets
 
 import (
+	"context"
 	"errors"
 	"testing"
 
@@ -51,7 +52,7 @@ func TestSecretsCreateHandler(t *testing.T) {
 			Value: secretValue,
 		}}
 
-		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Nil(t, cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient}))
 		assert.Equal(t, "Successfully created secret, id: secretID\n", out.String())
 
 		t.Log("and should properly pass through the expected inputs")
@@ -100,7 +101,7 @@ func TestSecretsCreateHandler(t *testing.T) {
 
 				cmd := &CommandCreate{}
 
-				err := cmd.Handler(nil, nil, cli.Clients{Realm: realmClient})
+				err := cmd.Handler(context.Background(), nil, nil, cli.Clients{Realm: realmClient})
 				assert.Equal(t, tc.expectedErr, err)
 			})
 		}
@@ -1,6 +1,7 @@
 package secrets
 
 import (
+	"context"
 	"fmt"
 	"sort"
 
@@ -50,8 +51,8 @@ func (cmd *CommandDelete) Inputs() cli.InputResolver {
 }
 
 // Handler function for the secrets delete command
-func (cmd *CommandDelete) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
-	app, err := cli.ResolveApp(ui, clients.Realm, cli.AppOptions{
+func (cmd *CommandDelete) Handler(ctx context.Context, profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, err := cli.ResolveApp(ctx, ui, clients.Realm, cli.AppOptions{
 		AppMeta: cmd.inputs.AppMeta,
 		Filter:  cmd.inputs.Filter(),
 	})
@@ -59,7 +60,7 @@ func (cmd *CommandDelete) Handler(profile *user.Profile, ui terminal.UI, clients
 		return err
 	}
 
-	secrets, err := clients.Realm.Secrets(app.GroupID, app.ID)
+	secrets, err := clients.Realm.Secrets(ctx, app.GroupID, app.ID)
 	if err != nil {
 		return err
 	}
@@ -76,7 +77,7 @@ func (cmd *CommandDelete) Handler(profile *user.Profile, ui terminal.UI, clients
 
 	outputs := make(secretOutputs, len(selected))
 	for i, secret := range selected {
-		err := clients.Realm.DeleteSecret(app.GroupID, app.ID, secret.ID)
+		err := clients.Realm.DeleteSecret(ctx, app.GroupID, app.ID, secret.ID)
 		outputs[i] = secretOutput{secret, err}
 	}
 
@@ -1,6 +1,7 @@
 package secrets
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strings"
@@ -50,7 +51,7 @@ func TestSecretsDeleteHandler(t *testing.T) {
 			},
 		}}
 
-		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Nil(t, cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient}))
 
 		assert.Equal(t, "No secrets to delete\n", out.String())
 	})
@@ -120,7 +121,7 @@ func TestSecretsDeleteHandler(t *testing.T) {
 				secrets: tc.testInput,
 			}}
 
-			assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+			assert.Nil(t, cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient}))
 
 			assert.Equal(t, tc.expectedOutput, out.String())
 
@@ -169,7 +170,7 @@ func TestSecretsDeleteHandler(t *testing.T) {
 		} {
 			t.Run(tc.description, func(t *testing.T) {
 				cmd := &CommandDelete{}
-				err := cmd.Handler(nil, nil, cli.Clients{Realm: tc.realmClient()})
+				err := cmd.Handler(context.Background(), nil, nil, cli.Clients{Realm: tc.realmClient()})
 				assert.Equal(t, tc.expectedErr, err)
 			})
 		}
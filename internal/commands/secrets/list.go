@@ -1,6 +1,7 @@
 package secrets
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/10gen/realm-cli/internal/cli"
@@ -43,8 +44,8 @@ func (cmd *CommandList) Inputs() cli.InputResolver {
 }
 
 // Handler is the command handler
-func (cmd *CommandList) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
-	app, appErr := cli.ResolveApp(ui, clients.Realm, cli.AppOptions{
+func (cmd *CommandList) Handler(ctx context.Context, profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, appErr := cli.ResolveApp(ctx, ui, clients.Realm, cli.AppOptions{
 		AppMeta: cmd.inputs.AppMeta,
 		Filter:  cmd.inputs.Filter(),
 	})
@@ -52,7 +53,7 @@ func (cmd *CommandList) Handler(profile *user.Profile, ui terminal.UI, clients c
 		return appErr
 	}
 
-	secrets, secretsErr := clients.Realm.Secrets(app.GroupID, app.ID)
+	secrets, secretsErr := clients.Realm.Secrets(ctx, app.GroupID, app.ID)
 	if secretsErr != nil {
 		return secretsErr
 	}
@@ -1,6 +1,7 @@
 package secrets
 
 import (
+	"context"
 	"errors"
 	"strings"
 	"testing"
@@ -71,7 +72,7 @@ func TestSecretsListHandler(t *testing.T) {
 				App:     appID,
 			}}}
 
-			assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+			assert.Nil(t, cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient}))
 			assert.Equal(t, tc.expectedOutput, out.String())
 		})
 	}
@@ -113,7 +114,7 @@ func TestSecretsListHandler(t *testing.T) {
 
 				cmd := &CommandList{}
 
-				err := cmd.Handler(nil, nil, cli.Clients{Realm: realmClient})
+				err := cmd.Handler(context.Background(), nil, nil, cli.Clients{Realm: realmClient})
 				assert.Equal(t, tc.expectedErr, err)
 			})
 		}
@@ -1,6 +1,8 @@
 package secrets
 
 import (
+	"context"
+
 	"github.com/10gen/realm-cli/internal/cli"
 	"github.com/10gen/realm-cli/internal/cli/user"
 	"github.com/10gen/realm-cli/internal/terminal"
@@ -48,8 +50,8 @@ func (cmd *CommandUpdate) Flags() []flags.Flag {
 }
 
 // Handler function for the secrets update command
-func (cmd *CommandUpdate) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
-	app, err := cli.ResolveApp(ui, clients.Realm, cli.AppOptions{
+func (cmd *CommandUpdate) Handler(ctx context.Context, profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, err := cli.ResolveApp(ctx, ui, clients.Realm, cli.AppOptions{
 		AppMeta: cmd.inputs.AppMeta,
 		Filter:  cmd.inputs.Filter(),
 	})
@@ -57,7 +59,7 @@ func (cmd *CommandUpdate) Handler(profile *user.Profile, ui terminal.UI, clients
 		return err
 	}
 
-	secrets, err := clients.Realm.Secrets(app.GroupID, app.ID)
+	secrets, err := clients.Realm.Secrets(ctx, app.GroupID, app.ID)
 	if err != nil {
 		return err
 	}
@@ -73,6 +75,7 @@ func (cmd *CommandUpdate) Handler(profile *user.Profile, ui terminal.UI, clients
 	}
 
 	if err := clients.Realm.UpdateSecret(
+		ctx,
 		app.GroupID,
 		app.ID,
 		secret.ID,
@@ -1,6 +1,7 @@
 package secrets
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"testing"
@@ -75,7 +76,7 @@ func TestSecretUpdateHandler(t *testing.T) {
 
 			out, ui := mock.NewUI()
 
-			assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+			assert.Nil(t, cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient}))
 
 			assert.Equal(t, "Successfully updated secret\n", out.String())
 		})
@@ -155,7 +156,7 @@ func TestSecretUpdateHandler(t *testing.T) {
 
 				realmClient := tc.clientSetup()
 				cmd := &CommandUpdate{tc.inputs}
-				assert.Equal(t, tc.expectedErr, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+				assert.Equal(t, tc.expectedErr, cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient}))
 			})
 		}
 	})
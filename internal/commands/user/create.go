@@ -1,6 +1,7 @@
 package user
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/10gen/realm-cli/internal/cli"
@@ -79,8 +80,8 @@ func (cmd *CommandCreate) Inputs() cli.InputResolver {
 }
 
 // Handler is the command handler
-func (cmd *CommandCreate) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
-	app, err := cli.ResolveApp(ui, clients.Realm, cli.AppOptions{
+func (cmd *CommandCreate) Handler(ctx context.Context, profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, err := cli.ResolveApp(ctx, ui, clients.Realm, cli.AppOptions{
 		AppMeta: cmd.inputs.AppMeta,
 		Filter:  cmd.inputs.Filter(),
 	})
@@ -90,7 +91,7 @@ func (cmd *CommandCreate) Handler(profile *user.Profile, ui terminal.UI, clients
 
 	switch cmd.inputs.UserType {
 	case userTypeAPIKey:
-		apiKey, err := clients.Realm.CreateAPIKey(app.GroupID, app.ID, cmd.inputs.APIKeyName)
+		apiKey, err := clients.Realm.CreateAPIKey(ctx, app.GroupID, app.ID, cmd.inputs.APIKeyName)
 		if err != nil {
 			return fmt.Errorf("failed to create API Key: %s", err)
 		}
@@ -107,7 +108,7 @@ func (cmd *CommandCreate) Handler(profile *user.Profile, ui terminal.UI, clients
 			},
 		))
 	case userTypeEmailPassword:
-		user, err := clients.Realm.CreateUser(app.GroupID, app.ID, cmd.inputs.Email, cmd.inputs.Password)
+		user, err := clients.Realm.CreateUser(ctx, app.GroupID, app.ID, cmd.inputs.Email, cmd.inputs.Password)
 		if err != nil {
 			return fmt.Errorf("failed to create user: %s", err)
 		}
@@ -1,6 +1,7 @@
 package user
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"testing"
@@ -42,7 +43,7 @@ func TestUserCreateHandler(t *testing.T) {
 
 		cmd := &CommandCreate{createInputs{UserType: userTypeEmailPassword}}
 
-		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Nil(t, cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient}))
 		assert.Equal(t, fmt.Sprintf(`Successfully created user
 {
   "id": %q,
@@ -66,7 +67,7 @@ func TestUserCreateHandler(t *testing.T) {
 
 		cmd := &CommandCreate{createInputs{UserType: userTypeAPIKey}}
 
-		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Nil(t, cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient}))
 		assert.Equal(t, fmt.Sprintf(`Successfully created API Key
 {
   "id": %q,
@@ -131,7 +132,7 @@ func TestUserCreateHandler(t *testing.T) {
 
 				cmd := &CommandCreate{createInputs{UserType: tc.userType}}
 
-				err := cmd.Handler(nil, nil, cli.Clients{Realm: realmClient})
+				err := cmd.Handler(context.Background(), nil, nil, cli.Clients{Realm: realmClient})
 				assert.Equal(t, tc.expectedErr, err)
 			})
 		}
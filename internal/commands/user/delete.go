@@ -1,6 +1,7 @@
 package user
 
 import (
+	"context"
 	"fmt"
 	"sort"
 
@@ -44,8 +45,8 @@ func (cmd *CommandDelete) Inputs() cli.InputResolver {
 }
 
 // Handler is the command handler
-func (cmd *CommandDelete) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
-	app, err := cli.ResolveApp(ui, clients.Realm, cli.AppOptions{
+func (cmd *CommandDelete) Handler(ctx context.Context, profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, err := cli.ResolveApp(ctx, ui, clients.Realm, cli.AppOptions{
 		AppMeta: cmd.inputs.AppMeta,
 		Filter:  cmd.inputs.Filter(),
 	})
@@ -53,7 +54,7 @@ func (cmd *CommandDelete) Handler(profile *user.Profile, ui terminal.UI, clients
 		return err
 	}
 
-	found, err := cmd.inputs.findUsers(clients.Realm, app.GroupID, app.ID)
+	found, err := cmd.inputs.findUsers(ctx, clients.Realm, app.GroupID, app.ID)
 	if err != nil {
 		return err
 	}
@@ -65,7 +66,7 @@ func (cmd *CommandDelete) Handler(profile *user.Profile, ui terminal.UI, clients
 
 	outputs := make(userOutputs, 0, len(users))
 	for _, user := range users {
-		err := clients.Realm.DeleteUser(app.GroupID, app.ID, user.ID)
+		err := clients.Realm.DeleteUser(ctx, app.GroupID, app.ID, user.ID)
 		outputs = append(outputs, userOutput{user, err})
 	}
 
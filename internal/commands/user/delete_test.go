@@ -1,6 +1,7 @@
 package user
 
 import (
+	"context"
 	"errors"
 	"strings"
 	"testing"
@@ -64,7 +65,7 @@ func TestUserDeleteHandler(t *testing.T) {
 			App:     appID,
 		}}}
 
-		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Nil(t, cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient}))
 		assert.Equal(t, "No users to delete\n", out.String())
 	})
 
@@ -92,7 +93,7 @@ func TestUserDeleteHandler(t *testing.T) {
 			},
 		}}
 
-		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Nil(t, cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient}))
 		assert.Equal(t, strings.Join([]string{
 			"Provider type: User/Password",
 			"  Email            ID      Type  Deleted  Details",
@@ -176,7 +177,7 @@ func TestUserDeleteHandler(t *testing.T) {
 				},
 			}}
 
-			assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+			assert.Nil(t, cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient}))
 			assert.Equal(t, tc.expectedOutput, out.String())
 
 			assert.Equal(t, realm.AppFilter{App: appID, GroupID: projectID}, capturedAppFilter)
@@ -222,7 +223,7 @@ func TestUserDeleteHandler(t *testing.T) {
 			t.Run(tc.description, func(t *testing.T) {
 				cmd := &CommandDelete{}
 
-				err := cmd.Handler(nil, nil, cli.Clients{Realm: tc.setupClient()})
+				err := cmd.Handler(context.Background(), nil, nil, cli.Clients{Realm: tc.setupClient()})
 				assert.Equal(t, tc.expectedErr, err)
 			})
 		}
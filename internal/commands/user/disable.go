@@ -1,6 +1,7 @@
 package user
 
 import (
+	"context"
 	"fmt"
 	"sort"
 
@@ -41,8 +42,8 @@ func (cmd *CommandDisable) Inputs() cli.InputResolver {
 }
 
 // Handler is the command handler
-func (cmd *CommandDisable) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
-	app, err := cli.ResolveApp(ui, clients.Realm, cli.AppOptions{
+func (cmd *CommandDisable) Handler(ctx context.Context, profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, err := cli.ResolveApp(ctx, ui, clients.Realm, cli.AppOptions{
 		AppMeta: cmd.inputs.AppMeta,
 		Filter:  cmd.inputs.Filter(),
 	})
@@ -50,7 +51,7 @@ func (cmd *CommandDisable) Handler(profile *user.Profile, ui terminal.UI, client
 		return err
 	}
 
-	found, err := cmd.inputs.findUsers(clients.Realm, app.GroupID, app.ID)
+	found, err := cmd.inputs.findUsers(ctx, clients.Realm, app.GroupID, app.ID)
 	if err != nil {
 		return err
 	}
@@ -62,7 +63,7 @@ func (cmd *CommandDisable) Handler(profile *user.Profile, ui terminal.UI, client
 
 	outputs := make(userOutputs, 0, len(users))
 	for _, user := range users {
-		err := clients.Realm.DisableUser(app.GroupID, app.ID, user.ID)
+		err := clients.Realm.DisableUser(ctx, app.GroupID, app.ID, user.ID)
 		outputs = append(outputs, userOutput{user, err})
 	}
 
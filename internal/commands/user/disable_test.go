@@ -1,6 +1,7 @@
 package user
 
 import (
+	"context"
 	"errors"
 	"strings"
 	"testing"
@@ -40,7 +41,7 @@ func TestUserDisableHandler(t *testing.T) {
 			App:     appID,
 		}}}
 
-		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Nil(t, cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient}))
 		assert.Equal(t, "No users to disable\n", out.String())
 	})
 
@@ -68,7 +69,7 @@ func TestUserDisableHandler(t *testing.T) {
 			},
 		}}
 
-		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Nil(t, cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient}))
 		assert.Equal(t, strings.Join([]string{
 			"Provider type: User/Password",
 			"  Email            ID      Type  Enabled  Details",
@@ -152,7 +153,7 @@ func TestUserDisableHandler(t *testing.T) {
 				},
 			}}
 
-			assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+			assert.Nil(t, cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient}))
 
 			assert.Equal(t, testUsers[0].ID, cmd.inputs.Users[0])
 			assert.Equal(t, tc.expectedOutput, out.String())
@@ -200,7 +201,7 @@ func TestUserDisableHandler(t *testing.T) {
 			t.Run(tc.description, func(t *testing.T) {
 				cmd := &CommandDisable{}
 
-				err := cmd.Handler(nil, nil, cli.Clients{Realm: tc.setupClient()})
+				err := cmd.Handler(context.Background(), nil, nil, cli.Clients{Realm: tc.setupClient()})
 				assert.Equal(t, tc.expectedErr, err)
 			})
 		}
@@ -1,6 +1,7 @@
 package user
 
 import (
+	"context"
 	"fmt"
 	"sort"
 
@@ -46,8 +47,8 @@ func (cmd *CommandEnable) Inputs() cli.InputResolver {
 }
 
 // Handler is the command handler
-func (cmd *CommandEnable) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
-	app, err := cli.ResolveApp(ui, clients.Realm, cli.AppOptions{
+func (cmd *CommandEnable) Handler(ctx context.Context, profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, err := cli.ResolveApp(ctx, ui, clients.Realm, cli.AppOptions{
 		AppMeta: cmd.inputs.AppMeta,
 		Filter:  cmd.inputs.Filter(),
 	})
@@ -55,7 +56,7 @@ func (cmd *CommandEnable) Handler(profile *user.Profile, ui terminal.UI, clients
 		return err
 	}
 
-	found, err := cmd.inputs.findUsers(clients.Realm, app.GroupID, app.ID)
+	found, err := cmd.inputs.findUsers(ctx, clients.Realm, app.GroupID, app.ID)
 	if err != nil {
 		return err
 	}
@@ -67,7 +68,7 @@ func (cmd *CommandEnable) Handler(profile *user.Profile, ui terminal.UI, clients
 
 	outputs := make(userOutputs, 0, len(users))
 	for _, user := range users {
-		err := clients.Realm.EnableUser(app.GroupID, app.ID, user.ID)
+		err := clients.Realm.EnableUser(ctx, app.GroupID, app.ID, user.ID)
 		outputs = append(outputs, userOutput{user, err})
 	}
 
@@ -1,6 +1,7 @@
 package user
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"testing"
@@ -168,7 +169,7 @@ func TestMultiUsersInputsFind(t *testing.T) {
 					return tc.users, nil
 				}
 
-				users, err := tc.inputs.findUsers(realmClient, "groupID", "appID")
+				users, err := tc.inputs.findUsers(context.Background(), realmClient, "groupID", "appID")
 				assert.Equal(t, tc.expectedErr, err)
 				assert.Equal(t, tc.expectedUsers, users)
 			})
@@ -181,7 +182,7 @@ func TestMultiUsersInputsFind(t *testing.T) {
 			return nil, errors.New("client error")
 		}
 		inputs := multiUserInputs{}
-		_, err := inputs.findUsers(realmClient, "groupID", "appID")
+		_, err := inputs.findUsers(context.Background(), realmClient, "groupID", "appID")
 
 		assert.Equal(t, errors.New("client error"), err)
 	})
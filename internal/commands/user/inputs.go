@@ -1,6 +1,7 @@
 package user
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strings"
@@ -59,8 +60,8 @@ func (i multiUserInputs) filter() realm.UserFilter {
 	}
 }
 
-func (i multiUserInputs) findUsers(realmClient realm.Client, groupID, appID string) ([]realm.User, error) {
-	foundUsers, err := realmClient.FindUsers(groupID, appID, i.filter())
+func (i multiUserInputs) findUsers(ctx context.Context, realmClient realm.Client, groupID, appID string) ([]realm.User, error) {
+	foundUsers, err := realmClient.FindUsers(ctx, groupID, appID, i.filter())
 	if err != nil {
 		return nil, err
 	}
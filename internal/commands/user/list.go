@@ -1,6 +1,7 @@
 package user
 
 import (
+	"context"
 	"fmt"
 	"sort"
 	"time"
@@ -50,8 +51,8 @@ func (cmd *CommandList) Inputs() cli.InputResolver {
 }
 
 // Handler is the command handler
-func (cmd *CommandList) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
-	app, err := cli.ResolveApp(ui, clients.Realm, cli.AppOptions{
+func (cmd *CommandList) Handler(ctx context.Context, profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, err := cli.ResolveApp(ctx, ui, clients.Realm, cli.AppOptions{
 		AppMeta: cmd.inputs.AppMeta,
 		Filter:  cmd.inputs.Filter(),
 	})
@@ -59,7 +60,7 @@ func (cmd *CommandList) Handler(profile *user.Profile, ui terminal.UI, clients c
 		return err
 	}
 
-	users, err := cmd.inputs.findUsers(clients.Realm, app.GroupID, app.ID)
+	users, err := cmd.inputs.findUsers(ctx, clients.Realm, app.GroupID, app.ID)
 	if err != nil {
 		return err
 	}
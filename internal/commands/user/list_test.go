@@ -1,6 +1,7 @@
 package user
 
 import (
+	"context"
 	"errors"
 	"strings"
 	"testing"
@@ -40,7 +41,7 @@ func TestUserListHandler(t *testing.T) {
 			App:     appID,
 		}}}
 
-		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Nil(t, cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient}))
 		assert.Equal(t, "No available users to show\n", out.String())
 	})
 
@@ -65,7 +66,7 @@ func TestUserListHandler(t *testing.T) {
 			},
 		}}
 
-		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Nil(t, cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient}))
 		assert.Equal(t, strings.Join([]string{
 			"Provider type: User/Password",
 			"  Email            ID      Type  Enabled  Last Authenticated",
@@ -113,7 +114,7 @@ func TestUserListHandler(t *testing.T) {
 			},
 		}}
 
-		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Nil(t, cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient}))
 		assert.Equal(t, strings.Join([]string{
 			"Provider type: Anonymous",
 			"  ID      Type    Enabled  Last Authenticated",
@@ -162,7 +163,7 @@ func TestUserListHandler(t *testing.T) {
 			t.Run(tc.description, func(t *testing.T) {
 				cmd := &CommandList{}
 
-				err := cmd.Handler(nil, nil, cli.Clients{Realm: tc.setupClient()})
+				err := cmd.Handler(context.Background(), nil, nil, cli.Clients{Realm: tc.setupClient()})
 				assert.Equal(t, tc.expectedErr, err)
 			})
 		}
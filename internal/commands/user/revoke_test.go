@@ -1,6 +1,7 @@
 package user
 
 import (
+	"context"
 	"errors"
 	"strings"
 	"testing"
@@ -40,7 +41,7 @@ func TestUserRevokeHandler(t *testing.T) {
 			App:     appID,
 		}}}
 
-		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Nil(t, cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient}))
 		assert.Equal(t, "No users to revoke sessions for\n", out.String())
 	})
 
@@ -68,7 +69,7 @@ func TestUserRevokeHandler(t *testing.T) {
 			},
 		}}
 
-		assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+		assert.Nil(t, cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient}))
 		assert.Equal(t, strings.Join([]string{
 			"Provider type: User/Password",
 			"  Email            ID      Type  Session Revoked  Details",
@@ -152,7 +153,7 @@ func TestUserRevokeHandler(t *testing.T) {
 				},
 			}}
 
-			assert.Nil(t, cmd.Handler(nil, ui, cli.Clients{Realm: realmClient}))
+			assert.Nil(t, cmd.Handler(context.Background(), nil, ui, cli.Clients{Realm: realmClient}))
 			assert.Equal(t, tc.expectedOutput, out.String())
 
 			assert.Equal(t, realm.AppFilter{App: appID, GroupID: projectID}, capturedAppFilter)
@@ -198,7 +199,7 @@ func TestUserRevokeHandler(t *testing.T) {
 			t.Run(tc.description, func(t *testing.T) {
 				cmd := &CommandRevoke{}
 
-				err := cmd.Handler(nil, nil, cli.Clients{Realm: tc.setupClient()})
+				err := cmd.Handler(context.Background(), nil, nil, cli.Clients{Realm: tc.setupClient()})
 				assert.Equal(t, tc.expectedErr, err)
 			})
 		}
@@ -1,6 +1,7 @@
 package whoami
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/10gen/realm-cli/internal/cli"
@@ -54,9 +55,16 @@ const (
 )
 
 // Handler is the command handler
-func (cmd *Command) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
-	u := profile.Credentials()
-	sess := profile.Session()
+func (cmd *Command) Handler(ctx context.Context, profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	u, err := profile.Credentials()
+	if err != nil {
+		return err
+	}
+
+	sess, err := profile.Session()
+	if err != nil {
+		return err
+	}
 
 	if u.PrivateAPIKey == "" && u.Password == "" {
 		ui.Print(terminal.NewTextLog("No user is currently logged in"))
@@ -83,7 +91,7 @@ func (cmd *Command) Handler(profile *user.Profile, ui terminal.UI, clients cli.C
 	ui.Print(terminal.NewTextLog("Currently logged in user: %s (%s)", userDisplay, userSecret))
 
 	if cmd.inputs.showProjects {
-		if groups, err := atlas.AllGroups(clients.Atlas); err == nil {
+		if groups, err := atlas.AllGroups(ctx, clients.Atlas); err == nil {
 			tableRows := make([]map[string]interface{}, 0, len(groups))
 			for _, group := range groups {
 				tableRows = append(tableRows, map[string]interface{}{
@@ -1,6 +1,7 @@
 package whoami
 
 import (
+	"context"
 	"strings"
 	"testing"
 
@@ -87,7 +88,7 @@ func TestWhoamiFeedback(t *testing.T) {
 				out, ui := mock.NewUI()
 
 				cmd := &Command{inputs{showProjects: tc.showProjects}}
-				err := cmd.Handler(profile, ui, cli.Clients{Atlas: atlasClient})
+				err := cmd.Handler(context.Background(), profile, ui, cli.Clients{Atlas: atlasClient})
 				assert.Nil(t, err)
 
 				assert.Equal(t, tc.showProjects, groupsCalled)
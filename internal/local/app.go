@@ -39,9 +39,10 @@ func errFailedToFindApp(path string) error {
 
 // App is the Realm app data represented on the local filesystem
 type App struct {
-	RootDir string
-	Config  File
-	Meta    AppMeta
+	RootDir       string
+	Config        File
+	Meta          AppMeta
+	ProjectConfig *ProjectConfig
 	AppData
 }
 
@@ -363,6 +364,12 @@ func loadAppRoot(path string) (App, bool, error) {
 		return App{}, false, nil
 	}
 
+	projectConfig, err := LoadProjectConfig(path)
+	if err != nil {
+		return App{}, false, err
+	}
+	app.ProjectConfig = projectConfig
+
 	return app, true, nil
 }
 
@@ -0,0 +1,416 @@
+package local
+
+import (
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+)
+
+// set of local Realm app file schemas
+const (
+	schemaAppConfig        = "app_config"
+	schemaAuthProvider     = "auth_provider"
+	schemaAuthProviders    = "auth_providers"
+	schemaCustomUserData   = "custom_user_data"
+	schemaFunctionConfig   = "function_config"
+	schemaFunctionConfigs  = "function_configs"
+	schemaTrigger          = "trigger"
+	schemaDataSourceConfig = "data_source_config"
+	schemaDefaultRule      = "default_rule"
+	schemaRule             = "rule"
+	schemaCollectionSchema = "collection_schema"
+	schemaRelationships    = "relationships"
+	schemaServiceConfig    = "service_config"
+	schemaServiceRule      = "service_rule"
+	schemaIncomingWebhook  = "incoming_webhook"
+	schemaEndpointConfigs  = "endpoint_configs"
+	schemaDataAPIConfig    = "data_api_config"
+	schemaSyncConfig       = "sync_config"
+	schemaValue            = "value"
+	schemaEnvironment      = "environment"
+	schemaGraphQLConfig    = "graphql_config"
+	schemaCustomResolver   = "custom_resolver"
+	schemaLogForwarder     = "log_forwarder"
+	schemaAnyObject        = "any_object"
+)
+
+const (
+	schemaDocAuthProvider = `{
+  "type": "object",
+  "required": ["name", "type"],
+  "properties": {
+    "name": {"type": "string"},
+    "type": {
+      "type": "string",
+      "enum": ["anon-user", "api-key", "local-userpass", "oauth2-apple", "oauth2-facebook", "oauth2-google", "custom-token", "custom-function"]
+    },
+    "disabled": {"type": "boolean"},
+    "config": {"type": "object"},
+    "secret_config": {"type": "object"},
+    "metadata_fields": {"type": "array", "items": {"type": "object"}},
+    "redirect_uris": {"type": "array", "items": {"type": "string"}},
+    "domain_restrictions": {"type": "array", "items": {"type": "string"}}
+  }
+}`
+
+	schemaDocCustomUserData = `{
+  "type": "object",
+  "properties": {
+    "enabled": {"type": "boolean"},
+    "mongo_service_name": {"type": "string"},
+    "database_name": {"type": "string"},
+    "collection_name": {"type": "string"},
+    "user_id_field": {"type": "string"},
+    "on_user_creation_function_name": {"type": "string"}
+  }
+}`
+
+	schemaDocFunctionConfig = `{
+  "type": "object",
+  "required": ["name"],
+  "properties": {
+    "name": {"type": "string"},
+    "private": {"type": "boolean"},
+    "can_evaluate": {"type": "object"},
+    "run_as_system": {"type": "boolean"},
+    "disable_arg_logs": {"type": "boolean"}
+  }
+}`
+
+	schemaDocTrigger = `{
+  "type": "object",
+  "required": ["name", "type", "config"],
+  "properties": {
+    "name": {"type": "string"},
+    "type": {"type": "string", "enum": ["DATABASE", "AUTHENTICATION", "SCHEDULED"]},
+    "config": {"type": "object"},
+    "function_name": {"type": "string"},
+    "event_processors": {
+      "type": "object",
+      "additionalProperties": {
+        "type": "object",
+        "properties": {
+          "config": {"type": "object"}
+        }
+      }
+    },
+    "disabled": {"type": "boolean"}
+  }
+}`
+
+	schemaDocRole = `{
+  "type": "object",
+  "required": ["name"],
+  "properties": {
+    "name": {"type": "string"},
+    "apply_when": {"type": "object"},
+    "document_filters": {"type": "object"},
+    "fields": {"type": "object"},
+    "additional_fields": {"type": "object"},
+    "read": {"type": ["boolean", "object"]},
+    "write": {"type": ["boolean", "object"]},
+    "insert": {"type": ["boolean", "object"]},
+    "delete": {"type": ["boolean", "object"]},
+    "search": {"type": ["boolean", "object"]}
+  }
+}`
+
+	schemaDocFilter = `{
+  "type": "object",
+  "required": ["name"],
+  "properties": {
+    "name": {"type": "string"},
+    "apply_when": {"type": "object"},
+    "query": {"type": "object"},
+    "projection": {"type": "object"}
+  }
+}`
+
+	schemaDocDefaultRule = `{
+  "type": "object",
+  "properties": {
+    "roles": {"type": "array", "items": ` + schemaDocRole + `},
+    "filters": {"type": "array", "items": ` + schemaDocFilter + `}
+  }
+}`
+
+	schemaDocRule = `{
+  "type": "object",
+  "properties": {
+    "database": {"type": "string"},
+    "collection": {"type": "string"},
+    "roles": {"type": "array", "items": ` + schemaDocRole + `},
+    "filters": {"type": "array", "items": ` + schemaDocFilter + `}
+  }
+}`
+
+	schemaDocServiceConfig = `{
+  "type": "object",
+  "required": ["name", "type"],
+  "properties": {
+    "name": {"type": "string"},
+    "type": {"type": "string"},
+    "config": {"type": "object"},
+    "secret_config": {"type": "object"},
+    "version": {"type": "integer"}
+  }
+}`
+
+	schemaDocServiceRule = `{
+  "type": "object",
+  "properties": {
+    "name": {"type": "string"},
+    "actions": {"type": "array", "items": {"type": "string"}},
+    "when": {"type": "object"},
+    "database": {"type": "string"},
+    "collection": {"type": "string"},
+    "roles": {"type": "array", "items": ` + schemaDocRole + `},
+    "filters": {"type": "array", "items": ` + schemaDocFilter + `},
+    "schema": {"type": "object"}
+  }
+}`
+
+	schemaDocIncomingWebhook = `{
+  "type": "object",
+  "required": ["name"],
+  "properties": {
+    "name": {"type": "string"},
+    "run_as_authed_user": {"type": "boolean"},
+    "run_as_user_id": {"type": "string"},
+    "run_as_user_id_script_source": {"type": "string"},
+    "can_evaluate": {"type": "object"},
+    "options": {"type": "object"},
+    "respond_result": {"type": "boolean"},
+    "fetch_custom_user_data": {"type": "boolean"},
+    "create_user_on_auth": {"type": "boolean"},
+    "disable_arg_logs": {"type": "boolean"}
+  }
+}`
+
+	schemaDocDataAPIConfig = `{
+  "type": "object",
+  "properties": {
+    "disabled": {"type": "boolean"},
+    "versions": {"type": "array", "items": {"type": "string"}},
+    "return_type": {"type": "string", "enum": ["EJSON", "JSON"]},
+    "create_user_on_auth": {"type": "boolean"},
+    "run_as_system": {"type": "boolean"},
+    "run_as_user_id": {"type": "string"},
+    "run_as_user_id_script_source": {"type": "string"},
+    "validation_method": {"type": "string"},
+    "secret_name": {"type": "string"},
+    "can_evaluate": {"type": "object"}
+  }
+}`
+
+	schemaDocValue = `{
+  "type": "object",
+  "required": ["name"],
+  "properties": {
+    "name": {"type": "string"},
+    "from_secret": {"type": "boolean"}
+  }
+}`
+
+	schemaDocEnvironment = `{
+  "type": "object",
+  "properties": {
+    "values": {"type": "object"}
+  }
+}`
+
+	schemaDocGraphQLConfig = `{
+  "type": "object",
+  "properties": {
+    "use_natural_pluralization": {"type": "boolean"},
+    "disable_schema_introspection": {"type": "boolean"}
+  }
+}`
+
+	schemaDocCustomResolver = `{
+  "type": "object",
+  "required": ["field_name", "on_type", "function_name"],
+  "properties": {
+    "field_name": {"type": "string"},
+    "on_type": {"type": "string"},
+    "function_name": {"type": "string"},
+    "input_type_format": {"type": "string"},
+    "payload_type_format": {"type": "string"}
+  }
+}`
+
+	schemaDocLogForwarder = `{
+  "type": "object",
+  "required": ["name", "log_types", "log_statuses", "policy", "action"],
+  "properties": {
+    "name": {"type": "string"},
+    "log_types": {"type": "array", "items": {"type": "string"}},
+    "log_statuses": {"type": "array", "items": {"type": "string", "enum": ["error", "success"]}},
+    "policy": {
+      "type": "object",
+      "required": ["type"],
+      "properties": {
+        "type": {"type": "string", "enum": ["single", "batch"]}
+      }
+    },
+    "action": {
+      "type": "object",
+      "required": ["type"],
+      "properties": {
+        "type": {"type": "string", "enum": ["collection", "function"]},
+        "name": {"type": "string"}
+      }
+    },
+    "disabled": {"type": "boolean"}
+  }
+}`
+
+	schemaDocAnyObject = `{"type": "object"}`
+)
+
+// appSchemasV1 are the schemas of the files in a 20180301 or 20200603 config version app
+var appSchemasV1 = map[string]*schema{
+	schemaAppConfig: mustParseSchema(`{
+  "type": "object",
+  "required": ["config_version", "name"],
+  "properties": {
+    "config_version": {"type": "integer"},
+    "app_id": {"type": "string"},
+    "name": {"type": "string"},
+    "location": {"type": "string"},
+    "deployment_model": {"type": "string", "enum": ["GLOBAL", "LOCAL"]},
+    "environment": {"type": "string"},
+    "security": {
+      "type": "object",
+      "properties": {
+        "allowed_request_origins": {"type": "array", "items": {"type": "string"}}
+      }
+    },
+    "hosting": {"type": "object"},
+    "custom_user_data_config": ` + schemaDocCustomUserData + `,
+    "sync": {
+      "type": "object",
+      "properties": {
+        "development_mode_enabled": {"type": "boolean"}
+      }
+    }
+  }
+}`),
+	schemaAuthProvider:    mustParseSchema(schemaDocAuthProvider),
+	schemaFunctionConfig:  mustParseSchema(schemaDocFunctionConfig),
+	schemaTrigger:         mustParseSchema(schemaDocTrigger),
+	schemaDefaultRule:     mustParseSchema(schemaDocDefaultRule),
+	schemaServiceConfig:   mustParseSchema(schemaDocServiceConfig),
+	schemaServiceRule:     mustParseSchema(schemaDocServiceRule),
+	schemaIncomingWebhook: mustParseSchema(schemaDocIncomingWebhook),
+	schemaDataAPIConfig:   mustParseSchema(schemaDocDataAPIConfig),
+	schemaValue:           mustParseSchema(schemaDocValue),
+	schemaEnvironment:     mustParseSchema(schemaDocEnvironment),
+	schemaGraphQLConfig:   mustParseSchema(schemaDocGraphQLConfig),
+	schemaCustomResolver:  mustParseSchema(schemaDocCustomResolver),
+	schemaLogForwarder:    mustParseSchema(schemaDocLogForwarder),
+	schemaAnyObject:       mustParseSchema(schemaDocAnyObject),
+}
+
+// appSchemasV2 are the schemas of the files in a 20210101 config version app
+var appSchemasV2 = map[string]*schema{
+	schemaAppConfig: mustParseSchema(`{
+  "type": "object",
+  "required": ["config_version", "name"],
+  "properties": {
+    "config_version": {"type": "integer"},
+    "app_id": {"type": "string"},
+    "name": {"type": "string"},
+    "location": {"type": "string"},
+    "provider_region": {"type": "string"},
+    "deployment_model": {"type": "string", "enum": ["GLOBAL", "LOCAL"]},
+    "environment": {"type": "string"},
+    "allowed_request_origins": {"type": "array", "items": {"type": "string"}}
+  }
+}`),
+	schemaAuthProviders: mustParseSchema(`{
+  "type": "object",
+  "additionalProperties": ` + schemaDocAuthProvider + `
+}`),
+	schemaCustomUserData: mustParseSchema(schemaDocCustomUserData),
+	schemaFunctionConfigs: mustParseSchema(`{
+  "type": "array",
+  "items": ` + schemaDocFunctionConfig + `
+}`),
+	schemaTrigger: mustParseSchema(schemaDocTrigger),
+	schemaDataSourceConfig: mustParseSchema(`{
+  "type": "object",
+  "required": ["name", "type"],
+  "properties": {
+    "name": {"type": "string"},
+    "type": {"type": "string"},
+    "config": {"type": "object"},
+    "version": {"type": "integer"}
+  }
+}`),
+	schemaDefaultRule:      mustParseSchema(schemaDocDefaultRule),
+	schemaRule:             mustParseSchema(schemaDocRule),
+	schemaCollectionSchema: mustParseSchema(schemaDocAnyObject),
+	schemaRelationships: mustParseSchema(`{
+  "type": "object",
+  "additionalProperties": {
+    "type": "object",
+    "required": ["ref", "foreign_key"],
+    "properties": {
+      "ref": {"type": "string"},
+      "foreign_key": {"type": "string"},
+      "is_list": {"type": "boolean"}
+    }
+  }
+}`),
+	schemaServiceConfig:   mustParseSchema(schemaDocServiceConfig),
+	schemaServiceRule:     mustParseSchema(schemaDocServiceRule),
+	schemaIncomingWebhook: mustParseSchema(schemaDocIncomingWebhook),
+	schemaEndpointConfigs: mustParseSchema(`{
+  "type": "array",
+  "items": {
+    "type": "object",
+    "required": ["route", "http_method", "function_name"],
+    "properties": {
+      "route": {"type": "string"},
+      "http_method": {"type": "string", "enum": ["GET", "POST", "PUT", "PATCH", "DELETE", "*"]},
+      "endpoint_type": {"type": "string"},
+      "function_name": {"type": "string"},
+      "validation_method": {"type": "string", "enum": ["NO_VALIDATION", "SECRET_AS_QUERY_PARAM", "VERIFY_PAYLOAD"]},
+      "secret_name": {"type": "string"},
+      "return_type": {"type": "string", "enum": ["EJSON", "JSON"]},
+      "respond_result": {"type": "boolean"},
+      "fetch_custom_user_data": {"type": "boolean"},
+      "create_user_on_auth": {"type": "boolean"},
+      "disabled": {"type": "boolean"}
+    }
+  }
+}`),
+	schemaDataAPIConfig: mustParseSchema(schemaDocDataAPIConfig),
+	schemaSyncConfig: mustParseSchema(`{
+  "type": "object",
+  "properties": {
+    "type": {"type": "string"},
+    "state": {"type": "string"},
+    "development_mode_enabled": {"type": "boolean"},
+    "service_name": {"type": "string"},
+    "database_name": {"type": "string"},
+    "partition": {"type": "object"},
+    "queryable_fields_names": {"type": "array", "items": {"type": "string"}},
+    "permissions": {"type": "object"},
+    "client_max_offline_days": {"type": "integer"},
+    "is_recovery_mode_disabled": {"type": "boolean"}
+  }
+}`),
+	schemaValue:          mustParseSchema(schemaDocValue),
+	schemaEnvironment:    mustParseSchema(schemaDocEnvironment),
+	schemaGraphQLConfig:  mustParseSchema(schemaDocGraphQLConfig),
+	schemaCustomResolver: mustParseSchema(schemaDocCustomResolver),
+	schemaLogForwarder:   mustParseSchema(schemaDocLogForwarder),
+	schemaAnyObject:      mustParseSchema(schemaDocAnyObject),
+}
+
+// appSchemas are the local Realm app file schemas by config version
+var appSchemas = map[realm.AppConfigVersion]map[string]*schema{
+	realm.AppConfigVersion20180301: appSchemasV1,
+	realm.AppConfigVersion20200603: appSchemasV1,
+	realm.AppConfigVersion20210101: appSchemasV2,
+}
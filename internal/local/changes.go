@@ -0,0 +1,207 @@
+package local
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+)
+
+// set of app change operations
+const (
+	ChangeAdded    = "added"
+	ChangeDeleted  = "deleted"
+	ChangeModified = "modified"
+)
+
+// AppChange is a change to a resource of a Realm app, named within the app section
+// which it belongs to, along with the resource before and after the change
+type AppChange struct {
+	Type      string      `json:"type"`
+	Name      string      `json:"name,omitempty"`
+	Operation string      `json:"operation"`
+	Before    interface{} `json:"before,omitempty"`
+	After     interface{} `json:"after,omitempty"`
+}
+
+// DiffAppData returns the changes which the local app data makes to the remote app data,
+// ordered by the app section and name of the changed resources
+func DiffAppData(localData, remoteData interface{}) ([]AppChange, error) {
+	localSections, err := toJSONMap(localData)
+	if err != nil {
+		return nil, err
+	}
+
+	remoteSections, err := toJSONMap(remoteData)
+	if err != nil {
+		return nil, err
+	}
+
+	sections := map[string]struct{}{}
+	for section := range localSections {
+		sections[section] = struct{}{}
+	}
+	for section := range remoteSections {
+		sections[section] = struct{}{}
+	}
+
+	var changes []AppChange
+	for section := range sections {
+		localEntries, localOK := sectionEntries(section, localSections[section])
+		remoteEntries, remoteOK := sectionEntries(section, remoteSections[section])
+
+		if !localOK || !remoteOK {
+			if change, ok := diffEntry(section, "", localSections[section], remoteSections[section]); ok {
+				changes = append(changes, change)
+			}
+			continue
+		}
+
+		names := map[string]struct{}{}
+		for name := range localEntries {
+			names[name] = struct{}{}
+		}
+		for name := range remoteEntries {
+			names[name] = struct{}{}
+		}
+
+		for name := range names {
+			if change, ok := diffEntry(section, name, localEntries[name], remoteEntries[name]); ok {
+				changes = append(changes, change)
+			}
+		}
+	}
+
+	sort.SliceStable(changes, func(i, j int) bool {
+		if changes[i].Type != changes[j].Type {
+			return changes[i].Type < changes[j].Type
+		}
+		return changes[i].Name < changes[j].Name
+	})
+	return changes, nil
+}
+
+// DependenciesChanges returns the changes to the Realm app dependencies, named by package
+func DependenciesChanges(diff realm.DependenciesDiff) []AppChange {
+	changes := make([]AppChange, 0, diff.Len())
+	for _, dep := range diff.Added {
+		changes = append(changes, AppChange{Type: SectionDependencies, Name: dep.Name, Operation: ChangeAdded, After: dep.Version})
+	}
+	for _, dep := range diff.Deleted {
+		changes = append(changes, AppChange{Type: SectionDependencies, Name: dep.Name, Operation: ChangeDeleted, Before: dep.Version})
+	}
+	for _, dep := range diff.Modified {
+		changes = append(changes, AppChange{Type: SectionDependencies, Name: dep.Name, Operation: ChangeModified, Before: dep.PreviousVersion, After: dep.Version})
+	}
+	return changes
+}
+
+// HostingChanges returns the changes to the Realm app hosting files, named by file path
+func HostingChanges(diffs HostingDiffs) []AppChange {
+	changes := make([]AppChange, 0, diffs.Size())
+	for _, added := range diffs.Added {
+		changes = append(changes, AppChange{Type: NameHosting, Name: added.FilePath, Operation: ChangeAdded, After: added})
+	}
+	for _, deleted := range diffs.Deleted {
+		changes = append(changes, AppChange{Type: NameHosting, Name: deleted.FilePath, Operation: ChangeDeleted, Before: deleted})
+	}
+	for _, modified := range diffs.Modified {
+		changes = append(changes, AppChange{Type: NameHosting, Name: modified.FilePath, Operation: ChangeModified, After: modified.HostingAsset})
+	}
+	return changes
+}
+
+// ReverseChanges returns the changes which undo the provided changes
+func ReverseChanges(changes []AppChange) []AppChange {
+	reversed := make([]AppChange, len(changes))
+	for i, change := range changes {
+		change.Before, change.After = change.After, change.Before
+		switch change.Operation {
+		case ChangeAdded:
+			change.Operation = ChangeDeleted
+		case ChangeDeleted:
+			change.Operation = ChangeAdded
+		}
+		reversed[i] = change
+	}
+	return reversed
+}
+
+func diffEntry(section, name string, localValue, remoteValue interface{}) (AppChange, bool) {
+	change := AppChange{Type: section, Name: name, Before: remoteValue, After: localValue}
+	switch {
+	case isEmptyValue(localValue) && isEmptyValue(remoteValue):
+		return AppChange{}, false
+	case isEmptyValue(remoteValue):
+		change.Operation = ChangeAdded
+		change.Before = nil
+	case isEmptyValue(localValue):
+		change.Operation = ChangeDeleted
+		change.After = nil
+	case !reflect.DeepEqual(localValue, remoteValue):
+		change.Operation = ChangeModified
+	default:
+		return AppChange{}, false
+	}
+	return change, true
+}
+
+// sectionEntries returns the named entries of an app section, which are the
+// elements of a list or the keys of a map, and functions with their sources
+func sectionEntries(section string, value interface{}) (map[string]interface{}, bool) {
+	switch v := value.(type) {
+	case nil:
+		return map[string]interface{}{}, true
+	case []interface{}:
+		entries := make(map[string]interface{}, len(v))
+		for _, entry := range v {
+			entries[entryKey(entry)] = entry
+		}
+		return entries, true
+	case map[string]interface{}:
+		if !isConfigList(v) {
+			return v, true
+		}
+
+		configs, _ := v[NameConfig].([]interface{})
+		sources, _ := v["sources"].(map[string]interface{})
+
+		entries := make(map[string]interface{}, len(configs))
+		for _, config := range configs {
+			name := entryKey(config)
+			if section != NameFunctions {
+				entries[name] = config
+				continue
+			}
+			entries[name] = map[string]interface{}{
+				NameConfig: config,
+				NameSource: sources[name+extJS],
+			}
+		}
+
+		// function sources which are not configured are shared by other functions
+		for path, src := range sources {
+			name := strings.TrimSuffix(path, extJS)
+			if _, ok := entries[name]; !ok {
+				entries[name] = map[string]interface{}{NameSource: src}
+			}
+		}
+		return entries, true
+	}
+	return nil, false
+}
+
+func isEmptyValue(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	case string:
+		return v == ""
+	}
+	return false
+}
@@ -0,0 +1,142 @@
+package local
+
+import (
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+)
+
+func TestDiffAppData(t *testing.T) {
+	remoteData := &AppRealmConfigJSON{AppDataV2{AppStructureV2{
+		ConfigVersion: 20210101,
+		Name:          "eggcorn",
+		Functions: FunctionsStructure{
+			Configs: []map[string]interface{}{{"name": "foo", "private": false}, {"name": "old"}},
+			Sources: map[string]string{"foo.js": "exports = 'remote foo'", "old.js": "exports = 'old'"},
+		},
+		Triggers: []map[string]interface{}{{"name": "trigger", "disabled": false}},
+		Auth:     AuthStructure{Providers: map[string]interface{}{"api-key": map[string]interface{}{"disabled": false}}},
+	}}}
+
+	t.Run("should find no changes between the same app data", func(t *testing.T) {
+		changes, err := DiffAppData(remoteData, remoteData)
+		assert.Nil(t, err)
+		assert.Equal(t, 0, len(changes))
+	})
+
+	t.Run("should find the added, deleted, and modified resources of the app data", func(t *testing.T) {
+		localData := &AppRealmConfigJSON{AppDataV2{AppStructureV2{
+			ConfigVersion: 20210101,
+			Name:          "eggcorn",
+			Functions: FunctionsStructure{
+				Configs: []map[string]interface{}{{"name": "foo", "private": false}, {"name": "new"}},
+				Sources: map[string]string{"foo.js": "exports = 'local foo'", "new.js": "exports = 'new'"},
+			},
+			Triggers: []map[string]interface{}{{"name": "trigger", "disabled": true}},
+			Auth:     AuthStructure{Providers: map[string]interface{}{"api-key": map[string]interface{}{"disabled": false}}},
+		}}}
+
+		changes, err := DiffAppData(localData, remoteData)
+		assert.Nil(t, err)
+		assert.Equal(t, []AppChange{
+			{
+				Type:      NameFunctions,
+				Name:      "foo",
+				Operation: ChangeModified,
+				Before:    map[string]interface{}{NameConfig: map[string]interface{}{"name": "foo", "private": false}, NameSource: "exports = 'remote foo'"},
+				After:     map[string]interface{}{NameConfig: map[string]interface{}{"name": "foo", "private": false}, NameSource: "exports = 'local foo'"},
+			},
+			{
+				Type:      NameFunctions,
+				Name:      "new",
+				Operation: ChangeAdded,
+				After:     map[string]interface{}{NameConfig: map[string]interface{}{"name": "new"}, NameSource: "exports = 'new'"},
+			},
+			{
+				Type:      NameFunctions,
+				Name:      "old",
+				Operation: ChangeDeleted,
+				Before:    map[string]interface{}{NameConfig: map[string]interface{}{"name": "old"}, NameSource: "exports = 'old'"},
+			},
+			{
+				Type:      NameTriggers,
+				Name:      "trigger",
+				Operation: ChangeModified,
+				Before:    map[string]interface{}{"name": "trigger", "disabled": false},
+				After:     map[string]interface{}{"name": "trigger", "disabled": true},
+			},
+		}, changes)
+	})
+
+	t.Run("should compare sections which are not named resources as a whole", func(t *testing.T) {
+		localData := &AppRealmConfigJSON{AppDataV2{AppStructureV2{
+			ConfigVersion: 20210101,
+			Name:          "eggcorn-renamed",
+			Functions:     remoteData.Functions,
+			Triggers:      remoteData.Triggers,
+			Auth:          remoteData.Auth,
+		}}}
+
+		changes, err := DiffAppData(localData, remoteData)
+		assert.Nil(t, err)
+		assert.Equal(t, []AppChange{
+			{Type: "name", Operation: ChangeModified, Before: "eggcorn", After: "eggcorn-renamed"},
+		}, changes)
+	})
+
+	t.Run("should find the changes to endpoints which share a route with different http methods", func(t *testing.T) {
+		remoteData := &AppRealmConfigJSON{AppDataV2{AppStructureV2{
+			ConfigVersion: 20210101,
+			Endpoints: EndpointStructure{Configs: []map[string]interface{}{
+				{"route": "/hello", "http_method": "GET", "function_name": "get"},
+				{"route": "/hello", "http_method": "POST", "function_name": "post"},
+			}},
+		}}}
+		localData := &AppRealmConfigJSON{AppDataV2{AppStructureV2{
+			ConfigVersion: 20210101,
+			Endpoints: EndpointStructure{Configs: []map[string]interface{}{
+				{"route": "/hello", "http_method": "GET", "function_name": "getV2"},
+				{"route": "/hello", "http_method": "POST", "function_name": "post"},
+			}},
+		}}}
+
+		changes, err := DiffAppData(localData, remoteData)
+		assert.Nil(t, err)
+		assert.Equal(t, []AppChange{
+			{
+				Type:      "endpoints",
+				Name:      "GET /hello",
+				Operation: ChangeModified,
+				Before:    map[string]interface{}{"route": "/hello", "http_method": "GET", "function_name": "get"},
+				After:     map[string]interface{}{"route": "/hello", "http_method": "GET", "function_name": "getV2"},
+			},
+		}, changes)
+	})
+}
+
+func TestDependenciesChanges(t *testing.T) {
+	changes := DependenciesChanges(realm.DependenciesDiff{
+		Added:    []realm.DependencyData{{Name: "twilio", Version: "3.35.1"}},
+		Deleted:  []realm.DependencyData{{Name: "debug", Version: "4.3.1"}},
+		Modified: []realm.DependencyDiffData{{DependencyData: realm.DependencyData{Name: "underscore", Version: "1.9.2"}, PreviousVersion: "1.9.1"}},
+	})
+
+	assert.Equal(t, []AppChange{
+		{Type: SectionDependencies, Name: "twilio", Operation: ChangeAdded, After: "3.35.1"},
+		{Type: SectionDependencies, Name: "debug", Operation: ChangeDeleted, Before: "4.3.1"},
+		{Type: SectionDependencies, Name: "underscore", Operation: ChangeModified, Before: "1.9.1", After: "1.9.2"},
+	}, changes)
+}
+
+func TestReverseChanges(t *testing.T) {
+	assert.Equal(t, []AppChange{
+		{Type: SectionDependencies, Name: "twilio", Operation: ChangeDeleted, Before: "3.35.1"},
+		{Type: SectionDependencies, Name: "debug", Operation: ChangeAdded, After: "4.3.1"},
+		{Type: SectionDependencies, Name: "underscore", Operation: ChangeModified, Before: "1.9.2", After: "1.9.1"},
+	}, ReverseChanges([]AppChange{
+		{Type: SectionDependencies, Name: "twilio", Operation: ChangeAdded, After: "3.35.1"},
+		{Type: SectionDependencies, Name: "debug", Operation: ChangeDeleted, Before: "4.3.1"},
+		{Type: SectionDependencies, Name: "underscore", Operation: ChangeModified, Before: "1.9.1", After: "1.9.2"},
+	}))
+}
@@ -15,10 +15,13 @@ type AppData interface {
 	ConfigData() ([]byte, error)
 	ConfigVersion() realm.AppConfigVersion
 	ID() string
+	SetID(id string)
 	Name() string
+	SetName(name string)
 	Location() realm.Location
 	DeploymentModel() realm.DeploymentModel
 	Environment() realm.Environment
+	SetEnvironment(environment realm.Environment)
 	LoadData(rootDir string) error
 	WriteData(rootDir string) error
 }
@@ -29,8 +32,9 @@ const (
 	extJSON = ".json"
 
 	// cli utilities
-	NameDotMDB  = ".mdb"
-	NameAppMeta = "app_meta"
+	NameDotMDB   = ".mdb"
+	NameAppMeta  = "app_meta"
+	NameSnapshot = "snapshot"
 
 	// app configs
 	NameRealmConfig = "realm_config"
@@ -127,18 +131,18 @@ type File struct {
 
 func (f File) String() string { return f.Name + f.Ext }
 
-func walk(rootDir string, ignorePaths map[string]struct{}, fn func(file os.FileInfo, path string) error) error {
+func walk(rootDir string, ignorePaths map[string]struct{}, ignore Ignore, fn func(file os.FileInfo, path string) error) error {
 	if ignorePaths == nil {
 		ignorePaths = map[string]struct{}{}
 	}
 
-	dw := directoryWalker{path: rootDir}
+	dw := directoryWalker{path: rootDir, ignore: ignore}
 	if err := dw.walk(func(f os.FileInfo, p string) error {
 		if _, ok := ignorePaths[f.Name()]; ok {
 			return nil
 		}
 		if f.IsDir() {
-			return walk(p, ignorePaths, fn)
+			return walk(p, ignorePaths, ignore, fn)
 		}
 		return fn(f, p)
 	}); err != nil {
@@ -153,6 +157,7 @@ type directoryWalker struct {
 	failOnNotExist  bool
 	onlyDirs        bool
 	onlyFiles       bool
+	ignore          Ignore
 }
 
 func (dw directoryWalker) walk(fn func(file os.FileInfo, path string) error) error {
@@ -170,7 +175,11 @@ func (dw directoryWalker) walk(fn func(file os.FileInfo, path string) error) err
 		if dw.onlyDirs && !file.IsDir() || dw.onlyFiles && file.IsDir() {
 			continue
 		}
-		err := fn(file, filepath.Join(dw.path, file.Name()))
+		path := filepath.Join(dw.path, file.Name())
+		if dw.ignore.Ignores(path, file.IsDir()) {
+			continue
+		}
+		err := fn(file, path)
 		if err != nil {
 			if dw.continueOnError {
 				continue
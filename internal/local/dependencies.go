@@ -94,6 +94,11 @@ func (d Dependencies) PrepareUpload() (string, func(), error) {
 		return "", func() {}, err
 	}
 
+	ignore, err := LoadIgnore(filepath.Dir(d.RootDir))
+	if err != nil {
+		return "", func() {}, err
+	}
+
 	tmpDir, err := ioutil.TempDir("", "") // uses os.TempDir and guarantees existence and proper permissions
 	if err != nil {
 		return "", func() {}, err
@@ -127,7 +132,7 @@ func (d Dependencies) PrepareUpload() (string, func(), error) {
 			return "", func() {}, err
 		}
 
-		if h.info.IsDir() {
+		if h.info.IsDir() || ignore.Ignores(h.path, false) {
 			continue
 		}
 
@@ -19,8 +19,9 @@ type Environment struct {
 
 // Credentials are the apikeys associated with the profiles
 type Credentials struct {
-	PublicAPIKey  string `yaml:"public_api_key"`
-	PrivateAPIKey string `yaml:"private_api_key"`
+	PublicAPIKey    string `yaml:"public_api_key"`
+	PrivateAPIKey   string `yaml:"private_api_key"`
+	CredentialStore string `yaml:"credential_store"`
 }
 
 // LoadEnvironments returns a list of each profile's environment containing name, filepath, and api keys
@@ -57,10 +58,19 @@ func LoadEnvironments() ([]Environment, error) {
 			return nil, fmt.Errorf("failed to read profile: %s", name)
 		}
 
+		creds := profile[name]
+		if creds.PublicAPIKey != "" && creds.PrivateAPIKey == "" {
+			stored, err := user.StoredCredentials(user.CredentialStoreType(creds.CredentialStore), name)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read profile: %s: %w", name, err)
+			}
+			creds.PrivateAPIKey = stored.PrivateAPIKey
+		}
+
 		environments = append(environments, Environment{
 			Name:        name,
 			Filepath:    filepath.Join(dir, v.Name()),
-			Credentials: profile[name],
+			Credentials: creds,
 		})
 	}
 	return environments, nil
@@ -2,6 +2,7 @@ package local
 
 import (
 	"bytes"
+	"context"
 	"crypto/md5"
 	"encoding/json"
 	"fmt"
@@ -122,7 +123,13 @@ func (h Hosting) Diffs(cachePath, appID string, appAssets []realm.HostingAsset)
 	if err != nil {
 		return HostingDiffs{}, err
 	}
-	localAssets, err := walkFiles(h.RootDir, appID, assets, assetCache)
+
+	ignore, err := LoadIgnore(filepath.Dir(h.RootDir))
+	if err != nil {
+		return HostingDiffs{}, err
+	}
+
+	localAssets, err := walkFiles(h.RootDir, appID, assets, assetCache, ignore)
 	if err != nil {
 		return HostingDiffs{}, err
 	}
@@ -169,7 +176,7 @@ func (h Hosting) Diffs(cachePath, appID string, appAssets []realm.HostingAsset)
 }
 
 // UploadHostingAssets uploads the hosting assets based on the diff of that file
-func (h Hosting) UploadHostingAssets(realmClient realm.Client, groupID, appID string, hostingDiffs HostingDiffs, errHandler func(err error)) error {
+func (h Hosting) UploadHostingAssets(ctx context.Context, realmClient realm.Client, groupID, appID string, hostingDiffs HostingDiffs, errHandler func(err error)) error {
 	var wg sync.WaitGroup
 
 	jobCh := make(chan func())
@@ -200,7 +207,7 @@ func (h Hosting) UploadHostingAssets(realmClient realm.Client, groupID, appID st
 	for _, added := range hostingDiffs.Added {
 		asset := added // the closure otherwise sees the same value for `added` each iteration
 		jobCh <- func() {
-			if err := realmClient.HostingAssetUpload(groupID, appID, assetsDir, asset); err != nil {
+			if err := realmClient.HostingAssetUpload(ctx, groupID, appID, assetsDir, asset); err != nil {
 				errCh <- fmt.Errorf("failed to add %s: %w", asset.FilePath, err)
 			}
 		}
@@ -209,7 +216,7 @@ func (h Hosting) UploadHostingAssets(realmClient realm.Client, groupID, appID st
 	for _, deleted := range hostingDiffs.Deleted {
 		asset := deleted // the closure otherwise sees the same value for `added` each iteration
 		jobCh <- func() {
-			if err := realmClient.HostingAssetRemove(groupID, appID, asset.FilePath); err != nil {
+			if err := realmClient.HostingAssetRemove(ctx, groupID, appID, asset.FilePath); err != nil {
 				errCh <- fmt.Errorf("failed to remove %s: %w", asset.FilePath, err)
 			}
 		}
@@ -219,11 +226,11 @@ func (h Hosting) UploadHostingAssets(realmClient realm.Client, groupID, appID st
 		asset := modified // the closure otherwise sees the same value for `added` each iteration
 		jobCh <- func() {
 			if asset.AttrsModified && !asset.BodyModified {
-				if err := realmClient.HostingAssetAttributesUpdate(groupID, appID, asset.FilePath, asset.Attrs...); err != nil {
+				if err := realmClient.HostingAssetAttributesUpdate(ctx, groupID, appID, asset.FilePath, asset.Attrs...); err != nil {
 					errCh <- fmt.Errorf("failed to update attributes for %s: %w", asset.FilePath, err)
 				}
 			} else {
-				if err := realmClient.HostingAssetUpload(groupID, appID, assetsDir, asset.HostingAsset); err != nil {
+				if err := realmClient.HostingAssetUpload(ctx, groupID, appID, assetsDir, asset.HostingAsset); err != nil {
 					errCh <- fmt.Errorf("failed to update %s: %w", asset.FilePath, err)
 				}
 			}
@@ -399,7 +406,7 @@ func readMetadata(rootDir string) (map[string]hostingAsset, error) {
 	return assetsByPath, nil
 }
 
-func walkFiles(rootDir, appID string, localAssets map[string]hostingAsset, assetCache *hostingAssetCache) ([]realm.HostingAsset, error) {
+func walkFiles(rootDir, appID string, localAssets map[string]hostingAsset, assetCache *hostingAssetCache, ignore Ignore) ([]realm.HostingAsset, error) {
 	dir := filepath.Join(rootDir, NameFiles)
 
 	var assets []realm.HostingAsset
@@ -409,6 +416,13 @@ func walkFiles(rootDir, appID string, localAssets map[string]hostingAsset, asset
 			return err
 		}
 
+		if ignore.Ignores(path, fileInfo.IsDir()) {
+			if fileInfo.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
 		if fileInfo.IsDir() {
 			return nil
 		}
@@ -466,6 +480,9 @@ func walkFiles(rootDir, appID string, localAssets map[string]hostingAsset, asset
 	}
 
 	for k := range localAssets {
+		if ignore.Ignores(filepath.Join(dir, filepath.FromSlash(k)), false) {
+			continue
+		}
 		if _, ok := assetsByPath[k]; !ok {
 			return nil, fmt.Errorf("file '%s' has an entry in metadata file, but does not appear in files directory", k)
 		}
@@ -0,0 +1,248 @@
+package local
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// set of .realmignore file names
+const (
+	NameRealmIgnore = ".realmignore"
+)
+
+// Ignore is the set of gitignore-style rules found in the .realmignore file
+// at the root of a local Realm app, which exclude files from the app
+type Ignore struct {
+	rootDir string
+	rules   []ignoreRule
+}
+
+type ignoreRule struct {
+	pattern *regexp.Regexp
+	negate  bool
+	dirOnly bool
+}
+
+// LoadIgnore loads the .realmignore file found at the local Realm app root directory,
+// which ignores nothing when the file does not exist
+func LoadIgnore(rootDir string) (Ignore, error) {
+	rootDirAbs, err := filepath.Abs(rootDir)
+	if err != nil {
+		return Ignore{}, err
+	}
+
+	ignore := Ignore{rootDir: rootDirAbs}
+
+	path := filepath.Join(rootDirAbs, NameRealmIgnore)
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return ignore, nil
+		}
+		return Ignore{}, err
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		rule, ok, err := parseIgnoreRule(scanner.Text())
+		if err != nil {
+			return Ignore{}, fmt.Errorf("failed to parse %s at line %d: %s", path, lineNum, err)
+		}
+		if ok {
+			ignore.rules = append(ignore.rules, rule)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return Ignore{}, err
+	}
+
+	return ignore, nil
+}
+
+// withRootDir returns the same rules as found in the .realmignore file,
+// applied to the files of another Realm app root directory
+func (ig Ignore) withRootDir(rootDir string) (Ignore, error) {
+	rootDirAbs, err := filepath.Abs(rootDir)
+	if err != nil {
+		return Ignore{}, err
+	}
+	return Ignore{rootDir: rootDirAbs, rules: ig.rules}, nil
+}
+
+// Ignores returns true if the file or directory at the path is ignored,
+// either by its own rule or because one of its parent directories is ignored
+func (ig Ignore) Ignores(path string, isDir bool) bool {
+	if len(ig.rules) == 0 {
+		return false
+	}
+
+	pathAbs, err := filepath.Abs(path)
+	if err != nil {
+		return false
+	}
+
+	pathRelative, err := filepath.Rel(ig.rootDir, pathAbs)
+	if err != nil || pathRelative == "." || strings.HasPrefix(pathRelative, "..") {
+		return false
+	}
+
+	parts := strings.Split(filepath.ToSlash(pathRelative), "/")
+	for i := 1; i < len(parts); i++ {
+		if ig.matches(strings.Join(parts[:i], "/"), true) {
+			return true
+		}
+	}
+	return ig.matches(strings.Join(parts, "/"), isDir)
+}
+
+// the last rule to match the path decides whether it is ignored
+func (ig Ignore) matches(path string, isDir bool) bool {
+	var ignored bool
+	for _, rule := range ig.rules {
+		if rule.dirOnly && !isDir {
+			continue
+		}
+		if rule.pattern.MatchString(path) {
+			ignored = !rule.negate
+		}
+	}
+	return ignored
+}
+
+// IgnoredFiles returns the paths, relative to the local Realm app root directory,
+// of the files and directories ignored by its .realmignore file
+// Directories are listed with a trailing slash, without the files within them
+func IgnoredFiles(rootDir string) ([]string, error) {
+	ignore, err := LoadIgnore(rootDir)
+	if err != nil {
+		return nil, err
+	}
+
+	var ignored []string
+	if len(ignore.rules) == 0 {
+		return ignored, nil
+	}
+
+	if err := filepath.Walk(rootDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if path == rootDir {
+			return nil
+		}
+		if info.IsDir() && info.Name() == ".git" {
+			return filepath.SkipDir
+		}
+
+		if !ignore.Ignores(path, info.IsDir()) {
+			return nil
+		}
+
+		pathRelative, err := filepath.Rel(rootDir, path)
+		if err != nil {
+			return err
+		}
+
+		if info.IsDir() {
+			ignored = append(ignored, filepath.ToSlash(pathRelative)+"/")
+			return filepath.SkipDir
+		}
+		ignored = append(ignored, filepath.ToSlash(pathRelative))
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	sort.Strings(ignored)
+	return ignored, nil
+}
+
+func parseIgnoreRule(line string) (ignoreRule, bool, error) {
+	line = strings.TrimRight(line, " \t\r")
+	if line == "" || strings.HasPrefix(line, "#") {
+		return ignoreRule{}, false, nil
+	}
+
+	var rule ignoreRule
+
+	if strings.HasPrefix(line, "!") {
+		rule.negate = true
+		line = line[1:]
+	} else if strings.HasPrefix(line, `\!`) || strings.HasPrefix(line, `\#`) {
+		line = line[1:]
+	}
+
+	if strings.HasSuffix(line, "/") {
+		rule.dirOnly = true
+		line = strings.TrimRight(line, "/")
+	}
+
+	// patterns with a slash other than a trailing one are relative to the app root,
+	// while any others match a file or directory name at any depth
+	anchored := strings.Contains(line, "/")
+	line = strings.TrimPrefix(line, "/")
+
+	if line == "" {
+		return ignoreRule{}, false, nil
+	}
+
+	expr := globToRegexp(line)
+	if !anchored {
+		expr = "(.*/)?" + expr
+	}
+
+	pattern, err := regexp.Compile("^" + expr + "$")
+	if err != nil {
+		return ignoreRule{}, false, err
+	}
+	rule.pattern = pattern
+
+	return rule, true, nil
+}
+
+func globToRegexp(glob string) string {
+	var sb strings.Builder
+
+	for i := 0; i < len(glob); i++ {
+		c := glob[i]
+		switch {
+		case strings.HasPrefix(glob[i:], "**/"):
+			sb.WriteString("(.*/)?")
+			i += 2
+		case strings.HasPrefix(glob[i:], "**"):
+			sb.WriteString(".*")
+			i++
+		case c == '*':
+			sb.WriteString("[^/]*")
+		case c == '?':
+			sb.WriteString("[^/]")
+		case c == '[':
+			end := strings.IndexByte(glob[i+1:], ']')
+			if end < 0 {
+				sb.WriteString(regexp.QuoteMeta(string(c)))
+				continue
+			}
+			class := glob[i+1 : i+1+end]
+			if strings.HasPrefix(class, "!") {
+				class = "^" + class[1:]
+			}
+			sb.WriteString("[" + class + "]")
+			i += end + 1
+		case c == '\\' && i+1 < len(glob):
+			i++
+			sb.WriteString(regexp.QuoteMeta(string(glob[i])))
+		default:
+			sb.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+
+	return sb.String()
+}
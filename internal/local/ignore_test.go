@@ -0,0 +1,173 @@
+package local
+
+import (
+	"archive/zip"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	u "github.com/10gen/realm-cli/internal/utils/test"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+)
+
+func TestIgnore(t *testing.T) {
+	setup := func(t *testing.T, realmIgnore string) (string, Ignore, func()) {
+		t.Helper()
+
+		tmpDir, teardown, err := u.NewTempDir("ignore")
+		assert.Nil(t, err)
+
+		assert.Nil(t, ioutil.WriteFile(filepath.Join(tmpDir, NameRealmIgnore), []byte(realmIgnore), 0666))
+
+		ignore, err := LoadIgnore(tmpDir)
+		assert.Nil(t, err)
+
+		return tmpDir, ignore, teardown
+	}
+
+	t.Run("should ignore nothing without a .realmignore file", func(t *testing.T) {
+		tmpDir, teardown, err := u.NewTempDir("ignore")
+		assert.Nil(t, err)
+		defer teardown()
+
+		ignore, err := LoadIgnore(tmpDir)
+		assert.Nil(t, err)
+		assert.False(t, ignore.Ignores(filepath.Join(tmpDir, "functions", "foo.js"), false), "expected nothing to be ignored")
+	})
+
+	tmpDir, ignore, teardown := setup(t, `# editor files
+*.swp
+.DS_Store
+
+/functions/scratch.js
+hosting/files/**/*.map
+test/
+!important.swp
+\#hash
+`)
+	defer teardown()
+
+	for _, tc := range []struct {
+		path     string
+		isDir    bool
+		expected bool
+	}{
+		{path: "functions/foo.js"},
+		{path: "functions/foo.js.swp", expected: true},
+		{path: "hosting/files/.DS_Store", expected: true},
+		{path: "functions/scratch.js", expected: true},
+		{path: "functions/nested/scratch.js"},
+		{path: "hosting/files/app.js.map", expected: true},
+		{path: "hosting/files/js/app.js.map", expected: true},
+		{path: "hosting/files/js/app.js"},
+		{path: "functions/test", isDir: true, expected: true},
+		{path: "functions/test"},
+		{path: "functions/test/fixture.json", expected: true},
+		{path: "functions/important.swp"},
+		{path: "#hash", expected: true},
+	} {
+		t.Run("should match "+tc.path, func(t *testing.T) {
+			assert.Equal(t, tc.expected, ignore.Ignores(filepath.Join(tmpDir, filepath.FromSlash(tc.path)), tc.isDir))
+		})
+	}
+
+	t.Run("should list the ignored files and directories", func(t *testing.T) {
+		tmpDir, _, teardown := setup(t, "*.swp\ntest/\n")
+		defer teardown()
+
+		for _, path := range []string{"functions/foo.js", "functions/foo.js.swp", "functions/test/fixture.json", "hosting/files/index.html.swp"} {
+			assert.Nil(t, os.MkdirAll(filepath.Dir(filepath.Join(tmpDir, path)), os.ModePerm))
+			assert.Nil(t, ioutil.WriteFile(filepath.Join(tmpDir, path), []byte{}, 0666))
+		}
+
+		ignored, err := IgnoredFiles(tmpDir)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"functions/foo.js.swp", "functions/test/", "hosting/files/index.html.swp"}, ignored)
+	})
+
+	t.Run("should fail to load an invalid pattern", func(t *testing.T) {
+		tmpDir, teardown, err := u.NewTempDir("ignore")
+		assert.Nil(t, err)
+		defer teardown()
+
+		assert.Nil(t, ioutil.WriteFile(filepath.Join(tmpDir, NameRealmIgnore), []byte("*.swp\n[z-a]\n"), 0666))
+
+		_, err = LoadIgnore(tmpDir)
+		assert.NotNil(t, err)
+	})
+}
+
+func TestIgnoreApp(t *testing.T) {
+	tmpDir, teardown, err := u.NewTempDir("ignore_app")
+	assert.Nil(t, err)
+	defer teardown()
+
+	app := NewApp(tmpDir, "eggcorn-abcde", "eggcorn", realm.LocationVirginia, realm.DeploymentModelGlobal, realm.EnvironmentNone, realm.AppConfigVersion20210101)
+	assert.Nil(t, app.Write())
+
+	for path, data := range map[string]string{
+		NameRealmIgnore:                  "*.swp\nscratch.js\n*.map\nfunctions/node_modules/test/\n",
+		"functions/foo.js":               "exports = 'foo'",
+		"functions/scratch.js":           "exports = 'scratch'",
+		"functions/foo.js.swp":           "swap",
+		"triggers/trigger.json":          `{"name": "trigger"}`,
+		"triggers/trigger.json.swp":      "swap",
+		"hosting/files/index.html":       "<html></html>",
+		"hosting/files/index.js.map":     "{}",
+		"functions/node_modules/a/a.js":  "exports = 'a'",
+		"functions/node_modules/test/t":  "test",
+		"functions/node_modules/a/a.swp": "swap",
+	} {
+		assert.Nil(t, os.MkdirAll(filepath.Dir(filepath.Join(tmpDir, path)), os.ModePerm))
+		assert.Nil(t, ioutil.WriteFile(filepath.Join(tmpDir, path), []byte(data), 0666))
+	}
+
+	t.Run("should leave the ignored files out of the app data", func(t *testing.T) {
+		app, err := LoadApp(tmpDir)
+		assert.Nil(t, err)
+
+		appData, ok := app.AppData.(*AppRealmConfigJSON)
+		assert.True(t, ok, "expected app data to be config version 20210101")
+
+		_, ok = appData.Functions.Sources["scratch.js"]
+		assert.False(t, ok, "expected scratch.js to be ignored")
+		_, ok = appData.Functions.Sources["foo.js"]
+		assert.True(t, ok, "expected foo.js to be loaded")
+		assert.Equal(t, []map[string]interface{}{{"name": "trigger"}}, appData.Triggers)
+	})
+
+	t.Run("should leave the ignored files out of the hosting diffs", func(t *testing.T) {
+		hosting, err := FindAppHosting(tmpDir)
+		assert.Nil(t, err)
+
+		hostingDiffs, err := hosting.Diffs(filepath.Join(tmpDir, "cache.json"), "appID", nil)
+		assert.Nil(t, err)
+
+		assert.Equal(t, 1, len(hostingDiffs.Added))
+		assert.Equal(t, "/index.html", hostingDiffs.Added[0].FilePath)
+	})
+
+	t.Run("should leave the ignored files out of the dependencies upload", func(t *testing.T) {
+		dependencies := Dependencies{
+			RootDir:     filepath.Join(tmpDir, NameFunctions),
+			FilePath:    filepath.Join(tmpDir, NameFunctions, nameNodeModules),
+			isDirectory: true,
+		}
+
+		uploadPath, cleanup, err := dependencies.PrepareUpload()
+		assert.Nil(t, err)
+		defer cleanup()
+
+		zipPkg, err := zip.OpenReader(uploadPath)
+		assert.Nil(t, err)
+		defer zipPkg.Close()
+
+		var paths []string
+		for _, file := range zipPkg.File {
+			paths = append(paths, file.Name)
+		}
+		assert.Equal(t, []string{filepath.Join(nameNodeModules, "a", "a.js")}, paths)
+	})
+}
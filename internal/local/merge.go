@@ -0,0 +1,333 @@
+package local
+
+import (
+	"archive/zip"
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// set of merge conflict markers
+const (
+	mergeMarkerLocal  = "<<<<<<< local"
+	mergeMarkerSplit  = "======="
+	mergeMarkerRemote = ">>>>>>> remote"
+
+	extRemote = ".remote"
+)
+
+// MergeResult is the summary of merging a remote Realm app into a local Realm app,
+// listing the paths relative to the app root directory by how they were merged
+type MergeResult struct {
+	Added      []string
+	Updated    []string
+	Deleted    []string
+	Merged     []string
+	Conflicted []string
+}
+
+// Len returns the number of files changed by the merge
+func (r MergeResult) Len() int {
+	return len(r.Added) + len(r.Updated) + len(r.Deleted) + len(r.Merged) + len(r.Conflicted)
+}
+
+// SnapshotPath returns the path of the last pulled snapshot of the local Realm app
+func SnapshotPath(rootDir string) string {
+	return filepath.Join(rootDir, NameDotMDB, NameSnapshot)
+}
+
+// WriteSnapshot writes the exported Realm app as the last pulled snapshot of the local Realm app,
+// which is the base for the next merge of the remote Realm app
+func WriteSnapshot(rootDir string, zipPkg *zip.Reader) error {
+	path := SnapshotPath(rootDir)
+	if err := os.RemoveAll(path); err != nil {
+		return err
+	}
+	return WriteZip(path, zipPkg)
+}
+
+// MergeZip merges the exported Realm app into the local Realm app, file by file,
+// using the last pulled snapshot of the app as the base of a three-way merge
+// Files changed only remotely are updated, files changed only locally are kept,
+// and files changed on both sides are merged line by line when possible
+// Conflicting text files are written with conflict markers, while other conflicts
+// keep the local file and write the remote file alongside it with a .remote extension
+// Once merged, the exported Realm app becomes the new snapshot
+func MergeZip(rootDir string, zipPkg *zip.Reader) (MergeResult, error) {
+	remoteFiles := map[string][]byte{}
+	for _, zipFile := range zipPkg.File {
+		if zipFile.FileInfo().IsDir() {
+			continue
+		}
+
+		data, err := readZipFile(zipFile)
+		if err != nil {
+			return MergeResult{}, err
+		}
+		remoteFiles[filepath.ToSlash(filepath.Clean(zipFile.Name))] = data
+	}
+
+	baseFiles, err := readSnapshot(rootDir)
+	if err != nil {
+		return MergeResult{}, err
+	}
+
+	paths := make([]string, 0, len(remoteFiles))
+	for path := range remoteFiles {
+		paths = append(paths, path)
+	}
+	for path := range baseFiles {
+		if _, ok := remoteFiles[path]; !ok {
+			paths = append(paths, path)
+		}
+	}
+	sort.Strings(paths)
+
+	var result MergeResult
+	for _, path := range paths {
+		filePath := filepath.Join(rootDir, filepath.FromSlash(path))
+
+		remote, inRemote := remoteFiles[path]
+		base, inBase := baseFiles[path]
+
+		local, err := ioutil.ReadFile(filePath)
+		inLocal := err == nil
+		if err != nil && !os.IsNotExist(err) {
+			return MergeResult{}, err
+		}
+
+		switch {
+		case inLocal == inRemote && bytes.Equal(local, remote):
+			// the file is the same on both sides
+		case inLocal == inBase && bytes.Equal(local, base):
+			// the file is only changed remotely
+			if !inRemote {
+				if err := os.Remove(filePath); err != nil {
+					return MergeResult{}, err
+				}
+				result.Deleted = append(result.Deleted, path)
+				continue
+			}
+			if err := WriteFile(filePath, 0666, bytes.NewReader(remote)); err != nil {
+				return MergeResult{}, err
+			}
+			if inLocal {
+				result.Updated = append(result.Updated, path)
+			} else {
+				result.Added = append(result.Added, path)
+			}
+		case inRemote == inBase && bytes.Equal(remote, base):
+			// the file is only changed locally
+		case inLocal && inRemote && isText(local) && isText(remote) && isText(base):
+			merged, ok := mergeLines(string(base), string(local), string(remote))
+			if err := WriteFile(filePath, 0666, strings.NewReader(merged)); err != nil {
+				return MergeResult{}, err
+			}
+			if ok {
+				result.Merged = append(result.Merged, path)
+			} else {
+				result.Conflicted = append(result.Conflicted, path)
+			}
+		default:
+			// the file is deleted on one side and changed on the other, or is not text,
+			// so the local file is kept as is alongside any remote file
+			if inRemote {
+				if err := WriteFile(filePath+extRemote, 0666, bytes.NewReader(remote)); err != nil {
+					return MergeResult{}, err
+				}
+			}
+			result.Conflicted = append(result.Conflicted, path)
+		}
+	}
+
+	if err := WriteSnapshot(rootDir, zipPkg); err != nil {
+		return MergeResult{}, err
+	}
+	return result, nil
+}
+
+func readZipFile(zipFile *zip.File) ([]byte, error) {
+	r, err := zipFile.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	return ioutil.ReadAll(r)
+}
+
+func readSnapshot(rootDir string) (map[string][]byte, error) {
+	files := map[string][]byte{}
+
+	snapshotPath := SnapshotPath(rootDir)
+	if _, err := os.Stat(snapshotPath); err != nil {
+		if os.IsNotExist(err) {
+			return files, nil
+		}
+		return nil, err
+	}
+
+	if err := filepath.Walk(snapshotPath, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+
+		pathRelative, err := filepath.Rel(snapshotPath, path)
+		if err != nil {
+			return err
+		}
+
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		files[filepath.ToSlash(pathRelative)] = data
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return files, nil
+}
+
+func isText(data []byte) bool {
+	return utf8.Valid(data) && bytes.IndexByte(data, 0) == -1
+}
+
+// mergeHunk is a change to the base lines from start to end, replacing them with lines
+type mergeHunk struct {
+	start, end int
+	lines      []string
+	local      bool
+}
+
+// mergeLines merges the local and remote changes to the base lines, returning false
+// along with conflict markers around the changes to the same lines on both sides
+func mergeLines(base, local, remote string) (string, bool) {
+	baseLines := splitLines(base)
+
+	hunks := append(
+		mergeHunks(baseLines, splitLines(local), true),
+		mergeHunks(baseLines, splitLines(remote), false)...,
+	)
+	sort.SliceStable(hunks, func(i, j int) bool { return hunks[i].start < hunks[j].start })
+
+	var out []string
+	clean := true
+
+	var pos int
+	for i := 0; i < len(hunks); {
+		// group the hunks which overlap or touch, as those change the same lines
+		start, end := hunks[i].start, hunks[i].end
+		j := i + 1
+		for ; j < len(hunks) && hunks[j].start <= end; j++ {
+			if hunks[j].end > end {
+				end = hunks[j].end
+			}
+		}
+		group := hunks[i:j]
+		i = j
+
+		out = append(out, baseLines[pos:start]...)
+		pos = end
+
+		var hasLocal, hasRemote bool
+		for _, hunk := range group {
+			if hunk.local {
+				hasLocal = true
+			} else {
+				hasRemote = true
+			}
+		}
+
+		localLines := applyHunks(baseLines, start, end, group, true)
+		remoteLines := applyHunks(baseLines, start, end, group, false)
+
+		switch {
+		case !hasRemote:
+			out = append(out, localLines...)
+		case !hasLocal:
+			out = append(out, remoteLines...)
+		case equalLines(localLines, remoteLines):
+			out = append(out, localLines...)
+		default:
+			clean = false
+			out = append(out, mergeMarkerLocal)
+			out = append(out, localLines...)
+			out = append(out, mergeMarkerSplit)
+			out = append(out, remoteLines...)
+			out = append(out, mergeMarkerRemote)
+		}
+	}
+	out = append(out, baseLines[pos:]...)
+
+	if len(out) == 0 {
+		return "", clean
+	}
+	return strings.Join(out, "\n") + "\n", clean
+}
+
+func equalLines(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func mergeHunks(base, other []string, local bool) []mergeHunk {
+	var hunks []mergeHunk
+
+	var pos int
+	var hunk *mergeHunk
+	for _, line := range diffLines(base, other) {
+		if line.op == ' ' {
+			if hunk != nil {
+				hunks = append(hunks, *hunk)
+				hunk = nil
+			}
+			pos++
+			continue
+		}
+
+		if hunk == nil {
+			hunk = &mergeHunk{start: pos, end: pos, local: local}
+		}
+		if line.op == '-' {
+			pos++
+			hunk.end = pos
+		} else {
+			hunk.lines = append(hunk.lines, line.text)
+		}
+	}
+	if hunk != nil {
+		hunks = append(hunks, *hunk)
+	}
+	return hunks
+}
+
+// applyHunks returns the base lines from start to end with the changes from one side applied
+func applyHunks(base []string, start, end int, hunks []mergeHunk, local bool) []string {
+	var lines []string
+
+	pos := start
+	for _, hunk := range hunks {
+		if hunk.local != local {
+			continue
+		}
+		lines = append(lines, base[pos:hunk.start]...)
+		lines = append(lines, hunk.lines...)
+		pos = hunk.end
+	}
+	return append(lines, base[pos:end]...)
+}
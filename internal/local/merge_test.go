@@ -0,0 +1,164 @@
+package local
+
+import (
+	"archive/zip"
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	u "github.com/10gen/realm-cli/internal/utils/test"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+)
+
+func TestMergeZip(t *testing.T) {
+	newZip := func(t *testing.T, files map[string]string) *zip.Reader {
+		t.Helper()
+
+		buf := new(bytes.Buffer)
+		w := zip.NewWriter(buf)
+		for name, data := range files {
+			f, err := w.Create(name)
+			assert.Nil(t, err)
+
+			_, err = f.Write([]byte(data))
+			assert.Nil(t, err)
+		}
+		assert.Nil(t, w.Close())
+
+		zipPkg, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+		assert.Nil(t, err)
+		return zipPkg
+	}
+
+	writeFiles := func(t *testing.T, dir string, files map[string]string) {
+		t.Helper()
+		for path, data := range files {
+			assert.Nil(t, os.MkdirAll(filepath.Dir(filepath.Join(dir, path)), os.ModePerm))
+			assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, path), []byte(data), 0666))
+		}
+	}
+
+	readFile := func(t *testing.T, path string) string {
+		t.Helper()
+		data, err := ioutil.ReadFile(path)
+		assert.Nil(t, err)
+		return string(data)
+	}
+
+	t.Run("should merge the remote app into the local app", func(t *testing.T) {
+		tmpDir, teardown, err := u.NewTempDir("merge")
+		assert.Nil(t, err)
+		defer teardown()
+
+		base := map[string]string{
+			"realm_config.json":        "{\n  \"name\": \"eggcorn\"\n}\n",
+			"functions/same.js":        "exports = 'same';\n",
+			"functions/remote.js":      "exports = 'base';\n",
+			"functions/local.js":       "exports = 'base';\n",
+			"functions/merged.js":      "a\nb\nc\nd\ne\nf\ng\n",
+			"functions/conflict.js":    "exports = 'base';\n",
+			"functions/deleted.js":     "exports = 'deleted';\n",
+			"functions/kept.js":        "exports = 'kept';\n",
+			"functions/unpulled.js":    "exports = 'unpulled';\n",
+			"functions/nothing_new.js": "exports = 'nothing new';\n",
+		}
+		assert.Nil(t, WriteSnapshot(tmpDir, newZip(t, base)))
+
+		writeFiles(t, tmpDir, base)
+		writeFiles(t, tmpDir, map[string]string{
+			"functions/local.js":    "exports = 'local';\n",
+			"functions/merged.js":   "A\nb\nc\nd\ne\nf\ng\n",
+			"functions/conflict.js": "exports = 'local';\n",
+			"functions/kept.js":     "exports = 'kept locally';\n",
+			"functions/scratch.js":  "exports = 'scratch';\n",
+		})
+		assert.Nil(t, os.Remove(filepath.Join(tmpDir, "functions/unpulled.js")))
+
+		remote := map[string]string{
+			"realm_config.json":        "{\n  \"name\": \"eggcorn\"\n}\n",
+			"functions/same.js":        "exports = 'same';\n",
+			"functions/remote.js":      "exports = 'remote';\n",
+			"functions/local.js":       "exports = 'base';\n",
+			"functions/merged.js":      "a\nb\nc\nd\ne\nf\nG\n",
+			"functions/conflict.js":    "exports = 'remote';\n",
+			"functions/added.js":       "exports = 'added';\n",
+			"functions/unpulled.js":    "exports = 'unpulled remotely';\n",
+			"functions/nothing_new.js": "exports = 'nothing new';\n",
+		}
+
+		result, err := MergeZip(tmpDir, newZip(t, remote))
+		assert.Nil(t, err)
+
+		assert.Equal(t, MergeResult{
+			Added:      []string{"functions/added.js"},
+			Updated:    []string{"functions/remote.js"},
+			Deleted:    []string{"functions/deleted.js"},
+			Merged:     []string{"functions/merged.js"},
+			Conflicted: []string{"functions/conflict.js", "functions/kept.js", "functions/unpulled.js"},
+		}, result)
+
+		assert.Equal(t, "exports = 'added';\n", readFile(t, filepath.Join(tmpDir, "functions/added.js")))
+		assert.Equal(t, "exports = 'remote';\n", readFile(t, filepath.Join(tmpDir, "functions/remote.js")))
+		assert.Equal(t, "exports = 'local';\n", readFile(t, filepath.Join(tmpDir, "functions/local.js")))
+		assert.Equal(t, "A\nb\nc\nd\ne\nf\nG\n", readFile(t, filepath.Join(tmpDir, "functions/merged.js")))
+		assert.Equal(t, `<<<<<<< local
+exports = 'local';
+=======
+exports = 'remote';
+>>>>>>> remote
+`, readFile(t, filepath.Join(tmpDir, "functions/conflict.js")))
+		assert.Equal(t, "exports = 'kept locally';\n", readFile(t, filepath.Join(tmpDir, "functions/kept.js")))
+		assert.Equal(t, "exports = 'unpulled remotely';\n", readFile(t, filepath.Join(tmpDir, "functions/unpulled.js.remote")))
+		assert.Equal(t, "exports = 'scratch';\n", readFile(t, filepath.Join(tmpDir, "functions/scratch.js")))
+
+		_, err = os.Stat(filepath.Join(tmpDir, "functions/deleted.js"))
+		assert.True(t, os.IsNotExist(err), "expected the remotely deleted file to be deleted")
+
+		assert.Equal(t, "exports = 'remote';\n", readFile(t, filepath.Join(SnapshotPath(tmpDir), "functions/remote.js")))
+		_, err = os.Stat(filepath.Join(SnapshotPath(tmpDir), "functions/deleted.js"))
+		assert.True(t, os.IsNotExist(err), "expected the snapshot to be replaced")
+	})
+}
+
+func TestMergeLines(t *testing.T) {
+	for _, tc := range []struct {
+		description string
+		base        string
+		local       string
+		remote      string
+		expected    string
+		clean       bool
+	}{
+		{
+			description: "should apply changes to separate lines from both sides",
+			base:        "1\n2\n3\n4\n5\n",
+			local:       "one\n2\n3\n4\n5\n",
+			remote:      "1\n2\n3\n4\nfive\n",
+			expected:    "one\n2\n3\n4\nfive\n",
+			clean:       true,
+		},
+		{
+			description: "should apply the same change from both sides once",
+			base:        "1\n2\n3\n",
+			local:       "1\ntwo\n3\n",
+			remote:      "1\ntwo\n3\n",
+			expected:    "1\ntwo\n3\n",
+			clean:       true,
+		},
+		{
+			description: "should mark conflicting changes to the same lines",
+			base:        "1\n2\n3\n4\n5\n",
+			local:       "1\nlocal\n3\n4\n5\n",
+			remote:      "1\nremote\n3\n4\nfive\n",
+			expected:    "1\n<<<<<<< local\nlocal\n=======\nremote\n>>>>>>> remote\n3\n4\nfive\n",
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			merged, clean := mergeLines(tc.base, tc.local, tc.remote)
+			assert.Equal(t, tc.expected, merged)
+			assert.Equal(t, tc.clean, clean)
+		})
+	}
+}
@@ -0,0 +1,472 @@
+package local
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+)
+
+// MigrationChangeType is the type of a file change made by a migration
+type MigrationChangeType string
+
+// set of supported migration change types
+const (
+	MigrationChangeMove   MigrationChangeType = "move"
+	MigrationChangeWrite  MigrationChangeType = "write"
+	MigrationChangeDelete MigrationChangeType = "delete"
+)
+
+// MigrationChange is a file change made by a migration,
+// with paths relative to the app's root directory
+type MigrationChange struct {
+	Type MigrationChangeType
+	From string
+	To   string
+	data []byte
+}
+
+func (c MigrationChange) String() string {
+	switch c.Type {
+	case MigrationChangeMove:
+		return fmt.Sprintf("move %s -> %s", c.From, c.To)
+	case MigrationChangeWrite:
+		return fmt.Sprintf("write %s", c.To)
+	case MigrationChangeDelete:
+		return fmt.Sprintf("delete %s", c.From)
+	}
+	return string(c.Type)
+}
+
+// Migration is the set of file changes which convert
+// a local Realm app to another config version
+type Migration struct {
+	RootDir       string
+	ConfigVersion realm.AppConfigVersion
+	Changes       []MigrationChange
+
+	// Unmapped describes the app configuration which
+	// has no equivalent in the new config version
+	Unmapped []string
+}
+
+// set of service types which are data sources in the 20210101 config version
+var dataSourceTypes = map[string]struct{}{
+	"mongodb":       {},
+	"mongodb-atlas": {},
+	"datalake":      {},
+}
+
+const (
+	serviceTypeHTTP = "http"
+)
+
+// MigrateApp plans the migration of the local Realm app to the config version,
+// where the app must have been loaded from its root directory
+//
+// Only 20180301 and 20200603 apps may be migrated, and only to 20210101
+func MigrateApp(app App, configVersion realm.AppConfigVersion) (Migration, error) {
+	if configVersion != realm.AppConfigVersion20210101 {
+		return Migration{}, fmt.Errorf("cannot migrate app to config version %s, only %s is supported", configVersion, realm.AppConfigVersion20210101)
+	}
+
+	var appData AppDataV1
+	switch ad := app.AppData.(type) {
+	case *AppConfigJSON:
+		appData = ad.AppDataV1
+	case *AppStitchJSON:
+		appData = ad.AppDataV1
+	case *AppRealmConfigJSON:
+		return Migration{}, fmt.Errorf("app is already at config version %s", ad.ConfigVersion())
+	default:
+		return Migration{}, errors.New("cannot migrate app with unknown config version")
+	}
+
+	m := Migration{RootDir: app.RootDir, ConfigVersion: configVersion}
+
+	if err := m.migrateConfig(app, appData); err != nil {
+		return Migration{}, err
+	}
+	if err := m.migrateAuthProviders(); err != nil {
+		return Migration{}, err
+	}
+	if err := m.migrateFunctions(); err != nil {
+		return Migration{}, err
+	}
+	if err := m.migrateServices(); err != nil {
+		return Migration{}, err
+	}
+	if err := m.migrateDataAPIConfig(); err != nil {
+		return Migration{}, err
+	}
+
+	return m, nil
+}
+
+func (m *Migration) migrateConfig(app App, appData AppDataV1) error {
+	config := AppDataV2{AppStructureV2{
+		ConfigVersion:   m.ConfigVersion,
+		ID:              appData.ID(),
+		Name:            appData.Name(),
+		Location:        appData.Location(),
+		DeploymentModel: appData.DeploymentModel(),
+		Environment:     appData.Environment(),
+	}}
+
+	securityKeys := make([]string, 0, len(appData.Security))
+	for key := range appData.Security {
+		securityKeys = append(securityKeys, key)
+	}
+	sort.Strings(securityKeys)
+
+	for _, key := range securityKeys {
+		value := appData.Security[key]
+		if key != "allowed_request_origins" {
+			m.unmapped("security.%s in %s", key, app.Config)
+			continue
+		}
+		origins, ok := value.([]interface{})
+		if !ok {
+			m.unmapped("security.%s in %s", key, app.Config)
+			continue
+		}
+		for _, origin := range origins {
+			config.AllowedRequestOrigins = append(config.AllowedRequestOrigins, fmt.Sprint(origin))
+		}
+	}
+
+	data, err := config.ConfigData()
+	if err != nil {
+		return err
+	}
+	m.write(FileRealmConfig.String(), data)
+	m.delete(app.Config.String())
+
+	if len(appData.Hosting) > 0 {
+		if err := m.writeJSON(filepath.Join(NameHosting, FileConfig.String()), appData.Hosting); err != nil {
+			return err
+		}
+	}
+
+	if appData.CustomUserDataConfig != nil {
+		if err := m.writeJSON(filepath.Join(NameAuth, FileCustomUserData.String()), appData.CustomUserDataConfig); err != nil {
+			return err
+		}
+	}
+
+	if appData.Sync != nil {
+		if err := m.writeJSON(filepath.Join(NameSync, FileConfig.String()), appData.Sync); err != nil {
+			return err
+		}
+	}
+
+	pathMeta := filepath.Join(NameDotMDB, FileAppMeta.String())
+	if _, err := os.Stat(filepath.Join(m.RootDir, pathMeta)); err == nil {
+		meta := app.Meta
+		meta.ConfigVersion = m.ConfigVersion
+
+		data, err := MarshalJSON(meta)
+		if err != nil {
+			return err
+		}
+		// the app meta is rewritten in place
+		m.Changes = append(m.Changes, MigrationChange{Type: MigrationChangeWrite, From: pathMeta, To: pathMeta, data: data})
+	}
+
+	return nil
+}
+
+func (m *Migration) migrateAuthProviders() error {
+	providers := map[string]interface{}{}
+
+	if err := m.walk(NameAuthProviders, false, func(path string) error {
+		provider, err := parseJSON(filepath.Join(m.RootDir, path))
+		if err != nil {
+			return err
+		}
+
+		name, ok := provider["name"].(string)
+		if !ok {
+			m.unmapped("auth provider without a name in %s", path)
+			return nil
+		}
+
+		providers[name] = provider
+		m.delete(path)
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	if len(providers) == 0 {
+		return nil
+	}
+	return m.writeJSON(filepath.Join(NameAuth, FileProviders.String()), providers)
+}
+
+func (m *Migration) migrateFunctions() error {
+	var configs []map[string]interface{}
+
+	if err := m.walk(NameFunctions, true, func(dir string) error {
+		if filepath.Base(dir) == nameNodeModules {
+			return nil
+		}
+
+		pathConfig := filepath.Join(dir, FileConfig.String())
+		config, err := parseJSON(filepath.Join(m.RootDir, pathConfig))
+		if err != nil {
+			return err
+		}
+		if config == nil {
+			m.unmapped("function directory %s without a %s", dir, FileConfig)
+			return nil
+		}
+
+		name, ok := config["name"].(string)
+		if !ok {
+			name = filepath.Base(dir)
+		}
+
+		pathSource := filepath.Join(dir, FileSource.String())
+		if _, err := os.Stat(filepath.Join(m.RootDir, pathSource)); err != nil {
+			if os.IsNotExist(err) {
+				m.unmapped("function %s without a %s", name, FileSource)
+				return nil
+			}
+			return err
+		}
+
+		configs = append(configs, config)
+		m.move(pathSource, filepath.Join(NameFunctions, name+extJS))
+		m.delete(pathConfig)
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	if len(configs) == 0 {
+		return nil
+	}
+	return m.writeJSON(filepath.Join(NameFunctions, FileConfig.String()), configs)
+}
+
+func (m *Migration) migrateServices() error {
+	return m.walk(NameServices, true, func(dir string) error {
+		config, err := parseJSON(filepath.Join(m.RootDir, dir, FileConfig.String()))
+		if err != nil {
+			return err
+		}
+
+		svcType, _ := config["type"].(string)
+		if _, ok := dataSourceTypes[svcType]; ok {
+			return m.migrateDataSource(dir)
+		}
+
+		if svcType == serviceTypeHTTP {
+			return m.moveAll(dir, filepath.Join(NameHTTPEndpoints, filepath.Base(dir)))
+		}
+
+		// other third-party services keep their layout
+		return nil
+	})
+}
+
+func (m *Migration) migrateDataSource(dir string) error {
+	dirDataSource := filepath.Join(NameDataSources, filepath.Base(dir))
+
+	m.move(filepath.Join(dir, FileConfig.String()), filepath.Join(dirDataSource, FileConfig.String()))
+
+	pathDefaultRule := filepath.Join(dir, FileDefaultRule.String())
+	if _, err := os.Stat(filepath.Join(m.RootDir, pathDefaultRule)); err == nil {
+		m.move(pathDefaultRule, filepath.Join(dirDataSource, FileDefaultRule.String()))
+	}
+
+	if _, err := os.Stat(filepath.Join(m.RootDir, dir, NameIncomingWebhooks)); err == nil {
+		m.unmapped("incoming webhooks of data source %s", filepath.Base(dir))
+	}
+
+	return m.walk(filepath.Join(dir, NameRules), false, func(path string) error {
+		rule, err := parseJSON(filepath.Join(m.RootDir, path))
+		if err != nil {
+			return err
+		}
+
+		database, _ := rule["database"].(string)
+		collection, _ := rule["collection"].(string)
+		if database == "" || collection == "" {
+			m.unmapped("rule without a database and collection in %s", path)
+			return nil
+		}
+
+		dirRule := filepath.Join(dirDataSource, database, collection)
+
+		for _, file := range []File{FileSchema, FileRelationships} {
+			if value, ok := rule[file.Name]; ok {
+				if err := m.writeJSON(filepath.Join(dirRule, file.String()), value); err != nil {
+					return err
+				}
+				delete(rule, file.Name)
+			}
+		}
+
+		if err := m.writeJSON(filepath.Join(dirRule, FileRules.String()), rule); err != nil {
+			return err
+		}
+		m.delete(path)
+		return nil
+	})
+}
+
+func (m *Migration) migrateDataAPIConfig() error {
+	path := FileDataAPIConfig.String()
+	if _, err := os.Stat(filepath.Join(m.RootDir, path)); err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	m.move(path, filepath.Join(NameHTTPEndpoints, path))
+	return nil
+}
+
+// Apply makes the migration's file changes,
+// failing before any change is made if a file would be overwritten
+func (m Migration) Apply() error {
+	removed := map[string]struct{}{}
+	for _, change := range m.Changes {
+		if change.Type != MigrationChangeWrite {
+			removed[change.From] = struct{}{}
+		}
+	}
+
+	for _, change := range m.Changes {
+		if change.To == "" || change.To == change.From {
+			continue
+		}
+		if _, ok := removed[change.To]; ok {
+			continue
+		}
+		if _, err := os.Stat(filepath.Join(m.RootDir, change.To)); err == nil {
+			return fmt.Errorf("cannot migrate app because %s already exists", change.To)
+		}
+	}
+
+	dirs := map[string]struct{}{}
+	for _, change := range m.Changes {
+		switch change.Type {
+		case MigrationChangeWrite:
+			if err := WriteFile(filepath.Join(m.RootDir, change.To), 0666, bytes.NewReader(change.data)); err != nil {
+				return err
+			}
+		case MigrationChangeMove:
+			to := filepath.Join(m.RootDir, change.To)
+			if err := os.MkdirAll(filepath.Dir(to), os.ModePerm); err != nil {
+				return err
+			}
+			if err := os.Rename(filepath.Join(m.RootDir, change.From), to); err != nil {
+				return err
+			}
+			dirs[filepath.Dir(change.From)] = struct{}{}
+		}
+	}
+
+	for _, change := range m.Changes {
+		if change.Type != MigrationChangeDelete {
+			continue
+		}
+		if err := os.Remove(filepath.Join(m.RootDir, change.From)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		dirs[filepath.Dir(change.From)] = struct{}{}
+	}
+
+	m.removeEmptyDirs(dirs)
+	return nil
+}
+
+// removeEmptyDirs removes the directories left empty by the migration,
+// along with any of their parents which are then empty too
+func (m Migration) removeEmptyDirs(dirs map[string]struct{}) {
+	sorted := make([]string, 0, len(dirs))
+	for dir := range dirs {
+		sorted = append(sorted, dir)
+	}
+	// remove the most nested directories first
+	sort.Slice(sorted, func(i, j int) bool {
+		return strings.Count(sorted[i], string(filepath.Separator)) > strings.Count(sorted[j], string(filepath.Separator))
+	})
+
+	for _, dir := range sorted {
+		for ; dir != "." && dir != ""; dir = filepath.Dir(dir) {
+			files, err := ioutil.ReadDir(filepath.Join(m.RootDir, dir))
+			if err != nil || len(files) > 0 {
+				break
+			}
+			if err := os.Remove(filepath.Join(m.RootDir, dir)); err != nil {
+				break
+			}
+		}
+	}
+}
+
+func (m *Migration) move(from, to string) {
+	m.Changes = append(m.Changes, MigrationChange{Type: MigrationChangeMove, From: from, To: to})
+}
+
+// moveAll moves every file within the from directory to the to directory
+func (m *Migration) moveAll(from, to string) error {
+	return filepath.Walk(filepath.Join(m.RootDir, from), func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+
+		pathRelative, err := filepath.Rel(filepath.Join(m.RootDir, from), path)
+		if err != nil {
+			return err
+		}
+		m.move(filepath.Join(from, pathRelative), filepath.Join(to, pathRelative))
+		return nil
+	})
+}
+
+func (m *Migration) write(path string, data []byte) {
+	m.Changes = append(m.Changes, MigrationChange{Type: MigrationChangeWrite, To: path, data: data})
+}
+
+func (m *Migration) writeJSON(path string, o interface{}) error {
+	data, err := MarshalJSON(o)
+	if err != nil {
+		return err
+	}
+	m.write(path, data)
+	return nil
+}
+
+func (m *Migration) delete(path string) {
+	m.Changes = append(m.Changes, MigrationChange{Type: MigrationChangeDelete, From: path})
+}
+
+func (m *Migration) unmapped(format string, args ...interface{}) {
+	m.Unmapped = append(m.Unmapped, fmt.Sprintf(format, args...))
+}
+
+// walk calls fn with the relative path of each file, or directory, found within dir
+func (m *Migration) walk(dir string, onlyDirs bool, fn func(path string) error) error {
+	dw := directoryWalker{
+		path:      filepath.Join(m.RootDir, dir),
+		onlyDirs:  onlyDirs,
+		onlyFiles: !onlyDirs,
+	}
+	return dw.walk(func(file os.FileInfo, path string) error {
+		return fn(filepath.Join(dir, file.Name()))
+	})
+}
@@ -0,0 +1,180 @@
+package local
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	u "github.com/10gen/realm-cli/internal/utils/test"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+)
+
+func TestMigrateApp(t *testing.T) {
+	t.Run("should plan the file changes to migrate a 20200603 app", func(t *testing.T) {
+		app, err := LoadApp(filepath.Join("testdata", "migrate"))
+		assert.Nil(t, err)
+
+		migration, err := MigrateApp(app, realm.AppConfigVersion20210101)
+		assert.Nil(t, err)
+
+		changes := make([]string, 0, len(migration.Changes))
+		for _, change := range migration.Changes {
+			changes = append(changes, change.String())
+		}
+		assert.Equal(t, []string{
+			"write realm_config.json",
+			"delete config.json",
+			"write hosting/config.json",
+			"write auth/custom_user_data.json",
+			"write sync/config.json",
+			"write .mdb/app_meta.json",
+			"delete auth_providers/api-key.json",
+			"write auth/providers.json",
+			"move functions/hello/source.js -> functions/hello.js",
+			"delete functions/hello/config.json",
+			"write functions/config.json",
+			"move services/http/config.json -> http_endpoints/http/config.json",
+			"move services/http/incoming_webhooks/find/config.json -> http_endpoints/http/incoming_webhooks/find/config.json",
+			"move services/http/incoming_webhooks/find/source.js -> http_endpoints/http/incoming_webhooks/find/source.js",
+			"move services/http/rules/access.json -> http_endpoints/http/rules/access.json",
+			"move services/mongodb-atlas/config.json -> data_sources/mongodb-atlas/config.json",
+			"move services/mongodb-atlas/default_rule.json -> data_sources/mongodb-atlas/default_rule.json",
+			"write data_sources/mongodb-atlas/db/coll/schema.json",
+			"write data_sources/mongodb-atlas/db/coll/relationships.json",
+			"write data_sources/mongodb-atlas/db/coll/rules.json",
+			"delete services/mongodb-atlas/rules/db.coll.json",
+			"move data_api_config.json -> http_endpoints/data_api_config.json",
+		}, changes)
+
+		assert.Equal(t, []string{
+			"security.allowed_ip_addresses in config.json",
+		}, migration.Unmapped)
+	})
+
+	t.Run("should migrate a 20200603 app to a 20210101 app", func(t *testing.T) {
+		tmpDir, teardown, err := u.NewTempDir("migrate")
+		assert.Nil(t, err)
+		defer teardown()
+
+		assert.Nil(t, copyDir(filepath.Join("testdata", "migrate"), tmpDir))
+
+		app, err := LoadApp(tmpDir)
+		assert.Nil(t, err)
+
+		migration, err := MigrateApp(app, realm.AppConfigVersion20210101)
+		assert.Nil(t, err)
+		assert.Nil(t, migration.Apply())
+
+		migrated, err := LoadApp(tmpDir)
+		assert.Nil(t, err)
+
+		assert.Equal(t, FileRealmConfig, migrated.Config)
+		assert.Equal(t, AppMeta{"groupID", "appID", realm.AppConfigVersion20210101}, migrated.Meta)
+
+		appData, ok := migrated.AppData.(*AppRealmConfigJSON)
+		assert.True(t, ok, "expected a 20210101 app but got %T", migrated.AppData)
+
+		assert.Equal(t, "migrate-abcde", appData.ID())
+		assert.Equal(t, []string{"http://localhost:8080"}, appData.AllowedRequestOrigins)
+		assert.Equal(t, map[string]interface{}{
+			"api-key": map[string]interface{}{"name": "api-key", "type": "api-key", "disabled": false},
+		}, appData.Auth.Providers)
+		assert.Equal(t, map[string]interface{}{"enabled": false}, appData.Auth.CustomUserData)
+		assert.Equal(t, map[string]interface{}{"development_mode_enabled": false}, appData.Sync.Config)
+		assert.Equal(t, []map[string]interface{}{{"name": "hello", "private": false}}, appData.Functions.Configs)
+		assert.Equal(t, map[string]string{"hello.js": "exports = function() { return \"hello\"; };\n"}, appData.Functions.Sources)
+		assert.Equal(t, map[string]interface{}{"disabled": true}, appData.DataAPIConfig)
+		assert.Equal(t, map[string]interface{}{"enabled": true}, appData.Hosting)
+
+		assert.Equal(t, 1, len(appData.DataSources))
+		assert.Equal(t, "mongodb-atlas", appData.DataSources[0].Config["name"])
+		assert.Equal(t, []map[string]interface{}{{
+			"database":   "db",
+			"collection": "coll",
+			"roles": []interface{}{map[string]interface{}{
+				"name":       "owner",
+				"apply_when": map[string]interface{}{},
+				"read":       true,
+				"write":      true,
+			}},
+			"schema":        map[string]interface{}{"title": "coll"},
+			"relationships": map[string]interface{}{},
+		}}, appData.DataSources[0].Rules)
+
+		assert.Equal(t, 1, len(appData.HTTPServices))
+		assert.Equal(t, "http", appData.HTTPServices[0].Config["name"])
+		assert.Equal(t, 1, len(appData.HTTPServices[0].IncomingWebhooks))
+		assert.Equal(t, 1, len(appData.HTTPServices[0].Rules))
+		assert.Equal(t, 0, len(appData.Services))
+
+		for _, path := range []string{FileConfig.String(), NameAuthProviders, NameServices, filepath.Join(NameFunctions, "hello"), FileDataAPIConfig.String()} {
+			_, err := os.Stat(filepath.Join(tmpDir, path))
+			assert.True(t, os.IsNotExist(err), "expected %s to be removed", path)
+		}
+
+		validationErrs, err := migrated.Validate()
+		assert.Nil(t, err)
+		assert.Equal(t, 0, len(validationErrs))
+	})
+
+	t.Run("should not apply a migration which would overwrite a file", func(t *testing.T) {
+		tmpDir, teardown, err := u.NewTempDir("migrate")
+		assert.Nil(t, err)
+		defer teardown()
+
+		assert.Nil(t, copyDir(filepath.Join("testdata", "migrate"), tmpDir))
+		assert.Nil(t, ioutil.WriteFile(filepath.Join(tmpDir, FileRealmConfig.String()), []byte("{}"), 0666))
+
+		app, err := LoadApp(tmpDir)
+		assert.Nil(t, err)
+
+		migration, err := MigrateApp(app, realm.AppConfigVersion20210101)
+		assert.Nil(t, err)
+		assert.Equal(t, errors.New("cannot migrate app because realm_config.json already exists"), migration.Apply())
+
+		_, err = os.Stat(filepath.Join(tmpDir, FileConfig.String()))
+		assert.Nil(t, err)
+	})
+
+	t.Run("should not migrate an app already at the config version", func(t *testing.T) {
+		app, err := LoadApp(filepath.Join("testdata", "validate", "valid"))
+		assert.Nil(t, err)
+
+		_, err = MigrateApp(app, realm.AppConfigVersion20210101)
+		assert.Equal(t, errors.New("app is already at config version 20210101"), err)
+	})
+
+	t.Run("should not migrate an app to an older config version", func(t *testing.T) {
+		app, err := LoadApp(filepath.Join("testdata", "migrate"))
+		assert.Nil(t, err)
+
+		_, err = MigrateApp(app, realm.AppConfigVersion20180301)
+		assert.Equal(t, errors.New("cannot migrate app to config version 20180301, only 20210101 is supported"), err)
+	})
+}
+
+func copyDir(src, dst string) error {
+	return filepath.Walk(src, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		pathRelative, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+
+		if info.IsDir() {
+			return os.MkdirAll(filepath.Join(dst, pathRelative), os.ModePerm)
+		}
+
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		return ioutil.WriteFile(filepath.Join(dst, pathRelative), data, info.Mode())
+	})
+}
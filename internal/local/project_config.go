@@ -0,0 +1,142 @@
+package local
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+
+	"gopkg.in/yaml.v2"
+)
+
+// set of supported project config names
+const (
+	extYAML = ".yaml"
+
+	NameProjectConfig = ".realm-cli"
+)
+
+// FileProjectConfig is the project-level CLI config file
+var FileProjectConfig = File{NameProjectConfig, extYAML}
+
+// ErrProjectConfig is an error loading the project config at the path
+type ErrProjectConfig struct {
+	Path string
+	Err  error
+}
+
+func (err ErrProjectConfig) Error() string {
+	errMsg := "failed to load project config at " + err.Path
+	if err.Err != nil {
+		errMsg += ": " + err.Err.Error()
+	}
+	return errMsg
+}
+
+func (err ErrProjectConfig) Unwrap() error {
+	return err.Err
+}
+
+// ProjectConfig is the project-level CLI config checked in alongside the app,
+// which provides default flag values for the commands run within the project
+//
+// Defaults are resolved in order of increasing precedence: the top-level flags,
+// the flags for the command, the flags for the environment, and lastly
+// the flags for the command within the environment
+type ProjectConfig struct {
+	Flags        FlagValues                          `yaml:"flags,omitempty"`
+	Commands     map[string]FlagValues               `yaml:"commands,omitempty"`
+	Environments map[string]ProjectEnvironmentConfig `yaml:"environments,omitempty"`
+}
+
+// ProjectEnvironmentConfig is the project-level CLI config for an app environment,
+// along with the remote app which the environment is deployed to
+type ProjectEnvironmentConfig struct {
+	GroupID  string                `yaml:"group_id,omitempty"`
+	AppID    string                `yaml:"app_id,omitempty"`
+	Flags    FlagValues            `yaml:"flags,omitempty"`
+	Commands map[string]FlagValues `yaml:"commands,omitempty"`
+}
+
+// FlagValues maps flag names to their values, where a list value
+// sets the flag once per element
+type FlagValues map[string]interface{}
+
+// FlagDefaults returns the flag default values for the specified command and environment,
+// where the command is identified by its path (e.g. "push" or "logs list")
+func (pc ProjectConfig) FlagDefaults(command, environment string) map[string][]string {
+	defaults := map[string][]string{}
+
+	apply := func(values FlagValues) {
+		for name, value := range values {
+			defaults[name] = flagValueStrings(value)
+		}
+	}
+
+	apply(pc.Flags)
+	apply(pc.Commands[command])
+
+	if env, ok := pc.Environments[environment]; ok && environment != "" {
+		apply(env.Flags)
+		apply(env.Commands[command])
+	}
+
+	return defaults
+}
+
+// EnvironmentMeta returns the meta of the remote app which the environment is deployed to,
+// as configured in the project config of the app
+func (a App) EnvironmentMeta(environment realm.Environment) (AppMeta, error) {
+	path := filepath.Join(a.RootDir, FileProjectConfig.String())
+
+	var env ProjectEnvironmentConfig
+	if a.ProjectConfig != nil {
+		env = a.ProjectConfig.Environments[environment.String()]
+	}
+
+	if env.GroupID == "" || env.AppID == "" {
+		return AppMeta{}, fmt.Errorf("no remote app is configured for the '%s' environment, set its group_id and app_id in %s", environment, path)
+	}
+
+	return AppMeta{
+		ConfigVersion: a.ConfigVersion(),
+		GroupID:       env.GroupID,
+		AppID:         env.AppID,
+	}, nil
+}
+
+func flagValueStrings(value interface{}) []string {
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case []interface{}:
+		out := make([]string, 0, len(v))
+		for _, item := range v {
+			out = append(out, fmt.Sprint(item))
+		}
+		return out
+	}
+	return []string{fmt.Sprint(value)}
+}
+
+// LoadProjectConfig loads the project config found in the specified directory
+// and returns nil if there is none
+func LoadProjectConfig(dir string) (*ProjectConfig, error) {
+	path := filepath.Join(dir, FileProjectConfig.String())
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, ErrProjectConfig{Path: path, Err: err}
+	}
+
+	var config ProjectConfig
+	if err := yaml.Unmarshal(data, &config); err != nil {
+		return nil, ErrProjectConfig{Path: path, Err: err}
+	}
+	return &config, nil
+}
@@ -0,0 +1,174 @@
+package local
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	u "github.com/10gen/realm-cli/internal/utils/test"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+)
+
+const testProjectConfig = `flags:
+  project: 123
+commands:
+  push:
+    remote: my-app-abcde
+    include-hosting: true
+  logs list:
+    type: [function, trigger]
+environments:
+  production:
+    group_id: prod-group
+    app_id: prod-app
+    flags:
+      project: 456
+    commands:
+      push:
+        include-hosting: false
+`
+
+func TestProjectConfigFlagDefaults(t *testing.T) {
+	tmpDir, teardown, err := u.NewTempDir("project")
+	assert.Nil(t, err)
+	defer teardown()
+
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(tmpDir, FileProjectConfig.String()), []byte(testProjectConfig), 0666))
+
+	config, err := LoadProjectConfig(tmpDir)
+	assert.Nil(t, err)
+	assert.NotNil(t, config)
+
+	for _, tc := range []struct {
+		description string
+		command     string
+		environment string
+		expected    map[string][]string
+	}{
+		{
+			description: "should return the top-level flags for an unconfigured command",
+			command:     "apps list",
+			expected:    map[string][]string{"project": {"123"}},
+		},
+		{
+			description: "should return the command flags over the top-level flags",
+			command:     "push",
+			expected: map[string][]string{
+				"project":         {"123"},
+				"remote":          {"my-app-abcde"},
+				"include-hosting": {"true"},
+			},
+		},
+		{
+			description: "should return list values for each element",
+			command:     "logs list",
+			expected: map[string][]string{
+				"project": {"123"},
+				"type":    {"function", "trigger"},
+			},
+		},
+		{
+			description: "should return the environment flags over the command flags",
+			command:     "push",
+			environment: "production",
+			expected: map[string][]string{
+				"project":         {"456"},
+				"remote":          {"my-app-abcde"},
+				"include-hosting": {"false"},
+			},
+		},
+		{
+			description: "should ignore an unconfigured environment",
+			command:     "apps list",
+			environment: "development",
+			expected:    map[string][]string{"project": {"123"}},
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			assert.Equal(t, tc.expected, config.FlagDefaults(tc.command, tc.environment))
+		})
+	}
+}
+
+func TestLoadProjectConfig(t *testing.T) {
+	t.Run("should return nil without a project config", func(t *testing.T) {
+		tmpDir, teardown, err := u.NewTempDir("project")
+		assert.Nil(t, err)
+		defer teardown()
+
+		config, err := LoadProjectConfig(tmpDir)
+		assert.Nil(t, err)
+		assert.Nil(t, config)
+	})
+
+	t.Run("should fail with an invalid project config", func(t *testing.T) {
+		tmpDir, teardown, err := u.NewTempDir("project")
+		assert.Nil(t, err)
+		defer teardown()
+
+		assert.Nil(t, ioutil.WriteFile(filepath.Join(tmpDir, FileProjectConfig.String()), []byte("flags: [oops"), 0666))
+
+		_, err = LoadProjectConfig(tmpDir)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("should fail with the cause of a project config that cannot be read", func(t *testing.T) {
+		tmpDir, teardown, err := u.NewTempDir("project")
+		assert.Nil(t, err)
+		defer teardown()
+
+		path := filepath.Join(tmpDir, FileProjectConfig.String())
+		assert.Nil(t, os.Mkdir(path, 0755))
+
+		_, err = LoadProjectConfig(tmpDir)
+
+		var configErr ErrProjectConfig
+		assert.True(t, errors.As(err, &configErr), "error must be a project config error")
+		assert.Equal(t, path, configErr.Path)
+		assert.NotNil(t, configErr.Err)
+		assert.True(t, strings.HasSuffix(err.Error(), "is a directory"), "error must include its cause")
+	})
+
+	t.Run("should be discovered by find app", func(t *testing.T) {
+		tmpDir, teardown, err := u.NewTempDir("project")
+		assert.Nil(t, err)
+		defer teardown()
+
+		assert.Nil(t, ioutil.WriteFile(filepath.Join(tmpDir, FileRealmConfig.String()), []byte(`{"config_version": 20210101, "name": "test"}`), 0666))
+		assert.Nil(t, ioutil.WriteFile(filepath.Join(tmpDir, FileProjectConfig.String()), []byte(testProjectConfig), 0666))
+
+		app, ok, err := FindApp(tmpDir)
+		assert.Nil(t, err)
+		assert.True(t, ok, "should find app")
+		assert.NotNil(t, app.ProjectConfig)
+		assert.Equal(t, FlagValues{"project": 123}, app.ProjectConfig.Flags)
+	})
+}
+
+func TestAppEnvironmentMeta(t *testing.T) {
+	tmpDir, teardown, err := u.NewTempDir("project")
+	assert.Nil(t, err)
+	defer teardown()
+
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(tmpDir, FileRealmConfig.String()), []byte(`{"config_version": 20210101, "name": "test"}`), 0666))
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(tmpDir, FileProjectConfig.String()), []byte(testProjectConfig), 0666))
+
+	app, err := LoadApp(tmpDir)
+	assert.Nil(t, err)
+
+	t.Run("should return the remote app configured for the environment", func(t *testing.T) {
+		meta, err := app.EnvironmentMeta(realm.EnvironmentProduction)
+		assert.Nil(t, err)
+		assert.Equal(t, AppMeta{ConfigVersion: realm.AppConfigVersion20210101, GroupID: "prod-group", AppID: "prod-app"}, meta)
+	})
+
+	t.Run("should return an error for an environment without a remote app", func(t *testing.T) {
+		_, err := app.EnvironmentMeta(realm.EnvironmentQA)
+		assert.Equal(t, fmt.Errorf("no remote app is configured for the 'qa' environment, set its group_id and app_id in %s", filepath.Join(tmpDir, FileProjectConfig.String())), err)
+	})
+}
@@ -0,0 +1,337 @@
+package local
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// set of supported json types
+const (
+	jsonTypeObject  = "object"
+	jsonTypeArray   = "array"
+	jsonTypeString  = "string"
+	jsonTypeNumber  = "number"
+	jsonTypeInteger = "integer"
+	jsonTypeBoolean = "boolean"
+	jsonTypeNull    = "null"
+)
+
+// jsonNode is a parsed json value which retains the offsets
+// of itself and its object keys within the source document
+type jsonNode struct {
+	kind       string
+	offset     int
+	value      interface{}
+	keys       []string
+	fields     map[string]*jsonNode
+	keyOffsets map[string]int
+	items      []*jsonNode
+}
+
+func (n *jsonNode) field(key string) (*jsonNode, bool) {
+	if n == nil || n.kind != jsonTypeObject {
+		return nil, false
+	}
+	field, ok := n.fields[key]
+	return field, ok
+}
+
+func (n *jsonNode) str(key string) (*jsonNode, string, bool) {
+	field, ok := n.field(key)
+	if !ok || field.kind != jsonTypeString {
+		return nil, "", false
+	}
+	return field, field.value.(string), true
+}
+
+func (n *jsonNode) boolean(key string) bool {
+	field, ok := n.field(key)
+	if !ok || field.kind != jsonTypeBoolean {
+		return false
+	}
+	return field.value.(bool)
+}
+
+// parseJSONNode parses the json document, and returns
+// the offset of any syntax error found along with the error
+func parseJSONNode(data []byte) (*jsonNode, int, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	node, err := decodeJSONNode(dec, data)
+	if err == nil {
+		offset := skipJSONSeparators(data, int(dec.InputOffset()))
+		if _, tokenErr := dec.Token(); tokenErr == nil {
+			return nil, offset, errors.New("invalid data after top-level value")
+		} else if tokenErr != io.EOF {
+			err = tokenErr
+		}
+	}
+
+	if err != nil {
+		offset := int(dec.InputOffset())
+		var syntaxErr *json.SyntaxError
+		if errors.As(err, &syntaxErr) {
+			offset = int(syntaxErr.Offset)
+		}
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, offset, err
+	}
+	return node, 0, nil
+}
+
+func decodeJSONNode(dec *json.Decoder, data []byte) (*jsonNode, error) {
+	node := jsonNode{offset: skipJSONSeparators(data, int(dec.InputOffset()))}
+
+	token, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	switch t := token.(type) {
+	case json.Delim:
+		switch t {
+		case '{':
+			node.kind = jsonTypeObject
+			node.fields = map[string]*jsonNode{}
+			node.keyOffsets = map[string]int{}
+			for dec.More() {
+				keyOffset := skipJSONSeparators(data, int(dec.InputOffset()))
+
+				keyToken, err := dec.Token()
+				if err != nil {
+					return nil, err
+				}
+				key, ok := keyToken.(string)
+				if !ok {
+					return nil, fmt.Errorf("invalid object key: %v", keyToken)
+				}
+
+				field, err := decodeJSONNode(dec, data)
+				if err != nil {
+					return nil, err
+				}
+
+				if _, ok := node.fields[key]; !ok {
+					node.keys = append(node.keys, key)
+				}
+				node.fields[key] = field
+				node.keyOffsets[key] = keyOffset
+			}
+		case '[':
+			node.kind = jsonTypeArray
+			node.items = []*jsonNode{}
+			for dec.More() {
+				item, err := decodeJSONNode(dec, data)
+				if err != nil {
+					return nil, err
+				}
+				node.items = append(node.items, item)
+			}
+		}
+
+		// consume the closing delimiter
+		if _, err := dec.Token(); err != nil {
+			return nil, err
+		}
+	case string:
+		node.kind = jsonTypeString
+		node.value = t
+	case json.Number:
+		node.kind = jsonTypeNumber
+		node.value = t
+	case bool:
+		node.kind = jsonTypeBoolean
+		node.value = t
+	case nil:
+		node.kind = jsonTypeNull
+	}
+
+	return &node, nil
+}
+
+// skipJSONSeparators returns the offset of the next json token,
+// since the decoder's offset precedes any whitespace and separators
+func skipJSONSeparators(data []byte, offset int) int {
+	for offset < len(data) {
+		switch data[offset] {
+		case ' ', '\t', '\r', '\n', ',', ':':
+			offset++
+		default:
+			return offset
+		}
+	}
+	return offset
+}
+
+// jsonPosition returns the 1-based line and column of the offset within the data
+func jsonPosition(data []byte, offset int) (int, int) {
+	if offset > len(data) {
+		offset = len(data)
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	line, column := 1, 1
+	for _, b := range data[:offset] {
+		if b == '\n' {
+			line++
+			column = 1
+			continue
+		}
+		column++
+	}
+	return line, column
+}
+
+// schema is a JSON schema, supporting the subset of keywords
+// needed to describe the local Realm app files
+type schema struct {
+	Type                 schemaTypes        `json:"type,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	Properties           map[string]*schema `json:"properties,omitempty"`
+	AdditionalProperties *schema            `json:"additionalProperties,omitempty"`
+	Items                *schema            `json:"items,omitempty"`
+	Enum                 []string           `json:"enum,omitempty"`
+}
+
+// schemaTypes are the json types a schema allows,
+// which may be specified as either a string or a list of strings
+type schemaTypes []string
+
+func (st *schemaTypes) UnmarshalJSON(data []byte) error {
+	var t string
+	if err := json.Unmarshal(data, &t); err == nil {
+		*st = schemaTypes{t}
+		return nil
+	}
+
+	var ts []string
+	if err := json.Unmarshal(data, &ts); err != nil {
+		return err
+	}
+	*st = ts
+	return nil
+}
+
+func (st schemaTypes) allows(kind string) bool {
+	if len(st) == 0 {
+		return true
+	}
+	for _, t := range st {
+		if t == kind || t == jsonTypeNumber && kind == jsonTypeInteger {
+			return true
+		}
+	}
+	return false
+}
+
+func mustParseSchema(doc string) *schema {
+	var s schema
+	if err := json.Unmarshal([]byte(doc), &s); err != nil {
+		panic(fmt.Sprintf("failed to parse schema: %s\n%s", err, doc))
+	}
+	return &s
+}
+
+// schemaViolation is a json value which does not satisfy its schema
+type schemaViolation struct {
+	offset  int
+	message string
+}
+
+func (s *schema) validate(node *jsonNode) []schemaViolation {
+	var violations []schemaViolation
+	s.validateNode(node, "", &violations)
+	return violations
+}
+
+func (s *schema) validateNode(node *jsonNode, field string, violations *[]schemaViolation) {
+	if s == nil {
+		return
+	}
+
+	kind := node.kind
+	if kind == jsonTypeNumber && !strings.ContainsAny(node.value.(json.Number).String(), ".eE") {
+		kind = jsonTypeInteger
+	}
+
+	if !s.Type.allows(kind) {
+		*violations = append(*violations, schemaViolation{node.offset, fmt.Sprintf("%s must be %s", describeField(field), describeTypes(s.Type))})
+		return
+	}
+
+	if len(s.Enum) > 0 && node.kind == jsonTypeString {
+		value := node.value.(string)
+
+		var ok bool
+		for _, e := range s.Enum {
+			if e == value {
+				ok = true
+				break
+			}
+		}
+		if !ok {
+			*violations = append(*violations, schemaViolation{node.offset, fmt.Sprintf(`%s must be one of: "%s"`, describeField(field), strings.Join(s.Enum, `", "`))})
+		}
+	}
+
+	switch node.kind {
+	case jsonTypeObject:
+		for _, key := range s.Required {
+			if _, ok := node.fields[key]; !ok {
+				*violations = append(*violations, schemaViolation{node.offset, fmt.Sprintf("%s is required", describeField(joinField(field, key)))})
+			}
+		}
+
+		for _, key := range node.keys {
+			property, ok := s.Properties[key]
+			if !ok {
+				property = s.AdditionalProperties
+			}
+			property.validateNode(node.fields[key], joinField(field, key), violations)
+		}
+	case jsonTypeArray:
+		for i, item := range node.items {
+			s.Items.validateNode(item, fmt.Sprintf("%s[%d]", field, i), violations)
+		}
+	}
+}
+
+func joinField(field, key string) string {
+	if field == "" {
+		return key
+	}
+	return field + "." + key
+}
+
+func describeField(field string) string {
+	if field == "" {
+		return "document"
+	}
+	return fmt.Sprintf("%q", field)
+}
+
+func describeTypes(types schemaTypes) string {
+	described := make([]string, 0, len(types))
+	for _, t := range types {
+		switch t {
+		case jsonTypeObject, jsonTypeArray, jsonTypeInteger:
+			described = append(described, "an "+t)
+		case jsonTypeNull:
+			described = append(described, t)
+		default:
+			described = append(described, "a "+t)
+		}
+	}
+	sort.Strings(described)
+	return strings.Join(described, " or ")
+}
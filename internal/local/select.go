@@ -0,0 +1,376 @@
+package local
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// set of app sections which can be selected but are not part of the app data
+const (
+	SectionDependencies = "dependencies"
+)
+
+// set of app sections which can be selected, keyed by their app data field
+var appSections = map[string]struct{}{
+	"allowed_request_origins": {},
+	"custom_user_data_config": {},
+	"endpoints":               {},
+	"security":                {},
+	NameAuth:                  {},
+	NameAuthProviders:         {},
+	NameDataAPIConfig:         {},
+	NameDataSources:           {},
+	NameEnvironments:          {},
+	NameFunctions:             {},
+	NameGraphQL:               {},
+	NameHosting:               {},
+	NameHTTPEndpoints:         {},
+	NameLogForwarders:         {},
+	NameSecrets:               {},
+	NameServices:              {},
+	NameSync:                  {},
+	NameTriggers:              {},
+	NameValues:                {},
+	SectionDependencies:       {},
+}
+
+// AppSections returns the names of the app sections which can be selected
+func AppSections() []string {
+	sections := make([]string, 0, len(appSections))
+	for section := range appSections {
+		sections = append(sections, section)
+	}
+	sort.Strings(sections)
+	return sections
+}
+
+// AppSelector selects a section of a Realm app, or a single named entry of the section
+type AppSelector struct {
+	Section string
+	Name    string
+}
+
+// ParseAppSelector parses an app selector of the form "section" or "section/name"
+func ParseAppSelector(selector string) (AppSelector, error) {
+	parts := strings.SplitN(selector, "/", 2)
+
+	s := AppSelector{Section: parts[0]}
+	if len(parts) == 2 {
+		if parts[1] == "" {
+			return AppSelector{}, fmt.Errorf("invalid app selector '%s': must be of the form <section>[/<name>]", selector)
+		}
+		s.Name = parts[1]
+	}
+
+	if _, ok := appSections[s.Section]; !ok {
+		return AppSelector{}, fmt.Errorf("invalid app selector '%s': unknown section '%s'", selector, s.Section)
+	}
+	return s, nil
+}
+
+func (s AppSelector) String() string {
+	if s.Name == "" {
+		return s.Section
+	}
+	return s.Section + "/" + s.Name
+}
+
+// AppSelection is the set of app sections to include in or exclude from a push
+type AppSelection struct {
+	Only    []AppSelector
+	Exclude []AppSelector
+}
+
+// IsZero returns true if the selection includes the whole app
+func (s AppSelection) IsZero() bool {
+	return len(s.Only) == 0 && len(s.Exclude) == 0
+}
+
+// Includes returns true if the named entry of the app section is selected,
+// or if any part of the app section is selected when the name is empty
+func (s AppSelection) Includes(section, name string) bool {
+	for _, selector := range s.Exclude {
+		if selector.Section == section && (selector.Name == "" || selector.Name == name) {
+			return false
+		}
+	}
+
+	if len(s.Only) == 0 {
+		return true
+	}
+
+	for _, selector := range s.Only {
+		if selector.Section == section && (selector.Name == "" || name == "" || selector.Name == name) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s AppSelection) selectsEntries(section string) bool {
+	for _, selectors := range [][]AppSelector{s.Only, s.Exclude} {
+		for _, selector := range selectors {
+			if selector.Section == section && selector.Name != "" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// SelectAppData returns the app data which takes the selected sections from the local
+// app data and everything else from the remote app data, so that importing it
+// only changes the selected sections of the remote app
+func SelectAppData(localData, remoteData interface{}, selection AppSelection) (map[string]interface{}, error) {
+	localSections, err := toJSONMap(localData)
+	if err != nil {
+		return nil, err
+	}
+
+	remoteSections, err := toJSONMap(remoteData)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(map[string]interface{}, len(remoteSections))
+	for section, value := range remoteSections {
+		out[section] = value
+	}
+
+	for section, value := range localSections {
+		if _, ok := out[section]; !ok {
+			out[section] = value
+		}
+	}
+
+	for section := range out {
+		value := selectSection(section, localSections[section], remoteSections[section], selection)
+		if value == nil {
+			delete(out, section)
+			continue
+		}
+		out[section] = value
+	}
+
+	// the data must be imported with the local config version
+	if configVersion, ok := localSections["config_version"]; ok {
+		out["config_version"] = configVersion
+	}
+
+	return out, nil
+}
+
+func selectSection(section string, localValue, remoteValue interface{}, selection AppSelection) interface{} {
+	if !selection.Includes(section, "") {
+		return remoteValue
+	}
+
+	if !selection.selectsEntries(section) {
+		return localValue
+	}
+
+	localMap, localIsMap := localValue.(map[string]interface{})
+	remoteMap, remoteIsMap := remoteValue.(map[string]interface{})
+
+	if (localValue == nil || localIsMap) && (remoteValue == nil || remoteIsMap) {
+		if isConfigList(localMap) || isConfigList(remoteMap) {
+			return selectConfigList(section, localMap, remoteMap, selection)
+		}
+		return selectKeys(section, localMap, remoteMap, selection)
+	}
+
+	localList, localIsList := localValue.([]interface{})
+	remoteList, remoteIsList := remoteValue.([]interface{})
+
+	if (localValue == nil || localIsList) && (remoteValue == nil || remoteIsList) {
+		if entries := selectEntries(section, localList, remoteList, selection); len(entries) > 0 {
+			return entries
+		}
+		return nil
+	}
+
+	return localValue
+}
+
+// isConfigList returns true if the section lists its entries under a "config" field,
+// as functions and endpoints do in the 20210101 config version
+func isConfigList(value map[string]interface{}) bool {
+	_, ok := value[NameConfig].([]interface{})
+	return ok
+}
+
+func selectConfigList(section string, localValue, remoteValue map[string]interface{}, selection AppSelection) interface{} {
+	out := map[string]interface{}{}
+	for k, v := range remoteValue {
+		out[k] = v
+	}
+
+	localConfigs, _ := localValue[NameConfig].([]interface{})
+	remoteConfigs, _ := remoteValue[NameConfig].([]interface{})
+
+	if configs := selectEntries(section, localConfigs, remoteConfigs, selection); len(configs) > 0 {
+		out[NameConfig] = configs
+	} else {
+		delete(out, NameConfig)
+	}
+
+	if section == NameFunctions {
+		localSources, _ := localValue["sources"].(map[string]interface{})
+		remoteSources, _ := remoteValue["sources"].(map[string]interface{})
+
+		sources := map[string]interface{}{}
+		for path, src := range remoteSources {
+			if !selection.Includes(section, strings.TrimSuffix(path, extJS)) {
+				sources[path] = src
+			}
+		}
+		for path, src := range localSources {
+			if selection.Includes(section, strings.TrimSuffix(path, extJS)) {
+				sources[path] = src
+			}
+		}
+
+		if len(sources) > 0 {
+			out["sources"] = sources
+		} else {
+			delete(out, "sources")
+		}
+	}
+
+	if len(out) == 0 {
+		return nil
+	}
+	return out
+}
+
+func selectKeys(section string, localValue, remoteValue map[string]interface{}, selection AppSelection) interface{} {
+	out := map[string]interface{}{}
+	for k, v := range remoteValue {
+		if !selection.Includes(section, k) {
+			out[k] = v
+		}
+	}
+	for k, v := range localValue {
+		if selection.Includes(section, k) {
+			out[k] = v
+		}
+	}
+
+	if len(out) == 0 {
+		return nil
+	}
+	return out
+}
+
+func selectEntries(section string, localValue, remoteValue []interface{}, selection AppSelection) []interface{} {
+	localEntries := make(map[string]interface{}, len(localValue))
+	for _, entry := range localValue {
+		localEntries[entryKey(entry)] = entry
+	}
+
+	var out []interface{}
+
+	remoteEntries := make(map[string]struct{}, len(remoteValue))
+	for _, entry := range remoteValue {
+		key := entryKey(entry)
+		remoteEntries[key] = struct{}{}
+
+		if !selection.includesEntry(section, entry) {
+			out = append(out, entry)
+			continue
+		}
+		if localEntry, ok := localEntries[key]; ok {
+			out = append(out, localEntry)
+		}
+	}
+
+	for _, entry := range localValue {
+		if _, ok := remoteEntries[entryKey(entry)]; ok {
+			continue
+		}
+		if selection.includesEntry(section, entry) {
+			out = append(out, entry)
+		}
+	}
+
+	return out
+}
+
+// includesEntry returns true if the app section entry is selected by its name,
+// or by its key when that differs, such as an endpoint's http method and route
+func (s AppSelection) includesEntry(section string, entry interface{}) bool {
+	name, key := entryName(entry), entryKey(entry)
+	if key == name {
+		return s.Includes(section, name)
+	}
+
+	for _, selector := range s.Exclude {
+		if selector.Section == section && (selector.Name == "" || selector.Name == name || selector.Name == key) {
+			return false
+		}
+	}
+	return s.Includes(section, name) || s.Includes(section, key)
+}
+
+// entryName returns the name of an app section entry, which is found
+// either on the entry itself or on its config
+func entryName(entry interface{}) string {
+	e, ok := entry.(map[string]interface{})
+	if !ok {
+		return ""
+	}
+
+	if name, ok := e["name"].(string); ok {
+		return name
+	}
+
+	if config, ok := e[NameConfig].(map[string]interface{}); ok {
+		if name, ok := config["name"].(string); ok {
+			return name
+		}
+	}
+
+	if route, ok := e["route"].(string); ok {
+		return route
+	}
+
+	return ""
+}
+
+// entryKey returns the key which identifies an app section entry, which is its
+// name, except for endpoints which may share a route with another http method
+func entryKey(entry interface{}) string {
+	name := entryName(entry)
+
+	e, ok := entry.(map[string]interface{})
+	if !ok {
+		return name
+	}
+	if method, ok := e["http_method"].(string); ok && method != "" && e["route"] == name {
+		return method + " " + name
+	}
+	return name
+}
+
+func toJSONMap(data interface{}) (map[string]interface{}, error) {
+	if data == nil {
+		return map[string]interface{}{}, nil
+	}
+
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+
+	out := map[string]interface{}{}
+	if err := dec.Decode(&out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
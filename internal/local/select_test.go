@@ -0,0 +1,237 @@
+package local
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+)
+
+func TestParseAppSelector(t *testing.T) {
+	for _, tc := range []struct {
+		selector string
+		expected AppSelector
+	}{
+		{"triggers", AppSelector{Section: NameTriggers}},
+		{"functions/foo", AppSelector{Section: NameFunctions, Name: "foo"}},
+		{"functions/nested/foo", AppSelector{Section: NameFunctions, Name: "nested/foo"}},
+		{"dependencies", AppSelector{Section: SectionDependencies}},
+	} {
+		t.Run("should parse "+tc.selector, func(t *testing.T) {
+			selector, err := ParseAppSelector(tc.selector)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expected, selector)
+			assert.Equal(t, tc.selector, selector.String())
+		})
+	}
+
+	for _, tc := range []struct {
+		selector string
+		err      error
+	}{
+		{"nope", errors.New("invalid app selector 'nope': unknown section 'nope'")},
+		{"functions/", errors.New("invalid app selector 'functions/': must be of the form <section>[/<name>]")},
+		{"", errors.New("invalid app selector '': unknown section ''")},
+	} {
+		t.Run("should fail to parse '"+tc.selector+"'", func(t *testing.T) {
+			_, err := ParseAppSelector(tc.selector)
+			assert.Equal(t, tc.err, err)
+		})
+	}
+}
+
+func TestAppSelectionIncludes(t *testing.T) {
+	selection := AppSelection{
+		Only:    []AppSelector{{Section: NameFunctions}, {Section: NameDataSources, Name: "mongodb-atlas"}},
+		Exclude: []AppSelector{{Section: NameFunctions, Name: "secret"}},
+	}
+
+	for _, tc := range []struct {
+		section  string
+		name     string
+		expected bool
+	}{
+		{NameFunctions, "", true},
+		{NameFunctions, "foo", true},
+		{NameFunctions, "secret", false},
+		{NameDataSources, "", true},
+		{NameDataSources, "mongodb-atlas", true},
+		{NameDataSources, "other", false},
+		{NameTriggers, "", false},
+		{NameHosting, "", false},
+	} {
+		t.Run("should return "+map[bool]string{true: "true", false: "false"}[tc.expected]+" for "+tc.section+"/"+tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, selection.Includes(tc.section, tc.name))
+		})
+	}
+
+	t.Run("should include everything but the excluded sections without only selectors", func(t *testing.T) {
+		selection := AppSelection{Exclude: []AppSelector{{Section: NameHosting}}}
+
+		assert.True(t, selection.Includes(NameTriggers, ""), "expected triggers to be included")
+		assert.False(t, selection.Includes(NameHosting, ""), "expected hosting to be excluded")
+	})
+}
+
+func TestSelectAppData(t *testing.T) {
+	localData := &AppRealmConfigJSON{AppDataV2{AppStructureV2{
+		ConfigVersion: 20210101,
+		Name:          "local-name",
+		Functions: FunctionsStructure{
+			Configs: []map[string]interface{}{{"name": "foo", "private": true}, {"name": "bar", "private": true}, {"name": "new"}},
+			Sources: map[string]string{"foo.js": "exports = 'local foo'", "bar.js": "exports = 'local bar'", "new.js": "exports = 'new'"},
+		},
+		Triggers: []map[string]interface{}{{"name": "trigger", "disabled": true}},
+		DataSources: []DataSourceStructure{
+			{Config: map[string]interface{}{"name": "mongodb-atlas"}, Rules: []map[string]interface{}{{"collection": "local"}}},
+			{Config: map[string]interface{}{"name": "other"}, Rules: []map[string]interface{}{{"collection": "local"}}},
+		},
+		Auth: AuthStructure{Providers: map[string]interface{}{"api-key": map[string]interface{}{"disabled": true}}},
+	}}}
+
+	remoteData := &AppRealmConfigJSON{AppDataV2{AppStructureV2{
+		ConfigVersion: 20210101,
+		Name:          "remote-name",
+		Functions: FunctionsStructure{
+			Configs: []map[string]interface{}{{"name": "foo", "private": false}, {"name": "bar", "private": false}, {"name": "old"}},
+			Sources: map[string]string{"foo.js": "exports = 'remote foo'", "bar.js": "exports = 'remote bar'", "old.js": "exports = 'old'"},
+		},
+		Triggers: []map[string]interface{}{{"name": "trigger", "disabled": false}},
+		DataSources: []DataSourceStructure{
+			{Config: map[string]interface{}{"name": "mongodb-atlas"}, Rules: []map[string]interface{}{{"collection": "remote"}}},
+			{Config: map[string]interface{}{"name": "other"}, Rules: []map[string]interface{}{{"collection": "remote"}}},
+		},
+		Auth: AuthStructure{Providers: map[string]interface{}{"api-key": map[string]interface{}{"disabled": false}}},
+	}}}
+
+	selectData := func(t *testing.T, selection AppSelection) AppStructureV2 {
+		t.Helper()
+
+		data, err := SelectAppData(localData, remoteData, selection)
+		assert.Nil(t, err)
+
+		raw, err := json.Marshal(data)
+		assert.Nil(t, err)
+
+		var out AppStructureV2
+		assert.Nil(t, json.Unmarshal(raw, &out))
+		return out
+	}
+
+	t.Run("should take only the selected function from the local app", func(t *testing.T) {
+		data := selectData(t, AppSelection{Only: []AppSelector{{Section: NameFunctions, Name: "foo"}}})
+
+		assert.Equal(t, "remote-name", data.Name)
+		assert.Equal(t, FunctionsStructure{
+			Configs: []map[string]interface{}{{"name": "foo", "private": true}, {"name": "bar", "private": false}, {"name": "old"}},
+			Sources: map[string]string{"foo.js": "exports = 'local foo'", "bar.js": "exports = 'remote bar'", "old.js": "exports = 'old'"},
+		}, data.Functions)
+		assert.Equal(t, remoteData.Triggers, data.Triggers)
+		assert.Equal(t, remoteData.DataSources, data.DataSources)
+		assert.Equal(t, remoteData.Auth, data.Auth)
+	})
+
+	t.Run("should take a whole section from the local app", func(t *testing.T) {
+		data := selectData(t, AppSelection{Only: []AppSelector{{Section: NameFunctions}}})
+
+		assert.Equal(t, localData.Functions, data.Functions)
+		assert.Equal(t, remoteData.Triggers, data.Triggers)
+	})
+
+	t.Run("should take the rules of a single data source from the local app", func(t *testing.T) {
+		data := selectData(t, AppSelection{Only: []AppSelector{{Section: NameDataSources, Name: "mongodb-atlas"}}})
+
+		assert.Equal(t, []DataSourceStructure{
+			{Config: map[string]interface{}{"name": "mongodb-atlas"}, Rules: []map[string]interface{}{{"collection": "local"}}},
+			{Config: map[string]interface{}{"name": "other"}, Rules: []map[string]interface{}{{"collection": "remote"}}},
+		}, data.DataSources)
+	})
+
+	t.Run("should take named keys of a section from the local app", func(t *testing.T) {
+		data := selectData(t, AppSelection{Only: []AppSelector{{Section: NameAuth, Name: NameProviders}}})
+
+		assert.Equal(t, localData.Auth, data.Auth)
+		assert.Equal(t, remoteData.Functions, data.Functions)
+	})
+
+	t.Run("should take everything but the excluded sections from the local app", func(t *testing.T) {
+		data := selectData(t, AppSelection{Exclude: []AppSelector{{Section: NameTriggers}, {Section: NameFunctions, Name: "bar"}}})
+
+		assert.Equal(t, "local-name", data.Name)
+		assert.Equal(t, FunctionsStructure{
+			Configs: []map[string]interface{}{{"name": "foo", "private": true}, {"name": "bar", "private": false}, {"name": "new"}},
+			Sources: map[string]string{"foo.js": "exports = 'local foo'", "bar.js": "exports = 'remote bar'", "new.js": "exports = 'new'"},
+		}, data.Functions)
+		assert.Equal(t, remoteData.Triggers, data.Triggers)
+		assert.Equal(t, localData.DataSources, data.DataSources)
+	})
+
+	t.Run("should keep endpoints which share a route with different http methods apart", func(t *testing.T) {
+		localData := &AppRealmConfigJSON{AppDataV2{AppStructureV2{
+			ConfigVersion: 20210101,
+			Endpoints: EndpointStructure{Configs: []map[string]interface{}{
+				{"route": "/hello/world", "http_method": "GET", "function_name": "localGet"},
+				{"route": "/hello/world", "http_method": "POST", "function_name": "localPost"},
+			}},
+		}}}
+		remoteData := &AppRealmConfigJSON{AppDataV2{AppStructureV2{
+			ConfigVersion: 20210101,
+			Endpoints: EndpointStructure{Configs: []map[string]interface{}{
+				{"route": "/hello/world", "http_method": "GET", "function_name": "remoteGet"},
+				{"route": "/hello/world", "http_method": "POST", "function_name": "remotePost"},
+			}},
+		}}}
+
+		for _, tc := range []struct {
+			description string
+			selection   AppSelection
+			expected    []map[string]interface{}
+		}{
+			{
+				description: "the whole section",
+				selection:   AppSelection{Only: []AppSelector{{Section: "endpoints"}}},
+				expected: []map[string]interface{}{
+					{"route": "/hello/world", "http_method": "GET", "function_name": "localGet"},
+					{"route": "/hello/world", "http_method": "POST", "function_name": "localPost"},
+				},
+			},
+			{
+				description: "the route",
+				selection:   AppSelection{Only: []AppSelector{{Section: "endpoints", Name: "/hello/world"}}},
+				expected: []map[string]interface{}{
+					{"route": "/hello/world", "http_method": "GET", "function_name": "localGet"},
+					{"route": "/hello/world", "http_method": "POST", "function_name": "localPost"},
+				},
+			},
+			{
+				description: "the http method and route",
+				selection:   AppSelection{Only: []AppSelector{{Section: "endpoints", Name: "POST /hello/world"}}},
+				expected: []map[string]interface{}{
+					{"route": "/hello/world", "http_method": "GET", "function_name": "remoteGet"},
+					{"route": "/hello/world", "http_method": "POST", "function_name": "localPost"},
+				},
+			},
+			{
+				description: "everything but the excluded http method and route",
+				selection:   AppSelection{Exclude: []AppSelector{{Section: "endpoints", Name: "GET /hello/world"}}},
+				expected: []map[string]interface{}{
+					{"route": "/hello/world", "http_method": "GET", "function_name": "remoteGet"},
+					{"route": "/hello/world", "http_method": "POST", "function_name": "localPost"},
+				},
+			},
+		} {
+			t.Run("when selecting "+tc.description, func(t *testing.T) {
+				data, err := SelectAppData(localData, remoteData, tc.selection)
+				assert.Nil(t, err)
+
+				raw, err := json.Marshal(data)
+				assert.Nil(t, err)
+
+				var out AppStructureV2
+				assert.Nil(t, json.Unmarshal(raw, &out))
+				assert.Equal(t, tc.expected, out.Endpoints.Configs)
+			})
+		}
+	})
+}
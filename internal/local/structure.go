@@ -31,12 +31,13 @@ type ServiceStructure struct {
 	Rules            []map[string]interface{} `json:"rules"`
 }
 
-func parseEnvironments(rootDir string) (map[string]map[string]interface{}, error) {
+func parseEnvironments(rootDir string, ignore Ignore) (map[string]map[string]interface{}, error) {
 	out := map[string]map[string]interface{}{}
 
 	dw := directoryWalker{
 		path:      filepath.Join(rootDir, NameEnvironments),
 		onlyFiles: true,
+		ignore:    ignore,
 	}
 	if err := dw.walk(func(file os.FileInfo, path string) error {
 		o, err := parseJSON(path)
@@ -55,7 +56,7 @@ func parseEnvironments(rootDir string) (map[string]map[string]interface{}, error
 	return out, nil
 }
 
-func parseFunctions(rootDir string) ([]map[string]interface{}, error) {
+func parseFunctions(rootDir string, ignore Ignore) ([]map[string]interface{}, error) {
 	if _, err := os.Stat(rootDir); err != nil {
 		if os.IsNotExist(err) {
 			return nil, nil
@@ -65,7 +66,7 @@ func parseFunctions(rootDir string) ([]map[string]interface{}, error) {
 
 	var out []map[string]interface{}
 
-	dw := directoryWalker{path: rootDir, onlyDirs: true}
+	dw := directoryWalker{path: rootDir, onlyDirs: true, ignore: ignore}
 	if walkErr := dw.walk(func(file os.FileInfo, path string) error {
 		if strings.Contains(path, nameNodeModules) {
 			return nil // skip node_modules
@@ -93,7 +94,7 @@ func parseFunctions(rootDir string) ([]map[string]interface{}, error) {
 	return out, nil
 }
 
-func parseGraphQL(rootDir string) (GraphQLStructure, bool, error) {
+func parseGraphQL(rootDir string, ignore Ignore) (GraphQLStructure, bool, error) {
 	dir := filepath.Join(rootDir, NameGraphQL)
 
 	if _, err := os.Stat(dir); err != nil {
@@ -108,7 +109,7 @@ func parseGraphQL(rootDir string) (GraphQLStructure, bool, error) {
 		return GraphQLStructure{}, false, configErr
 	}
 
-	customResolvers, customResolversErr := parseJSONFiles(filepath.Join(dir, NameCustomResolvers))
+	customResolvers, customResolversErr := parseJSONFiles(filepath.Join(dir, NameCustomResolvers), ignore)
 	if customResolversErr != nil {
 		return GraphQLStructure{}, false, customResolversErr
 	}
@@ -150,7 +151,7 @@ func parseJSONArray(path string) ([]map[string]interface{}, error) {
 	return out, nil
 }
 
-func parseJSONFiles(rootDir string) ([]map[string]interface{}, error) {
+func parseJSONFiles(rootDir string, ignore Ignore) ([]map[string]interface{}, error) {
 	if _, err := os.Stat(rootDir); err != nil {
 		if os.IsNotExist(err) {
 			return nil, nil
@@ -160,7 +161,7 @@ func parseJSONFiles(rootDir string) ([]map[string]interface{}, error) {
 
 	out := make([]map[string]interface{}, 0)
 
-	dw := directoryWalker{path: rootDir, onlyFiles: true}
+	dw := directoryWalker{path: rootDir, onlyFiles: true, ignore: ignore}
 	if walkErr := dw.walk(func(file os.FileInfo, path string) error {
 		o, err := parseJSON(path)
 		if err != nil {
@@ -198,12 +199,13 @@ func parseSecrets(rootDir string) (SecretsStructure, error) {
 	return secrets, nil
 }
 
-func parseServices(rootDir string) ([]ServiceStructure, error) {
+func parseServices(rootDir string, ignore Ignore) ([]ServiceStructure, error) {
 	var out []ServiceStructure
 
 	dw := directoryWalker{
 		path:     filepath.Join(rootDir, NameServices),
 		onlyDirs: true,
+		ignore:   ignore,
 	}
 	if walkErr := dw.walk(func(file os.FileInfo, path string) error {
 		var svc ServiceStructure
@@ -223,14 +225,14 @@ func parseServices(rootDir string) ([]ServiceStructure, error) {
 		svc.DefaultRule = defaultRule
 
 		// Webhooks
-		webhooks, err := parseFunctions(filepath.Join(path, NameIncomingWebhooks))
+		webhooks, err := parseFunctions(filepath.Join(path, NameIncomingWebhooks), ignore)
 		if err != nil {
 			return err
 		}
 		svc.IncomingWebhooks = webhooks
 
 		// Rules
-		rules, err := parseJSONFiles(filepath.Join(path, NameRules))
+		rules, err := parseJSONFiles(filepath.Join(path, NameRules), ignore)
 		if err != nil {
 			return err
 		}
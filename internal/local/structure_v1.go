@@ -49,11 +49,21 @@ func (a AppDataV1) ID() string {
 	return a.AppStructureV1.ID
 }
 
+// SetID sets the local Realm app id
+func (a *AppDataV1) SetID(id string) {
+	a.AppStructureV1.ID = id
+}
+
 // Name returns the local Realm app name
 func (a AppDataV1) Name() string {
 	return a.AppStructureV1.Name
 }
 
+// SetName sets the local Realm app name
+func (a *AppDataV1) SetName(name string) {
+	a.AppStructureV1.Name = name
+}
+
 // Location returns the local Realm app location
 func (a AppDataV1) Location() realm.Location {
 	return a.AppStructureV1.Location
@@ -69,58 +79,68 @@ func (a AppDataV1) Environment() realm.Environment {
 	return a.AppStructureV1.Environment
 }
 
+// SetEnvironment sets the local Realm app environment
+func (a *AppDataV1) SetEnvironment(environment realm.Environment) {
+	a.AppStructureV1.Environment = environment
+}
+
 // LoadData will load the local Realm app data
 func (a *AppDataV1) LoadData(rootDir string) error {
+	ignore, err := LoadIgnore(rootDir)
+	if err != nil {
+		return err
+	}
+
 	secrets, err := parseSecrets(rootDir)
 	if err != nil {
 		return err
 	}
 	a.Secrets = secrets
 
-	environments, err := parseEnvironments(rootDir)
+	environments, err := parseEnvironments(rootDir, ignore)
 	if err != nil {
 		return err
 	}
 	a.Environments = environments
 
-	values, err := parseJSONFiles(filepath.Join(rootDir, NameValues))
+	values, err := parseJSONFiles(filepath.Join(rootDir, NameValues), ignore)
 	if err != nil {
 		return err
 	}
 	a.Values = values
 
-	authProviders, err := parseJSONFiles(filepath.Join(rootDir, NameAuthProviders))
+	authProviders, err := parseJSONFiles(filepath.Join(rootDir, NameAuthProviders), ignore)
 	if err != nil {
 		return err
 	}
 	a.AuthProviders = authProviders
 
-	functions, err := parseFunctions(filepath.Join(rootDir, NameFunctions))
+	functions, err := parseFunctions(filepath.Join(rootDir, NameFunctions), ignore)
 	if err != nil {
 		return err
 	}
 	a.Functions = functions
 
-	triggers, err := parseJSONFiles(filepath.Join(rootDir, NameTriggers))
+	triggers, err := parseJSONFiles(filepath.Join(rootDir, NameTriggers), ignore)
 	if err != nil {
 		return err
 	}
 	a.Triggers = triggers
 
-	graphql, ok, err := parseGraphQL(rootDir)
+	graphql, ok, err := parseGraphQL(rootDir, ignore)
 	if err != nil {
 		return err
 	} else if ok {
 		a.GraphQL = graphql
 	}
 
-	services, err := parseServices(rootDir)
+	services, err := parseServices(rootDir, ignore)
 	if err != nil {
 		return err
 	}
 	a.Services = services
 
-	logForwarders, err := parseJSONFiles(filepath.Join(rootDir, NameLogForwarders))
+	logForwarders, err := parseJSONFiles(filepath.Join(rootDir, NameLogForwarders), ignore)
 	if err != nil {
 		return err
 	}
@@ -88,11 +88,21 @@ func (a AppDataV2) ID() string {
 	return a.AppStructureV2.ID
 }
 
+// SetID sets the local Realm app id
+func (a *AppDataV2) SetID(id string) {
+	a.AppStructureV2.ID = id
+}
+
 // Name returns the local Realm app name
 func (a AppDataV2) Name() string {
 	return a.AppStructureV2.Name
 }
 
+// SetName sets the local Realm app name
+func (a *AppDataV2) SetName(name string) {
+	a.AppStructureV2.Name = name
+}
+
 // Location returns the local Realm app location
 func (a AppDataV2) Location() realm.Location {
 	return a.AppStructureV2.Location
@@ -108,21 +118,31 @@ func (a AppDataV2) Environment() realm.Environment {
 	return a.AppStructureV2.Environment
 }
 
+// SetEnvironment sets the local Realm app environment
+func (a *AppDataV2) SetEnvironment(environment realm.Environment) {
+	a.AppStructureV2.Environment = environment
+}
+
 // LoadData will load the local Realm app data
 func (a *AppDataV2) LoadData(rootDir string) error {
+	ignore, err := LoadIgnore(rootDir)
+	if err != nil {
+		return err
+	}
+
 	secrets, err := parseSecrets(rootDir)
 	if err != nil {
 		return err
 	}
 	a.Secrets = secrets
 
-	environments, err := parseEnvironments(rootDir)
+	environments, err := parseEnvironments(rootDir, ignore)
 	if err != nil {
 		return err
 	}
 	a.Environments = environments
 
-	values, err := parseJSONFiles(filepath.Join(rootDir, NameValues))
+	values, err := parseJSONFiles(filepath.Join(rootDir, NameValues), ignore)
 	if err != nil {
 		return err
 	}
@@ -140,38 +160,38 @@ func (a *AppDataV2) LoadData(rootDir string) error {
 	}
 	a.Sync = sync
 
-	functions, err := parseFunctionsV2(rootDir)
+	functions, err := parseFunctionsV2(rootDir, ignore)
 	if err != nil {
 		return err
 	}
 	a.Functions = functions
 
-	triggers, err := parseJSONFiles(filepath.Join(rootDir, NameTriggers))
+	triggers, err := parseJSONFiles(filepath.Join(rootDir, NameTriggers), ignore)
 	if err != nil {
 		return err
 	}
 	a.Triggers = triggers
 
-	graphql, ok, err := parseGraphQL(rootDir)
+	graphql, ok, err := parseGraphQL(rootDir, ignore)
 	if err != nil {
 		return err
 	} else if ok {
 		a.GraphQL = graphql
 	}
 
-	services, err := parseServices(rootDir)
+	services, err := parseServices(rootDir, ignore)
 	if err != nil {
 		return err
 	}
 	a.Services = services
 
-	dataSources, err := parseDataSources(rootDir)
+	dataSources, err := parseDataSources(rootDir, ignore)
 	if err != nil {
 		return err
 	}
 	a.DataSources = dataSources
 
-	httpServices, err := parseHTTPServices(rootDir)
+	httpServices, err := parseHTTPServices(rootDir, ignore)
 	if err != nil {
 		return err
 	}
@@ -183,7 +203,7 @@ func (a *AppDataV2) LoadData(rootDir string) error {
 	}
 	a.Endpoints = endpoints
 
-	logForwarders, err := parseJSONFiles(filepath.Join(rootDir, NameLogForwarders))
+	logForwarders, err := parseJSONFiles(filepath.Join(rootDir, NameLogForwarders), ignore)
 	if err != nil {
 		return err
 	}
@@ -197,6 +217,14 @@ func (a *AppDataV2) LoadData(rootDir string) error {
 		a.DataAPIConfig = dataAPIConfig
 	}
 
+	hosting, err := parseJSON(filepath.Join(rootDir, NameHosting, FileConfig.String()))
+	if err != nil {
+		return err
+	}
+	if len(hosting) > 0 {
+		a.Hosting = hosting
+	}
+
 	return nil
 }
 
@@ -223,7 +251,7 @@ func parseAuth(rootDir string) (AuthStructure, error) {
 	return AuthStructure{customUserData, providers}, nil
 }
 
-func parseFunctionsV2(rootDir string) (FunctionsStructure, error) {
+func parseFunctionsV2(rootDir string, ignore Ignore) (FunctionsStructure, error) {
 	dir := filepath.Join(rootDir, NameFunctions)
 
 	if _, err := os.Stat(dir); err != nil {
@@ -239,7 +267,7 @@ func parseFunctionsV2(rootDir string) (FunctionsStructure, error) {
 	}
 
 	sources := map[string]string{}
-	if err := walk(dir, map[string]struct{}{nameNodeModules: {}}, func(file os.FileInfo, path string) error {
+	if err := walk(dir, map[string]struct{}{nameNodeModules: {}}, ignore, func(file os.FileInfo, path string) error {
 		if filepath.Ext(path) != extJS {
 			return nil // looking for javascript files
 		}
@@ -282,12 +310,13 @@ func parseEndpointsV2(rootDir string) (EndpointStructure, error) {
 	return EndpointStructure{configs}, nil
 }
 
-func parseDataSources(rootDir string) ([]DataSourceStructure, error) {
+func parseDataSources(rootDir string, ignore Ignore) ([]DataSourceStructure, error) {
 	var out []DataSourceStructure
 
 	dw := directoryWalker{
 		path:     filepath.Join(rootDir, NameDataSources),
 		onlyDirs: true,
+		ignore:   ignore,
 	}
 	if err := dw.walk(func(file os.FileInfo, path string) error {
 		config, err := parseJSON(filepath.Join(path, FileConfig.String()))
@@ -302,10 +331,10 @@ func parseDataSources(rootDir string) ([]DataSourceStructure, error) {
 
 		var rules []map[string]interface{}
 
-		dbs := directoryWalker{path: path, onlyDirs: true}
+		dbs := directoryWalker{path: path, onlyDirs: true, ignore: ignore}
 		if err := dbs.walk(func(db os.FileInfo, dbPath string) error {
 
-			colls := directoryWalker{path: dbPath, onlyDirs: true}
+			colls := directoryWalker{path: dbPath, onlyDirs: true, ignore: ignore}
 			if err := colls.walk(func(coll os.FileInfo, collPath string) error {
 				// A valid data sources folder contains at least one of:
 				// - a rules.json file
@@ -371,12 +400,13 @@ func parseDataSources(rootDir string) ([]DataSourceStructure, error) {
 	return out, nil
 }
 
-func parseHTTPServices(rootDir string) ([]HTTPServiceStructure, error) {
+func parseHTTPServices(rootDir string, ignore Ignore) ([]HTTPServiceStructure, error) {
 	var out []HTTPServiceStructure
 
 	dw := directoryWalker{
 		path:     filepath.Join(rootDir, NameHTTPEndpoints),
 		onlyDirs: true,
+		ignore:   ignore,
 	}
 	if err := dw.walk(func(file os.FileInfo, path string) error {
 		config, err := parseJSON(filepath.Join(path, FileConfig.String()))
@@ -384,7 +414,7 @@ func parseHTTPServices(rootDir string) ([]HTTPServiceStructure, error) {
 			return err
 		}
 
-		webhooks, err := parseFunctions(filepath.Join(path, NameIncomingWebhooks))
+		webhooks, err := parseFunctions(filepath.Join(path, NameIncomingWebhooks), ignore)
 		if err != nil {
 			return err
 		}
@@ -392,7 +422,7 @@ func parseHTTPServices(rootDir string) ([]HTTPServiceStructure, error) {
 			webhooks = []map[string]interface{}{}
 		}
 
-		rules, err := parseJSONFiles(filepath.Join(path, NameRules))
+		rules, err := parseJSONFiles(filepath.Join(path, NameRules), ignore)
 		if err != nil {
 			return err
 		}
@@ -491,6 +521,9 @@ func (a AppDataV2) WriteData(rootDir string) error {
 	if err := writeDataAPIConfigV2(rootDir, a.DataAPIConfig); err != nil {
 		return err
 	}
+	if err := writeHostingConfigV2(rootDir, a.Hosting); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -765,3 +798,20 @@ func writeDataAPIConfigV2(rootDir string, dataAPIConfig map[string]interface{})
 		bytes.NewReader(data),
 	)
 }
+
+func writeHostingConfigV2(rootDir string, hosting map[string]interface{}) error {
+	if hosting == nil {
+		return nil
+	}
+
+	data, err := MarshalJSON(hosting)
+	if err != nil {
+		return err
+	}
+
+	return WriteFile(
+		filepath.Join(rootDir, NameHosting, FileConfig.String()),
+		0666,
+		bytes.NewReader(data),
+	)
+}
@@ -19,7 +19,7 @@ func TestParseFunctionsV2(t *testing.T) {
 	testRoot := filepath.Join(wd, "testdata/functions")
 
 	t.Run("should return the parsed functions directory with nested javascript files", func(t *testing.T) {
-		functions, err := parseFunctionsV2(testRoot)
+		functions, err := parseFunctionsV2(testRoot, Ignore{})
 		assert.Nil(t, err)
 		assert.Equal(t, FunctionsStructure{
 			Configs: []map[string]interface{}{{
@@ -47,7 +47,7 @@ func TestParseDataSources(t *testing.T) {
 	testRoot := filepath.Join(wd, "testdata/data_sources")
 
 	t.Run("should return the parsed data sources directory with nested rules and schema", func(t *testing.T) {
-		dataSources, err := parseDataSources(testRoot)
+		dataSources, err := parseDataSources(testRoot, Ignore{})
 		assert.Nil(t, err)
 		assert.Equal(t, []DataSourceStructure{{
 			Config: map[string]interface{}{
@@ -0,0 +1 @@
+exports = function(payload, response) { return "found"; };
@@ -0,0 +1,344 @@
+package local
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const (
+	unifiedDiffContext = 3
+	unifiedDiffNoFile  = "/dev/null"
+)
+
+// set of directories which are not compared in a unified diff, as they are
+// either local to the app directory or not exported with the app
+var unifiedDiffIgnoredDirs = map[string]struct{}{
+	".git":     {},
+	NameDotMDB: {},
+}
+
+// set of files and directories at the root of an app directory which make up
+// the Realm app, so that other files of the project (such as a README) are
+// not compared in a unified diff
+var (
+	unifiedDiffAppFiles = map[string]struct{}{
+		FileRealmConfig.String(): {},
+		FileConfig.String():      {},
+		FileStitch.String():      {},
+		FileSecrets.String():     {},
+	}
+
+	unifiedDiffAppDirs = map[string]struct{}{
+		NameAuth:          {},
+		NameAuthProviders: {},
+		NameDataSources:   {},
+		NameEnvironments:  {},
+		NameFunctions:     {},
+		NameGraphQL:       {},
+		NameHosting:       {},
+		NameHTTPEndpoints: {},
+		NameLogForwarders: {},
+		NameServices:      {},
+		NameSync:          {},
+		NameTriggers:      {},
+		NameValues:        {},
+	}
+)
+
+// UnifiedDiff returns the unified diff of the files in the remote Realm app directory
+// against the files in the local Realm app directory, with one diff per changed file
+// Only the files which make up the Realm app are compared, without its hosting files
+// and dependencies, and the files ignored by the local app's .realmignore file are left out
+func UnifiedDiff(remoteDir, localDir string) ([]string, error) {
+	return unifiedDiffDirs(remoteDir, localDir, localDir)
+}
+
+// RemotePatch returns the unified diff of the files in the local Realm app directory
+// against the files in the remote Realm app directory, which applies the remote changes
+// onto the local app as a patch
+func RemotePatch(localDir, remoteDir string) ([]string, error) {
+	return unifiedDiffDirs(localDir, remoteDir, localDir)
+}
+
+// unifiedDiffDirs returns the diffs from the files in one directory to the files in another,
+// leaving out the files ignored by the .realmignore file found in the local app directory
+func unifiedDiffDirs(fromDir, toDir, localDir string) ([]string, error) {
+	ignore, err := LoadIgnore(localDir)
+	if err != nil {
+		return nil, err
+	}
+
+	fromIgnore, err := ignore.withRootDir(fromDir)
+	if err != nil {
+		return nil, err
+	}
+
+	toIgnore, err := ignore.withRootDir(toDir)
+	if err != nil {
+		return nil, err
+	}
+
+	fromFiles, err := unifiedDiffFiles(fromDir, fromIgnore)
+	if err != nil {
+		return nil, err
+	}
+
+	toFiles, err := unifiedDiffFiles(toDir, toIgnore)
+	if err != nil {
+		return nil, err
+	}
+
+	paths := make([]string, 0, len(toFiles))
+	for path := range toFiles {
+		paths = append(paths, path)
+	}
+	for path := range fromFiles {
+		if _, ok := toFiles[path]; !ok {
+			paths = append(paths, path)
+		}
+	}
+	sort.Strings(paths)
+
+	var diffs []string
+	for _, path := range paths {
+		var before, after string
+
+		fromFile, toFile := "a/"+path, "b/"+path
+		if _, ok := fromFiles[path]; ok {
+			data, err := ioutil.ReadFile(filepath.Join(fromDir, filepath.FromSlash(path)))
+			if err != nil {
+				return nil, err
+			}
+			before = string(data)
+		} else {
+			fromFile = unifiedDiffNoFile
+		}
+		if _, ok := toFiles[path]; ok {
+			data, err := ioutil.ReadFile(filepath.Join(toDir, filepath.FromSlash(path)))
+			if err != nil {
+				return nil, err
+			}
+			after = string(data)
+		} else {
+			toFile = unifiedDiffNoFile
+		}
+
+		if before == after && fromFile != unifiedDiffNoFile && toFile != unifiedDiffNoFile {
+			continue
+		}
+
+		diffs = append(diffs, unifiedDiff(fromFile, toFile, before, after))
+	}
+	return diffs, nil
+}
+
+func unifiedDiffFiles(rootDir string, ignore Ignore) (map[string]struct{}, error) {
+	files := map[string]struct{}{}
+	if err := filepath.Walk(rootDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		pathRelative, err := filepath.Rel(rootDir, path)
+		if err != nil {
+			return err
+		}
+		pathRelative = filepath.ToSlash(pathRelative)
+		if pathRelative == "." {
+			return nil
+		}
+		atRoot := !strings.Contains(pathRelative, "/")
+
+		if info.IsDir() {
+			if _, ok := unifiedDiffAppDirs[pathRelative]; atRoot && !ok {
+				return filepath.SkipDir
+			}
+			if _, ok := unifiedDiffIgnoredDirs[info.Name()]; ok ||
+				info.Name() == nameNodeModules ||
+				pathRelative == NameHosting+"/"+NameFiles ||
+				ignore.Ignores(path, true) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		if _, ok := unifiedDiffAppFiles[pathRelative]; atRoot && !ok {
+			return nil
+		}
+		if strings.HasPrefix(info.Name(), nameNodeModules) ||
+			ignore.Ignores(path, false) {
+			return nil
+		}
+
+		files[pathRelative] = struct{}{}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return files, nil
+}
+
+type diffLine struct {
+	op   byte
+	text string
+}
+
+// unifiedDiff returns the unified diff of the before and after file contents
+func unifiedDiff(fromFile, toFile, before, after string) string {
+	lines := diffLines(splitLines(before), splitLines(after))
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("--- %s\n+++ %s\n", fromFile, toFile))
+
+	for start := 0; start < len(lines); {
+		// find the next change, and the context lines before it
+		for start < len(lines) && lines[start].op == ' ' {
+			start++
+		}
+		if start == len(lines) {
+			break
+		}
+		hunkStart := start - unifiedDiffContext
+		if hunkStart < 0 {
+			hunkStart = 0
+		}
+
+		// extend the hunk until the changes are followed by more unchanged lines than fit in two contexts
+		hunkEnd := start
+		for unchanged := 0; hunkEnd < len(lines) && unchanged <= 2*unifiedDiffContext; hunkEnd++ {
+			if lines[hunkEnd].op == ' ' {
+				unchanged++
+			} else {
+				unchanged = 0
+			}
+		}
+		for hunkEnd > start && lines[hunkEnd-1].op == ' ' {
+			hunkEnd--
+		}
+		hunkEnd += unifiedDiffContext
+		if hunkEnd > len(lines) {
+			hunkEnd = len(lines)
+		}
+
+		fromLine, toLine := 1, 1
+		for _, line := range lines[:hunkStart] {
+			if line.op != '+' {
+				fromLine++
+			}
+			if line.op != '-' {
+				toLine++
+			}
+		}
+
+		var fromCount, toCount int
+		for _, line := range lines[hunkStart:hunkEnd] {
+			if line.op != '+' {
+				fromCount++
+			}
+			if line.op != '-' {
+				toCount++
+			}
+		}
+		if fromCount == 0 {
+			fromLine--
+		}
+		if toCount == 0 {
+			toLine--
+		}
+
+		sb.WriteString(fmt.Sprintf("@@ -%s +%s @@\n", hunkRange(fromLine, fromCount), hunkRange(toLine, toCount)))
+		for _, line := range lines[hunkStart:hunkEnd] {
+			sb.WriteByte(line.op)
+			sb.WriteString(line.text)
+			sb.WriteByte('\n')
+		}
+
+		start = hunkEnd
+	}
+
+	return strings.TrimSuffix(sb.String(), "\n")
+}
+
+func hunkRange(line, count int) string {
+	if count == 1 {
+		return fmt.Sprintf("%d", line)
+	}
+	return fmt.Sprintf("%d,%d", line, count)
+}
+
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
+
+// diffLines returns the edit script which turns the before lines into the after lines,
+// found from the longest common subsequence of the lines between their common prefix and suffix
+func diffLines(before, after []string) []diffLine {
+	var prefix int
+	for prefix < len(before) && prefix < len(after) && before[prefix] == after[prefix] {
+		prefix++
+	}
+
+	var suffix int
+	for suffix < len(before)-prefix && suffix < len(after)-prefix &&
+		before[len(before)-1-suffix] == after[len(after)-1-suffix] {
+		suffix++
+	}
+
+	a, b := before[prefix:len(before)-suffix], after[prefix:len(after)-suffix]
+
+	// lcs[i][j] is the length of the longest common subsequence of a[i:] and b[j:]
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	lines := make([]diffLine, 0, len(before)+len(after))
+	for _, line := range before[:prefix] {
+		lines = append(lines, diffLine{' ', line})
+	}
+
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			lines = append(lines, diffLine{' ', a[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			lines = append(lines, diffLine{'-', a[i]})
+			i++
+		default:
+			lines = append(lines, diffLine{'+', b[j]})
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		lines = append(lines, diffLine{'-', a[i]})
+	}
+	for ; j < len(b); j++ {
+		lines = append(lines, diffLine{'+', b[j]})
+	}
+
+	for _, line := range before[len(before)-suffix:] {
+		lines = append(lines, diffLine{' ', line})
+	}
+	return lines
+}
@@ -0,0 +1,159 @@
+package local
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	u "github.com/10gen/realm-cli/internal/utils/test"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+)
+
+func TestUnifiedDiff(t *testing.T) {
+	writeFiles := func(t *testing.T, dir string, files map[string]string) {
+		t.Helper()
+		for path, data := range files {
+			assert.Nil(t, os.MkdirAll(filepath.Dir(filepath.Join(dir, path)), os.ModePerm))
+			assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, path), []byte(data), 0666))
+		}
+	}
+
+	t.Run("should diff the files of the remote and local app directories", func(t *testing.T) {
+		remoteDir, remoteTeardown, err := u.NewTempDir("remote")
+		assert.Nil(t, err)
+		defer remoteTeardown()
+
+		localDir, localTeardown, err := u.NewTempDir("local")
+		assert.Nil(t, err)
+		defer localTeardown()
+
+		writeFiles(t, remoteDir, map[string]string{
+			"realm_config.json":        `{"name": "eggcorn"}` + "\n",
+			"functions/foo.js":         "exports = function() {\n  return 'remote';\n};\n",
+			"functions/old.js":         "exports = 'old';\n",
+			"hosting/files/index.html": "<html>remote</html>",
+		})
+		writeFiles(t, localDir, map[string]string{
+			"realm_config.json":             `{"name": "eggcorn"}` + "\n",
+			"functions/foo.js":              "exports = function() {\n  return 'local';\n};\n",
+			"functions/new.js":              "exports = 'new';\n",
+			"functions/scratch.js":          "exports = 'scratch';\n",
+			"functions/node_modules/a/a.js": "exports = 'a';\n",
+			"hosting/files/index.html":      "<html>local</html>",
+			".mdb/meta.json":                "{}",
+			".gitignore":                    "node_modules\n",
+			".realm-cli.yaml":               "remote: eggcorn\n",
+			"README.md":                     "# eggcorn\n",
+			"scripts/seed.js":               "exports = 'seed';\n",
+			NameRealmIgnore:                 "scratch.js\n",
+		})
+
+		diffs, err := UnifiedDiff(remoteDir, localDir)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{
+			`--- a/functions/foo.js
++++ b/functions/foo.js
+@@ -1,3 +1,3 @@
+ exports = function() {
+-  return 'remote';
++  return 'local';
+ };`,
+			`--- /dev/null
++++ b/functions/new.js
+@@ -0,0 +1 @@
++exports = 'new';`,
+			`--- a/functions/old.js
++++ /dev/null
+@@ -1 +0,0 @@
+-exports = 'old';`,
+		}, diffs)
+	})
+}
+
+func TestRemotePatch(t *testing.T) {
+	remoteDir, remoteTeardown, err := u.NewTempDir("remote")
+	assert.Nil(t, err)
+	defer remoteTeardown()
+
+	localDir, localTeardown, err := u.NewTempDir("local")
+	assert.Nil(t, err)
+	defer localTeardown()
+
+	for dir, files := range map[string]map[string]string{
+		remoteDir: {"functions/foo.js": "exports = 'remote';\n", "functions/scratch.js": "exports = 'remote scratch';\n"},
+		localDir:  {"functions/foo.js": "exports = 'local';\n", NameRealmIgnore: "scratch.js\n"},
+	} {
+		for path, data := range files {
+			assert.Nil(t, os.MkdirAll(filepath.Dir(filepath.Join(dir, path)), os.ModePerm))
+			assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, path), []byte(data), 0666))
+		}
+	}
+
+	t.Run("should diff the local files against the remote files leaving out the ignored files", func(t *testing.T) {
+		patch, err := RemotePatch(localDir, remoteDir)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{`--- a/functions/foo.js
++++ b/functions/foo.js
+@@ -1 +1 @@
+-exports = 'local';
++exports = 'remote';`}, patch)
+	})
+}
+
+func TestUnifiedDiffHunks(t *testing.T) {
+	before := "1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\n12\n13\n14\n15\n16\n17\n18\n19\n20\n"
+
+	for _, tc := range []struct {
+		description string
+		after       string
+		expected    string
+	}{
+		{
+			description: "should split changes far apart into separate hunks",
+			after:       "1\ntwo\n3\n4\n5\n6\n7\n8\n9\n10\n11\n12\n13\n14\n15\n16\n17\n18\nnineteen\n20\n",
+			expected: `--- a/file
++++ b/file
+@@ -1,5 +1,5 @@
+ 1
+-2
++two
+ 3
+ 4
+ 5
+@@ -16,5 +16,5 @@
+ 16
+ 17
+ 18
+-19
++nineteen
+ 20`,
+		},
+		{
+			description: "should join changes close together into one hunk",
+			after:       "1\n2\n3\n4\nfive\n6\n7\n8\n9\n10\n11\neleven\n12\n13\n14\n15\n16\n17\n18\n19\n20\n",
+			expected: `--- a/file
++++ b/file
+@@ -2,13 +2,14 @@
+ 2
+ 3
+ 4
+-5
++five
+ 6
+ 7
+ 8
+ 9
+ 10
+ 11
++eleven
+ 12
+ 13
+ 14`,
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			assert.Equal(t, tc.expected, unifiedDiff("a/file", "b/file", before, tc.after))
+		})
+	}
+}
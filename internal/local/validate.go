@@ -0,0 +1,380 @@
+package local
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+)
+
+// ValidationError is a problem found within a local Realm app file
+type ValidationError struct {
+	Path    string `json:"path"`
+	Line    int    `json:"line"`
+	Column  int    `json:"column"`
+	Message string `json:"message"`
+}
+
+func (err ValidationError) Error() string {
+	return fmt.Sprintf("%s:%d:%d: %s", err.Path, err.Line, err.Column, err.Message)
+}
+
+// ValidationErrors are the problems found within a local Realm app
+type ValidationErrors []ValidationError
+
+func (errs ValidationErrors) Len() int      { return len(errs) }
+func (errs ValidationErrors) Swap(i, j int) { errs[i], errs[j] = errs[j], errs[i] }
+func (errs ValidationErrors) Less(i, j int) bool {
+	if errs[i].Path != errs[j].Path {
+		return errs[i].Path < errs[j].Path
+	}
+	if errs[i].Line != errs[j].Line {
+		return errs[i].Line < errs[j].Line
+	}
+	return errs[i].Column < errs[j].Column
+}
+
+// Validate checks the local Realm app files against the schemas of the app's
+// config version, and that any functions and services referenced by its
+// configuration are defined
+//
+// The returned validation errors describe the problems found with each file,
+// while the returned error is reserved for failing to read the app files
+func (a App) Validate() (ValidationErrors, error) {
+	configVersion := a.ConfigVersion()
+
+	schemas, ok := appSchemas[configVersion]
+	if !ok {
+		return nil, fmt.Errorf("unsupported config version: %s", configVersion)
+	}
+
+	ignore, err := LoadIgnore(a.RootDir)
+	if err != nil {
+		return nil, err
+	}
+
+	v := appValidator{
+		rootDir:   a.RootDir,
+		ignore:    ignore,
+		schemas:   schemas,
+		functions: map[string]struct{}{},
+		services:  map[string]struct{}{},
+	}
+
+	if configVersion >= realm.AppConfigVersion20210101 {
+		err = v.validateV2(a.Config)
+	} else {
+		err = v.validateV1(a.Config)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	v.validateReferences()
+
+	sort.Stable(v.errs)
+	return v.errs, nil
+}
+
+type appValidator struct {
+	rootDir    string
+	ignore     Ignore
+	schemas    map[string]*schema
+	errs       ValidationErrors
+	functions  map[string]struct{}
+	services   map[string]struct{}
+	references []appReference
+}
+
+// appReference is a function or service named by an app file
+type appReference struct {
+	kind   string
+	name   string
+	path   string
+	line   int
+	column int
+}
+
+// set of app reference kinds
+const (
+	referenceFunction = "function"
+	referenceService  = "service"
+)
+
+func (v *appValidator) validateV2(config File) error {
+	if err := v.file(config.String(), schemaAppConfig); err != nil {
+		return err
+	}
+
+	if err := v.file(filepath.Join(NameAuth, FileProviders.String()), schemaAuthProviders); err != nil {
+		return err
+	}
+	if err := v.file(filepath.Join(NameAuth, FileCustomUserData.String()), schemaCustomUserData); err != nil {
+		return err
+	}
+
+	if err := v.file(filepath.Join(NameFunctions, FileConfig.String()), schemaFunctionConfigs); err != nil {
+		return err
+	}
+
+	if err := v.dirs(NameDataSources, func(dir string) error {
+		if err := v.file(filepath.Join(dir, FileConfig.String()), schemaDataSourceConfig); err != nil {
+			return err
+		}
+		if err := v.file(filepath.Join(dir, FileDefaultRule.String()), schemaDefaultRule); err != nil {
+			return err
+		}
+		return v.dirs(dir, func(dbDir string) error {
+			return v.dirs(dbDir, func(collDir string) error {
+				if err := v.file(filepath.Join(collDir, FileRules.String()), schemaRule); err != nil {
+					return err
+				}
+				if err := v.file(filepath.Join(collDir, FileSchema.String()), schemaCollectionSchema); err != nil {
+					return err
+				}
+				return v.file(filepath.Join(collDir, FileRelationships.String()), schemaRelationships)
+			})
+		})
+	}); err != nil {
+		return err
+	}
+
+	if err := v.file(filepath.Join(NameHTTPEndpoints, FileConfig.String()), schemaEndpointConfigs); err != nil {
+		return err
+	}
+	if err := v.file(filepath.Join(NameHTTPEndpoints, FileDataAPIConfig.String()), schemaDataAPIConfig); err != nil {
+		return err
+	}
+	if err := v.dirs(NameHTTPEndpoints, v.service); err != nil {
+		return err
+	}
+
+	if err := v.file(filepath.Join(NameSync, FileConfig.String()), schemaSyncConfig); err != nil {
+		return err
+	}
+
+	return v.validateCommon()
+}
+
+func (v *appValidator) validateV1(config File) error {
+	if err := v.file(config.String(), schemaAppConfig); err != nil {
+		return err
+	}
+
+	if err := v.files(NameAuthProviders, schemaAuthProvider); err != nil {
+		return err
+	}
+
+	if err := v.dirs(NameFunctions, func(dir string) error {
+		return v.file(filepath.Join(dir, FileConfig.String()), schemaFunctionConfig)
+	}); err != nil {
+		return err
+	}
+
+	if err := v.file(FileDataAPIConfig.String(), schemaDataAPIConfig); err != nil {
+		return err
+	}
+
+	return v.validateCommon()
+}
+
+// validateCommon validates the app files which share their layout across config versions
+func (v *appValidator) validateCommon() error {
+	if err := v.files(NameTriggers, schemaTrigger); err != nil {
+		return err
+	}
+	if err := v.dirs(NameServices, v.service); err != nil {
+		return err
+	}
+	if err := v.files(NameValues, schemaValue); err != nil {
+		return err
+	}
+	if err := v.files(NameEnvironments, schemaEnvironment); err != nil {
+		return err
+	}
+	if err := v.file(filepath.Join(NameGraphQL, FileConfig.String()), schemaGraphQLConfig); err != nil {
+		return err
+	}
+	if err := v.files(filepath.Join(NameGraphQL, NameCustomResolvers), schemaCustomResolver); err != nil {
+		return err
+	}
+	return v.files(NameLogForwarders, schemaLogForwarder)
+}
+
+func (v *appValidator) service(dir string) error {
+	if err := v.file(filepath.Join(dir, FileConfig.String()), schemaServiceConfig); err != nil {
+		return err
+	}
+	if err := v.file(filepath.Join(dir, FileDefaultRule.String()), schemaDefaultRule); err != nil {
+		return err
+	}
+	if err := v.dirs(filepath.Join(dir, NameIncomingWebhooks), func(webhookDir string) error {
+		return v.file(filepath.Join(webhookDir, FileConfig.String()), schemaIncomingWebhook)
+	}); err != nil {
+		return err
+	}
+	return v.files(filepath.Join(dir, NameRules), schemaServiceRule)
+}
+
+// dirs calls fn with the relative path of each directory found within dir
+func (v *appValidator) dirs(dir string, fn func(dir string) error) error {
+	return v.walk(dir, true, fn)
+}
+
+// files validates each file found within dir against the named schema
+func (v *appValidator) files(dir, schemaName string) error {
+	return v.walk(dir, false, func(path string) error {
+		return v.file(path, schemaName)
+	})
+}
+
+func (v *appValidator) walk(dir string, onlyDirs bool, fn func(path string) error) error {
+	dw := directoryWalker{
+		path:      filepath.Join(v.rootDir, dir),
+		onlyDirs:  onlyDirs,
+		onlyFiles: !onlyDirs,
+		ignore:    v.ignore,
+	}
+	return dw.walk(func(file os.FileInfo, path string) error {
+		return fn(filepath.Join(dir, file.Name()))
+	})
+}
+
+// file validates the file found at the relative path against the named schema,
+// and collects the functions and services it defines or references
+func (v *appValidator) file(path, schemaName string) error {
+	data, err := ioutil.ReadFile(filepath.Join(v.rootDir, path))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+
+	if len(data) == 0 {
+		return nil
+	}
+
+	node, offset, err := parseJSONNode(data)
+	if err != nil {
+		v.report(path, data, offset, "invalid JSON: "+err.Error())
+		return nil
+	}
+
+	for _, violation := range v.schemas[schemaName].validate(node) {
+		v.report(path, data, violation.offset, violation.message)
+	}
+
+	v.collect(path, data, schemaName, node)
+	return nil
+}
+
+func (v *appValidator) report(path string, data []byte, offset int, message string) {
+	line, column := jsonPosition(data, offset)
+	v.errs = append(v.errs, ValidationError{filepath.ToSlash(path), line, column, message})
+}
+
+func (v *appValidator) collect(path string, data []byte, schemaName string, node *jsonNode) {
+	reference := func(kind string, node *jsonNode, key string) {
+		field, name, ok := node.str(key)
+		if !ok || name == "" {
+			return
+		}
+		line, column := jsonPosition(data, field.offset)
+		v.references = append(v.references, appReference{kind, name, filepath.ToSlash(path), line, column})
+	}
+
+	define := func(names map[string]struct{}, node *jsonNode) {
+		if _, name, ok := node.str("name"); ok {
+			names[name] = struct{}{}
+		}
+	}
+
+	switch schemaName {
+	case schemaFunctionConfig:
+		define(v.functions, node)
+	case schemaFunctionConfigs:
+		for _, item := range node.items {
+			define(v.functions, item)
+		}
+	case schemaDataSourceConfig, schemaServiceConfig:
+		define(v.services, node)
+	case schemaTrigger:
+		reference(referenceFunction, node, "function_name")
+		if config, ok := node.field("config"); ok {
+			reference(referenceService, config, "service_name")
+		}
+		if processors, ok := node.field("event_processors"); ok {
+			if processor, ok := processors.field("FUNCTION"); ok {
+				if config, ok := processor.field("config"); ok {
+					reference(referenceFunction, config, "function_name")
+				}
+			}
+		}
+	case schemaEndpointConfigs:
+		for _, item := range node.items {
+			reference(referenceFunction, item, "function_name")
+		}
+	case schemaCustomResolver:
+		reference(referenceFunction, node, "function_name")
+	case schemaCustomUserData:
+		v.collectCustomUserData(reference, node)
+	case schemaAppConfig:
+		if customUserData, ok := node.field("custom_user_data_config"); ok {
+			v.collectCustomUserData(reference, customUserData)
+		}
+	case schemaSyncConfig:
+		reference(referenceService, node, "service_name")
+	case schemaLogForwarder:
+		if action, ok := node.field("action"); ok {
+			if _, actionType, _ := action.str("type"); actionType == "function" {
+				reference(referenceFunction, action, "name")
+			}
+		}
+	case schemaAuthProvider:
+		v.collectAuthProvider(reference, node)
+	case schemaAuthProviders:
+		for _, key := range node.keys {
+			v.collectAuthProvider(reference, node.fields[key])
+		}
+	}
+}
+
+func (v *appValidator) collectCustomUserData(reference func(kind string, node *jsonNode, key string), node *jsonNode) {
+	reference(referenceFunction, node, "on_user_creation_function_name")
+	if node.boolean("enabled") {
+		reference(referenceService, node, "mongo_service_name")
+	}
+}
+
+func (v *appValidator) collectAuthProvider(reference func(kind string, node *jsonNode, key string), node *jsonNode) {
+	if _, providerType, _ := node.str("type"); providerType != "custom-function" {
+		return
+	}
+	if config, ok := node.field("config"); ok {
+		reference(referenceFunction, config, "authFunctionName")
+	}
+}
+
+func (v *appValidator) validateReferences() {
+	for _, reference := range v.references {
+		names := v.functions
+		if reference.kind == referenceService {
+			names = v.services
+		}
+
+		if _, ok := names[reference.name]; ok {
+			continue
+		}
+
+		v.errs = append(v.errs, ValidationError{
+			reference.path,
+			reference.line,
+			reference.column,
+			fmt.Sprintf("%s %q is not defined", reference.kind, reference.name),
+		})
+	}
+}
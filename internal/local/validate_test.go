@@ -0,0 +1,186 @@
+package local
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+)
+
+func TestAppValidate(t *testing.T) {
+	for _, tc := range []struct {
+		description  string
+		path         string
+		expectedErrs ValidationErrors
+	}{
+		{
+			description: "should find no problems with a valid app",
+			path:        "valid",
+		},
+		{
+			description: "should find the schema violations and dangling references of a 20210101 app",
+			path:        "invalid",
+			expectedErrs: ValidationErrors{
+				{"auth/providers.json", 6, 33, `function "authenticate" is not defined`},
+				{"functions/config.json", 4, 20, `"[0].private" must be a boolean`},
+				{"functions/config.json", 6, 5, `"[1].name" is required`},
+				{"http_endpoints/config.json", 5, 26, `function "missing" is not defined`},
+				{"realm_config.json", 4, 25, `"deployment_model" must be one of: "GLOBAL", "LOCAL"`},
+				{"triggers/onLogin.json", 1, 1, `"config" is required`},
+				{"triggers/onLogin.json", 3, 13, `"type" must be one of: "DATABASE", "AUTHENTICATION", "SCHEDULED"`},
+				{"triggers/onSchedule.json", 7, 22, `function "goodbye" is not defined`},
+				{"values/value.json", 3, 21, "invalid JSON: invalid character ',' looking for beginning of value"},
+			},
+		},
+		{
+			description: "should find the schema violations and dangling references of a 20200603 app",
+			path:        "v1",
+			expectedErrs: ValidationErrors{
+				{"auth_providers/anon-user.json", 3, 13, `"type" must be one of: "anon-user", "api-key", "local-userpass", "oauth2-apple", "oauth2-facebook", "oauth2-google", "custom-token", "custom-function"`},
+				{"triggers/onSchedule.json", 7, 22, `function "goodbye" is not defined`},
+			},
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			app, ok, err := FindApp(filepath.Join("testdata", "validate", tc.path))
+			assert.Nil(t, err)
+			assert.True(t, ok, "expected to find the app")
+
+			errs, err := app.Validate()
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expectedErrs, errs)
+		})
+	}
+}
+
+func TestValidationError(t *testing.T) {
+	err := ValidationError{"triggers/trigger.json", 7, 22, `function "goodbye" is not defined`}
+	assert.Equal(t, `triggers/trigger.json:7:22: function "goodbye" is not defined`, err.Error())
+}
+
+func TestSchemaValidate(t *testing.T) {
+	s := mustParseSchema(`{
+  "type": "object",
+  "required": ["name"],
+  "properties": {
+    "name": {"type": "string"},
+    "count": {"type": "integer"},
+    "tags": {"type": "array", "items": {"type": "string", "enum": ["a", "b"]}}
+  },
+  "additionalProperties": {"type": ["boolean", "null"]}
+}`)
+
+	for _, tc := range []struct {
+		description        string
+		data               string
+		expectedViolations []string
+	}{
+		{
+			description: "should find no violations in a valid document",
+			data:        `{"name": "name", "count": 2, "tags": ["a"], "flag": true, "other": null}`,
+		},
+		{
+			description:        "should find a missing required field",
+			data:               `{"count": 2}`,
+			expectedViolations: []string{`0: "name" is required`},
+		},
+		{
+			description: "should find values of the wrong type",
+			data:        `{"name": 1, "count": 2.5, "flag": "true"}`,
+			expectedViolations: []string{
+				`9: "name" must be a string`,
+				`21: "count" must be an integer`,
+				`34: "flag" must be a boolean or null`,
+			},
+		},
+		{
+			description:        "should find array items outside of the enum",
+			data:               `{"name": "name", "tags": ["a", "c"]}`,
+			expectedViolations: []string{`31: "tags[1]" must be one of: "a", "b"`},
+		},
+		{
+			description:        "should find a document of the wrong type",
+			data:               `["name"]`,
+			expectedViolations: []string{"0: document must be an object"},
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			node, _, err := parseJSONNode([]byte(tc.data))
+			assert.Nil(t, err)
+
+			var violations []string
+			for _, violation := range s.validate(node) {
+				violations = append(violations, fmt.Sprintf("%d: %s", violation.offset, violation.message))
+			}
+			assert.Equal(t, tc.expectedViolations, violations)
+		})
+	}
+
+	t.Run("should parse all of the app schemas", func(t *testing.T) {
+		for configVersion, schemas := range appSchemas {
+			for name, s := range schemas {
+				assert.True(t, s != nil, "expected the %s schema of config version %s to be parsed", name, configVersion)
+			}
+		}
+	})
+}
+
+func TestParseJSONNode(t *testing.T) {
+	t.Run("should track the offsets of values and keys", func(t *testing.T) {
+		data := "{\n  \"a\": [1, {\"b\": true}],\n  \"c\" : \"d\"\n}"
+
+		node, _, err := parseJSONNode([]byte(data))
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"a", "c"}, node.keys)
+
+		a, ok := node.field("a")
+		assert.True(t, ok, "expected field a")
+		assert.Equal(t, 9, a.offset)
+		assert.Equal(t, 4, node.keyOffsets["a"])
+
+		b, ok := a.items[1].field("b")
+		assert.True(t, ok, "expected field b")
+		assert.Equal(t, 19, b.offset)
+
+		c, value, ok := node.str("c")
+		assert.True(t, ok, "expected field c")
+		assert.Equal(t, "d", value)
+
+		line, column := jsonPosition([]byte(data), c.offset)
+		assert.Equal(t, 3, line)
+		assert.Equal(t, 9, column)
+	})
+
+	for _, tc := range []struct {
+		description    string
+		data           string
+		expectedOffset int
+		expectedErr    string
+	}{
+		{
+			description:    "should return the offset of a syntax error",
+			data:           `{"a": 1,}`,
+			expectedOffset: 8,
+			expectedErr:    "invalid character ',' looking for beginning of value",
+		},
+		{
+			description:    "should return an error for a truncated document",
+			data:           `{"a": [1`,
+			expectedOffset: 8,
+			expectedErr:    "unexpected end of JSON input",
+		},
+		{
+			description:    "should return an error for data after the document",
+			data:           `{"a": 1} {}`,
+			expectedOffset: 9,
+			expectedErr:    "invalid data after top-level value",
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			_, offset, err := parseJSONNode([]byte(tc.data))
+			assert.Equal(t, tc.expectedOffset, offset)
+			assert.Equal(t, tc.expectedErr, err.Error())
+		})
+	}
+}
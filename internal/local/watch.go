@@ -0,0 +1,140 @@
+package local
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// set of directories which are never watched for app changes
+var watchIgnoredDirs = map[string]struct{}{
+	".git":          {},
+	".mdb":          {},
+	nameNodeModules: {},
+}
+
+// AppChanges are the files changed in a local Realm app directory
+type AppChanges struct {
+	Paths        []string
+	Config       bool
+	Hosting      bool
+	Dependencies bool
+}
+
+func (c *AppChanges) add(path string) {
+	for _, p := range c.Paths {
+		if p == path {
+			return
+		}
+	}
+	c.Paths = append(c.Paths, path)
+
+	switch {
+	case path == NameHosting || strings.HasPrefix(path, NameHosting+string(filepath.Separator)):
+		c.Hosting = true
+	case path == filepath.Join(NameFunctions, NamePackageJSON):
+		c.Dependencies = true
+	default:
+		c.Config = true
+	}
+}
+
+// WatchApp watches the local Realm app directory for file changes until the context is done.
+// Changes are debounced, so the callback is called once no files have changed for the debounce
+// duration, with the paths of the changed files relative to the app directory
+func WatchApp(ctx context.Context, rootDir string, debounce time.Duration, onChanges func(changes AppChanges)) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	defer watcher.Close()
+
+	ignore, err := LoadIgnore(rootDir)
+	if err != nil {
+		return err
+	}
+
+	if err := watchDirs(watcher, rootDir); err != nil {
+		return err
+	}
+
+	var changes AppChanges
+
+	timer := time.NewTimer(debounce)
+	timer.Stop()
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return nil
+			}
+			return err
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return nil
+			}
+
+			path, err := filepath.Rel(rootDir, event.Name)
+			if err != nil {
+				return err
+			}
+			if isWatchIgnored(path) || ignore.Ignores(event.Name, false) {
+				continue
+			}
+
+			if event.Op&fsnotify.Create == fsnotify.Create {
+				if info, err := os.Stat(event.Name); err == nil && info.IsDir() {
+					if err := watchDirs(watcher, event.Name); err != nil {
+						return err
+					}
+				}
+			}
+
+			changes.add(path)
+			timer.Reset(debounce)
+		case <-timer.C:
+			sort.Strings(changes.Paths)
+			onChanges(changes)
+			changes = AppChanges{}
+		}
+	}
+}
+
+func watchDirs(watcher *fsnotify.Watcher, rootDir string) error {
+	return filepath.Walk(rootDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if !info.IsDir() {
+			return nil
+		}
+		if _, ok := watchIgnoredDirs[info.Name()]; ok && path != rootDir {
+			return filepath.SkipDir
+		}
+		return watcher.Add(path)
+	})
+}
+
+func isWatchIgnored(path string) bool {
+	for _, part := range strings.Split(path, string(filepath.Separator)) {
+		if _, ok := watchIgnoredDirs[part]; ok {
+			return true
+		}
+	}
+
+	// editors commonly write swap and backup files alongside the files being edited
+	name := filepath.Base(path)
+	return strings.HasSuffix(name, ".swp") || strings.HasSuffix(name, "~")
+}
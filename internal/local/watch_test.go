@@ -0,0 +1,99 @@
+package local
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	u "github.com/10gen/realm-cli/internal/utils/test"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+)
+
+func TestWatchApp(t *testing.T) {
+	setup := func(t *testing.T) (string, <-chan AppChanges, func()) {
+		t.Helper()
+
+		tmpDir, teardown, err := u.NewTempDir("watch")
+		assert.Nil(t, err)
+
+		for _, dir := range []string{NameFunctions, filepath.Join(NameHosting, NameFiles), nameNodeModules} {
+			assert.Nil(t, os.MkdirAll(filepath.Join(tmpDir, dir), os.ModePerm))
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+
+		changesCh := make(chan AppChanges, 10)
+		doneCh := make(chan error)
+		go func() {
+			doneCh <- WatchApp(ctx, tmpDir, 50*time.Millisecond, func(changes AppChanges) {
+				changesCh <- changes
+			})
+		}()
+
+		// allow the watcher to add the app directories before any files change
+		time.Sleep(50 * time.Millisecond)
+
+		return tmpDir, changesCh, func() {
+			cancel()
+			assert.Nil(t, <-doneCh)
+			teardown()
+		}
+	}
+
+	receive := func(t *testing.T, changesCh <-chan AppChanges) AppChanges {
+		t.Helper()
+
+		select {
+		case changes := <-changesCh:
+			return changes
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for app changes")
+		}
+		return AppChanges{}
+	}
+
+	t.Run("should debounce file changes into a single set of changes", func(t *testing.T) {
+		tmpDir, changesCh, teardown := setup(t)
+		defer teardown()
+
+		assert.Nil(t, ioutil.WriteFile(filepath.Join(tmpDir, NameFunctions, "one.js"), []byte("exports = 1"), 0666))
+		assert.Nil(t, ioutil.WriteFile(filepath.Join(tmpDir, NameFunctions, "two.js"), []byte("exports = 2"), 0666))
+
+		changes := receive(t, changesCh)
+		assert.Equal(t, []string{filepath.Join(NameFunctions, "one.js"), filepath.Join(NameFunctions, "two.js")}, changes.Paths)
+		assert.True(t, changes.Config, "expected config changes")
+		assert.False(t, changes.Hosting, "expected no hosting changes")
+		assert.False(t, changes.Dependencies, "expected no dependencies changes")
+	})
+
+	t.Run("should classify hosting and package.json changes", func(t *testing.T) {
+		tmpDir, changesCh, teardown := setup(t)
+		defer teardown()
+
+		assert.Nil(t, ioutil.WriteFile(filepath.Join(tmpDir, NameHosting, NameFiles, "index.html"), []byte("<html></html>"), 0666))
+		assert.Nil(t, ioutil.WriteFile(filepath.Join(tmpDir, NameFunctions, NamePackageJSON), []byte("{}"), 0666))
+
+		changes := receive(t, changesCh)
+		assert.False(t, changes.Config, "expected no config changes")
+		assert.True(t, changes.Hosting, "expected hosting changes")
+		assert.True(t, changes.Dependencies, "expected dependencies changes")
+	})
+
+	t.Run("should watch new directories and ignore node_modules", func(t *testing.T) {
+		tmpDir, changesCh, teardown := setup(t)
+		defer teardown()
+
+		assert.Nil(t, ioutil.WriteFile(filepath.Join(tmpDir, nameNodeModules, "index.js"), []byte("exports = 1"), 0666))
+
+		assert.Nil(t, os.MkdirAll(filepath.Join(tmpDir, NameTriggers), os.ModePerm))
+		receive(t, changesCh)
+
+		assert.Nil(t, ioutil.WriteFile(filepath.Join(tmpDir, NameTriggers, "trigger.json"), []byte("{}"), 0666))
+
+		changes := receive(t, changesCh)
+		assert.Equal(t, []string{filepath.Join(NameTriggers, "trigger.json")}, changes.Paths)
+	})
+}
@@ -13,6 +13,7 @@ import (
 // UI is a terminal UI
 type UI interface {
 	AutoConfirm() bool
+	OutputFormat() OutputFormat
 	Ask(answer interface{}, questions ...*survey.Question) error
 	AskOne(answer interface{}, prompt survey.Prompt) error
 	Confirm(format string, args ...interface{}) (bool, error)
@@ -50,6 +51,10 @@ func (ui *ui) AutoConfirm() bool {
 	return ui.config.AutoConfirm
 }
 
+func (ui *ui) OutputFormat() OutputFormat {
+	return ui.config.OutputFormat
+}
+
 func (ui *ui) Ask(answer interface{}, questions ...*survey.Question) error {
 	return survey.Ask(
 		questions,
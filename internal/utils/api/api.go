@@ -1,6 +1,7 @@
 package api
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"net/http"
@@ -26,6 +27,7 @@ const (
 // RequestOptions are options to configure an *http.Request
 type RequestOptions struct {
 	Body           io.Reader
+	GetBody        func() (io.ReadCloser, error)
 	ContentType    string
 	NoAuth         bool
 	PreventRefresh bool
@@ -33,6 +35,32 @@ type RequestOptions struct {
 	RefreshAuth    bool
 }
 
+// NewRequest creates a new *http.Request bound to the context and configured with the provided options,
+// where a GetBody option supplies the body and allows it to be replayed on retries
+func NewRequest(ctx context.Context, method, url string, options RequestOptions) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, method, url, options.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if options.GetBody != nil {
+		body, err := options.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		req.Body = body
+		req.GetBody = options.GetBody
+	}
+
+	IncludeQuery(req, options.Query)
+
+	if options.ContentType != "" {
+		req.Header.Set(HeaderContentType, options.ContentType)
+	}
+
+	return req, nil
+}
+
 // IncludeQuery includes the query with the http request
 func IncludeQuery(req *http.Request, q map[string]string) {
 	if len(q) > 0 {
@@ -0,0 +1,79 @@
+package api
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+)
+
+// NetworkOptions configure how the CLI's http clients connect to servers,
+// such as when sitting behind a proxy which inspects traffic with a private root CA
+type NetworkOptions struct {
+	ProxyURL           string
+	CABundle           string
+	ClientCert         string
+	ClientKey          string
+	InsecureSkipVerify bool
+}
+
+// NewBaseTransport creates an *http.Transport configured with the network options,
+// which otherwise behaves the same as http.DefaultTransport
+func NewBaseTransport(options NetworkOptions) (*http.Transport, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	if options.ProxyURL != "" {
+		proxyURL, err := url.Parse(options.ProxyURL)
+		if err != nil || proxyURL.Scheme == "" || proxyURL.Host == "" {
+			return nil, fmt.Errorf("invalid proxy url: %s", options.ProxyURL)
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	tlsConfig, err := newTLSConfig(options)
+	if err != nil {
+		return nil, err
+	}
+	transport.TLSClientConfig = tlsConfig
+
+	return transport, nil
+}
+
+func newTLSConfig(options NetworkOptions) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: options.InsecureSkipVerify,
+	}
+
+	if options.CABundle != "" {
+		data, err := ioutil.ReadFile(options.CABundle)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA bundle: %w", err)
+		}
+
+		rootCAs, err := x509.SystemCertPool()
+		if err != nil || rootCAs == nil {
+			rootCAs = x509.NewCertPool()
+		}
+		if !rootCAs.AppendCertsFromPEM(data) {
+			return nil, fmt.Errorf("failed to read CA bundle: no PEM certificates found in %s", options.CABundle)
+		}
+		tlsConfig.RootCAs = rootCAs
+	}
+
+	if options.ClientCert != "" || options.ClientKey != "" {
+		if options.ClientCert == "" || options.ClientKey == "" {
+			return nil, errors.New("client certificate and key must be provided together")
+		}
+
+		cert, err := tls.LoadX509KeyPair(options.ClientCert, options.ClientKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
+}
@@ -0,0 +1,97 @@
+package api
+
+import (
+	"encoding/pem"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+)
+
+func TestNewBaseTransport(t *testing.T) {
+	tlsServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer tlsServer.Close()
+
+	tmpDir, err := ioutil.TempDir("", "realm-cli-network")
+	assert.Nil(t, err)
+	defer os.RemoveAll(tmpDir)
+
+	caBundle := filepath.Join(tmpDir, "ca.pem")
+	assert.Nil(t, ioutil.WriteFile(caBundle, pem.EncodeToMemory(&pem.Block{
+		Type:  "CERTIFICATE",
+		Bytes: tlsServer.Certificate().Raw,
+	}), 0600))
+
+	get := func(options NetworkOptions, url string) (*http.Response, error) {
+		transport, err := NewBaseTransport(options)
+		if err != nil {
+			return nil, err
+		}
+		return (&http.Client{Transport: transport}).Get(url)
+	}
+
+	t.Run("should fail to verify a server certificate signed by an unknown authority", func(t *testing.T) {
+		_, err := get(NetworkOptions{}, tlsServer.URL)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("should verify a server certificate signed by a root CA from the CA bundle", func(t *testing.T) {
+		res, err := get(NetworkOptions{CABundle: caBundle}, tlsServer.URL)
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusNoContent, res.StatusCode)
+	})
+
+	t.Run("should skip verifying the server certificate when insecure", func(t *testing.T) {
+		res, err := get(NetworkOptions{InsecureSkipVerify: true}, tlsServer.URL)
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusNoContent, res.StatusCode)
+	})
+
+	t.Run("should send requests through the proxy", func(t *testing.T) {
+		var proxiedURL string
+		proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			proxiedURL = r.URL.String()
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer proxy.Close()
+
+		res, err := get(NetworkOptions{ProxyURL: proxy.URL}, "http://realm.example.com/api/admin/v3.0")
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.Equal(t, "http://realm.example.com/api/admin/v3.0", proxiedURL)
+	})
+
+	for _, tc := range []struct {
+		description string
+		options     NetworkOptions
+		expectedErr error
+	}{
+		{
+			description: "an invalid proxy url",
+			options:     NetworkOptions{ProxyURL: "proxy.example.com"},
+			expectedErr: errors.New("invalid proxy url: proxy.example.com"),
+		},
+		{
+			description: "a CA bundle without certificates",
+			options:     NetworkOptions{CABundle: filepath.Join("testdata", "no_certs.pem")},
+			expectedErr: errors.New("failed to read CA bundle: no PEM certificates found in " + filepath.Join("testdata", "no_certs.pem")),
+		},
+		{
+			description: "a client certificate without a key",
+			options:     NetworkOptions{ClientCert: "cert.pem"},
+			expectedErr: errors.New("client certificate and key must be provided together"),
+		},
+	} {
+		t.Run("should fail to create a transport with "+tc.description, func(t *testing.T) {
+			_, err := NewBaseTransport(tc.options)
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
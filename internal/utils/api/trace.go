@@ -0,0 +1,251 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// set of supported trace flags
+const (
+	FlagVerbose      = "verbose"
+	FlagVerboseUsage = "Log every HTTP request and response made by the CLI to stderr, with any credentials and secrets redacted"
+
+	FlagVerboseTarget      = "verbose-target"
+	FlagVerboseTargetUsage = `Write the "--verbose" HTTP log to the specified filepath instead of stderr`
+)
+
+const (
+	traceBodyLimit = 2048
+	traceRedacted  = "[REDACTED]"
+)
+
+// set of header keys whose values are always redacted from the trace
+var traceRedactedHeaders = map[string]bool{
+	HeaderAuthorization:   true,
+	"Proxy-Authorization": true,
+	"Cookie":              true,
+	"Set-Cookie":          true,
+}
+
+// set of (normalized) field names whose values are always redacted from the trace
+var traceRedactedFields = map[string]bool{
+	"accesstoken":   true,
+	"apikey":        true,
+	"clientsecret":  true,
+	"key":           true,
+	"password":      true,
+	"privateapikey": true,
+	"refreshtoken":  true,
+	"secret":        true,
+	"token":         true,
+}
+
+// TraceTransport is an http.RoundTripper which logs every request and response,
+// including the method, url, status, duration and truncated bodies
+//
+// Credentials and secrets are redacted from the log: authorization headers,
+// fields which hold passwords, api keys and tokens, and the values sent to
+// or received from the app secrets endpoints
+type TraceTransport struct {
+	Base http.RoundTripper
+
+	mu  sync.Mutex
+	out io.Writer
+	now func() time.Time
+}
+
+// NewTraceTransport creates a new tracing transport which writes to the provided writer
+func NewTraceTransport(base http.RoundTripper, out io.Writer) *TraceTransport {
+	return &TraceTransport{Base: base, out: out, now: time.Now}
+}
+
+// RoundTrip executes the http request and logs it along with its response
+func (t *TraceTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	var reqBody []byte
+	if req.Body != nil && req.Body != http.NoBody && IsTextContent(req.Header.Get(HeaderContentType)) {
+		data, err := ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		reqBody = data
+
+		req = req.Clone(req.Context())
+		req.Body = ioutil.NopCloser(bytes.NewReader(data))
+	}
+
+	var entry strings.Builder
+	fmt.Fprintf(&entry, "--> %s %s\n", req.Method, RedactURL(req.URL))
+	writeTraceHeaders(&entry, req.Header)
+	writeTraceBody(&entry, req.URL.Path, req.Header.Get(HeaderContentType), req.ContentLength, req.Body != nil && req.Body != http.NoBody, reqBody)
+
+	start := t.now()
+	res, err := base.RoundTrip(req)
+	elapsed := t.now().Sub(start)
+
+	if err != nil {
+		fmt.Fprintf(&entry, "<-- error: %s (%s)\n\n", err, elapsed)
+		t.write(entry.String())
+		return nil, err
+	}
+
+	var resBody []byte
+	if IsTextContent(res.Header.Get(HeaderContentType)) {
+		data, readErr := ioutil.ReadAll(res.Body)
+		res.Body.Close()
+		resBody = data
+		res.Body = ioutil.NopCloser(bytes.NewReader(data))
+
+		if readErr != nil {
+			fmt.Fprintf(&entry, "<-- %s (%s)\n<-- error: %s\n\n", res.Status, elapsed, readErr)
+			t.write(entry.String())
+			return nil, readErr
+		}
+	}
+
+	fmt.Fprintf(&entry, "<-- %s (%s)\n", res.Status, elapsed)
+	writeTraceHeaders(&entry, res.Header)
+	writeTraceBody(&entry, req.URL.Path, res.Header.Get(HeaderContentType), res.ContentLength, true, resBody)
+	entry.WriteString("\n")
+
+	t.write(entry.String())
+	return res, nil
+}
+
+func (t *TraceTransport) write(entry string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	io.WriteString(t.out, entry)
+}
+
+// RedactURL returns the url with the values of any credential query parameters redacted
+func RedactURL(u *url.URL) string {
+	query := u.Query()
+	if len(query) == 0 {
+		return u.String()
+	}
+
+	redacted := *u
+	for key := range query {
+		if isRedactedField(key) {
+			query.Set(key, traceRedacted)
+		}
+	}
+	redacted.RawQuery = query.Encode()
+	return redacted.String()
+}
+
+// RedactHeader returns a copy of the header with the values of any credential keys redacted
+func RedactHeader(header http.Header) http.Header {
+	redacted := header.Clone()
+	for key := range redacted {
+		if traceRedactedHeaders[http.CanonicalHeaderKey(key)] {
+			redacted[key] = []string{traceRedacted}
+		}
+	}
+	return redacted
+}
+
+func writeTraceHeaders(w io.Writer, header http.Header) {
+	redacted := RedactHeader(header)
+
+	keys := make([]string, 0, len(redacted))
+	for key := range redacted {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		fmt.Fprintf(w, "%s: %s\n", key, strings.Join(redacted[key], ", "))
+	}
+}
+
+func writeTraceBody(w io.Writer, path, contentType string, contentLength int64, hasBody bool, body []byte) {
+	if !hasBody {
+		return
+	}
+
+	if !IsTextContent(contentType) {
+		if contentLength >= 0 {
+			fmt.Fprintf(w, "[%d bytes of %s]\n", contentLength, contentType)
+		} else {
+			fmt.Fprintf(w, "[body of %s]\n", contentType)
+		}
+		return
+	}
+
+	if len(body) == 0 {
+		return
+	}
+
+	if strings.Contains(contentType, "json") {
+		body = RedactJSON(path, body)
+	}
+
+	if len(body) > traceBodyLimit {
+		fmt.Fprintf(w, "%s... (%d more bytes)\n", body[:traceBodyLimit], len(body)-traceBodyLimit)
+		return
+	}
+	fmt.Fprintf(w, "%s\n", body)
+}
+
+// IsTextContent returns whether or not the content type is JSON or text
+func IsTextContent(contentType string) bool {
+	return strings.Contains(contentType, "json") || strings.HasPrefix(contentType, "text/")
+}
+
+// RedactJSON redacts the values of credential fields from the JSON data,
+// along with the values of any secrets when the request path is for app secrets
+func RedactJSON(path string, data []byte) []byte {
+	secrets := strings.Contains(path, "/secrets")
+
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return []byte(traceRedacted) // do not risk logging secrets from malformed data
+	}
+
+	redacted, err := json.Marshal(redactValue(v, secrets))
+	if err != nil {
+		return []byte(traceRedacted)
+	}
+	return redacted
+}
+
+func redactValue(v interface{}, secrets bool) interface{} {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		for key, fieldValue := range value {
+			if isRedactedField(key) || (secrets && strings.EqualFold(key, "value")) {
+				value[key] = traceRedacted
+				continue
+			}
+			value[key] = redactValue(fieldValue, secrets)
+		}
+		return value
+	case []interface{}:
+		for i, item := range value {
+			value[i] = redactValue(item, secrets)
+		}
+		return value
+	}
+	return v
+}
+
+func isRedactedField(name string) bool {
+	normalized := strings.NewReplacer("_", "", "-", "").Replace(strings.ToLower(name))
+	return traceRedactedFields[normalized]
+}
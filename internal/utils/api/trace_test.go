@@ -0,0 +1,117 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+)
+
+func TestTraceTransport(t *testing.T) {
+	newTransport := func(out *bytes.Buffer) *TraceTransport {
+		transport := NewTraceTransport(http.DefaultTransport, out)
+
+		var calls int
+		transport.now = func() time.Time {
+			calls++
+			return time.Date(2021, time.June, 22, 7, 54, 42, calls*int(time.Millisecond), time.UTC)
+		}
+		return transport
+	}
+
+	t.Run("should log the request and response with credentials redacted", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set(HeaderContentType, MediaTypeJSON)
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"access_token":"token","refresh_token":"token","user_id":"user"}`))
+		}))
+		defer server.Close()
+
+		out := new(bytes.Buffer)
+		client := &http.Client{Transport: newTransport(out)}
+
+		req, err := NewRequest(context.Background(), http.MethodPost, server.URL+"/auth?api_key=key&type=cloud", RequestOptions{
+			Body:        strings.NewReader(`{"username":"user","apiKey":"key","nested":[{"password":"password"}]}`),
+			ContentType: MediaTypeJSON,
+		})
+		assert.Nil(t, err)
+		req.Header.Set(HeaderAuthorization, "Bearer token")
+
+		res, err := client.Do(req)
+		assert.Nil(t, err)
+
+		body, err := ioutil.ReadAll(res.Body)
+		assert.Nil(t, err)
+		assert.Equal(t, `{"access_token":"token","refresh_token":"token","user_id":"user"}`, string(body))
+
+		trace := out.String()
+		assert.True(t, strings.HasPrefix(trace, "--> POST "+server.URL+"/auth?api_key=%5BREDACTED%5D&type=cloud\n"), "trace must start with the request line")
+		assert.True(t, strings.Contains(trace, "Authorization: [REDACTED]\n"), "trace must redact the authorization header")
+		assert.True(t, strings.Contains(trace, `{"apiKey":"[REDACTED]","nested":[{"password":"[REDACTED]"}],"username":"user"}`), "trace must redact the request credentials")
+		assert.True(t, strings.Contains(trace, "<-- 201 Created (1ms)\n"), "trace must include the response status and duration")
+		assert.True(t, strings.Contains(trace, `{"access_token":"[REDACTED]","refresh_token":"[REDACTED]","user_id":"user"}`), "trace must redact the response tokens")
+		assert.False(t, strings.Contains(trace, `"token"`), "trace must not include any tokens")
+	})
+
+	t.Run("should redact secret values and truncate long bodies", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set(HeaderContentType, MediaTypeJSON)
+			w.Write([]byte(`{"message":"` + strings.Repeat("a", traceBodyLimit) + `"}`))
+		}))
+		defer server.Close()
+
+		out := new(bytes.Buffer)
+		client := &http.Client{Transport: newTransport(out)}
+
+		res, err := client.Post(server.URL+"/groups/groupID/apps/appID/secrets", MediaTypeJSON, strings.NewReader(`{"name":"name","value":"shh"}`))
+		assert.Nil(t, err)
+		res.Body.Close()
+
+		trace := out.String()
+		assert.True(t, strings.Contains(trace, `{"name":"name","value":"[REDACTED]"}`), "trace must redact the secret value")
+		assert.True(t, strings.Contains(trace, "... (14 more bytes)\n"), "trace must truncate the response body")
+	})
+
+	t.Run("should summarize binary bodies", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set(HeaderContentType, "application/zip")
+			w.Write([]byte("zip"))
+		}))
+		defer server.Close()
+
+		out := new(bytes.Buffer)
+		client := &http.Client{Transport: newTransport(out)}
+
+		res, err := client.Get(server.URL)
+		assert.Nil(t, err)
+		res.Body.Close()
+
+		assert.True(t, strings.Contains(out.String(), "[3 bytes of application/zip]\n"), "trace must summarize the response body")
+	})
+
+	t.Run("should log request errors", func(t *testing.T) {
+		out := new(bytes.Buffer)
+		transport := newTransport(out)
+		transport.Base = roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			return nil, errors.New("something bad happened")
+		})
+
+		req, err := http.NewRequest(http.MethodGet, "http://realm.example.com", nil)
+		assert.Nil(t, err)
+
+		_, err = transport.RoundTrip(req)
+		assert.Equal(t, errors.New("something bad happened"), err)
+		assert.Equal(t, "--> GET http://realm.example.com\n<-- error: something bad happened (1ms)\n\n", out.String())
+	})
+}
+
+type roundTripperFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) { return f(req) }
@@ -0,0 +1,261 @@
+package api
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// set of retry and timeout defaults
+const (
+	DefaultMaxRetries = 3
+	DefaultMinBackoff = 500 * time.Millisecond
+	DefaultMaxBackoff = 30 * time.Second
+	DefaultTimeout    = 5 * time.Minute
+
+	headerRetryAfter = "Retry-After"
+)
+
+// RetryOptions configure how failed requests are retried
+type RetryOptions struct {
+	MaxRetries int
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+}
+
+// DefaultRetryOptions are the default retry options
+var DefaultRetryOptions = RetryOptions{
+	MaxRetries: DefaultMaxRetries,
+	MinBackoff: DefaultMinBackoff,
+	MaxBackoff: DefaultMaxBackoff,
+}
+
+// DefaultTransport is the transport shared by all of the CLI's http clients
+var DefaultTransport = NewTransport(http.DefaultTransport, DefaultRetryOptions)
+
+// Transport is an http.RoundTripper which retries requests that fail due to
+// network errors, rate limiting or server errors using exponential backoff with jitter
+//
+// Only requests with idempotent methods are retried after network and server errors,
+// while any request is retried after being rate limited. Requests with a body are
+// only retried if the body can be replayed through the request's GetBody
+//
+// When a timeout is set, each attempt is cancelled if it does not complete in time
+// (including reading the response body), and is then retried as a network error
+type Transport struct {
+	Base http.RoundTripper
+
+	mu      sync.RWMutex
+	options RetryOptions
+	timeout time.Duration
+	sleep   func(req *http.Request, d time.Duration) error
+}
+
+// NewTransport creates a new retrying transport
+func NewTransport(base http.RoundTripper, options RetryOptions) *Transport {
+	return &Transport{Base: base, options: options, sleep: sleepWithContext}
+}
+
+// RetryOptions returns the transport's retry options
+func (t *Transport) RetryOptions() RetryOptions {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.options
+}
+
+// SetRetryOptions sets the transport's retry options
+func (t *Transport) SetRetryOptions(options RetryOptions) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.options = options
+}
+
+// Timeout returns the transport's per-request timeout
+func (t *Transport) Timeout() time.Duration {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.timeout
+}
+
+// SetTimeout sets the transport's per-request timeout, where zero means no timeout
+func (t *Transport) SetTimeout(timeout time.Duration) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.timeout = timeout
+}
+
+// RoundTrip executes the http request, retrying it as necessary
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	options := t.RetryOptions()
+	timeout := t.Timeout()
+
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 && req.Body != nil && req.Body != http.NoBody {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req = cloneRequest(req, body)
+		}
+
+		res, err := roundTripWithTimeout(base, req, timeout)
+
+		if attempt >= options.MaxRetries || !shouldRetry(req, res, err) {
+			return res, err
+		}
+
+		wait := backoff(options, attempt)
+		if res != nil {
+			if retryAfter, ok := parseRetryAfter(res.Header.Get(headerRetryAfter)); ok {
+				wait = retryAfter
+				if options.MaxBackoff > 0 && wait > options.MaxBackoff {
+					wait = options.MaxBackoff
+				}
+			}
+
+			// drain the response so the connection can be reused
+			io.Copy(ioutil.Discard, io.LimitReader(res.Body, 4096))
+			res.Body.Close()
+		}
+
+		if err := t.sleep(req, wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// roundTripWithTimeout executes a single attempt of the request, which is cancelled
+// once the timeout elapses or the response body is closed
+func roundTripWithTimeout(base http.RoundTripper, req *http.Request, timeout time.Duration) (*http.Response, error) {
+	if timeout <= 0 {
+		return base.RoundTrip(req)
+	}
+
+	ctx, cancel := context.WithTimeout(req.Context(), timeout)
+
+	res, err := base.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	res.Body = cancelOnClose{res.Body, cancel}
+	return res, nil
+}
+
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (c cancelOnClose) Close() error {
+	defer c.cancel()
+	return c.ReadCloser.Close()
+}
+
+func shouldRetry(req *http.Request, res *http.Response, err error) bool {
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return false // the body cannot be replayed
+	}
+
+	if err != nil {
+		if req.Context().Err() != nil {
+			return false
+		}
+		return isIdempotent(req.Method)
+	}
+
+	switch res.StatusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return isIdempotent(req.Method)
+	}
+	return false
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet,
+		http.MethodHead,
+		http.MethodOptions,
+		http.MethodTrace,
+		http.MethodPut,
+		http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// backoff returns a random wait between half and all of the exponential backoff for the attempt
+func backoff(options RetryOptions, attempt int) time.Duration {
+	wait := options.MinBackoff
+	for i := 0; i < attempt && (options.MaxBackoff <= 0 || wait < options.MaxBackoff); i++ {
+		wait *= 2
+	}
+	if options.MaxBackoff > 0 && wait > options.MaxBackoff {
+		wait = options.MaxBackoff
+	}
+	if wait <= 0 {
+		return 0
+	}
+
+	half := wait / 2
+	return half + time.Duration(rand.Int63n(int64(wait-half)+1))
+}
+
+// parseRetryAfter parses the Retry-After header value as either
+// a number of seconds or an http date
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		wait := time.Until(date)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+
+	return 0, false
+}
+
+func cloneRequest(req *http.Request, body io.ReadCloser) *http.Request {
+	clone := req.Clone(req.Context())
+	clone.Body = body
+	return clone
+}
+
+func sleepWithContext(req *http.Request, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-req.Context().Done():
+		return req.Context().Err()
+	}
+}
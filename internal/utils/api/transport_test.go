@@ -0,0 +1,213 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+)
+
+func TestTransport(t *testing.T) {
+	setup := func(statuses []int, headers http.Header) (*httptest.Server, *[]string, func()) {
+		var bodies []string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := ioutil.ReadAll(r.Body)
+			bodies = append(bodies, string(body))
+
+			status := statuses[0]
+			if len(statuses) > 1 {
+				statuses = statuses[1:]
+			}
+			for k, v := range headers {
+				w.Header()[k] = v
+			}
+			w.WriteHeader(status)
+		}))
+		return server, &bodies, server.Close
+	}
+
+	newTransport := func(waits *[]time.Duration) *Transport {
+		transport := NewTransport(http.DefaultTransport, RetryOptions{MaxRetries: 3, MinBackoff: time.Second, MaxBackoff: 4 * time.Second})
+		transport.sleep = func(req *http.Request, d time.Duration) error {
+			*waits = append(*waits, d)
+			return nil
+		}
+		return transport
+	}
+
+	t.Run("should retry idempotent requests after server errors with backoff", func(t *testing.T) {
+		server, bodies, teardown := setup([]int{http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusOK}, nil)
+		defer teardown()
+
+		var waits []time.Duration
+		client := &http.Client{Transport: newTransport(&waits)}
+
+		req, err := http.NewRequest(http.MethodPut, server.URL, bytes.NewReader([]byte("payload")))
+		assert.Nil(t, err)
+
+		res, err := client.Do(req)
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+
+		assert.Equal(t, []string{"payload", "payload", "payload"}, *bodies)
+		assert.Equal(t, 2, len(waits))
+		assert.True(t, waits[0] >= 500*time.Millisecond && waits[0] <= time.Second, "first wait should be within the first backoff")
+		assert.True(t, waits[1] >= time.Second && waits[1] <= 2*time.Second, "second wait should be within the second backoff")
+	})
+
+	t.Run("should stop retrying after the max retries", func(t *testing.T) {
+		server, bodies, teardown := setup([]int{http.StatusInternalServerError}, nil)
+		defer teardown()
+
+		var waits []time.Duration
+		client := &http.Client{Transport: newTransport(&waits)}
+
+		res, err := client.Get(server.URL)
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusInternalServerError, res.StatusCode)
+
+		assert.Equal(t, 4, len(*bodies))
+		assert.Equal(t, 3, len(waits))
+		for _, wait := range waits {
+			assert.True(t, wait <= 4*time.Second, "wait should not exceed the max backoff")
+		}
+	})
+
+	t.Run("should not retry non-idempotent requests after server errors", func(t *testing.T) {
+		server, bodies, teardown := setup([]int{http.StatusInternalServerError, http.StatusOK}, nil)
+		defer teardown()
+
+		var waits []time.Duration
+		client := &http.Client{Transport: newTransport(&waits)}
+
+		res, err := client.Post(server.URL, MediaTypeJSON, bytes.NewReader([]byte("{}")))
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusInternalServerError, res.StatusCode)
+		assert.Equal(t, 1, len(*bodies))
+	})
+
+	t.Run("should retry rate limited requests honoring the retry after header", func(t *testing.T) {
+		server, bodies, teardown := setup([]int{http.StatusTooManyRequests, http.StatusOK}, http.Header{"Retry-After": {"2"}})
+		defer teardown()
+
+		var waits []time.Duration
+		client := &http.Client{Transport: newTransport(&waits)}
+
+		res, err := client.Post(server.URL, MediaTypeJSON, bytes.NewReader([]byte("{}")))
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+
+		assert.Equal(t, []string{"{}", "{}"}, *bodies)
+		assert.Equal(t, []time.Duration{2 * time.Second}, waits)
+	})
+
+	t.Run("should not retry requests with a body that cannot be replayed", func(t *testing.T) {
+		server, bodies, teardown := setup([]int{http.StatusTooManyRequests, http.StatusOK}, nil)
+		defer teardown()
+
+		var waits []time.Duration
+		client := &http.Client{Transport: newTransport(&waits)}
+
+		req, err := http.NewRequest(http.MethodPut, server.URL, ioutil.NopCloser(bytes.NewReader([]byte("payload"))))
+		assert.Nil(t, err)
+
+		res, err := client.Do(req)
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusTooManyRequests, res.StatusCode)
+		assert.Equal(t, 1, len(*bodies))
+	})
+
+	t.Run("should replay bodies provided by the request options", func(t *testing.T) {
+		server, bodies, teardown := setup([]int{http.StatusServiceUnavailable, http.StatusNoContent}, nil)
+		defer teardown()
+
+		var waits []time.Duration
+		client := &http.Client{Transport: newTransport(&waits)}
+
+		req, err := NewRequest(context.Background(), http.MethodPut, server.URL, RequestOptions{
+			GetBody: func() (io.ReadCloser, error) {
+				return ioutil.NopCloser(bytes.NewReader([]byte("stream"))), nil
+			},
+		})
+		assert.Nil(t, err)
+
+		res, err := client.Do(req)
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusNoContent, res.StatusCode)
+		assert.Equal(t, []string{"stream", "stream"}, *bodies)
+	})
+
+	t.Run("should retry idempotent requests which time out", func(t *testing.T) {
+		var calls int
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			if calls == 1 {
+				<-r.Context().Done() // hang until the attempt times out
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		var waits []time.Duration
+		transport := newTransport(&waits)
+		transport.SetTimeout(50 * time.Millisecond)
+		client := &http.Client{Transport: transport}
+
+		res, err := client.Get(server.URL)
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.Nil(t, res.Body.Close())
+
+		assert.Equal(t, 2, calls)
+		assert.Equal(t, 1, len(waits))
+	})
+
+	t.Run("should not retry requests once the context is cancelled", func(t *testing.T) {
+		server, bodies, teardown := setup([]int{http.StatusServiceUnavailable, http.StatusOK}, nil)
+		defer teardown()
+
+		ctx, cancel := context.WithCancel(context.Background())
+
+		transport := NewTransport(http.DefaultTransport, RetryOptions{MaxRetries: 3, MinBackoff: time.Second, MaxBackoff: time.Second})
+		transport.sleep = func(req *http.Request, d time.Duration) error {
+			cancel()
+			return sleepWithContext(req, d)
+		}
+		client := &http.Client{Transport: transport}
+
+		req, err := NewRequest(ctx, http.MethodGet, server.URL, RequestOptions{})
+		assert.Nil(t, err)
+
+		_, err = client.Do(req)
+		assert.True(t, errors.Is(err, context.Canceled), "expected the request to be cancelled")
+		assert.Equal(t, 1, len(*bodies))
+	})
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	for _, tc := range []struct {
+		value    string
+		expected time.Duration
+		ok       bool
+	}{
+		{"", 0, false},
+		{"10", 10 * time.Second, true},
+		{"-1", 0, false},
+		{"soon", 0, false},
+		{"Wed, 21 Oct 2015 07:28:00 GMT", 0, true},
+	} {
+		t.Run("should parse "+tc.value, func(t *testing.T) {
+			wait, ok := parseRetryAfter(tc.value)
+			assert.Equal(t, tc.ok, ok)
+			assert.Equal(t, tc.expected, wait)
+		})
+	}
+}
@@ -2,6 +2,9 @@ package flags
 
 import (
 	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -19,6 +22,30 @@ const (
 	dateFormatDays      = "2006-01-02"
 )
 
+// set of supported relative dates
+const (
+	DateNow       = "now"
+	DateToday     = "today"
+	DateYesterday = "yesterday"
+
+	dateSuffixAgo = " ago"
+)
+
+// DateAllowedFormat is the usage of a date flag's accepted value pattern
+const DateAllowedFormat = "2006-01-02[T15:04:05.000-0700]"
+
+// DateNote is the usage note describing a date flag's accepted relative values
+const DateNote = `Relative dates such as "15m", "2h", "3d", "1w", "today", or "yesterday" are also accepted`
+
+var (
+	// now returns the current time, to which relative dates are resolved
+	now = time.Now
+
+	// durationDays matches the week and day units a duration may start with,
+	// which are not supported by time.ParseDuration
+	durationDays = regexp.MustCompile(`^(?:(\d+)w)?(?:(\d+)d)?(.*)$`)
+)
+
 // Date is a date flag
 type Date struct {
 	Time time.Time
@@ -36,7 +63,9 @@ func (d Date) String() string {
 	return d.Time.Format(dateFormatTZ)
 }
 
-// Set adds new values to the EnumSetValue
+// Set parses the value as either an absolute date, or a date relative to now,
+// such as "15m" or "2h ago" for a duration before now, or "today" and "yesterday"
+// for the start of those days in local time
 func (d *Date) Set(val string) error {
 	t, err := parseTime(val)
 	if err != nil {
@@ -48,6 +77,9 @@ func (d *Date) Set(val string) error {
 }
 
 func parseTime(val string) (time.Time, error) {
+	if t, ok := parseRelativeTime(val); ok {
+		return t, nil
+	}
 	if t, err := time.Parse(dateFormatTZ, val); err == nil {
 		return t, nil
 	}
@@ -80,3 +112,57 @@ func parseTime(val string) (time.Time, error) {
 	}
 	return time.Time{}, fmt.Errorf("unrecognized date string: %s", val)
 }
+
+func parseRelativeTime(val string) (time.Time, bool) {
+	current := now().UTC()
+
+	switch strings.ToLower(strings.TrimSpace(val)) {
+	case DateNow:
+		return current, true
+	case DateToday:
+		return startOfDay(current, 0), true
+	case DateYesterday:
+		return startOfDay(current, -1), true
+	}
+
+	d, err := parseDuration(strings.TrimSuffix(strings.TrimSpace(val), dateSuffixAgo))
+	if err != nil || d < 0 {
+		return time.Time{}, false
+	}
+	return current.Add(-d), true
+}
+
+// startOfDay returns the start of the local day, the given number of days from t
+func startOfDay(t time.Time, days int) time.Time {
+	local := t.In(time.Local)
+	return time.Date(local.Year(), local.Month(), local.Day()+days, 0, 0, 0, 0, time.Local).UTC()
+}
+
+// parseDuration parses a duration which may also start with weeks and days
+func parseDuration(val string) (time.Duration, error) {
+	match := durationDays.FindStringSubmatch(val)
+	if match == nil || val == "" {
+		return 0, fmt.Errorf("invalid duration: %s", val)
+	}
+
+	var d time.Duration
+	for i, unit := range []time.Duration{7 * 24 * time.Hour, 24 * time.Hour} {
+		if match[i+1] == "" {
+			continue
+		}
+		n, err := strconv.Atoi(match[i+1])
+		if err != nil {
+			return 0, err
+		}
+		d += time.Duration(n) * unit
+	}
+
+	if match[3] != "" {
+		rest, err := time.ParseDuration(match[3])
+		if err != nil {
+			return 0, err
+		}
+		d += rest
+	}
+	return d, nil
+}
@@ -1,7 +1,9 @@
 package flags
 
 import (
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/10gen/realm-cli/internal/utils/test/assert"
 )
@@ -32,3 +34,69 @@ func TestDateSet(t *testing.T) {
 		})
 	}
 }
+
+func TestDateSetRelative(t *testing.T) {
+	origNow, origLocal := now, time.Local
+	defer func() { now, time.Local = origNow, origLocal }()
+
+	time.Local = time.UTC
+	now = func() time.Time {
+		return time.Date(2021, time.June, 22, 7, 54, 42, 123_000_000, time.FixedZone("EST", -5*60*60))
+	}
+
+	for _, tc := range []struct {
+		input  string
+		output string
+	}{
+		{"now", "2021-06-22T12:54:42.123+0000"},
+		{"today", "2021-06-22T00:00:00.000+0000"},
+		{"Yesterday", "2021-06-21T00:00:00.000+0000"},
+		{"15m", "2021-06-22T12:39:42.123+0000"},
+		{"2h", "2021-06-22T10:54:42.123+0000"},
+		{"1h30m", "2021-06-22T11:24:42.123+0000"},
+		{"3d", "2021-06-19T12:54:42.123+0000"},
+		{"1w2d12h", "2021-06-13T00:54:42.123+0000"},
+		{"10m ago", "2021-06-22T12:44:42.123+0000"},
+	} {
+		t.Run("should parse "+tc.input+" relative to now", func(t *testing.T) {
+			date := new(Date)
+
+			assert.Nil(t, date.Set(tc.input))
+
+			assert.Equal(t, tc.output, date.String())
+		})
+	}
+
+	for _, input := range []string{"", "15", "-15m", "2d ago ago", "tomorrow", "d"} {
+		t.Run("should not parse "+input, func(t *testing.T) {
+			date := new(Date)
+
+			assert.Equal(t, fmt.Errorf("unrecognized date string: %s", input), date.Set(input))
+		})
+	}
+
+	t.Run("should parse today and yesterday from the start of the local day", func(t *testing.T) {
+		est := time.FixedZone("EST", -5*60*60)
+
+		time.Local = est
+		defer func() { time.Local = time.UTC }()
+
+		now = func() time.Time {
+			return time.Date(2021, time.June, 22, 22, 30, 0, 0, est) // already June 23rd in UTC
+		}
+
+		for _, tc := range []struct {
+			input  string
+			output string
+		}{
+			{"today", "2021-06-22T05:00:00.000+0000"},
+			{"yesterday", "2021-06-21T05:00:00.000+0000"},
+		} {
+			date := new(Date)
+
+			assert.Nil(t, date.Set(tc.input))
+
+			assert.Equal(t, tc.output, date.String())
+		}
+	})
+}
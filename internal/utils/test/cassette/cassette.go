@@ -0,0 +1,324 @@
+// Package cassette records the http exchanges made by the CLI's clients,
+// and replays them deterministically in tests
+package cassette
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/utils/api"
+)
+
+// Mode is the cassette mode
+type Mode string
+
+// set of supported cassette modes
+const (
+	// ModeReplay serves responses from the recorded interactions only,
+	// and fails any request which does not match an unplayed interaction
+	ModeReplay Mode = "replay"
+
+	// ModeRecord sends requests to the server, and records each interaction
+	ModeRecord Mode = "record"
+)
+
+const (
+	// EnvMode is the environment variable which sets the cassette mode of tests,
+	// which otherwise replay their recorded interactions
+	EnvMode = "REALM_CLI_CASSETTE_MODE"
+
+	encodingBase64 = "base64"
+)
+
+// ModeFromEnv returns the cassette mode set by the environment
+func ModeFromEnv() Mode {
+	if Mode(os.Getenv(EnvMode)) == ModeRecord {
+		return ModeRecord
+	}
+	return ModeReplay
+}
+
+// Interaction is a recorded http request and its response
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+// Request is a recorded http request
+//
+// Requests are matched on their method, path, query and body, where
+// any credentials and secrets have been redacted before matching
+type Request struct {
+	Method string      `json:"method"`
+	Path   string      `json:"path"`
+	Query  string      `json:"query,omitempty"`
+	Header http.Header `json:"header,omitempty"`
+	Body   string      `json:"body,omitempty"`
+}
+
+func (r Request) matches(other Request) bool {
+	return r.Method == other.Method &&
+		r.Path == other.Path &&
+		r.Query == other.Query &&
+		r.Body == other.Body
+}
+
+// Response is a recorded http response
+type Response struct {
+	Status       int         `json:"status"`
+	Header       http.Header `json:"header,omitempty"`
+	Body         string      `json:"body,omitempty"`
+	BodyEncoding string      `json:"body_encoding,omitempty"`
+}
+
+// Cassette is an http.RoundTripper which either records the interactions
+// sent through its base transport or replays previously recorded ones
+type Cassette struct {
+	Interactions []Interaction `json:"interactions"`
+
+	path   string
+	mode   Mode
+	base   http.RoundTripper
+	mu     sync.Mutex
+	played []bool
+}
+
+// New creates a cassette for the provided file path
+//
+// In replay mode the recorded interactions are loaded from the file,
+// and in record mode requests are sent using the base transport
+func New(path string, mode Mode, base http.RoundTripper) (*Cassette, error) {
+	c := &Cassette{path: path, mode: mode, base: base}
+	if mode == ModeRecord {
+		return c, nil
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read cassette: %w", err)
+	}
+	if err := json.Unmarshal(data, c); err != nil {
+		return nil, fmt.Errorf("failed to parse cassette %s: %w", path, err)
+	}
+	c.played = make([]bool, len(c.Interactions))
+	return c, nil
+}
+
+// Use installs the named cassette into the api.DefaultTransport
+// used by the realm and atlas clients, in the mode set by the environment
+//
+// The cassette is saved to (or loaded from) "testdata/cassettes/<name>.json",
+// and the returned function must be called once the test is complete to
+// restore the transport and, when recording, save the cassette
+func Use(t *testing.T, name string) func() {
+	t.Helper()
+
+	base := api.DefaultTransport.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	c, err := New(filepath.Join("testdata", "cassettes", name+".json"), ModeFromEnv(), base)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	api.DefaultTransport.Base = c
+	return func() {
+		t.Helper()
+
+		api.DefaultTransport.Base = base
+
+		if c.mode == ModeRecord {
+			if err := c.Save(); err != nil {
+				t.Fatal(err)
+			}
+			return
+		}
+
+		if unplayed := c.Unplayed(); len(unplayed) > 0 {
+			t.Errorf("cassette %s has %d unplayed interaction(s), starting with %s %s", c.path, len(unplayed), unplayed[0].Request.Method, unplayed[0].Request.Path)
+		}
+	}
+}
+
+// RoundTrip executes the http request, or replays its recorded response
+func (c *Cassette) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil && req.Body != http.NoBody {
+		data, err := ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		body = data
+
+		req = req.Clone(req.Context())
+		req.Body = ioutil.NopCloser(bytes.NewReader(data))
+	}
+
+	recorded := newRequest(req, body)
+
+	if c.mode == ModeRecord {
+		return c.record(req, recorded)
+	}
+	return c.replay(req, recorded)
+}
+
+// Save writes the recorded interactions to the cassette file
+func (c *Cassette) Save() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.Interactions == nil {
+		c.Interactions = []Interaction{}
+	}
+
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(c.path), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(c.path, append(data, '\n'), 0666)
+}
+
+// Unplayed returns the recorded interactions which have not been replayed
+func (c *Cassette) Unplayed() []Interaction {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var unplayed []Interaction
+	for i, interaction := range c.Interactions {
+		if !c.played[i] {
+			unplayed = append(unplayed, interaction)
+		}
+	}
+	return unplayed
+}
+
+func (c *Cassette) record(req *http.Request, recorded Request) (*http.Response, error) {
+	if c.base == nil {
+		return nil, errors.New("cassette must have a base transport to record with")
+	}
+
+	res, err := c.base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := ioutil.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	res.Body = ioutil.NopCloser(bytes.NewReader(data))
+
+	response := Response{Status: res.StatusCode, Header: scrubHeader(res.Header)}
+	contentType := res.Header.Get(api.HeaderContentType)
+	switch {
+	case len(data) == 0:
+	case strings.Contains(contentType, "json"):
+		response.Body = string(api.RedactJSON(req.URL.Path, data))
+	case api.IsTextContent(contentType):
+		response.Body = string(data)
+	default:
+		response.Body = base64.StdEncoding.EncodeToString(data)
+		response.BodyEncoding = encodingBase64
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.Interactions = append(c.Interactions, Interaction{recorded, response})
+	return res, nil
+}
+
+func (c *Cassette) replay(req *http.Request, recorded Request) (*http.Response, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for i, interaction := range c.Interactions {
+		if c.played[i] || !interaction.Request.matches(recorded) {
+			continue
+		}
+		c.played[i] = true
+
+		body := []byte(interaction.Response.Body)
+		if interaction.Response.BodyEncoding == encodingBase64 {
+			data, err := base64.StdEncoding.DecodeString(interaction.Response.Body)
+			if err != nil {
+				return nil, fmt.Errorf("failed to decode recorded response body: %w", err)
+			}
+			body = data
+		}
+
+		header := interaction.Response.Header.Clone()
+		if header == nil {
+			header = http.Header{}
+		}
+
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", interaction.Response.Status, http.StatusText(interaction.Response.Status)),
+			StatusCode:    interaction.Response.Status,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        header,
+			Body:          ioutil.NopCloser(bytes.NewReader(body)),
+			ContentLength: int64(len(body)),
+			Request:       req,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("cassette %s has no unplayed interaction for %s %s", c.path, recorded.Method, api.RedactURL(req.URL))
+}
+
+func newRequest(req *http.Request, body []byte) Request {
+	recorded := Request{
+		Method: req.Method,
+		Path:   req.URL.Path,
+		Header: scrubHeader(req.Header),
+	}
+
+	if req.URL.RawQuery != "" {
+		recorded.Query = strings.SplitN(api.RedactURL(req.URL), "?", 2)[1]
+	}
+
+	// only text bodies are matched, since others (such as multipart uploads)
+	// may be encoded differently each time they are sent
+	contentType := req.Header.Get(api.HeaderContentType)
+	switch {
+	case len(body) == 0:
+	case strings.Contains(contentType, "json"):
+		recorded.Body = string(api.RedactJSON(req.URL.Path, body))
+	case api.IsTextContent(contentType):
+		recorded.Body = string(body)
+	}
+	return recorded
+}
+
+// scrubHeader redacts any credentials from the header, and removes
+// the keys which vary between recordings without affecting the exchange
+func scrubHeader(header http.Header) http.Header {
+	scrubbed := api.RedactHeader(header)
+	for _, key := range []string{"Content-Length", "Date", "User-Agent"} {
+		scrubbed.Del(key)
+	}
+	if len(scrubbed) == 0 {
+		return nil
+	}
+	return scrubbed
+}
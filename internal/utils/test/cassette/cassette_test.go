@@ -0,0 +1,178 @@
+package cassette_test
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/utils/api"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/cassette"
+	"github.com/10gen/realm-cli/internal/utils/test/fake"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestCassette(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/login":
+			w.Header().Set(api.HeaderContentType, api.MediaTypeJSON)
+			w.Write([]byte(`{"access_token":"accessToken","refresh_token":"refreshToken"}`))
+		case "/export":
+			w.Header().Set(api.HeaderContentType, "application/zip")
+			w.Write([]byte{0x50, 0x4b, 0x03, 0x04})
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer server.Close()
+
+	tmpDir, err := ioutil.TempDir("", "realm-cli-cassette")
+	assert.Nil(t, err)
+	defer os.RemoveAll(tmpDir)
+
+	path := filepath.Join(tmpDir, "cassette.json")
+
+	send := func(t *testing.T, client *http.Client, method, url, body string) (*http.Response, []byte) {
+		t.Helper()
+
+		options := api.RequestOptions{}
+		if body != "" {
+			options.Body = strings.NewReader(body)
+			options.ContentType = api.MediaTypeJSON
+		}
+
+		req, err := api.NewRequest(context.Background(), method, url, options)
+		assert.Nil(t, err)
+		req.Header.Set(api.HeaderAuthorization, "Bearer accessToken")
+
+		res, err := client.Do(req)
+		assert.Nil(t, err)
+		defer res.Body.Close()
+
+		data, err := ioutil.ReadAll(res.Body)
+		assert.Nil(t, err)
+		return res, data
+	}
+
+	t.Run("should record interactions with credentials scrubbed", func(t *testing.T) {
+		c, err := cassette.New(path, cassette.ModeRecord, http.DefaultTransport)
+		assert.Nil(t, err)
+
+		client := &http.Client{Transport: c}
+
+		_, body := send(t, client, http.MethodPost, server.URL+"/login", `{"username":"publicKey","apiKey":"privateKey"}`)
+		assert.Equal(t, `{"access_token":"accessToken","refresh_token":"refreshToken"}`, string(body))
+
+		_, body = send(t, client, http.MethodGet, server.URL+"/export?version=20210101&source_control=true", "")
+		assert.Equal(t, []byte{0x50, 0x4b, 0x03, 0x04}, body)
+
+		send(t, client, http.MethodPost, server.URL+"/apps/appID/secrets", `{"name":"name","value":"shh"}`)
+
+		assert.Nil(t, c.Save())
+
+		data, err := ioutil.ReadFile(path)
+		assert.Nil(t, err)
+
+		for _, credential := range []string{"privateKey", "accessToken", "refreshToken", "shh"} {
+			assert.False(t, strings.Contains(string(data), credential), "cassette must not contain %s", credential)
+		}
+		assert.True(t, strings.Contains(string(data), "publicKey"), "cassette must contain the uncredentialed fields")
+	})
+
+	t.Run("should replay the recorded interactions", func(t *testing.T) {
+		c, err := cassette.New(path, cassette.ModeReplay, nil)
+		assert.Nil(t, err)
+
+		client := &http.Client{Transport: c}
+
+		res, body := send(t, client, http.MethodPost, "http://realm.example.com/login", `{"apiKey":"anotherKey","username":"publicKey"}`)
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.Equal(t, `{"access_token":"[REDACTED]","refresh_token":"[REDACTED]"}`, string(body))
+
+		res, body = send(t, client, http.MethodGet, "http://realm.example.com/export?source_control=true&version=20210101", "")
+		assert.Equal(t, "application/zip", res.Header.Get(api.HeaderContentType))
+		assert.Equal(t, []byte{0x50, 0x4b, 0x03, 0x04}, body)
+
+		assert.Equal(t, 1, len(c.Unplayed()))
+
+		res, _ = send(t, client, http.MethodPost, "http://realm.example.com/apps/appID/secrets", `{"name":"name","value":"shh"}`)
+		assert.Equal(t, http.StatusNoContent, res.StatusCode)
+
+		assert.Equal(t, 0, len(c.Unplayed()))
+	})
+
+	t.Run("should fail to replay an unrecorded or already played request", func(t *testing.T) {
+		c, err := cassette.New(path, cassette.ModeReplay, nil)
+		assert.Nil(t, err)
+
+		client := &http.Client{Transport: c}
+
+		for _, tc := range []struct {
+			method string
+			url    string
+			body   string
+		}{
+			{http.MethodPost, "http://realm.example.com/login", `{"username":"anotherKey","apiKey":"privateKey"}`},
+			{http.MethodGet, "http://realm.example.com/export?version=20200603&source_control=true", ""},
+		} {
+			req, err := api.NewRequest(context.Background(), tc.method, tc.url, api.RequestOptions{Body: strings.NewReader(tc.body), ContentType: api.MediaTypeJSON})
+			assert.Nil(t, err)
+
+			_, err = client.Do(req)
+			assert.NotNil(t, err)
+			assert.True(t, strings.Contains(err.Error(), "has no unplayed interaction for "+tc.method), "unexpected error: %s", err)
+		}
+	})
+
+	t.Run("should fail to load a missing cassette in replay mode", func(t *testing.T) {
+		_, err := cassette.New(filepath.Join(tmpDir, "missing.json"), cassette.ModeReplay, nil)
+		assert.NotNil(t, err)
+		assert.True(t, errors.Is(err, os.ErrNotExist), "expected a not exist error but got: %s", err)
+	})
+}
+
+// TestUse replays the realm client interactions recorded in testdata/cassettes,
+// which can be re-recorded against a fake Realm server with REALM_CLI_CASSETTE_MODE=record
+func TestUse(t *testing.T) {
+	baseURL := "http://realm.example.com"
+	if cassette.ModeFromEnv() == cassette.ModeRecord {
+		server := fake.NewRealmServer("5fd45718cface356de9d104d")
+		defer server.Close()
+		baseURL = server.URL
+	}
+
+	teardown := cassette.Use(t, "realm_secrets")
+	defer teardown()
+
+	session, err := realm.NewClient(baseURL).Authenticate(context.Background(), realm.AuthTypeCloud, user.Credentials{
+		PublicAPIKey:  "publicKey",
+		PrivateAPIKey: "privateKey",
+	})
+	assert.Nil(t, err)
+
+	profile, profileTeardown := mock.NewProfileFromTmpDir(t, "realm-cli-cassette")
+	defer profileTeardown()
+	profile.SetSession(user.Session{session.AccessToken, session.RefreshToken})
+
+	client := realm.NewAuthClient(baseURL, profile)
+
+	app, err := client.CreateApp(context.Background(), "5fd45718cface356de9d104d", "cassette-app", realm.AppMeta{})
+	assert.Nil(t, err)
+	assert.Equal(t, "cassette-app", app.Name)
+
+	secret, err := client.CreateSecret(context.Background(), app.GroupID, app.ID, "name", "value")
+	assert.Nil(t, err)
+
+	secrets, err := client.Secrets(context.Background(), app.GroupID, app.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, []realm.Secret{secret}, secrets)
+}
@@ -0,0 +1,546 @@
+package fake
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/local"
+	"github.com/10gen/realm-cli/internal/utils/api"
+)
+
+// app is the in-memory state of a Realm app
+type app struct {
+	realm.App
+
+	// data is the app configuration last deployed with an import or a draft deployment
+	data json.RawMessage
+
+	draft       *draft
+	deployments []deployment // newest first
+	secrets     []secret
+	users       []realm.User
+	assets      []realm.HostingAsset
+	logs        realm.Logs
+	allowedIPs  []realm.AllowedIP
+}
+
+// draft is a set of staged app changes which have not yet been deployed
+type draft struct {
+	realm.AppDraft
+	data json.RawMessage
+}
+
+// deployment is a deployment along with the app configuration it deployed
+type deployment struct {
+	realm.AppDeployment
+	data json.RawMessage
+}
+
+type secret struct {
+	realm.Secret
+	value string
+}
+
+// AddApp creates an app in the specified project, and returns it
+func (s *RealmServer) AddApp(groupID, name string) realm.App {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.addApp(groupID, name, realm.AppMeta{}).App
+}
+
+// Apps returns every app on the server
+func (s *RealmServer) Apps() []realm.App {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	apps := make([]realm.App, 0, len(s.apps))
+	for _, app := range s.apps {
+		apps = append(apps, app.App)
+	}
+	return apps
+}
+
+// AddLogs seeds the specified app with logs
+func (s *RealmServer) AddLogs(appID string, logs ...realm.Log) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if app := s.findApp(appID); app != nil {
+		app.logs = append(app.logs, logs...)
+	}
+}
+
+// AppData returns the configuration last deployed to the specified app
+func (s *RealmServer) AppData(appID string) json.RawMessage {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if app := s.findApp(appID); app != nil {
+		return append(json.RawMessage{}, app.data...)
+	}
+	return nil
+}
+
+// Deployments returns the deployments of the specified app, newest first
+func (s *RealmServer) Deployments(appID string) []realm.AppDeployment {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if app := s.findApp(appID); app != nil {
+		return app.appDeployments()
+	}
+	return nil
+}
+
+// SecretValue returns the value of the specified app secret
+func (s *RealmServer) SecretValue(appID, name string) (string, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if app := s.findApp(appID); app != nil {
+		for _, secret := range app.secrets {
+			if secret.Name == name {
+				return secret.value, true
+			}
+		}
+	}
+	return "", false
+}
+
+func (s *RealmServer) addApp(groupID, name string, meta realm.AppMeta) *app {
+	id := newID()
+
+	a := &app{App: realm.App{
+		AppMeta:      meta,
+		ID:           id,
+		ClientAppID:  fmt.Sprintf("%s-%s", strings.ToLower(name), id[len(id)-5:]),
+		Name:         name,
+		DomainID:     newID(),
+		GroupID:      groupID,
+		LastModified: time.Now().Unix(),
+		Product:      "standard",
+	}}
+	s.apps = append(s.apps, a)
+	return a
+}
+
+func (s *RealmServer) findApp(appID string) *app {
+	for _, app := range s.apps {
+		if app.ID == appID {
+			return app
+		}
+	}
+	return nil
+}
+
+// withApp resolves the app from the first two route params (the group and app ids),
+// and passes the remaining params along to the handler
+func (s *RealmServer) withApp(handler func(w http.ResponseWriter, r *http.Request, app *app, params []string)) func(w http.ResponseWriter, r *http.Request, params []string) {
+	return func(w http.ResponseWriter, r *http.Request, params []string) {
+		groupID, appID := params[0], params[1]
+
+		app := s.findApp(appID)
+		if !s.hasGroup(groupID) || app == nil || app.GroupID != groupID {
+			writeError(w, http.StatusNotFound, "AppNotFound", "app not found")
+			return
+		}
+		handler(w, r, app, params[2:])
+	}
+}
+
+func (s *RealmServer) handleFindApps(w http.ResponseWriter, r *http.Request, params []string) {
+	groupID := params[0]
+	if !s.hasGroup(groupID) {
+		writeError(w, http.StatusNotFound, "GroupNotFound", "group not found")
+		return
+	}
+
+	product := r.URL.Query().Get("product")
+	if product == "" {
+		product = "standard"
+	}
+
+	apps := []realm.App{}
+	for _, app := range s.apps {
+		if app.GroupID == groupID && app.Product == product {
+			apps = append(apps, app.App)
+		}
+	}
+	writeJSON(w, http.StatusOK, apps)
+}
+
+func (s *RealmServer) handleCreateApp(w http.ResponseWriter, r *http.Request, params []string) {
+	groupID := params[0]
+	if !s.hasGroup(groupID) {
+		writeError(w, http.StatusNotFound, "GroupNotFound", "group not found")
+		return
+	}
+
+	var req struct {
+		Name string `json:"name"`
+		realm.AppMeta
+	}
+	if !readJSON(w, r, &req) {
+		return
+	}
+
+	if req.Name == "" {
+		writeError(w, http.StatusBadRequest, "", "app name must not be empty")
+		return
+	}
+	for _, app := range s.apps {
+		if app.GroupID == groupID && app.Name == req.Name {
+			writeError(w, http.StatusConflict, "DuplicateAppName", fmt.Sprintf("app name '%s' is already in use", req.Name))
+			return
+		}
+	}
+
+	writeJSON(w, http.StatusCreated, s.addApp(groupID, req.Name, req.AppMeta).App)
+}
+
+func (s *RealmServer) handleGetApp(w http.ResponseWriter, r *http.Request, app *app, params []string) {
+	writeJSON(w, http.StatusOK, app.App)
+}
+
+func (s *RealmServer) handleDeleteApp(w http.ResponseWriter, r *http.Request, app *app, params []string) {
+	for i, a := range s.apps {
+		if a == app {
+			s.apps = append(s.apps[:i], s.apps[i+1:]...)
+			break
+		}
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *RealmServer) handleAppDescription(w http.ResponseWriter, r *http.Request, app *app, params []string) {
+	functions := []realm.FunctionSummary{}
+	for _, name := range app.functionNames() {
+		functions = append(functions, realm.FunctionSummary{Name: name})
+	}
+
+	values := []string{}
+	for _, secret := range app.secrets {
+		values = append(values, secret.Name)
+	}
+
+	writeJSON(w, http.StatusOK, realm.AppDescription{
+		ClientAppID: app.ClientAppID,
+		Name:        app.Name,
+		RealmURL:    s.URL,
+		Functions:   functions,
+		Values:      values,
+		Environment: string(app.Environment),
+	})
+}
+
+func (s *RealmServer) handleImport(w http.ResponseWriter, r *http.Request, app *app, params []string) {
+	var data json.RawMessage
+	if !readJSON(w, r, &data) {
+		return
+	}
+
+	if r.URL.Query().Get("diff") == "true" {
+		writeJSON(w, http.StatusOK, appDiffs(app.data, data))
+		return
+	}
+
+	if app.draft != nil {
+		app.draft.data = data
+	} else {
+		app.deploy(data)
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *RealmServer) handleExport(w http.ResponseWriter, r *http.Request, app *app, params []string) {
+	configVersion := realm.DefaultAppConfigVersion
+	if version := r.URL.Query().Get("version"); version != "" {
+		v, err := strconv.Atoi(version)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "", "invalid config version: "+version)
+			return
+		}
+		configVersion = realm.AppConfigVersion(v)
+	}
+
+	data, err := app.export(configVersion)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "", "failed to export app: "+err.Error())
+		return
+	}
+
+	filename := fmt.Sprintf("%s_%s.zip", app.Name, time.Now().UTC().Format("20060102150405"))
+
+	w.Header().Set(api.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%s", filename))
+	w.Header().Set(api.HeaderContentType, "application/zip")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
+
+func (s *RealmServer) handleGetDrafts(w http.ResponseWriter, r *http.Request, app *app, params []string) {
+	drafts := []realm.AppDraft{}
+	if app.draft != nil {
+		drafts = append(drafts, app.draft.AppDraft)
+	}
+	writeJSON(w, http.StatusOK, drafts)
+}
+
+func (s *RealmServer) handleCreateDraft(w http.ResponseWriter, r *http.Request, app *app, params []string) {
+	if app.draft != nil {
+		writeError(w, http.StatusBadRequest, realm.ErrCodeDraftAlreadyExists, "a draft already exists")
+		return
+	}
+
+	app.draft = &draft{AppDraft: realm.AppDraft{ID: newID()}, data: app.data}
+	writeJSON(w, http.StatusCreated, app.draft.AppDraft)
+}
+
+func (s *RealmServer) handleDiscardDraft(w http.ResponseWriter, r *http.Request, app *app, params []string) {
+	if !app.hasDraft(params[0]) {
+		writeError(w, http.StatusNotFound, "DraftNotFound", "draft not found")
+		return
+	}
+
+	app.draft = nil
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *RealmServer) handleDiffDraft(w http.ResponseWriter, r *http.Request, app *app, params []string) {
+	if !app.hasDraft(params[0]) {
+		writeError(w, http.StatusNotFound, "DraftNotFound", "draft not found")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, realm.AppDraftDiff{Diffs: appDiffs(app.data, app.draft.data)})
+}
+
+func (s *RealmServer) handleDeployDraft(w http.ResponseWriter, r *http.Request, app *app, params []string) {
+	if !app.hasDraft(params[0]) {
+		writeError(w, http.StatusNotFound, "DraftNotFound", "draft not found")
+		return
+	}
+
+	data := app.draft.data
+	app.draft = nil
+
+	writeJSON(w, http.StatusCreated, app.deploy(data))
+}
+
+func (s *RealmServer) handleGetDeployments(w http.ResponseWriter, r *http.Request, app *app, params []string) {
+	writeJSON(w, http.StatusOK, app.appDeployments())
+}
+
+func (s *RealmServer) handleGetDeployment(w http.ResponseWriter, r *http.Request, app *app, params []string) {
+	for _, deployment := range app.deployments {
+		if deployment.ID == params[0] {
+			writeJSON(w, http.StatusOK, deployment.AppDeployment)
+			return
+		}
+	}
+	writeError(w, http.StatusNotFound, "DeploymentNotFound", "deployment not found")
+}
+
+func (s *RealmServer) handleRedeploy(w http.ResponseWriter, r *http.Request, app *app, params []string) {
+	for _, deployment := range app.deployments {
+		if deployment.ID == params[0] {
+			app.deploy(deployment.data)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+	}
+	writeError(w, http.StatusNotFound, "DeploymentNotFound", "deployment not found")
+}
+
+func (a *app) hasDraft(draftID string) bool {
+	return a.draft != nil && a.draft.ID == draftID
+}
+
+// deploy applies the app configuration and records a successful deployment of it
+func (a *app) deploy(data json.RawMessage) realm.AppDeployment {
+	a.data = data
+	a.LastModified = time.Now().Unix()
+
+	appDeployment := realm.AppDeployment{
+		ID:         newID(),
+		AppID:      a.ID,
+		DeployedAt: a.LastModified,
+		Status:     realm.DeploymentStatusSuccessful,
+	}
+	a.deployments = append([]deployment{{appDeployment, data}}, a.deployments...)
+	return appDeployment
+}
+
+func (a *app) appDeployments() []realm.AppDeployment {
+	deployments := make([]realm.AppDeployment, 0, len(a.deployments))
+	for _, deployment := range a.deployments {
+		deployments = append(deployments, deployment.AppDeployment)
+	}
+	return deployments
+}
+
+// export zips the deployed app configuration in the same layout as the Realm server
+//
+// An app which has never been deployed exports as an empty app in the requested config version,
+// otherwise the app exports in the config version it was last deployed with
+func (a *app) export(configVersion realm.AppConfigVersion) ([]byte, error) {
+	tmpDir, err := ioutil.TempDir("", "realm-cli-fake-export")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	localApp := local.AsApp(tmpDir, a.App, configVersion)
+	if len(a.data) > 0 {
+		var meta struct {
+			ConfigVersion realm.AppConfigVersion `json:"config_version"`
+		}
+		if err := json.Unmarshal(a.data, &meta); err != nil {
+			return nil, err
+		}
+
+		var appData local.AppData
+		switch meta.ConfigVersion {
+		case realm.AppConfigVersion20180301:
+			appData, localApp.Config = &local.AppStitchJSON{}, local.FileStitch
+		case realm.AppConfigVersion20200603:
+			appData, localApp.Config = &local.AppConfigJSON{}, local.FileConfig
+		default:
+			appData, localApp.Config = &local.AppRealmConfigJSON{}, local.FileRealmConfig
+		}
+		if err := json.Unmarshal(a.data, appData); err != nil {
+			return nil, err
+		}
+		localApp.AppData = appData
+	}
+
+	if err := localApp.Write(); err != nil {
+		return nil, err
+	}
+
+	buf := new(bytes.Buffer)
+	zw := zip.NewWriter(buf)
+
+	if err := filepath.Walk(tmpDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+
+		rel, err := filepath.Rel(tmpDir, path)
+		if err != nil {
+			return err
+		}
+
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		fw, err := zw.Create(filepath.ToSlash(rel))
+		if err != nil {
+			return err
+		}
+		_, err = fw.Write(data)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// functionNames returns the names of the functions in the deployed app configuration
+func (a *app) functionNames() []string {
+	if len(a.data) == 0 {
+		return nil
+	}
+
+	var data struct {
+		Functions json.RawMessage `json:"functions"`
+	}
+	if err := json.Unmarshal(a.data, &data); err != nil || len(data.Functions) == 0 {
+		return nil
+	}
+
+	var names []string
+
+	var functionsV2 struct {
+		Configs []struct {
+			Name string `json:"name"`
+		} `json:"config"`
+	}
+	if err := json.Unmarshal(data.Functions, &functionsV2); err == nil {
+		for _, config := range functionsV2.Configs {
+			names = append(names, config.Name)
+		}
+		return names
+	}
+
+	var functionsV1 []struct {
+		Config struct {
+			Name string `json:"name"`
+		} `json:"config"`
+	}
+	if err := json.Unmarshal(data.Functions, &functionsV1); err == nil {
+		for _, function := range functionsV1 {
+			names = append(names, function.Config.Name)
+		}
+	}
+	return names
+}
+
+// appDiffs describes the top-level differences between two app configurations
+func appDiffs(from, to json.RawMessage) []string {
+	var fromData, toData map[string]interface{}
+	if len(from) > 0 {
+		json.Unmarshal(from, &fromData)
+	}
+	if len(to) > 0 {
+		json.Unmarshal(to, &toData)
+	}
+
+	keys := map[string]struct{}{}
+	for key := range fromData {
+		keys[key] = struct{}{}
+	}
+	for key := range toData {
+		keys[key] = struct{}{}
+	}
+
+	sorted := make([]string, 0, len(keys))
+	for key := range keys {
+		sorted = append(sorted, key)
+	}
+	sort.Strings(sorted)
+
+	diffs := []string{}
+	for _, key := range sorted {
+		fromValue, inFrom := fromData[key]
+		toValue, inTo := toData[key]
+
+		switch {
+		case !inFrom:
+			diffs = append(diffs, "added: "+key)
+		case !inTo:
+			diffs = append(diffs, "deleted: "+key)
+		case !reflect.DeepEqual(fromValue, toValue):
+			diffs = append(diffs, "modified: "+key)
+		}
+	}
+	return diffs
+}
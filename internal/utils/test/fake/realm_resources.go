@@ -0,0 +1,478 @@
+package fake
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/utils/api"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	// the maximum number of logs returned by a single request
+	logsLimit = 100
+)
+
+func (s *RealmServer) handleGetSecrets(w http.ResponseWriter, r *http.Request, app *app, params []string) {
+	secrets := make([]realm.Secret, 0, len(app.secrets))
+	for _, secret := range app.secrets {
+		secrets = append(secrets, secret.Secret)
+	}
+	writeJSON(w, http.StatusOK, secrets)
+}
+
+type secretRequest struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+func (s *RealmServer) handleCreateSecret(w http.ResponseWriter, r *http.Request, app *app, params []string) {
+	var req secretRequest
+	if !readJSON(w, r, &req) {
+		return
+	}
+
+	for _, secret := range app.secrets {
+		if secret.Name == req.Name {
+			writeError(w, http.StatusConflict, "SecretAlreadyExists", "secret already exists")
+			return
+		}
+	}
+
+	secret := secret{realm.Secret{ID: newID(), Name: req.Name}, req.Value}
+	app.secrets = append(app.secrets, secret)
+
+	writeJSON(w, http.StatusCreated, secret.Secret)
+}
+
+func (s *RealmServer) handleUpdateSecret(w http.ResponseWriter, r *http.Request, app *app, params []string) {
+	var req secretRequest
+	if !readJSON(w, r, &req) {
+		return
+	}
+
+	for i, secret := range app.secrets {
+		if secret.ID == params[0] {
+			if req.Name != "" {
+				app.secrets[i].Name = req.Name
+			}
+			app.secrets[i].value = req.Value
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+	}
+	writeError(w, http.StatusNotFound, "SecretNotFound", "secret not found")
+}
+
+func (s *RealmServer) handleDeleteSecret(w http.ResponseWriter, r *http.Request, app *app, params []string) {
+	for i, secret := range app.secrets {
+		if secret.ID == params[0] {
+			app.secrets = append(app.secrets[:i], app.secrets[i+1:]...)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+	}
+	writeError(w, http.StatusNotFound, "SecretNotFound", "secret not found")
+}
+
+func (s *RealmServer) handleCreateAPIKey(w http.ResponseWriter, r *http.Request, app *app, params []string) {
+	var req struct {
+		Name string `json:"name"`
+	}
+	if !readJSON(w, r, &req) {
+		return
+	}
+
+	apiKey := realm.APIKey{ID: newID(), Name: req.Name, Key: newID() + newID()}
+	app.users = append(app.users, newUser(apiKey.ID, realm.AuthProviderTypeAPIKey, map[string]interface{}{"name": req.Name}))
+
+	writeJSON(w, http.StatusCreated, apiKey)
+}
+
+func (s *RealmServer) handleCreateUser(w http.ResponseWriter, r *http.Request, app *app, params []string) {
+	var req struct {
+		Email    string `json:"email"`
+		Password string `json:"password"`
+	}
+	if !readJSON(w, r, &req) {
+		return
+	}
+
+	for _, user := range app.users {
+		for _, identity := range user.Identities {
+			if identity.ProviderType == realm.AuthProviderTypeUserPassword && identity.ProviderData["email"] == req.Email {
+				writeError(w, http.StatusConflict, "AccountNameInUse", "name already in use")
+				return
+			}
+		}
+	}
+
+	user := newUser(newID(), realm.AuthProviderTypeUserPassword, map[string]interface{}{"email": req.Email})
+	user.Data = map[string]interface{}{"email": req.Email}
+	app.users = append(app.users, user)
+
+	writeJSON(w, http.StatusCreated, user)
+}
+
+func (s *RealmServer) handleGetUsers(w http.ResponseWriter, r *http.Request, app *app, params []string) {
+	query := r.URL.Query()
+
+	providerTypes := map[realm.AuthProviderType]bool{}
+	if types := query.Get("provider_types"); types != "" {
+		for _, providerType := range strings.Split(types, ",") {
+			providerTypes[realm.AuthProviderType(providerType)] = true
+		}
+	}
+
+	status := query.Get("status")
+
+	users := []realm.User{}
+	for _, user := range app.users {
+		if status == string(realm.UserStateEnabled) && user.Disabled || status == string(realm.UserStateDisabled) && !user.Disabled {
+			continue
+		}
+		if len(providerTypes) > 0 && !userHasProvider(user, providerTypes) {
+			continue
+		}
+		users = append(users, user)
+	}
+	writeJSON(w, http.StatusOK, users)
+}
+
+func (s *RealmServer) handleGetPendingUsers(w http.ResponseWriter, r *http.Request, app *app, params []string) {
+	writeJSON(w, http.StatusOK, []realm.User{})
+}
+
+func (s *RealmServer) handleGetUser(w http.ResponseWriter, r *http.Request, app *app, params []string) {
+	if i := app.userIndex(params[0]); i != -1 {
+		writeJSON(w, http.StatusOK, app.users[i])
+		return
+	}
+	writeError(w, http.StatusNotFound, "UserNotFound", "user not found")
+}
+
+func (s *RealmServer) handleDeleteUser(w http.ResponseWriter, r *http.Request, app *app, params []string) {
+	if i := app.userIndex(params[0]); i != -1 {
+		app.users = append(app.users[:i], app.users[i+1:]...)
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	writeError(w, http.StatusNotFound, "UserNotFound", "user not found")
+}
+
+func (s *RealmServer) handleSetUserDisabled(disabled bool) func(w http.ResponseWriter, r *http.Request, app *app, params []string) {
+	return func(w http.ResponseWriter, r *http.Request, app *app, params []string) {
+		if i := app.userIndex(params[0]); i != -1 {
+			app.users[i].Disabled = disabled
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		writeError(w, http.StatusNotFound, "UserNotFound", "user not found")
+	}
+}
+
+func (s *RealmServer) handleUserLogout(w http.ResponseWriter, r *http.Request, app *app, params []string) {
+	if i := app.userIndex(params[0]); i != -1 {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	writeError(w, http.StatusNotFound, "UserNotFound", "user not found")
+}
+
+func (a *app) userIndex(userID string) int {
+	for i, user := range a.users {
+		if user.ID == userID {
+			return i
+		}
+	}
+	return -1
+}
+
+func newUser(id string, providerType realm.AuthProviderType, providerData map[string]interface{}) realm.User {
+	return realm.User{
+		ID: id,
+		Identities: []realm.UserIdentity{{
+			UID:          newID(),
+			ProviderType: providerType,
+			ProviderID:   primitive.NewObjectID(),
+			ProviderData: providerData,
+		}},
+		Type:         "normal",
+		CreationDate: time.Now().Unix(),
+	}
+}
+
+func userHasProvider(user realm.User, providerTypes map[realm.AuthProviderType]bool) bool {
+	for _, identity := range user.Identities {
+		if providerTypes[identity.ProviderType] {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *RealmServer) handleGetHostingAssets(w http.ResponseWriter, r *http.Request, app *app, params []string) {
+	writeJSON(w, http.StatusOK, append([]realm.HostingAsset{}, app.assets...))
+}
+
+func (s *RealmServer) handleUploadHostingAsset(w http.ResponseWriter, r *http.Request, app *app, params []string) {
+	_, mediaParams, err := mime.ParseMediaType(r.Header.Get(api.HeaderContentType))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "", "invalid content type: "+err.Error())
+		return
+	}
+
+	var asset realm.HostingAsset
+	var size int64
+
+	mr := multipart.NewReader(r.Body, mediaParams["boundary"])
+	for {
+		part, err := mr.NextPart()
+		if err != nil {
+			break
+		}
+
+		data, err := ioutil.ReadAll(part)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "", "failed to read upload: "+err.Error())
+			return
+		}
+
+		switch part.FormName() {
+		case "meta":
+			if err := json.Unmarshal(data, &asset); err != nil {
+				writeError(w, http.StatusBadRequest, "", "invalid asset metadata: "+err.Error())
+				return
+			}
+		case "file":
+			size = int64(len(data))
+		}
+	}
+
+	if asset.FilePath == "" {
+		writeError(w, http.StatusBadRequest, "", "asset path must not be empty")
+		return
+	}
+
+	asset.AppID = app.ID
+	asset.FileSize = size
+	asset.LastModified = time.Now().Unix()
+	asset.URL = s.URL + "/hosting/" + app.ClientAppID + asset.FilePath
+
+	if i := app.assetIndex(asset.FilePath); i != -1 {
+		app.assets[i] = asset
+	} else {
+		app.assets = append(app.assets, asset)
+		sort.Slice(app.assets, func(i, j int) bool { return app.assets[i].FilePath < app.assets[j].FilePath })
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *RealmServer) handleUpdateHostingAsset(w http.ResponseWriter, r *http.Request, app *app, params []string) {
+	var req struct {
+		Attributes realm.HostingAssetAttributes `json:"attributes"`
+	}
+	if !readJSON(w, r, &req) {
+		return
+	}
+
+	if i := app.assetIndex(r.URL.Query().Get("path")); i != -1 {
+		app.assets[i].Attrs = req.Attributes
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	writeError(w, http.StatusNotFound, "AssetNotFound", "asset not found")
+}
+
+func (s *RealmServer) handleRemoveHostingAsset(w http.ResponseWriter, r *http.Request, app *app, params []string) {
+	if i := app.assetIndex(r.URL.Query().Get("path")); i != -1 {
+		app.assets = append(app.assets[:i], app.assets[i+1:]...)
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	writeError(w, http.StatusNotFound, "AssetNotFound", "asset not found")
+}
+
+func (s *RealmServer) handleInvalidateHostingCache(w http.ResponseWriter, r *http.Request, app *app, params []string) {
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (a *app) assetIndex(path string) int {
+	for i, asset := range a.assets {
+		if asset.FilePath == path {
+			return i
+		}
+	}
+	return -1
+}
+
+func (s *RealmServer) handleGetLogs(w http.ResponseWriter, r *http.Request, app *app, params []string) {
+	query := r.URL.Query()
+
+	types := map[string]bool{}
+	if t := query.Get("type"); t != "" {
+		for _, logType := range strings.Split(t, ",") {
+			types[logType] = true
+		}
+	}
+
+	var start, end time.Time
+	for _, date := range []struct {
+		param string
+		value *time.Time
+	}{
+		{"start_date", &start},
+		{"end_date", &end},
+	} {
+		if v := query.Get(date.param); v != "" {
+			t, err := time.Parse(time.RFC3339, v)
+			if err != nil {
+				writeError(w, http.StatusBadRequest, "", "invalid "+date.param+": "+v)
+				return
+			}
+			*date.value = t
+		}
+	}
+
+	logs := realm.Logs{}
+	for _, log := range app.logs {
+		if len(types) > 0 && !types[log.Type] {
+			continue
+		}
+		if query.Get("errors_only") == "true" && log.Error == "" {
+			continue
+		}
+		if !start.IsZero() && log.Started.Before(start) || !end.IsZero() && log.Started.After(end) {
+			continue
+		}
+		logs = append(logs, log)
+	}
+
+	// the most recent logs are returned first
+	sort.Sort(sort.Reverse(logs))
+	if len(logs) > logsLimit {
+		logs = logs[:logsLimit]
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"logs": logs})
+}
+
+func (s *RealmServer) handleGetAllowedIPs(w http.ResponseWriter, r *http.Request, app *app, params []string) {
+	writeJSON(w, http.StatusOK, map[string]interface{}{"allowed_ips": append([]realm.AllowedIP{}, app.allowedIPs...)})
+}
+
+func (s *RealmServer) handleCreateAllowedIP(w http.ResponseWriter, r *http.Request, app *app, params []string) {
+	var req struct {
+		Address    string `json:"address"`
+		Comment    string `json:"comment"`
+		UseCurrent bool   `json:"use_current"`
+	}
+	if !readJSON(w, r, &req) {
+		return
+	}
+
+	allowedIP := realm.AllowedIP{ID: newID(), Address: req.Address, Comment: req.Comment}
+	if req.UseCurrent {
+		allowedIP.Address = strings.Split(r.RemoteAddr, ":")[0]
+		allowedIP.IncludesCurrent = true
+	}
+	app.allowedIPs = append(app.allowedIPs, allowedIP)
+
+	writeJSON(w, http.StatusCreated, allowedIP)
+}
+
+func (s *RealmServer) handleUpdateAllowedIP(w http.ResponseWriter, r *http.Request, app *app, params []string) {
+	var req struct {
+		Address string `json:"address"`
+		Comment string `json:"comment"`
+	}
+	if !readJSON(w, r, &req) {
+		return
+	}
+
+	for i, allowedIP := range app.allowedIPs {
+		if allowedIP.ID == params[0] {
+			app.allowedIPs[i].Address = req.Address
+			app.allowedIPs[i].Comment = req.Comment
+			writeJSON(w, http.StatusOK, app.allowedIPs[i])
+			return
+		}
+	}
+	writeError(w, http.StatusNotFound, "AllowedIPNotFound", "allowed ip not found")
+}
+
+func (s *RealmServer) handleDeleteAllowedIP(w http.ResponseWriter, r *http.Request, app *app, params []string) {
+	for i, allowedIP := range app.allowedIPs {
+		if allowedIP.ID == params[0] {
+			app.allowedIPs = append(app.allowedIPs[:i], app.allowedIPs[i+1:]...)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+	}
+	writeError(w, http.StatusNotFound, "AllowedIPNotFound", "allowed ip not found")
+}
+
+func (s *RealmServer) handleGetFunctions(w http.ResponseWriter, r *http.Request, app *app, params []string) {
+	functions := []realm.Function{}
+	for _, name := range app.functionNames() {
+		functions = append(functions, realm.Function{ID: name, Name: name})
+	}
+	writeJSON(w, http.StatusOK, functions)
+}
+
+func (s *RealmServer) handleExecuteFunction(w http.ResponseWriter, r *http.Request, app *app, params []string) {
+	var req struct {
+		Name string `json:"name"`
+	}
+	if !readJSON(w, r, &req) {
+		return
+	}
+
+	for _, name := range app.functionNames() {
+		if name == req.Name {
+			writeJSON(w, http.StatusOK, realm.ExecutionResults{Result: map[string]interface{}{"$undefined": true}})
+			return
+		}
+	}
+	writeError(w, http.StatusNotFound, "FunctionNotFound", "function not found")
+}
+
+func (s *RealmServer) handleDependenciesStatus(w http.ResponseWriter, r *http.Request, app *app, params []string) {
+	writeJSON(w, http.StatusOK, realm.DependenciesStatus{State: realm.DependenciesStateSuccessful})
+}
+
+func (s *RealmServer) handleImportDependencies(w http.ResponseWriter, r *http.Request, app *app, params []string) {
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *RealmServer) handleDiffDependencies(w http.ResponseWriter, r *http.Request, app *app, params []string) {
+	writeJSON(w, http.StatusOK, realm.DependenciesDiff{})
+}
+
+func (s *RealmServer) handleExportDependencies(w http.ResponseWriter, r *http.Request, app *app, params []string) {
+	writeError(w, http.StatusNotFound, "DependenciesNotFound", "app has no dependencies")
+}
+
+func (s *RealmServer) handleGetSchemaModels(w http.ResponseWriter, r *http.Request, app *app, params []string) {
+	writeJSON(w, http.StatusOK, []realm.SchemaModel{})
+}
+
+func (s *RealmServer) handleTemplates(w http.ResponseWriter, r *http.Request, params []string) {
+	writeJSON(w, http.StatusOK, []realm.Template{})
+}
+
+func (s *RealmServer) handleCompatibleTemplates(w http.ResponseWriter, r *http.Request, app *app, params []string) {
+	writeJSON(w, http.StatusOK, []realm.Template{})
+}
+
+func (s *RealmServer) handleClientTemplate(w http.ResponseWriter, r *http.Request, app *app, params []string) {
+	w.WriteHeader(http.StatusNoContent)
+}
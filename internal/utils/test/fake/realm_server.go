@@ -0,0 +1,299 @@
+// Package fake provides in-memory fakes of the cloud services the CLI talks to,
+// so commands can be exercised end-to-end without network access
+package fake
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/utils/api"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	adminAPI   = "/api/admin/v3.0"
+	privateAPI = "/api/private/v1.0"
+
+	errCodeInvalidSession = "InvalidSession"
+)
+
+// RealmServer is an in-process fake of the Realm Admin API
+//
+// It keeps the apps of its projects in memory, along with their drafts,
+// deployments, secrets, users, hosting assets, and logs, and serves
+// the endpoints used by realm.Client so that CLI commands can run
+// against it with "--realm-url"
+type RealmServer struct {
+	*httptest.Server
+
+	mu            sync.Mutex
+	groupIDs      []string
+	credentials   map[string]string
+	accessTokens  map[string]bool
+	refreshTokens map[string]bool
+	apps          []*app
+	routes        []route
+}
+
+type route struct {
+	method  string
+	pattern *regexp.Regexp
+	noAuth  bool
+	handler func(w http.ResponseWriter, r *http.Request, params []string)
+}
+
+// NewRealmServer starts a new fake Realm server whose user belongs to the provided projects,
+// or to a single generated project if none are provided
+//
+// The server must be closed once it is no longer in use
+func NewRealmServer(groupIDs ...string) *RealmServer {
+	if len(groupIDs) == 0 {
+		groupIDs = []string{primitive.NewObjectID().Hex()}
+	}
+
+	s := &RealmServer{
+		groupIDs:      groupIDs,
+		accessTokens:  map[string]bool{},
+		refreshTokens: map[string]bool{},
+	}
+	s.routes = s.newRoutes()
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// GroupIDs returns the ids of the projects the server's user belongs to
+func (s *RealmServer) GroupIDs() []string {
+	return append([]string{}, s.groupIDs...)
+}
+
+// AddCredentials registers a username (or public api key) and its password (or private api key)
+//
+// Until any credentials are registered, the server accepts any non-empty credentials
+func (s *RealmServer) AddCredentials(username, password string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.credentials == nil {
+		s.credentials = map[string]string{}
+	}
+	s.credentials[username] = password
+}
+
+// ExpireSessions invalidates every access token issued by the server,
+// so clients must refresh their sessions with their refresh tokens
+func (s *RealmServer) ExpireSessions() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.accessTokens = map[string]bool{}
+}
+
+func (s *RealmServer) newRoutes() []route {
+	const id = `([^/]+)`
+	appPath := adminAPI + "/groups/" + id + "/apps/" + id
+
+	newRoute := func(method, path string, handler func(w http.ResponseWriter, r *http.Request, params []string)) route {
+		return route{method: method, pattern: regexp.MustCompile("^" + path + "$"), handler: handler}
+	}
+
+	return []route{
+		{http.MethodGet, regexp.MustCompile("^" + privateAPI + "/version$"), true, s.handleStatus},
+		{http.MethodPost, regexp.MustCompile("^" + adminAPI + "/auth/providers/" + id + "/login$"), true, s.handleLogin},
+		{http.MethodPost, regexp.MustCompile("^" + adminAPI + "/auth/session$"), true, s.handleRefreshSession},
+		newRoute(http.MethodGet, adminAPI+"/auth/profile", s.handleAuthProfile),
+		newRoute(http.MethodGet, adminAPI+"/templates", s.handleTemplates),
+
+		newRoute(http.MethodGet, adminAPI+"/groups/"+id+"/apps", s.handleFindApps),
+		newRoute(http.MethodPost, adminAPI+"/groups/"+id+"/apps", s.handleCreateApp),
+		newRoute(http.MethodGet, appPath, s.withApp(s.handleGetApp)),
+		newRoute(http.MethodDelete, appPath, s.withApp(s.handleDeleteApp)),
+		newRoute(http.MethodGet, appPath+"/description", s.withApp(s.handleAppDescription)),
+		newRoute(http.MethodGet, appPath+"/templates", s.withApp(s.handleCompatibleTemplates)),
+		newRoute(http.MethodGet, appPath+"/templates/"+id+"/client", s.withApp(s.handleClientTemplate)),
+
+		newRoute(http.MethodPost, appPath+"/import", s.withApp(s.handleImport)),
+		newRoute(http.MethodGet, appPath+"/export", s.withApp(s.handleExport)),
+		newRoute(http.MethodGet, appPath+"/drafts", s.withApp(s.handleGetDrafts)),
+		newRoute(http.MethodPost, appPath+"/drafts", s.withApp(s.handleCreateDraft)),
+		newRoute(http.MethodDelete, appPath+"/drafts/"+id, s.withApp(s.handleDiscardDraft)),
+		newRoute(http.MethodGet, appPath+"/drafts/"+id+"/diff", s.withApp(s.handleDiffDraft)),
+		newRoute(http.MethodPost, appPath+"/drafts/"+id+"/deployment", s.withApp(s.handleDeployDraft)),
+		newRoute(http.MethodGet, appPath+"/deployments", s.withApp(s.handleGetDeployments)),
+		newRoute(http.MethodGet, appPath+"/deployments/"+id, s.withApp(s.handleGetDeployment)),
+		newRoute(http.MethodPost, appPath+"/deployments/"+id+"/redeploy", s.withApp(s.handleRedeploy)),
+
+		newRoute(http.MethodGet, appPath+"/secrets", s.withApp(s.handleGetSecrets)),
+		newRoute(http.MethodPost, appPath+"/secrets", s.withApp(s.handleCreateSecret)),
+		newRoute(http.MethodPut, appPath+"/secrets/"+id, s.withApp(s.handleUpdateSecret)),
+		newRoute(http.MethodDelete, appPath+"/secrets/"+id, s.withApp(s.handleDeleteSecret)),
+
+		newRoute(http.MethodPost, appPath+"/api_keys", s.withApp(s.handleCreateAPIKey)),
+		newRoute(http.MethodGet, appPath+"/user_registrations/pending_users", s.withApp(s.handleGetPendingUsers)),
+		newRoute(http.MethodGet, appPath+"/users", s.withApp(s.handleGetUsers)),
+		newRoute(http.MethodPost, appPath+"/users", s.withApp(s.handleCreateUser)),
+		newRoute(http.MethodGet, appPath+"/users/"+id, s.withApp(s.handleGetUser)),
+		newRoute(http.MethodDelete, appPath+"/users/"+id, s.withApp(s.handleDeleteUser)),
+		newRoute(http.MethodPut, appPath+"/users/"+id+"/disable", s.withApp(s.handleSetUserDisabled(true))),
+		newRoute(http.MethodPut, appPath+"/users/"+id+"/enable", s.withApp(s.handleSetUserDisabled(false))),
+		newRoute(http.MethodPut, appPath+"/users/"+id+"/logout", s.withApp(s.handleUserLogout)),
+
+		newRoute(http.MethodGet, appPath+"/hosting/assets", s.withApp(s.handleGetHostingAssets)),
+		newRoute(http.MethodPut, appPath+"/hosting/assets/asset", s.withApp(s.handleUploadHostingAsset)),
+		newRoute(http.MethodPatch, appPath+"/hosting/assets/asset", s.withApp(s.handleUpdateHostingAsset)),
+		newRoute(http.MethodDelete, appPath+"/hosting/assets/asset", s.withApp(s.handleRemoveHostingAsset)),
+		newRoute(http.MethodPut, appPath+"/hosting/cache", s.withApp(s.handleInvalidateHostingCache)),
+
+		newRoute(http.MethodGet, appPath+"/logs", s.withApp(s.handleGetLogs)),
+
+		newRoute(http.MethodGet, appPath+"/security/access_list", s.withApp(s.handleGetAllowedIPs)),
+		newRoute(http.MethodPost, appPath+"/security/access_list", s.withApp(s.handleCreateAllowedIP)),
+		newRoute(http.MethodPatch, appPath+"/security/access_list/"+id, s.withApp(s.handleUpdateAllowedIP)),
+		newRoute(http.MethodDelete, appPath+"/security/access_list/"+id, s.withApp(s.handleDeleteAllowedIP)),
+
+		newRoute(http.MethodGet, appPath+"/functions", s.withApp(s.handleGetFunctions)),
+		newRoute(http.MethodPost, appPath+"/debug/execute_function", s.withApp(s.handleExecuteFunction)),
+
+		newRoute(http.MethodGet, appPath+"/dependencies/status", s.withApp(s.handleDependenciesStatus)),
+		newRoute(http.MethodPut, appPath+"/dependencies", s.withApp(s.handleImportDependencies)),
+		newRoute(http.MethodPost, appPath+"/dependencies/diff", s.withApp(s.handleDiffDependencies)),
+		newRoute(http.MethodGet, appPath+"/dependencies/export", s.withApp(s.handleExportDependencies)),
+		newRoute(http.MethodGet, appPath+"/dependencies/archive", s.withApp(s.handleExportDependencies)),
+
+		newRoute(http.MethodGet, appPath+"/sync/client_schemas/"+id, s.withApp(s.handleGetSchemaModels)),
+	}
+}
+
+func (s *RealmServer) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	var pathMatched bool
+	for _, route := range s.routes {
+		match := route.pattern.FindStringSubmatch(r.URL.Path)
+		if match == nil {
+			continue
+		}
+		pathMatched = true
+
+		if route.method != r.Method {
+			continue
+		}
+
+		if !route.noAuth && !s.hasToken(s.accessTokens, bearerToken(r)) {
+			writeError(w, http.StatusUnauthorized, errCodeInvalidSession, "invalid session")
+			return
+		}
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		route.handler(w, r, match[1:])
+		return
+	}
+
+	if pathMatched {
+		writeError(w, http.StatusMethodNotAllowed, "", "method not allowed")
+		return
+	}
+	writeError(w, http.StatusNotFound, "", "resource not found")
+}
+
+func (s *RealmServer) handleStatus(w http.ResponseWriter, r *http.Request, params []string) {
+	writeJSON(w, http.StatusOK, map[string]string{"version": "fake"})
+}
+
+func (s *RealmServer) handleLogin(w http.ResponseWriter, r *http.Request, params []string) {
+	var creds struct {
+		Username string `json:"username"`
+		APIKey   string `json:"apiKey"`
+		Password string `json:"password"`
+	}
+	if !readJSON(w, r, &creds) {
+		return
+	}
+
+	password := creds.Password
+	if params[0] == realm.AuthTypeCloud {
+		password = creds.APIKey
+	}
+
+	if creds.Username == "" || password == "" {
+		writeError(w, http.StatusUnauthorized, "InvalidPassword", "invalid username/password")
+		return
+	}
+	if s.credentials != nil && s.credentials[creds.Username] != password {
+		writeError(w, http.StatusUnauthorized, "InvalidPassword", "invalid username/password")
+		return
+	}
+
+	session := realm.Session{AccessToken: newID(), RefreshToken: newID()}
+	s.accessTokens[session.AccessToken] = true
+	s.refreshTokens[session.RefreshToken] = true
+
+	writeJSON(w, http.StatusOK, session)
+}
+
+func (s *RealmServer) handleRefreshSession(w http.ResponseWriter, r *http.Request, params []string) {
+	if !s.refreshTokens[bearerToken(r)] {
+		writeError(w, http.StatusUnauthorized, errCodeInvalidSession, "invalid session")
+		return
+	}
+
+	accessToken := newID()
+	s.accessTokens[accessToken] = true
+
+	writeJSON(w, http.StatusCreated, realm.Session{AccessToken: accessToken})
+}
+
+func (s *RealmServer) handleAuthProfile(w http.ResponseWriter, r *http.Request, params []string) {
+	roles := make([]realm.Role, 0, len(s.groupIDs))
+	for _, groupID := range s.groupIDs {
+		roles = append(roles, realm.Role{GroupID: groupID})
+	}
+	writeJSON(w, http.StatusOK, realm.AuthProfile{Roles: roles})
+}
+
+func (s *RealmServer) hasToken(tokens map[string]bool, token string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return tokens[token]
+}
+
+func (s *RealmServer) hasGroup(groupID string) bool {
+	for _, id := range s.groupIDs {
+		if id == groupID {
+			return true
+		}
+	}
+	return false
+}
+
+func bearerToken(r *http.Request) string {
+	return strings.TrimPrefix(r.Header.Get(api.HeaderAuthorization), "Bearer ")
+}
+
+func newID() string {
+	return primitive.NewObjectID().Hex()
+}
+
+func readJSON(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, "", "failed to parse request body: "+err.Error())
+		return false
+	}
+	return true
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set(api.HeaderContentType, api.MediaTypeJSON)
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, code, message string) {
+	writeJSON(w, status, realm.ServerError{Code: code, Message: message})
+}
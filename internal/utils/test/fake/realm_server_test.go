@@ -0,0 +1,308 @@
+package fake_test
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/local"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/fake"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestRealmServerAuth(t *testing.T) {
+	server := fake.NewRealmServer()
+	defer server.Close()
+
+	server.AddCredentials("username", "password")
+
+	client := realm.NewClient(server.URL)
+
+	t.Run("should fail to authenticate with invalid credentials", func(t *testing.T) {
+		_, err := client.Authenticate(context.Background(), realm.AuthTypeCloud, user.Credentials{PublicAPIKey: "username", PrivateAPIKey: "wrong"})
+		assert.Equal(t, realm.ServerError{Code: "InvalidPassword", Message: "invalid username/password"}, err)
+	})
+
+	t.Run("should authenticate with valid credentials", func(t *testing.T) {
+		session, err := client.Authenticate(context.Background(), realm.AuthTypeLocal, user.Credentials{Username: "username", Password: "password"})
+		assert.Nil(t, err)
+		assert.NotEqual(t, "", session.AccessToken, "expected an access token")
+		assert.NotEqual(t, "", session.RefreshToken, "expected a refresh token")
+	})
+
+	t.Run("should fail to get the auth profile without a session", func(t *testing.T) {
+		_, err := client.AuthProfile(context.Background())
+		assert.Equal(t, realm.ErrInvalidSession(user.DefaultProfile), err)
+	})
+
+	t.Run("should get the auth profile and refresh an expired session", func(t *testing.T) {
+		authClient, profile, teardown := newAuthClient(t, server)
+		defer teardown()
+
+		session, err := profile.Session()
+		assert.Nil(t, err)
+		server.ExpireSessions()
+
+		authProfile, err := authClient.AuthProfile(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, server.GroupIDs(), authProfile.AllGroupIDs())
+
+		refreshed, err := profile.Session()
+		assert.Nil(t, err)
+		assert.NotEqual(t, session.AccessToken, refreshed.AccessToken, "expected the session to be refreshed")
+	})
+}
+
+func TestRealmServerApps(t *testing.T) {
+	server := fake.NewRealmServer()
+	defer server.Close()
+
+	client, _, teardown := newAuthClient(t, server)
+	defer teardown()
+
+	groupID := server.GroupIDs()[0]
+
+	app, err := client.CreateApp(context.Background(), groupID, "test-app", realm.AppMeta{Location: realm.LocationIreland})
+	assert.Nil(t, err)
+	assert.Equal(t, "test-app", app.Name)
+	assert.Equal(t, realm.LocationIreland, app.Location)
+
+	t.Run("should fail to create an app with a duplicate name", func(t *testing.T) {
+		_, err := client.CreateApp(context.Background(), groupID, "test-app", realm.AppMeta{})
+		assert.Equal(t, realm.ServerError{Code: "DuplicateAppName", Message: "app name 'test-app' is already in use"}, err)
+	})
+
+	t.Run("should find the app", func(t *testing.T) {
+		apps, err := client.FindApps(context.Background(), realm.AppFilter{App: app.ClientAppID})
+		assert.Nil(t, err)
+		assert.Equal(t, []realm.App{app}, apps)
+	})
+
+	t.Run("should fail to find an app in an unknown project", func(t *testing.T) {
+		_, err := client.FindApps(context.Background(), realm.AppFilter{GroupID: "unknown"})
+		assert.Equal(t, realm.ServerError{Code: "GroupNotFound", Message: "group not found"}, err)
+	})
+
+	appData := local.AsApp("", app, realm.DefaultAppConfigVersion).AppData
+
+	t.Run("should diff and import the app data as a new deployment", func(t *testing.T) {
+		diffs, err := client.Diff(context.Background(), groupID, app.ID, appData)
+		assert.Nil(t, err)
+		assert.True(t, len(diffs) > 0, "expected diffs against a new app")
+
+		assert.Nil(t, client.Import(context.Background(), groupID, app.ID, appData))
+
+		diffs, err = client.Diff(context.Background(), groupID, app.ID, appData)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{}, diffs)
+
+		deployments, err := client.Deployments(context.Background(), groupID, app.ID)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(deployments))
+		assert.Equal(t, realm.DeploymentStatusSuccessful, deployments[0].Status)
+	})
+
+	t.Run("should stage imports in a draft until it is deployed", func(t *testing.T) {
+		draft, err := client.CreateDraft(context.Background(), groupID, app.ID)
+		assert.Nil(t, err)
+
+		_, err = client.CreateDraft(context.Background(), groupID, app.ID)
+		assert.Equal(t, realm.ServerError{Code: realm.ErrCodeDraftAlreadyExists, Message: "a draft already exists"}, err)
+
+		stagedApp := local.AsApp("", app, realm.DefaultAppConfigVersion)
+		stagedApp.AppData.(*local.AppRealmConfigJSON).Values = []map[string]interface{}{{"name": "value", "value": "data"}}
+
+		assert.Nil(t, client.Import(context.Background(), groupID, app.ID, stagedApp.AppData))
+
+		diff, err := client.DiffDraft(context.Background(), groupID, app.ID, draft.ID)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"added: values"}, diff.Diffs)
+
+		assert.Equal(t, 1, len(server.Deployments(app.ID)))
+
+		deployment, err := client.DeployDraft(context.Background(), groupID, app.ID, draft.ID)
+		assert.Nil(t, err)
+		assert.Equal(t, realm.DeploymentStatusSuccessful, deployment.Status)
+
+		_, err = client.Draft(context.Background(), groupID, app.ID)
+		assert.Equal(t, realm.ErrDraftNotFound, err)
+
+		deployments, err := client.Deployments(context.Background(), groupID, app.ID)
+		assert.Nil(t, err)
+		assert.Equal(t, []realm.AppDeployment{deployment}, deployments[:1])
+	})
+
+	t.Run("should redeploy a previous deployment as a new deployment", func(t *testing.T) {
+		deployments, err := client.Deployments(context.Background(), groupID, app.ID)
+		assert.Nil(t, err)
+
+		previous := deployments[0]
+		data := server.AppData(app.ID)
+
+		assert.Nil(t, client.Redeploy(context.Background(), groupID, app.ID, previous.ID))
+
+		redeployments, err := client.Deployments(context.Background(), groupID, app.ID)
+		assert.Nil(t, err)
+		assert.Equal(t, len(deployments)+1, len(redeployments))
+		assert.NotEqual(t, previous.ID, redeployments[0].ID, "expected a new deployment")
+		assert.Equal(t, data, server.AppData(app.ID))
+
+		err = client.Redeploy(context.Background(), groupID, app.ID, "unknown")
+		assert.Equal(t, realm.ServerError{Code: "DeploymentNotFound", Message: "deployment not found"}, err)
+	})
+
+	t.Run("should export the deployed app", func(t *testing.T) {
+		filename, zipPkg, err := client.Export(context.Background(), groupID, app.ID, realm.ExportRequest{})
+		assert.Nil(t, err)
+		assert.True(t, filepath.Ext(filename) == ".zip", "expected a zip file but got %s", filename)
+
+		tmpDir, err := ioutil.TempDir("", "realm-cli-fake-test")
+		assert.Nil(t, err)
+		defer os.RemoveAll(tmpDir)
+
+		assert.Nil(t, local.WriteZip(tmpDir, zipPkg))
+
+		exported, err := local.LoadApp(tmpDir)
+		assert.Nil(t, err)
+		assert.Equal(t, app.Name, exported.Name())
+		assert.Equal(t, []map[string]interface{}{{"name": "value", "value": "data"}}, exported.AppData.(*local.AppRealmConfigJSON).Values)
+	})
+
+	t.Run("should delete the app", func(t *testing.T) {
+		assert.Nil(t, client.DeleteApp(context.Background(), groupID, app.ID))
+
+		_, err := client.FindApp(context.Background(), groupID, app.ID)
+		assert.Equal(t, realm.ServerError{Code: "AppNotFound", Message: "app not found"}, err)
+	})
+}
+
+func TestRealmServerResources(t *testing.T) {
+	server := fake.NewRealmServer()
+	defer server.Close()
+
+	client, _, teardown := newAuthClient(t, server)
+	defer teardown()
+
+	groupID := server.GroupIDs()[0]
+	app := server.AddApp(groupID, "test-app")
+
+	t.Run("should create, update, and delete secrets", func(t *testing.T) {
+		secret, err := client.CreateSecret(context.Background(), groupID, app.ID, "name", "value")
+		assert.Nil(t, err)
+
+		value, ok := server.SecretValue(app.ID, "name")
+		assert.True(t, ok, "expected the secret to exist")
+		assert.Equal(t, "value", value)
+
+		assert.Nil(t, client.UpdateSecret(context.Background(), groupID, app.ID, secret.ID, "name", "new value"))
+
+		value, _ = server.SecretValue(app.ID, "name")
+		assert.Equal(t, "new value", value)
+
+		assert.Nil(t, client.DeleteSecret(context.Background(), groupID, app.ID, secret.ID))
+
+		secrets, err := client.Secrets(context.Background(), groupID, app.ID)
+		assert.Nil(t, err)
+		assert.Equal(t, []realm.Secret{}, secrets)
+	})
+
+	t.Run("should create, find, disable, and delete users", func(t *testing.T) {
+		created, err := client.CreateUser(context.Background(), groupID, app.ID, "user@domain.com", "password")
+		assert.Nil(t, err)
+
+		apiKey, err := client.CreateAPIKey(context.Background(), groupID, app.ID, "key")
+		assert.Nil(t, err)
+
+		users, err := client.FindUsers(context.Background(), groupID, app.ID, realm.UserFilter{
+			Providers: []realm.AuthProviderType{realm.AuthProviderTypeUserPassword},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, []realm.User{created}, users)
+
+		assert.Nil(t, client.DisableUser(context.Background(), groupID, app.ID, apiKey.ID))
+
+		users, err = client.FindUsers(context.Background(), groupID, app.ID, realm.UserFilter{State: realm.UserStateDisabled})
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(users))
+		assert.Equal(t, apiKey.ID, users[0].ID)
+
+		assert.Nil(t, client.DeleteUser(context.Background(), groupID, app.ID, created.ID))
+
+		users, err = client.FindUsers(context.Background(), groupID, app.ID, realm.UserFilter{})
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(users))
+	})
+
+	t.Run("should upload, update, and remove hosting assets", func(t *testing.T) {
+		rootDir, err := ioutil.TempDir("", "realm-cli-fake-test")
+		assert.Nil(t, err)
+		defer os.RemoveAll(rootDir)
+		assert.Nil(t, ioutil.WriteFile(filepath.Join(rootDir, "index.html"), []byte("<html></html>"), 0666))
+
+		asset := realm.HostingAsset{HostingAssetData: realm.HostingAssetData{FilePath: "/index.html", FileHash: "hash"}}
+		assert.Nil(t, client.HostingAssetUpload(context.Background(), groupID, app.ID, rootDir, asset))
+
+		attr := realm.HostingAssetAttribute{Name: "Content-Type", Value: "text/html"}
+		assert.Nil(t, client.HostingAssetAttributesUpdate(context.Background(), groupID, app.ID, "/index.html", attr))
+
+		assets, err := client.HostingAssets(context.Background(), groupID, app.ID)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(assets))
+		assert.Equal(t, "/index.html", assets[0].FilePath)
+		assert.Equal(t, "hash", assets[0].FileHash)
+		assert.Equal(t, int64(13), assets[0].FileSize)
+		assert.Equal(t, realm.HostingAssetAttributes{attr}, assets[0].Attrs)
+
+		assert.Nil(t, client.HostingAssetRemove(context.Background(), groupID, app.ID, "/index.html"))
+
+		assets, err = client.HostingAssets(context.Background(), groupID, app.ID)
+		assert.Nil(t, err)
+		assert.Equal(t, 0, len(assets))
+	})
+
+	t.Run("should filter and sort the seeded logs", func(t *testing.T) {
+		start := time.Date(2021, time.June, 22, 7, 54, 42, 0, time.UTC)
+
+		server.AddLogs(app.ID,
+			realm.Log{Type: realm.LogTypeFunction, Started: start},
+			realm.Log{Type: realm.LogTypeFunction, Started: start.Add(time.Minute), Error: "something bad happened"},
+			realm.Log{Type: realm.LogTypeAuth, Started: start.Add(2 * time.Minute)},
+		)
+
+		logs, err := client.Logs(context.Background(), groupID, app.ID, realm.LogsOptions{})
+		assert.Nil(t, err)
+		assert.Equal(t, 3, len(logs))
+		assert.Equal(t, realm.LogTypeAuth, logs[0].Type)
+
+		logs, err = client.Logs(context.Background(), groupID, app.ID, realm.LogsOptions{Types: []string{realm.LogTypeFunction}, ErrorsOnly: true})
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(logs))
+		assert.Equal(t, "something bad happened", logs[0].Error)
+
+		logs, err = client.Logs(context.Background(), groupID, app.ID, realm.LogsOptions{Start: start.Add(time.Minute)})
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(logs))
+	})
+}
+
+func newAuthClient(t *testing.T, server *fake.RealmServer) (realm.Client, *user.Profile, func()) {
+	t.Helper()
+
+	session, err := realm.NewClient(server.URL).Authenticate(context.Background(), realm.AuthTypeCloud, user.Credentials{
+		PublicAPIKey:  "username",
+		PrivateAPIKey: "password",
+	})
+	assert.Nil(t, err)
+
+	profile, teardown := mock.NewProfileFromTmpDir(t, "realm-cli-fake-test")
+	profile.SetRealmBaseURL(server.URL)
+	profile.SetSession(user.Session{session.AccessToken, session.RefreshToken})
+
+	return realm.NewAuthClient(server.URL, profile), profile, teardown
+}
@@ -1,6 +1,9 @@
 package mock
 
-import "github.com/10gen/realm-cli/internal/cloud/atlas"
+import (
+	"context"
+	"github.com/10gen/realm-cli/internal/cloud/atlas"
+)
 
 // AtlasClient is a mocked Atlas client
 type AtlasClient struct {
@@ -14,39 +17,39 @@ type AtlasClient struct {
 // Groups calls the mocked Groups implementation if provided,
 // otherwise the call falls back to the underlying atlas.Client implementation.
 // NOTE: this may panic if the underlying atlas.Client is left undefined
-func (ac AtlasClient) Groups(url string, useBaseURL bool) (atlas.Groups, error) {
+func (ac AtlasClient) Groups(ctx context.Context, url string, useBaseURL bool) (atlas.Groups, error) {
 	if ac.GroupsFn != nil {
 		return ac.GroupsFn(url, useBaseURL)
 	}
-	return ac.Client.Groups(url, useBaseURL)
+	return ac.Client.Groups(ctx, url, useBaseURL)
 }
 
 // Clusters calls the mocked Clusters implementation if provided,
 // otherwise the call falls back to the underlying atlas.Client implementation.
 // NOTE: this may panic if the underlying atlas.Client is left undefined
-func (ac AtlasClient) Clusters(groupID string) ([]atlas.Cluster, error) {
+func (ac AtlasClient) Clusters(ctx context.Context, groupID string) ([]atlas.Cluster, error) {
 	if ac.ClustersFn != nil {
 		return ac.ClustersFn(groupID)
 	}
-	return ac.Client.Clusters(groupID)
+	return ac.Client.Clusters(ctx, groupID)
 }
 
 // ServerlessInstances calls the mocked ServerlessInstances implementation if provided,
 // otherwise the call falls back to the underlying atlas.Client implementation.
 // NOTE: this may panic if the underlying atlas.Client is left undefined
-func (ac AtlasClient) ServerlessInstances(groupID string) ([]atlas.ServerlessInstance, error) {
+func (ac AtlasClient) ServerlessInstances(ctx context.Context, groupID string) ([]atlas.ServerlessInstance, error) {
 	if ac.ServerlessInstancesFn != nil {
 		return ac.ServerlessInstancesFn(groupID)
 	}
-	return ac.Client.ServerlessInstances(groupID)
+	return ac.Client.ServerlessInstances(ctx, groupID)
 }
 
 // Datalakes calls the mocked Datalakes implementation if provided,
 // otherwise the call falls back to the underlying atlas.Client implementation.
 // NOTE: this may panic if the underlying atlas.Client is left undefined
-func (ac AtlasClient) Datalakes(groupID string) ([]atlas.Datalake, error) {
+func (ac AtlasClient) Datalakes(ctx context.Context, groupID string) ([]atlas.Datalake, error) {
 	if ac.DatalakesFn != nil {
 		return ac.DatalakesFn(groupID)
 	}
-	return ac.Client.Datalakes(groupID)
+	return ac.Client.Datalakes(ctx, groupID)
 }
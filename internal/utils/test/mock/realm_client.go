@@ -2,6 +2,7 @@ package mock
 
 import (
 	"archive/zip"
+	"context"
 	"io"
 
 	"github.com/10gen/realm-cli/internal/cli/user"
@@ -37,7 +38,9 @@ type RealmClient struct {
 	DraftFn        func(groupID, appID string) (realm.AppDraft, error)
 
 	DeployDraftFn func(groupID, appID, draftID string) (realm.AppDeployment, error)
+	DeploymentsFn func(groupID, appID string) ([]realm.AppDeployment, error)
 	DeploymentFn  func(groupID, appID, deploymentID string) (realm.AppDeployment, error)
+	RedeployFn    func(groupID, appID, deploymentID string) error
 
 	SecretsFn      func(groupID, appID string) ([]realm.Secret, error)
 	CreateSecretFn func(groupID, appID, name, value string) (realm.Secret, error)
@@ -61,7 +64,8 @@ type RealmClient struct {
 	FunctionsFn               func(groupID, appID string) ([]realm.Function, error)
 	AppDebugExecuteFunctionFn func(groupID, appID, userID, name string, args []interface{}) (realm.ExecutionResults, error)
 
-	LogsFn func(groupID, appID string, opts realm.LogsOptions) (realm.Logs, error)
+	LogsFn     func(groupID, appID string, opts realm.LogsOptions) (realm.Logs, error)
+	LogsPageFn func(groupID, appID string, opts realm.LogsOptions) (realm.LogsPage, error)
 
 	SchemaModelsFn func(groupID, appID, language string) ([]realm.SchemaModel, error)
 
@@ -80,489 +84,519 @@ type RealmClient struct {
 // Authenticate calls the mocked Authenticate implementation if provided,
 // otherwise the call falls back to the underlying realm.Client implementation.
 // NOTE: this may panic if the underlying realm.Client is left undefined
-func (rc RealmClient) Authenticate(authType string, creds user.Credentials) (realm.Session, error) {
+func (rc RealmClient) Authenticate(ctx context.Context, authType string, creds user.Credentials) (realm.Session, error) {
 	if rc.AuthenticateFn != nil {
 		return rc.AuthenticateFn(authType, creds)
 	}
-	return rc.Client.Authenticate(authType, creds)
+	return rc.Client.Authenticate(ctx, authType, creds)
 }
 
 // AuthProfile calls the mocked AuthProfile implementation if provided,
 // otherwise the call falls back to the underlying realm.Client implementation.
 // NOTE: this may panic if the underlying realm.Client is left undefined
-func (rc RealmClient) AuthProfile() (realm.AuthProfile, error) {
+func (rc RealmClient) AuthProfile(ctx context.Context) (realm.AuthProfile, error) {
 	if rc.AuthProfileFn != nil {
 		return rc.AuthProfileFn()
 	}
-	return rc.Client.AuthProfile()
+	return rc.Client.AuthProfile(ctx)
 }
 
 // Export calls the mocked Export implementation if provided,
 // otherwise the call falls back to the underlying realm.Client implementation.
 // NOTE: this may panic if the underlying realm.Client is left undefined
-func (rc RealmClient) Export(groupID, appID string, req realm.ExportRequest) (string, *zip.Reader, error) {
+func (rc RealmClient) Export(ctx context.Context, groupID, appID string, req realm.ExportRequest) (string, *zip.Reader, error) {
 	if rc.ExportFn != nil {
 		return rc.ExportFn(groupID, appID, req)
 	}
-	return rc.Client.Export(groupID, appID, req)
+	return rc.Client.Export(ctx, groupID, appID, req)
 }
 
 // Import calls the mocked Import implementation if provided,
 // otherwise the call falls back to the underlying realm.Client implementation.
 // NOTE: this may panic if the underlying realm.Client is left undefined
-func (rc RealmClient) Import(groupID, appID string, appData interface{}) error {
+func (rc RealmClient) Import(ctx context.Context, groupID, appID string, appData interface{}) error {
 	if rc.ImportFn != nil {
 		return rc.ImportFn(groupID, appID, appData)
 	}
-	return rc.Client.Import(groupID, appID, appData)
+	return rc.Client.Import(ctx, groupID, appID, appData)
 }
 
 // Diff calls the mocked Diff implementation if provided,
 // otherwise the call falls back to the underlying realm.Client implementation.
 // NOTE: this may panic if the underlying realm.Client is left undefined
-func (rc RealmClient) Diff(groupID, appID string, appData interface{}) ([]string, error) {
+func (rc RealmClient) Diff(ctx context.Context, groupID, appID string, appData interface{}) ([]string, error) {
 	if rc.DiffFn != nil {
 		return rc.DiffFn(groupID, appID, appData)
 	}
-	return rc.Client.Diff(groupID, appID, appData)
+	return rc.Client.Diff(ctx, groupID, appID, appData)
 }
 
 // CreateApp calls the mocked CreateApp implementation if provided,
 // otherwise the call falls back to the underlying realm.Client implementation.
 // NOTE: this may panic if the underlying realm.Client is left undefined
-func (rc RealmClient) CreateApp(groupID, name string, meta realm.AppMeta) (realm.App, error) {
+func (rc RealmClient) CreateApp(ctx context.Context, groupID, name string, meta realm.AppMeta) (realm.App, error) {
 	if rc.CreateAppFn != nil {
 		return rc.CreateAppFn(groupID, name, meta)
 	}
-	return rc.Client.CreateApp(groupID, name, meta)
+	return rc.Client.CreateApp(ctx, groupID, name, meta)
 }
 
 // DeleteApp calls the mocked DeleteApp implementation if provided,
 // otherwise the call falls back to the underlying realm.Client implementation.
 // NOTE: this may panic if the underlying realm.Client is left undefined
-func (rc RealmClient) DeleteApp(groupID, appID string) error {
+func (rc RealmClient) DeleteApp(ctx context.Context, groupID, appID string) error {
 	if rc.DeleteAppFn != nil {
 		return rc.DeleteAppFn(groupID, appID)
 	}
-	return rc.Client.DeleteApp(groupID, appID)
+	return rc.Client.DeleteApp(ctx, groupID, appID)
 }
 
 // FindApp calls the mocked FindApp implementation if provided,
 // otherwise the call falls back to the underlying realm.Client implementation.
 // NOTE: this may panic if the underlying realm.Client is left undefined
-func (rc RealmClient) FindApp(groupID, appID string) (realm.App, error) {
+func (rc RealmClient) FindApp(ctx context.Context, groupID, appID string) (realm.App, error) {
 	if rc.FindAppFn != nil {
 		return rc.FindAppFn(groupID, appID)
 	}
-	return rc.Client.FindApp(groupID, appID)
+	return rc.Client.FindApp(ctx, groupID, appID)
 }
 
 // FindApps calls the mocked FindApps implementation if provided,
 // otherwise the call falls back to the underlying realm.Client implementation.
 // NOTE: this may panic if the underlying realm.Client is left undefined
-func (rc RealmClient) FindApps(filter realm.AppFilter) ([]realm.App, error) {
+func (rc RealmClient) FindApps(ctx context.Context, filter realm.AppFilter) ([]realm.App, error) {
 	if rc.FindAppsFn != nil {
 		return rc.FindAppsFn(filter)
 	}
-	return rc.Client.FindApps(filter)
+	return rc.Client.FindApps(ctx, filter)
 }
 
 // AppDescription calls the mocked AppDescription implementation if provided,
 // otherwise the call falls back to the underlying realm.Client implementation.
 // NOTE: this may panic if the underlying realm.Client is left undefined
-func (rc RealmClient) AppDescription(groupID, appID string) (realm.AppDescription, error) {
+func (rc RealmClient) AppDescription(ctx context.Context, groupID, appID string) (realm.AppDescription, error) {
 	if rc.AppDescriptionFn != nil {
 		return rc.AppDescriptionFn(groupID, appID)
 	}
-	return rc.Client.AppDescription(groupID, appID)
+	return rc.Client.AppDescription(ctx, groupID, appID)
 }
 
 // CreateDraft calls the mocked CreateDraft implementation if provided,
 // otherwise the call falls back to the underlying realm.Client implementation.
 // NOTE: this may panic if the underlying realm.Client is left undefined
-func (rc RealmClient) CreateDraft(groupID, appID string) (realm.AppDraft, error) {
+func (rc RealmClient) CreateDraft(ctx context.Context, groupID, appID string) (realm.AppDraft, error) {
 	if rc.CreateDraftFn != nil {
 		return rc.CreateDraftFn(groupID, appID)
 	}
-	return rc.Client.CreateDraft(groupID, appID)
+	return rc.Client.CreateDraft(ctx, groupID, appID)
 }
 
 // DeployDraft calls the mocked DeployDraft implementation if provided,
 // otherwise the call falls back to the underlying realm.Client implementation.
 // NOTE: this may panic if the underlying realm.Client is left undefined
-func (rc RealmClient) DeployDraft(groupID, appID, draftID string) (realm.AppDeployment, error) {
+func (rc RealmClient) DeployDraft(ctx context.Context, groupID, appID, draftID string) (realm.AppDeployment, error) {
 	if rc.DeployDraftFn != nil {
 		return rc.DeployDraftFn(groupID, appID, draftID)
 	}
-	return rc.Client.DeployDraft(groupID, appID, draftID)
+	return rc.Client.DeployDraft(ctx, groupID, appID, draftID)
 }
 
 // DiffDraft calls the mocked DiffDraft implementation if provided,
 // otherwise the call falls back to the underlying realm.Client implementation.
 // NOTE: this may panic if the underlying realm.Client is left undefined
-func (rc RealmClient) DiffDraft(groupID, appID, draftID string) (realm.AppDraftDiff, error) {
+func (rc RealmClient) DiffDraft(ctx context.Context, groupID, appID, draftID string) (realm.AppDraftDiff, error) {
 	if rc.DiffDraftFn != nil {
 		return rc.DiffDraftFn(groupID, appID, draftID)
 	}
-	return rc.Client.DiffDraft(groupID, appID, draftID)
+	return rc.Client.DiffDraft(ctx, groupID, appID, draftID)
 }
 
 // DiscardDraft calls the mocked DiscardDraft implementation if provided,
 // otherwise the call falls back to the underlying realm.Client implementation.
 // NOTE: this may panic if the underlying realm.Client is left undefined
-func (rc RealmClient) DiscardDraft(groupID, appID, draftID string) error {
+func (rc RealmClient) DiscardDraft(ctx context.Context, groupID, appID, draftID string) error {
 	if rc.DiscardDraftFn != nil {
 		return rc.DiscardDraftFn(groupID, appID, draftID)
 	}
-	return rc.Client.DiscardDraft(groupID, appID, draftID)
+	return rc.Client.DiscardDraft(ctx, groupID, appID, draftID)
 }
 
 // Draft calls the mocked Draft implementation if provided,
 // otherwise the call falls back to the underlying realm.Client implementation.
 // NOTE: this may panic if the underlying realm.Client is left undefined
-func (rc RealmClient) Draft(groupID, appID string) (realm.AppDraft, error) {
+func (rc RealmClient) Draft(ctx context.Context, groupID, appID string) (realm.AppDraft, error) {
 	if rc.DraftFn != nil {
 		return rc.DraftFn(groupID, appID)
 	}
-	return rc.Client.Draft(groupID, appID)
+	return rc.Client.Draft(ctx, groupID, appID)
+}
+
+// Deployments calls the mocked Deployments implementation if provided,
+// otherwise the call falls back to the underlying realm.Client implementation.
+// NOTE: this may panic if the underlying realm.Client is left undefined
+func (rc RealmClient) Deployments(ctx context.Context, groupID, appID string) ([]realm.AppDeployment, error) {
+	if rc.DeploymentsFn != nil {
+		return rc.DeploymentsFn(groupID, appID)
+	}
+	return rc.Client.Deployments(ctx, groupID, appID)
 }
 
 // Deployment calls the mocked Deployment implementation if provided,
 // otherwise the call falls back to the underlying realm.Client implementation.
 // NOTE: this may panic if the underlying realm.Client is left undefined
-func (rc RealmClient) Deployment(groupID, appID, deploymentID string) (realm.AppDeployment, error) {
+func (rc RealmClient) Deployment(ctx context.Context, groupID, appID, deploymentID string) (realm.AppDeployment, error) {
 	if rc.DeploymentFn != nil {
 		return rc.DeploymentFn(groupID, appID, deploymentID)
 	}
-	return rc.Client.Deployment(groupID, appID, deploymentID)
+	return rc.Client.Deployment(ctx, groupID, appID, deploymentID)
+}
+
+// Redeploy calls the mocked Redeploy implementation if provided,
+// otherwise the call falls back to the underlying realm.Client implementation.
+// NOTE: this may panic if the underlying realm.Client is left undefined
+func (rc RealmClient) Redeploy(ctx context.Context, groupID, appID, deploymentID string) error {
+	if rc.RedeployFn != nil {
+		return rc.RedeployFn(groupID, appID, deploymentID)
+	}
+	return rc.Client.Redeploy(ctx, groupID, appID, deploymentID)
 }
 
 // DependenciesStatus calls the mocked DependenciesStatus implementation if provided,
 // otherwise the call falls back to the underlying realm.Client implementation.
 // NOTE: this may panic if the underlying realm.Client is left undefined
-func (rc RealmClient) DependenciesStatus(groupID, appID string) (realm.DependenciesStatus, error) {
+func (rc RealmClient) DependenciesStatus(ctx context.Context, groupID, appID string) (realm.DependenciesStatus, error) {
 	if rc.DependenciesStatusFn != nil {
 		return rc.DependenciesStatusFn(groupID, appID)
 	}
-	return rc.Client.DependenciesStatus(groupID, appID)
+	return rc.Client.DependenciesStatus(ctx, groupID, appID)
 }
 
 // CreateAPIKey calls the mocked CreateAPIKey implementation if provided,
 // otherwise the call falls back to the underlying realm.Client implementation.
 // NOTE: this may panic if the underlying realm.Client is left undefined
-func (rc RealmClient) CreateAPIKey(groupID, appID, apiKeyName string) (realm.APIKey, error) {
+func (rc RealmClient) CreateAPIKey(ctx context.Context, groupID, appID, apiKeyName string) (realm.APIKey, error) {
 	if rc.CreateAPIKeyFn != nil {
 		return rc.CreateAPIKeyFn(groupID, appID, apiKeyName)
 	}
-	return rc.Client.CreateAPIKey(groupID, appID, apiKeyName)
+	return rc.Client.CreateAPIKey(ctx, groupID, appID, apiKeyName)
 }
 
 // Secrets calls the mocked Secrets implementation if provided,
 // otherwise the call falls back to the underlying realm.Client implementation.
 // NOTE: this may panic if the underlying realm.Client is left undefined
-func (rc RealmClient) Secrets(groupID, appID string) ([]realm.Secret, error) {
+func (rc RealmClient) Secrets(ctx context.Context, groupID, appID string) ([]realm.Secret, error) {
 	if rc.SecretsFn != nil {
 		return rc.SecretsFn(groupID, appID)
 	}
-	return rc.Client.Secrets(groupID, appID)
+	return rc.Client.Secrets(ctx, groupID, appID)
 }
 
 // CreateSecret calls the mocked CreateSecret implementation if provided,
 // otherwise the call falls back to the underlying realm.Client implementation.
 // NOTE: this may panic if the underlying realm.Client is left undefined
-func (rc RealmClient) CreateSecret(groupID, appID, name, value string) (realm.Secret, error) {
+func (rc RealmClient) CreateSecret(ctx context.Context, groupID, appID, name, value string) (realm.Secret, error) {
 	if rc.CreateSecretFn != nil {
 		return rc.CreateSecretFn(groupID, appID, name, value)
 	}
-	return rc.Client.CreateSecret(groupID, appID, name, value)
+	return rc.Client.CreateSecret(ctx, groupID, appID, name, value)
 }
 
 // DeleteSecret calls the mocked DeleteSecret implementation if provided,
 // otherwise the call falls back to the underlying realm.Client implementation
 // NOTE: this may panic if the underlying realm.Client is left undefined
-func (rc RealmClient) DeleteSecret(groupID, appID, secretID string) error {
+func (rc RealmClient) DeleteSecret(ctx context.Context, groupID, appID, secretID string) error {
 	if rc.DeleteSecretFn != nil {
 		return rc.DeleteSecretFn(groupID, appID, secretID)
 	}
-	return rc.Client.DeleteSecret(groupID, appID, secretID)
+	return rc.Client.DeleteSecret(ctx, groupID, appID, secretID)
 }
 
 // UpdateSecret calls the mocked UpdateSecret implementation if provided,
 // otherwise the call falls back to the underlying realm.Client implementation
 // NOTE: this may panic if the underlying realm.Client is left undefined
-func (rc RealmClient) UpdateSecret(groupID, appID, secretID, name, value string) error {
+func (rc RealmClient) UpdateSecret(ctx context.Context, groupID, appID, secretID, name, value string) error {
 	if rc.UpdateSecretFn != nil {
 		return rc.UpdateSecretFn(groupID, appID, secretID, name, value)
 	}
-	return rc.Client.UpdateSecret(groupID, appID, secretID, name, value)
+	return rc.Client.UpdateSecret(ctx, groupID, appID, secretID, name, value)
 }
 
 // CreateUser calls the mocked CreateUser implementation if provided,
 // otherwise the call falls back to the underlying realm.Client implementation.
 // NOTE: this may panic if the underlying realm.Client is left undefined
-func (rc RealmClient) CreateUser(groupID, appID, email, password string) (realm.User, error) {
+func (rc RealmClient) CreateUser(ctx context.Context, groupID, appID, email, password string) (realm.User, error) {
 	if rc.CreateUserFn != nil {
 		return rc.CreateUserFn(groupID, appID, email, password)
 	}
-	return rc.Client.CreateUser(groupID, appID, email, password)
+	return rc.Client.CreateUser(ctx, groupID, appID, email, password)
 }
 
 // DeleteUser calls the mocked DeleteUser implementation if provided,
 // otherwise the call falls back to the underlying realm.Client implementation.
 // NOTE: this may panic if the underlying realm.Client is left undefined
-func (rc RealmClient) DeleteUser(groupID, appID, userID string) error {
+func (rc RealmClient) DeleteUser(ctx context.Context, groupID, appID, userID string) error {
 	if rc.DeleteUserFn != nil {
 		return rc.DeleteUserFn(groupID, appID, userID)
 	}
-	return rc.Client.DeleteUser(groupID, appID, userID)
+	return rc.Client.DeleteUser(ctx, groupID, appID, userID)
 }
 
 // DisableUser calls the mocked DisableUser implementation if provided,
 // otherwise the call falls back to the underlying realm.Client implementation.
 // NOTE: this may panic if the underlying realm.Client is left undefined
-func (rc RealmClient) DisableUser(groupID, appID, userID string) error {
+func (rc RealmClient) DisableUser(ctx context.Context, groupID, appID, userID string) error {
 	if rc.DisableUserFn != nil {
 		return rc.DisableUserFn(groupID, appID, userID)
 	}
-	return rc.Client.DisableUser(groupID, appID, userID)
+	return rc.Client.DisableUser(ctx, groupID, appID, userID)
 }
 
 // EnableUser calls the mocked EnableUser implementation if provided,
 // otherwise the call falls back to the underlying realm.Client implementation.
 // NOTE: this may panic if the underlying realm.Client is left undefined
-func (rc RealmClient) EnableUser(groupID, appID, userID string) error {
+func (rc RealmClient) EnableUser(ctx context.Context, groupID, appID, userID string) error {
 	if rc.EnableUserFn != nil {
 		return rc.EnableUserFn(groupID, appID, userID)
 	}
-	return rc.Client.EnableUser(groupID, appID, userID)
+	return rc.Client.EnableUser(ctx, groupID, appID, userID)
 }
 
 // FindUsers calls the mocked FindUsers implementation if provided,
 // otherwise the call falls back to the underlying realm.Client implementation.
 // NOTE: this may panic if the underlying realm.Client is left undefined
-func (rc RealmClient) FindUsers(groupID, appID string, filter realm.UserFilter) ([]realm.User, error) {
+func (rc RealmClient) FindUsers(ctx context.Context, groupID, appID string, filter realm.UserFilter) ([]realm.User, error) {
 	if rc.FindUsersFn != nil {
 		return rc.FindUsersFn(groupID, appID, filter)
 	}
-	return rc.Client.FindUsers(groupID, appID, filter)
+	return rc.Client.FindUsers(ctx, groupID, appID, filter)
 }
 
 // RevokeUserSessions calls the mocked RevokeUserSessions implementation if provided,
 // otherwise the call falls back to the underlying realm.Client implementation.
 // NOTE: this may panic if the underlying realm.Client is left undefined
-func (rc RealmClient) RevokeUserSessions(groupID, appID, userID string) error {
+func (rc RealmClient) RevokeUserSessions(ctx context.Context, groupID, appID, userID string) error {
 	if rc.RevokeUserSessionFn != nil {
 		return rc.RevokeUserSessionFn(groupID, appID, userID)
 	}
-	return rc.Client.RevokeUserSessions(groupID, appID, userID)
+	return rc.Client.RevokeUserSessions(ctx, groupID, appID, userID)
 }
 
 // ExportDependencies calls the mocked ExportDependencies implementation if provided,
 // otherwise the call falls back to the underlying realm.Client implementation.
 // NOTE: this may panic if the underlying realm.Client is left undefined
-func (rc RealmClient) ExportDependencies(groupID, appID string) (string, io.ReadCloser, error) {
+func (rc RealmClient) ExportDependencies(ctx context.Context, groupID, appID string) (string, io.ReadCloser, error) {
 	if rc.ExportDependenciesFn != nil {
 		return rc.ExportDependenciesFn(groupID, appID)
 	}
-	return rc.Client.ExportDependencies(groupID, appID)
+	return rc.Client.ExportDependencies(ctx, groupID, appID)
 }
 
 // ExportDependenciesArchive calls the mocked ExportDependenciesArchive implementation if provided,
 // otherwise the call falls back to the underlying realm.Client implementation.
 // NOTE: this may panic if the underlying realm.Client is left undefined
-func (rc RealmClient) ExportDependenciesArchive(groupID, appID string) (string, io.ReadCloser, error) {
+func (rc RealmClient) ExportDependenciesArchive(ctx context.Context, groupID, appID string) (string, io.ReadCloser, error) {
 	if rc.ExportDependenciesArchiveFn != nil {
 		return rc.ExportDependenciesArchiveFn(groupID, appID)
 	}
-	return rc.Client.ExportDependenciesArchive(groupID, appID)
+	return rc.Client.ExportDependenciesArchive(ctx, groupID, appID)
 }
 
 // ImportDependencies calls the mocked ImportDependencies implementation if provided,
 // otherwise the call falls back to the underlying realm.Client implementation.
 // NOTE: this may panic if the underlying realm.Client is left undefined
-func (rc RealmClient) ImportDependencies(groupID, appID, uploadPath string) error {
+func (rc RealmClient) ImportDependencies(ctx context.Context, groupID, appID, uploadPath string) error {
 	if rc.ImportDependenciesFn != nil {
 		return rc.ImportDependenciesFn(groupID, appID, uploadPath)
 	}
-	return rc.Client.ImportDependencies(groupID, appID, uploadPath)
+	return rc.Client.ImportDependencies(ctx, groupID, appID, uploadPath)
 }
 
 // DiffDependencies calls the mocked DiffDependencies implementation if provided,
 // otherwise the call falls back to the underlying realm.Client implementation.
 // NOTE: this may panic if the underlying realm.Client is left undefined
-func (rc RealmClient) DiffDependencies(groupID, appID, uploadPath string) (realm.DependenciesDiff, error) {
+func (rc RealmClient) DiffDependencies(ctx context.Context, groupID, appID, uploadPath string) (realm.DependenciesDiff, error) {
 	if rc.DiffDependenciesFn != nil {
 		return rc.DiffDependenciesFn(groupID, appID, uploadPath)
 	}
-	return rc.Client.DiffDependencies(groupID, appID, uploadPath)
+	return rc.Client.DiffDependencies(ctx, groupID, appID, uploadPath)
 }
 
 // HostingAssets calls the mocked HostingAssets implementation if provided,
 // otherwise the call falls back to the underlying realm.Client implementation.
 // NOTE: this may panic if the underlying realm.Client is left undefined
-func (rc RealmClient) HostingAssets(groupID, appID string) ([]realm.HostingAsset, error) {
+func (rc RealmClient) HostingAssets(ctx context.Context, groupID, appID string) ([]realm.HostingAsset, error) {
 	if rc.HostingAssetsFn != nil {
 		return rc.HostingAssetsFn(groupID, appID)
 	}
-	return rc.Client.HostingAssets(groupID, appID)
+	return rc.Client.HostingAssets(ctx, groupID, appID)
 }
 
 // HostingAssetUpload calls the mocked HostingAssetUpload implementation if provided,
 // otherwise the call falls back to the underlying realm.Client implementation.
 // NOTE: this may panic if the underlying realm.Client is left undefined
-func (rc RealmClient) HostingAssetUpload(groupID, appID, rootDir string, asset realm.HostingAsset) error {
+func (rc RealmClient) HostingAssetUpload(ctx context.Context, groupID, appID, rootDir string, asset realm.HostingAsset) error {
 	if rc.HostingAssetUploadFn != nil {
 		return rc.HostingAssetUploadFn(groupID, appID, rootDir, asset)
 	}
-	return rc.Client.HostingAssetUpload(groupID, appID, rootDir, asset)
+	return rc.Client.HostingAssetUpload(ctx, groupID, appID, rootDir, asset)
 }
 
 // HostingAssetRemove calls the mocked HostingAssetRemove implementation if provided,
 // otherwise the call falls back to the underlying realm.Client implementation.
 // NOTE: this may panic if the underlying realm.Client is left undefined
-func (rc RealmClient) HostingAssetRemove(groupID, appID, path string) error {
+func (rc RealmClient) HostingAssetRemove(ctx context.Context, groupID, appID, path string) error {
 	if rc.HostingAssetRemoveFn != nil {
 		return rc.HostingAssetRemoveFn(groupID, appID, path)
 	}
-	return rc.Client.HostingAssetRemove(groupID, appID, path)
+	return rc.Client.HostingAssetRemove(ctx, groupID, appID, path)
 }
 
 // HostingAssetAttributesUpdate calls the mocked HostingAssetAttributesUpdate implementation if provided,
 // otherwise the call falls back to the underlying realm.Client implementation.
 // NOTE: this may panic if the underlying realm.Client is left undefined
-func (rc RealmClient) HostingAssetAttributesUpdate(groupID, appID, path string, attrs ...realm.HostingAssetAttribute) error {
+func (rc RealmClient) HostingAssetAttributesUpdate(ctx context.Context, groupID, appID, path string, attrs ...realm.HostingAssetAttribute) error {
 	if rc.HostingAssetAttributesUpdateFn != nil {
 		return rc.HostingAssetAttributesUpdateFn(groupID, appID, path, attrs...)
 	}
-	return rc.Client.HostingAssetAttributesUpdate(groupID, appID, path, attrs...)
+	return rc.Client.HostingAssetAttributesUpdate(ctx, groupID, appID, path, attrs...)
 }
 
 // HostingCacheInvalidate calls the mocked HostingCacheInvalidate implementation if provided,
 // otherwise the call falls back to the underlying realm.Client implementation.
 // NOTE: this may panic if the underlying realm.Client is left undefined
-func (rc RealmClient) HostingCacheInvalidate(groupID, appID, path string) error {
+func (rc RealmClient) HostingCacheInvalidate(ctx context.Context, groupID, appID, path string) error {
 	if rc.HostingCacheInvalidateFn != nil {
 		return rc.HostingCacheInvalidateFn(groupID, appID, path)
 	}
-	return rc.Client.HostingCacheInvalidate(groupID, appID, path)
+	return rc.Client.HostingCacheInvalidate(ctx, groupID, appID, path)
 }
 
 // Functions calls the mocked Functions implementation if provided,
 // otherwise the call falls back to the underlying realm.Client implementation.
 // NOTE: this may panic if the underlying realm.Client is left undefined
-func (rc RealmClient) Functions(groupID, appID string) ([]realm.Function, error) {
+func (rc RealmClient) Functions(ctx context.Context, groupID, appID string) ([]realm.Function, error) {
 	if rc.FunctionsFn != nil {
 		return rc.FunctionsFn(groupID, appID)
 	}
-	return rc.Client.Functions(groupID, appID)
+	return rc.Client.Functions(ctx, groupID, appID)
 }
 
 // AppDebugExecuteFunction calls the mocked AppDebugExecuteFunction implementation if provided,
 // otherwise the call falls back to the underlying realm.Client implementation.
 // NOTE: this may panic if the underlying realm.Client is left undefined
-func (rc RealmClient) AppDebugExecuteFunction(groupID, appID, userID, name string, args []interface{}) (realm.ExecutionResults, error) {
+func (rc RealmClient) AppDebugExecuteFunction(ctx context.Context, groupID, appID, userID, name string, args []interface{}) (realm.ExecutionResults, error) {
 	if rc.AppDebugExecuteFunctionFn != nil {
 		return rc.AppDebugExecuteFunctionFn(groupID, appID, userID, name, args)
 	}
-	return rc.Client.AppDebugExecuteFunction(groupID, appID, userID, name, args)
+	return rc.Client.AppDebugExecuteFunction(ctx, groupID, appID, userID, name, args)
 }
 
 // Logs calls the mocked Logs implementation if provided,
 // otherwise the call falls back to the underlying realm.Client implementation.
 // NOTE: this may panic if the underlying realm.Client is left undefined
-func (rc RealmClient) Logs(groupID, appID string, opts realm.LogsOptions) (realm.Logs, error) {
+func (rc RealmClient) Logs(ctx context.Context, groupID, appID string, opts realm.LogsOptions) (realm.Logs, error) {
 	if rc.LogsFn != nil {
 		return rc.LogsFn(groupID, appID, opts)
 	}
-	return rc.Client.Logs(groupID, appID, opts)
+	return rc.Client.Logs(ctx, groupID, appID, opts)
+}
+
+// LogsPage calls the mocked LogsPage implementation if provided,
+// otherwise the call falls back to the underlying realm.Client implementation.
+// NOTE: this may panic if the underlying realm.Client is left undefined
+func (rc RealmClient) LogsPage(ctx context.Context, groupID, appID string, opts realm.LogsOptions) (realm.LogsPage, error) {
+	if rc.LogsPageFn != nil {
+		return rc.LogsPageFn(groupID, appID, opts)
+	}
+	return rc.Client.LogsPage(ctx, groupID, appID, opts)
 }
 
 // SchemaModels calls the mocked SchemaModels implementation if provided,
 // otherwise the call falls back to the underlying realm.Client implementation.
 // NOTE: this may panic if the underlying realm.Client is left undefined
-func (rc RealmClient) SchemaModels(groupID, appID, language string) ([]realm.SchemaModel, error) {
+func (rc RealmClient) SchemaModels(ctx context.Context, groupID, appID, language string) ([]realm.SchemaModel, error) {
 	if rc.SchemaModelsFn != nil {
 		return rc.SchemaModelsFn(groupID, appID, language)
 	}
-	return rc.Client.SchemaModels(groupID, appID, language)
+	return rc.Client.SchemaModels(ctx, groupID, appID, language)
 }
 
 // AllTemplates calls the mocked AllTemplates implementation if provided,
 // otherwise the call falls back to the underlying realm.Client implementation.
 // NOTE: this may panic if the underlying realm.Client is left undefined
-func (rc RealmClient) AllTemplates() (realm.Templates, error) {
+func (rc RealmClient) AllTemplates(ctx context.Context) (realm.Templates, error) {
 	if rc.AllTemplatesFn != nil {
 		return rc.AllTemplatesFn()
 	}
-	return rc.Client.AllTemplates()
+	return rc.Client.AllTemplates(ctx)
 }
 
 // ClientTemplate calls the mocked ClientTemplate implementation if provided,
 // otherwise the call falls back to the underlying realm.Client implementation.
 // NOTE: this may panic if the underlying realm.Client is left undefined
-func (rc RealmClient) ClientTemplate(groupID, appID, templateID string) (*zip.Reader, bool, error) {
+func (rc RealmClient) ClientTemplate(ctx context.Context, groupID, appID, templateID string) (*zip.Reader, bool, error) {
 	if rc.ClientTemplateFn != nil {
 		return rc.ClientTemplateFn(groupID, appID, templateID)
 	}
-	return rc.Client.ClientTemplate(groupID, appID, templateID)
+	return rc.Client.ClientTemplate(ctx, groupID, appID, templateID)
 }
 
 // CompatibleTemplates calls the mocked CompatibleTemplates implementation if provided,
 // otherwise the call falls back to the underlying realm.Client implementation.
 // NOTE: this may panic if the underlying realm.Client is left undefined
-func (rc RealmClient) CompatibleTemplates(groupID, appID string) (realm.Templates, error) {
+func (rc RealmClient) CompatibleTemplates(ctx context.Context, groupID, appID string) (realm.Templates, error) {
 	if rc.CompatibleTemplatesFn != nil {
 		return rc.CompatibleTemplatesFn(groupID, appID)
 	}
-	return rc.Client.CompatibleTemplates(groupID, appID)
+	return rc.Client.CompatibleTemplates(ctx, groupID, appID)
 }
 
 // AllowedIPs calls the mocked AllowedIPs implementation if provided,
 // otherwise the call falls back to the underlying realm.Client implementation.
 // NOTE: this may panic if the underlying realm.Client is left undefined
-func (rc RealmClient) AllowedIPs(groupID, appID string) ([]realm.AllowedIP, error) {
+func (rc RealmClient) AllowedIPs(ctx context.Context, groupID, appID string) ([]realm.AllowedIP, error) {
 	if rc.AllowedIPsFn != nil {
 		return rc.AllowedIPsFn(groupID, appID)
 	}
-	return rc.Client.AllowedIPs(groupID, appID)
+	return rc.Client.AllowedIPs(ctx, groupID, appID)
 }
 
 // AllowedIPCreate calls the mocked AllowedIPCreate implementation if provided,
 // otherwise the call falls back to the underlying realm.Client implementation.
 // NOTE: this may panic if the underlying realm.Client is left undefined
-func (rc RealmClient) AllowedIPCreate(groupID, appID, address, comment string, useCurrent bool) (realm.AllowedIP, error) {
+func (rc RealmClient) AllowedIPCreate(ctx context.Context, groupID, appID, address, comment string, useCurrent bool) (realm.AllowedIP, error) {
 	if rc.AllowedIPCreateFn != nil {
 		return rc.AllowedIPCreateFn(groupID, appID, address, comment, useCurrent)
 	}
-	return rc.AllowedIPCreate(groupID, appID, address, comment, useCurrent)
+	return rc.AllowedIPCreate(ctx, groupID, appID, address, comment, useCurrent)
 }
 
 // AllowedIPUpdate calls the mocked AllowedIPUpdate implementation if provided,
 // otherwise the call falls back to the underlying realm.Client implementation.
 // NOTE: this may panic if the underlying realm.Client is left undefined
-func (rc RealmClient) AllowedIPUpdate(groupID, appID, allowedIPID, newAddress, newComment string) error {
+func (rc RealmClient) AllowedIPUpdate(ctx context.Context, groupID, appID, allowedIPID, newAddress, newComment string) error {
 	if rc.AllowedIPUpdateFn != nil {
 		return rc.AllowedIPUpdateFn(groupID, appID, allowedIPID, newAddress, newComment)
 	}
-	return rc.Client.AllowedIPUpdate(groupID, appID, allowedIPID, newAddress, newComment)
+	return rc.Client.AllowedIPUpdate(ctx, groupID, appID, allowedIPID, newAddress, newComment)
 }
 
 // AllowedIPDelete calls the mocked AllowedIPDelete implementation if provided,
 // otherwise the call falls back to the underlying realm.Client implementation.
 // NOTE: this may panic if the underlying realm.Client is left undefined
-func (rc RealmClient) AllowedIPDelete(groupID, appID, allowedIPID string) error {
+func (rc RealmClient) AllowedIPDelete(ctx context.Context, groupID, appID, allowedIPID string) error {
 	if rc.AllowedIPDeleteFn != nil {
 		return rc.AllowedIPDeleteFn(groupID, appID, allowedIPID)
 	}
-	return rc.Client.AllowedIPDelete(groupID, appID, allowedIPID)
+	return rc.Client.AllowedIPDelete(ctx, groupID, appID, allowedIPID)
 }
 
 // Status calls the mocked Status implementation if provided,
 // otherwise the call falls back to the underlying realm.Client implementation.
 // NOTE: this may panic if the underlying realm.Client is left undefined
-func (rc RealmClient) Status() error {
+func (rc RealmClient) Status(ctx context.Context) error {
 	if rc.StatusFn != nil {
 		return rc.StatusFn()
 	}
-	return rc.Client.Status()
+	return rc.Client.Status(ctx)
 }
@@ -1,6 +1,7 @@
 package testutils
 
 import (
+	"context"
 	"os"
 	"strconv"
 	"testing"
@@ -167,7 +168,7 @@ var SkipUnlessAtlasServerRunning = func() func(t *testing.T) {
 			PublicAPIKey:  CloudUsername(),
 			PrivateAPIKey: CloudAPIKey(),
 		})
-		if err := client.Status(); err != nil {
+		if err := client.Status(context.Background()); err != nil {
 			atlasServerNotRunning = true
 			MustSkipf(t, "Atlas server not running at %s", AtlasServerURL())
 			return
@@ -189,7 +190,7 @@ var SkipUnlessRealmServerRunning = func() func(t *testing.T) {
 			return
 		}
 		client := realm.NewClient(RealmServerURL())
-		if err := client.Status(); err != nil {
+		if err := client.Status(context.Background()); err != nil {
 			realmServerNotRunning = true
 			MustSkipf(t, "Realm server not running at %s", RealmServerURL())
 			return
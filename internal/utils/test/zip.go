@@ -0,0 +1,29 @@
+package testutils
+
+import (
+	"archive/zip"
+	"bytes"
+	"testing"
+
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+)
+
+// NewZip constructs a new zip archive of the provided files, keyed by their path
+func NewZip(t *testing.T, files map[string]string) *zip.Reader {
+	t.Helper()
+
+	buf := new(bytes.Buffer)
+	w := zip.NewWriter(buf)
+	for name, data := range files {
+		f, err := w.Create(name)
+		assert.Nil(t, err)
+
+		_, err = f.Write([]byte(data))
+		assert.Nil(t, err)
+	}
+	assert.Nil(t, w.Close())
+
+	zipPkg, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	assert.Nil(t, err)
+	return zipPkg
+}